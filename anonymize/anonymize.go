@@ -0,0 +1,390 @@
+// Package anonymize redacts personal data from Azerbaijani text.
+//
+// Entities are found by ner.Recognize and proper names by coref.Resolve,
+// so every mention of a person, place, or organization is redacted,
+// including inflected ones ("Əliyevin"). Each is replaced by one of three
+// strategies:
+//
+//   - Placeholder: an Azerbaijani label in brackets ("[AD]" for names,
+//     "[TELEFON]", "[FİN]", "[ÜNVAN]", ...). This is the default.
+//   - Mask: every letter and digit becomes "*", keeping the shape of the
+//     original ("*******" for a FIN); phone numbers keep the +994 country
+//     code and mask their digits with "X", dropping separators
+//     ("+994XXXXXXXXX").
+//   - Hash: the placeholder label with a keyed hash of the original
+//     ("[TELEFON:9f86d081884c7d65]"). Equal values get equal hashes, so
+//     redacted records can still be joined; all mentions of one name
+//     share the hash of the cluster's display name. Requires a key.
+//
+// By default FIN, VOEN, Phone, Email, IBAN, LicensePlate, Card, and
+// Address entities and all names are redacted; Money, Percent, Date, and
+// URL entities are kept. WithTypes, WithoutNames, WithStrategy,
+// WithTypeStrategy, and WithNameStrategy change this.
+//
+// When an Anonymizer is created WithKey, Anonymize also returns a mapping
+// from each replacement back to the original text, encrypted with
+// AES-256-GCM under a key derived from the caller's key. The redacted text
+// and mapping can be stored together; only a holder of the key can
+// reverse the redaction with Restore.
+//
+// Two API layers are provided:
+//
+//   - Structured: Anonymizer.Anonymize returns a Result with the redacted
+//     text, the byte offsets of each replacement in it, and the mapping.
+//   - Convenience: Anonymize returns the text redacted with placeholders.
+//
+// Input longer than 1 MiB cannot be scanned and yields an empty Result,
+// never the unredacted text.
+//
+// All functions are safe for concurrent use by multiple goroutines.
+//
+// Known limitations:
+//
+//   - Names come from coref and include places ("Bakıya"); names written
+//     in capitals ("SOCAR", and the "FIN" of "FIN: ...") are kept as
+//     acronyms, and sentence-initial names seen nowhere else in the
+//     document are missed.
+//   - Pronouns referring to a person are not redacted.
+//   - Redaction is only as complete as ner and coref: unrecognized
+//     identifiers stay in the text.
+package anonymize
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/coref"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+)
+
+// maxInputBytes is the maximum input size. Larger inputs yield an empty
+// Result.
+const maxInputBytes = 1 << 20 // 1 MiB
+
+// nameLabel is the Redaction label of names found by coref.
+const nameLabel = "Name"
+
+// Strategy selects how a redacted span is replaced.
+type Strategy int
+
+const (
+	Placeholder Strategy = iota // Bracketed label: "[AD]", "[TELEFON]"
+	Mask                        // Letters and digits masked: "*******", "+994XXXXXXXXX"
+	Hash                        // Label and keyed hash: "[TELEFON:9f86d081884c7d65]"
+)
+
+// strategyNames maps Strategy values to their string names.
+var strategyNames = [...]string{
+	Placeholder: "Placeholder",
+	Mask:        "Mask",
+	Hash:        "Hash",
+}
+
+// strategyFromName maps string names back to Strategy values.
+var strategyFromName = map[string]Strategy{
+	"Placeholder": Placeholder,
+	"Mask":        Mask,
+	"Hash":        Hash,
+}
+
+// String returns the name of the strategy.
+func (s Strategy) String() string {
+	if int(s) >= 0 && int(s) < len(strategyNames) {
+		return strategyNames[s]
+	}
+	return fmt.Sprintf("Strategy(%d)", int(s))
+}
+
+// MarshalJSON encodes the strategy as a JSON string (e.g. "Mask").
+func (s Strategy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Mask") into a Strategy.
+func (s *Strategy) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	v, ok := strategyFromName[name]
+	if !ok {
+		return fmt.Errorf("anonymize: unknown strategy: %q", name)
+	}
+	*s = v
+	return nil
+}
+
+// Redaction is one replacement in a Result.
+type Redaction struct {
+	Text  string `json:"text"`  // The replacement as written in Result.Text
+	Start int    `json:"start"` // Byte offset in Result.Text (inclusive)
+	End   int    `json:"end"`   // Byte offset in Result.Text (exclusive)
+	Label string `json:"label"` // "Name", or the ner entity type ("Phone")
+}
+
+// String returns a debug representation, e.g. Phone("[TELEFON]")[5:14].
+func (r Redaction) String() string {
+	return fmt.Sprintf("%s(%q)[%d:%d]", r.Label, r.Text, r.Start, r.End)
+}
+
+// Result is the outcome of Anonymizer.Anonymize.
+type Result struct {
+	Text       string      `json:"text"`              // The redacted text
+	Redactions []Redaction `json:"redactions"`        // In text order; Text[r.Start:r.End] == r.Text
+	Mapping    []byte      `json:"mapping,omitempty"` // Encrypted originals for Restore; set only WithKey
+}
+
+// defaultTypes are the entity types redacted unless WithTypes is given:
+// the identifiers and contact details of a person.
+var defaultTypes = []ner.EntityType{ner.FIN, ner.VOEN, ner.Phone, ner.Email, ner.IBAN, ner.LicensePlate, ner.Card, ner.Address}
+
+// Anonymizer redacts personal data with configurable strategies. The zero
+// value is not valid; use New.
+//
+// An Anonymizer is immutable once created and safe for concurrent use by
+// multiple goroutines.
+type Anonymizer struct {
+	types        []ner.EntityType            // entity types to redact
+	names        bool                        // redact names found by coref
+	strategy     Strategy                    // default strategy
+	typeStrategy map[ner.EntityType]Strategy // per-type overrides
+	nameStrategy *Strategy                   // override for names
+	key          []byte                      // WithKey; nil without
+}
+
+// defaultAnonymizer backs Anonymize.
+var defaultAnonymizer = &Anonymizer{types: defaultTypes, names: true, strategy: Placeholder}
+
+// Option configures an Anonymizer created by New.
+type Option func(*Anonymizer) error
+
+// WithTypes sets the entity types to redact, replacing the default FIN,
+// VOEN, Phone, Email, IBAN, LicensePlate, Card, and Address. Names are
+// controlled separately by WithoutNames. Returns an error from New for an
+// unknown type.
+func WithTypes(types ...ner.EntityType) Option {
+	return func(a *Anonymizer) error {
+		for _, t := range types {
+			if !validType(t) {
+				return fmt.Errorf("anonymize: unknown entity type: %v", t)
+			}
+		}
+		a.types = slices.Clone(types)
+		return nil
+	}
+}
+
+// WithoutNames keeps the names found by coref in the text.
+func WithoutNames() Option {
+	return func(a *Anonymizer) error {
+		a.names = false
+		return nil
+	}
+}
+
+// WithStrategy sets the strategy of names and of entity types without a
+// strategy of their own. The default is Placeholder. Returns an error from
+// New for an unknown strategy.
+func WithStrategy(s Strategy) Option {
+	return func(a *Anonymizer) error {
+		if !validStrategy(s) {
+			return fmt.Errorf("anonymize: unknown strategy: %v", s)
+		}
+		a.strategy = s
+		return nil
+	}
+}
+
+// WithTypeStrategy sets the strategy of one entity type, e.g. Mask for
+// Phone while other types keep their placeholders. It does not add t to
+// the redacted types. Returns an error from New for an unknown type or
+// strategy.
+func WithTypeStrategy(t ner.EntityType, s Strategy) Option {
+	return func(a *Anonymizer) error {
+		if !validType(t) {
+			return fmt.Errorf("anonymize: unknown entity type: %v", t)
+		}
+		if !validStrategy(s) {
+			return fmt.Errorf("anonymize: unknown strategy: %v", s)
+		}
+		if a.typeStrategy == nil {
+			a.typeStrategy = make(map[ner.EntityType]Strategy)
+		}
+		a.typeStrategy[t] = s
+		return nil
+	}
+}
+
+// WithNameStrategy sets the strategy of names. Returns an error from New
+// for an unknown strategy.
+func WithNameStrategy(s Strategy) Option {
+	return func(a *Anonymizer) error {
+		if !validStrategy(s) {
+			return fmt.Errorf("anonymize: unknown strategy: %v", s)
+		}
+		a.nameStrategy = &s
+		return nil
+	}
+}
+
+// WithKey sets the secret key of the Hash strategy and of the mapping
+// returned with each Result. Returns an error from New for an empty key.
+func WithKey(key []byte) Option {
+	return func(a *Anonymizer) error {
+		if len(key) == 0 {
+			return fmt.Errorf("anonymize: empty key")
+		}
+		a.key = slices.Clone(key)
+		return nil
+	}
+}
+
+// New returns an Anonymizer configured by opts. Without options it
+// behaves like Anonymize. Returns an error when an option is invalid or
+// the Hash strategy is used without a key.
+func New(opts ...Option) (*Anonymizer, error) {
+	a := &Anonymizer{types: defaultTypes, names: true, strategy: Placeholder}
+	for _, opt := range opts {
+		if err := opt(a); err != nil {
+			return nil, err
+		}
+	}
+	if a.key == nil && a.usesHash() {
+		return nil, fmt.Errorf("anonymize: Hash strategy requires a key")
+	}
+	return a, nil
+}
+
+// usesHash reports whether any redacted span may use the Hash strategy.
+func (a *Anonymizer) usesHash() bool {
+	if a.names && a.strategyOfName() == Hash {
+		return true
+	}
+	for _, t := range a.types {
+		if a.strategyOf(t) == Hash {
+			return true
+		}
+	}
+	return false
+}
+
+// strategyOf returns the strategy of entity type t.
+func (a *Anonymizer) strategyOf(t ner.EntityType) Strategy {
+	if s, ok := a.typeStrategy[t]; ok {
+		return s
+	}
+	return a.strategy
+}
+
+// strategyOfName returns the strategy of names.
+func (a *Anonymizer) strategyOfName() Strategy {
+	if a.nameStrategy != nil {
+		return *a.nameStrategy
+	}
+	return a.strategy
+}
+
+// validType reports whether t is a known ner entity type.
+func validType(t ner.EntityType) bool {
+	return t >= ner.FIN && t <= ner.Address
+}
+
+// validStrategy reports whether s is a known strategy.
+func validStrategy(s Strategy) bool {
+	return s >= Placeholder && s <= Hash
+}
+
+// span is a part of the input to redact.
+type span struct {
+	start, end int
+	label      string   // Redaction label
+	strategy   Strategy // how to replace it
+	value      string   // what Hash hashes: the cluster name or the entity text
+	phone      bool     // Mask keeps the country code
+}
+
+// Anonymize redacts the personal data of text. Returns an empty Result
+// for empty or oversized (>1 MiB) input.
+func (a *Anonymizer) Anonymize(text string) Result {
+	if text == "" || len(text) > maxInputBytes {
+		return Result{}
+	}
+	spans := a.spans(text)
+
+	var (
+		res     Result
+		entries []mappingEntry
+		buf     = make([]byte, 0, len(text))
+		prev    = 0
+	)
+	for _, s := range spans {
+		buf = append(buf, text[prev:s.start]...)
+		repl := a.replace(text[s.start:s.end], s)
+		r := Redaction{Text: repl, Start: len(buf), End: len(buf) + len(repl), Label: s.label}
+		buf = append(buf, repl...)
+		res.Redactions = append(res.Redactions, r)
+		entries = append(entries, mappingEntry{Start: r.Start, End: r.End, Text: repl, Original: text[s.start:s.end]})
+		prev = s.end
+	}
+	buf = append(buf, text[prev:]...)
+	res.Text = string(buf)
+
+	if a.key != nil {
+		mapping, err := a.seal(entries)
+		if err != nil {
+			return Result{} // never return redacted text without its mapping
+		}
+		res.Mapping = mapping
+	}
+	return res
+}
+
+// spans returns the non-overlapping spans of text to redact, ordered by
+// start.
+func (a *Anonymizer) spans(text string) []span {
+	var spans []span
+	for _, c := range coref.Resolve(text) {
+		for _, m := range c.Mentions {
+			switch {
+			case m.Kind == coref.Name && a.names && !isAcronym(m.Text):
+				spans = append(spans, span{
+					start: m.Start, end: m.End, label: nameLabel,
+					strategy: a.strategyOfName(), value: c.Name,
+				})
+			case m.Kind == coref.Entity && slices.Contains(a.types, m.Entity.Type):
+				spans = append(spans, span{
+					start: m.Start, end: m.End, label: m.Entity.Type.String(),
+					strategy: a.strategyOf(m.Entity.Type), value: m.Text,
+					phone: m.Entity.Type == ner.Phone,
+				})
+			}
+		}
+	}
+	slices.SortFunc(spans, func(x, y span) int { return x.start - y.start })
+	return spans
+}
+
+// isAcronym reports whether every word of the name is written in capitals
+// ("SOCAR", "FIN", "ADA'nın"): field labels and organizations, not people.
+func isAcronym(name string) bool {
+	for _, w := range strings.Fields(name) {
+		if i := strings.IndexFunc(w, azcase.IsApostrophe); i >= 0 {
+			w = w[:i]
+		}
+		if utf8.RuneCountInString(w) < 2 || !azcase.IsAllUpper(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// Anonymize returns text with personal data replaced by placeholders:
+// "[AD]" for names, "[TELEFON]", "[FİN]", and so on for entities. Returns
+// "" for empty or oversized (>1 MiB) input.
+func Anonymize(text string) string {
+	return defaultAnonymizer.Anonymize(text).Text
+}
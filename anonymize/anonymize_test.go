@@ -0,0 +1,216 @@
+package anonymize
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+)
+
+const sample = "Müştəri İlham Əliyev (FIN: 5ARPXK2) +994 50 123 45 67 nömrəsindən zəng etdi. " +
+	"Əliyevin e-poçtu ilham@mail.az, ödəniş 150 manat idi."
+
+// verifyRedactions checks that every redaction is found at its offsets in
+// the redacted text, in order.
+func verifyRedactions(t *testing.T, res Result) {
+	t.Helper()
+	prev := 0
+	for _, r := range res.Redactions {
+		if r.Start < prev || r.End > len(res.Text) || res.Text[r.Start:r.End] != r.Text {
+			t.Errorf("redaction %v not found at its offsets in %q", r, res.Text)
+		}
+		prev = r.End
+	}
+}
+
+func TestAnonymize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			"names and identifiers",
+			sample,
+			"Müştəri [AD] (FIN: [FİN]) [TELEFON] nömrəsindən zəng etdi. [AD] e-poçtu [EMAİL], ödəniş 150 manat idi.",
+		},
+		{
+			"IBAN and address",
+			"Hesab: AZ21NABZ00000000137010001944. Ünvan: Bakı şəhəri, Nizami küçəsi 25.",
+			"Hesab: [IBAN]. Ünvan: [ÜNVAN].",
+		},
+		{"no personal data", "Bu gün hava yaxşıdır.", "Bu gün hava yaxşıdır."},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Anonymize(tt.in); got != tt.want {
+				t.Errorf("Anonymize(%q)\n got %q\nwant %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnonymizeOversized(t *testing.T) {
+	t.Parallel()
+	text := strings.Repeat("Zəng: 050 123 45 67. ", maxInputBytes/20)
+	a, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := a.Anonymize(text); res.Text != "" || res.Redactions != nil {
+		t.Errorf("Anonymize(oversized) returned %d bytes, want an empty Result", len(res.Text))
+	}
+}
+
+func TestStrategies(t *testing.T) {
+	t.Parallel()
+	key := []byte("secret")
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			"mask",
+			[]Option{WithStrategy(Mask)},
+			"Müştəri ***** ****** (FIN: *******) +994XXXXXXXXX nömrəsindən zəng etdi. ******** e-poçtu *****@****.**, ödəniş 150 manat idi.",
+		},
+		{
+			"mask phones only, names kept",
+			[]Option{WithTypes(ner.Phone), WithTypeStrategy(ner.Phone, Mask), WithoutNames()},
+			"Müştəri İlham Əliyev (FIN: 5ARPXK2) +994XXXXXXXXX nömrəsindən zəng etdi. Əliyevin e-poçtu ilham@mail.az, ödəniş 150 manat idi.",
+		},
+		{
+			"name strategy",
+			[]Option{WithStrategy(Mask), WithNameStrategy(Placeholder), WithTypes(ner.Money)},
+			"Müştəri [AD] (FIN: 5ARPXK2) +994 50 123 45 67 nömrəsindən zəng etdi. [AD] e-poçtu ilham@mail.az, ödəniş *** ***** idi.",
+		},
+		{
+			"hash",
+			[]Option{WithKey(key), WithStrategy(Hash), WithTypes(ner.FIN)},
+			"Müştəri [AD:c4add392f858d43f] (FIN: [FİN:498a4c3757bf6f04]) +994 50 123 45 67 nömrəsindən zəng etdi. [AD:c4add392f858d43f] e-poçtu ilham@mail.az, ödəniş 150 manat idi.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			a, err := New(tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := a.Anonymize(sample)
+			verifyRedactions(t, res)
+			if res.Text != tt.want {
+				t.Errorf("Anonymize\n got %q\nwant %q", res.Text, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashDependsOnKey(t *testing.T) {
+	t.Parallel()
+	a1, _ := New(WithKey([]byte("one")), WithStrategy(Hash))
+	a2, _ := New(WithKey([]byte("two")), WithStrategy(Hash))
+	text := "Zəng: 050 123 45 67."
+	if a1.Anonymize(text).Text == a2.Anonymize(text).Text {
+		t.Error("hashes under different keys are equal")
+	}
+	if a1.Anonymize(text).Text != a1.Anonymize(text).Text {
+		t.Error("hash is not deterministic")
+	}
+}
+
+func TestRedactions(t *testing.T) {
+	t.Parallel()
+	a, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := a.Anonymize(sample)
+	verifyRedactions(t, res)
+	var labels []string
+	for _, r := range res.Redactions {
+		labels = append(labels, r.Label)
+	}
+	if got, want := strings.Join(labels, " "), "Name FIN Phone Name Email"; got != want {
+		t.Errorf("labels = %s, want %s", got, want)
+	}
+	if res.Mapping != nil {
+		t.Error("Mapping set without a key")
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{"hash without key", []Option{WithStrategy(Hash)}},
+		{"name hash without key", []Option{WithNameStrategy(Hash)}},
+		{"type hash without key", []Option{WithTypeStrategy(ner.Phone, Hash)}},
+		{"empty key", []Option{WithKey(nil)}},
+		{"unknown strategy", []Option{WithStrategy(Strategy(7))}},
+		{"unknown type", []Option{WithTypes(ner.EntityType(42))}},
+		{"unknown type strategy", []Option{WithTypeStrategy(ner.EntityType(-1), Mask)}},
+	}
+	for _, tt := range tests {
+		if _, err := New(tt.opts...); err == nil {
+			t.Errorf("%s: New succeeded", tt.name)
+		}
+	}
+	// Hash for a type that is not redacted needs no key.
+	if _, err := New(WithTypes(ner.Phone), WithTypeStrategy(ner.Email, Hash), WithoutNames()); err != nil {
+		t.Errorf("New with unused Hash: %v", err)
+	}
+}
+
+func TestStrategyJSON(t *testing.T) {
+	t.Parallel()
+	for _, s := range []Strategy{Placeholder, Mask, Hash} {
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Strategy
+		if err := json.Unmarshal(data, &got); err != nil || got != s {
+			t.Errorf("round trip of %v = %v, %v", s, got, err)
+		}
+	}
+	var s Strategy
+	if err := json.Unmarshal([]byte(`"Shuffle"`), &s); err == nil {
+		t.Error("Unmarshal(Shuffle) succeeded")
+	}
+	if got := Strategy(5).String(); got != "Strategy(5)" {
+		t.Errorf("Strategy(5).String() = %q", got)
+	}
+}
+
+func BenchmarkAnonymize(b *testing.B) {
+	text := strings.Repeat(sample+" ", 20)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		Anonymize(text)
+	}
+}
+
+func ExampleAnonymize() {
+	fmt.Println(Anonymize("Müştəri Nigar Məmmədova 050 123 45 67 nömrəsindən zəng etdi."))
+	// Output:
+	// Müştəri [AD] [TELEFON] nömrəsindən zəng etdi.
+}
+
+func ExampleNew() {
+	a, err := New(WithTypeStrategy(ner.Phone, Mask), WithTypeStrategy(ner.FIN, Mask))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(a.Anonymize("Vüqar Həsənov, FIN 5ARPXK2, tel. +994 50 123 45 67").Text)
+	// Output:
+	// [AD], FIN *******, tel. +994XXXXXXXXX
+}
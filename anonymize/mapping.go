@@ -0,0 +1,108 @@
+package anonymize
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// mappingFormatVersion is the version of the encrypted mapping format.
+const mappingFormatVersion = 1
+
+// mappingKeyLabel derives the mapping encryption key from the caller's
+// key, keeping it apart from the key of the Hash strategy.
+const mappingKeyLabel = "anonymize mapping"
+
+// mappingJSON is the plaintext of an encrypted mapping.
+type mappingJSON struct {
+	Version int            `json:"version"`
+	Entries []mappingEntry `json:"entries"`
+}
+
+// mappingEntry records one replacement: its span in the redacted text and
+// the original it replaced.
+type mappingEntry struct {
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+	Text     string `json:"text"`
+	Original string `json:"original"`
+}
+
+// aead returns the AES-256-GCM cipher keyed by the HMAC-SHA256 of
+// mappingKeyLabel under the caller's key.
+func (a *Anonymizer) aead() (cipher.AEAD, error) {
+	h := hmac.New(sha256.New, a.key)
+	h.Write([]byte(mappingKeyLabel))
+	block, err := aes.NewCipher(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts entries as a versioned JSON document, prefixed with a
+// random nonce.
+func (a *Anonymizer) seal(entries []mappingEntry) ([]byte, error) {
+	plain, err := json.Marshal(mappingJSON{Version: mappingFormatVersion, Entries: entries})
+	if err != nil {
+		return nil, err
+	}
+	aead, err := a.aead()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plain)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plain, nil), nil
+}
+
+// Restore reverses the redaction of text, the Text of a Result, using its
+// Mapping. The Anonymizer must have been created with the same key.
+// Returns an error without a key, when the mapping cannot be decrypted
+// (another key, or a damaged mapping), or when text no longer holds the
+// replacements at their recorded offsets.
+func (a *Anonymizer) Restore(text string, mapping []byte) (string, error) {
+	if a.key == nil {
+		return "", fmt.Errorf("anonymize: Restore requires a key")
+	}
+	aead, err := a.aead()
+	if err != nil {
+		return "", fmt.Errorf("anonymize: restoring: %w", err)
+	}
+	if len(mapping) < aead.NonceSize() {
+		return "", fmt.Errorf("anonymize: invalid mapping")
+	}
+	nonce, sealed := mapping[:aead.NonceSize()], mapping[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", fmt.Errorf("anonymize: invalid mapping: %w", err)
+	}
+	var mj mappingJSON
+	if err := json.Unmarshal(plain, &mj); err != nil {
+		return "", fmt.Errorf("anonymize: decoding mapping: %w", err)
+	}
+	if mj.Version != mappingFormatVersion {
+		return "", fmt.Errorf("anonymize: unsupported mapping version %d", mj.Version)
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+	prev := 0
+	for _, e := range mj.Entries {
+		if e.Start < prev || e.End > len(text) || e.Start > e.End || text[e.Start:e.End] != e.Text {
+			return "", fmt.Errorf("anonymize: mapping does not match text at [%d:%d]", e.Start, e.End)
+		}
+		b.WriteString(text[prev:e.Start])
+		b.WriteString(e.Original)
+		prev = e.End
+	}
+	b.WriteString(text[prev:])
+	return b.String(), nil
+}
@@ -0,0 +1,87 @@
+package anonymize
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+	a, err := New(WithKey([]byte("secret")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := a.Anonymize(sample)
+	if res.Mapping == nil {
+		t.Fatal("Mapping not set with a key")
+	}
+	got, err := a.Restore(res.Text, res.Mapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != sample {
+		t.Errorf("Restore = %q, want %q", got, sample)
+	}
+
+	// Text added around the redacted text breaks the recorded offsets.
+	if _, err := a.Restore("Qeyd: "+res.Text, res.Mapping); err == nil {
+		t.Error("Restore of shifted text succeeded")
+	}
+}
+
+func TestRestoreErrors(t *testing.T) {
+	t.Parallel()
+	a, _ := New(WithKey([]byte("secret")))
+	other, _ := New(WithKey([]byte("other")))
+	noKey, _ := New()
+	res := a.Anonymize(sample)
+
+	damaged := append([]byte(nil), res.Mapping...)
+	damaged[len(damaged)-1] ^= 1
+
+	tests := []struct {
+		name    string
+		a       *Anonymizer
+		mapping []byte
+	}{
+		{"no key", noKey, res.Mapping},
+		{"other key", other, res.Mapping},
+		{"damaged", a, damaged},
+		{"truncated", a, res.Mapping[:4]},
+		{"empty", a, nil},
+	}
+	for _, tt := range tests {
+		if _, err := tt.a.Restore(res.Text, tt.mapping); err == nil {
+			t.Errorf("%s: Restore succeeded", tt.name)
+		}
+	}
+}
+
+func TestMappingNonDeterministic(t *testing.T) {
+	t.Parallel()
+	a, _ := New(WithKey([]byte("secret")))
+	r1, r2 := a.Anonymize(sample), a.Anonymize(sample)
+	if r1.Text != r2.Text {
+		t.Error("redacted text differs between runs")
+	}
+	if string(r1.Mapping) == string(r2.Mapping) {
+		t.Error("mappings of two runs are equal; nonce not random")
+	}
+}
+
+func ExampleAnonymizer_Restore() {
+	a, err := New(WithKey([]byte("secret")))
+	if err != nil {
+		panic(err)
+	}
+	res := a.Anonymize("Zəng: 050 123 45 67.")
+	fmt.Println(res.Text)
+	orig, err := a.Restore(res.Text, res.Mapping)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(orig)
+	// Output:
+	// Zəng: [TELEFON].
+	// Zəng: 050 123 45 67.
+}
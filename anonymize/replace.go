@@ -0,0 +1,83 @@
+package anonymize
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// hashLen is the number of hex digits of the keyed hash kept by the Hash
+// strategy: 64 bits, ample to keep the values of one corpus apart.
+const hashLen = 16
+
+// countryCode is the prefix Mask keeps on phone numbers.
+const countryCode = "+994"
+
+// placeholders maps Redaction labels to their bracketed Azerbaijani
+// labels.
+var placeholders = map[string]string{
+	nameLabel:      "AD",
+	"FIN":          "FİN",
+	"VOEN":         "VÖEN",
+	"Phone":        "TELEFON",
+	"Email":        "EMAİL",
+	"IBAN":         "IBAN",
+	"LicensePlate": "NÖMRƏ",
+	"URL":          "URL",
+	"Card":         "KART",
+	"Money":        "MƏBLƏĞ",
+	"Percent":      "FAİZ",
+	"Date":         "TARİX",
+	"Address":      "ÜNVAN",
+}
+
+// replace returns the replacement of original, the text of span s.
+func (a *Anonymizer) replace(original string, s span) string {
+	label := placeholders[s.label]
+	switch s.strategy {
+	case Mask:
+		return mask(original, s.phone)
+	case Hash:
+		return "[" + label + ":" + a.hash(s.label, s.value) + "]"
+	}
+	return "[" + label + "]"
+}
+
+// mask replaces each letter and digit of s with '*', keeping spaces and
+// punctuation. Phone numbers keep a leading +994, mask digits with 'X',
+// and drop the rest.
+func mask(s string, phone bool) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	if phone && strings.HasPrefix(s, countryCode) {
+		b.WriteString(countryCode)
+		s = s[len(countryCode):]
+	}
+	for _, r := range s {
+		switch {
+		case phone && unicode.IsDigit(r):
+			b.WriteByte('X')
+		case phone: // separators are dropped
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteByte('*')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// hash returns the keyed hash of value under label: the first 16 hex
+// digits of the HMAC-SHA256 of label and the lowercase value,
+// NUL-separated.
+func (a *Anonymizer) hash(label, value string) string {
+	h := hmac.New(sha256.New, a.key)
+	h.Write([]byte(label))
+	h.Write([]byte{0})
+	h.Write([]byte(azcase.ToLower(value)))
+	return hex.EncodeToString(h.Sum(nil))[:hashLen]
+}
@@ -0,0 +1,165 @@
+// Package cache memoizes module outputs keyed by content hash.
+//
+// Repeated processing of identical documents (ingestion retries, fan-out
+// to several services) recomputes the same tokenization, stems, sentiment,
+// and entities. A [Cache] stores each result under a SHA-256 key derived
+// from the module name, the module's output version, and the input text,
+// so a hit skips the computation entirely.
+//
+// Two API layers are provided:
+//
+//   - Structured: [Cache] wraps a [Store] with typed methods for common
+//     modules ([Cache.Tokens], [Cache.Stems], [Cache.Sentiment],
+//     [Cache.Entities], [Cache.EntitiesAt]).
+//   - Generic: [Memoize] wraps any func(string) T whose result is
+//     JSON-encodable.
+//
+// Values are stored as JSON bytes, so a [Store] can be backed by any
+// byte-oriented system (Redis, memcached, a database table). [LRU] is the
+// built-in in-memory store.
+//
+// Cache keys include a per-module version. Bump the version whenever a
+// module's output changes for the same input, so stale entries in shared
+// stores are never served.
+//
+// All functions are safe for concurrent use by multiple goroutines, provided
+// the Store is.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/sentiment"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// Module output versions used in cache keys.
+const (
+	tokensVersion    = "1"
+	stemsVersion     = "4"
+	sentimentVersion = "4"
+	entitiesVersion  = "4"
+)
+
+// Store is a byte-oriented key/value store. Implementations must be safe
+// for concurrent use. Get must not return a slice the store will mutate.
+type Store interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+}
+
+// Key returns the cache key for text processed by module at version:
+// the hex SHA-256 of the three parts, NUL-separated.
+func Key(module, version, text string) string {
+	h := sha256.New()
+	h.Write([]byte(module))
+	h.Write([]byte{0})
+	h.Write([]byte(version))
+	h.Write([]byte{0})
+	h.Write([]byte(text))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Memoize returns fn wrapped with a cache lookup in store. Results are
+// JSON-encoded; entries that fail to decode are recomputed and overwritten.
+// A nil store disables caching and returns fn unchanged.
+func Memoize[T any](store Store, module, version string, fn func(string) T) func(string) T {
+	return memoizeIf(store, module, version, fn, nil)
+}
+
+// memoizeIf is like Memoize but stores a computed result only when keep
+// reports true for it. A nil keep stores every result.
+func memoizeIf[T any](store Store, module, version string, fn func(string) T, keep func(T) bool) func(string) T {
+	if store == nil {
+		return fn
+	}
+	return func(text string) T {
+		key := Key(module, version, text)
+		if data, ok := store.Get(key); ok {
+			var v T
+			if err := json.Unmarshal(data, &v); err == nil {
+				return v
+			}
+		}
+		v := fn(text)
+		if keep != nil && !keep(v) {
+			return v
+		}
+		if data, err := json.Marshal(v); err == nil {
+			store.Set(key, data)
+		}
+		return v
+	}
+}
+
+// Cache memoizes the outputs of common modules in a Store.
+// The zero value is not usable; construct with New.
+type Cache struct {
+	store     Store
+	tokens    func(string) []tokenizer.Token
+	stems     func(string) []string
+	sentiment func(string) sentiment.Result
+	entities  func(string) []ner.Entity
+}
+
+// New returns a Cache backed by store. A nil store disables caching.
+func New(store Store) *Cache {
+	return &Cache{
+		store:     store,
+		tokens:    Memoize(store, "tokenizer.WordTokens", tokensVersion, tokenizer.WordTokens),
+		stems:     Memoize(store, "morph.Stems", stemsVersion, stemText),
+		sentiment: Memoize(store, "sentiment.Analyze", sentimentVersion, sentiment.Analyze),
+		entities:  memoizeIf(store, "ner.Recognize", entitiesVersion, ner.Recognize, undated),
+	}
+}
+
+// Tokens returns tokenizer.WordTokens(text), cached.
+func (c *Cache) Tokens(text string) []tokenizer.Token {
+	return c.tokens(text)
+}
+
+// Stems returns morph.Stems(tokenizer.Words(text)), cached.
+func (c *Cache) Stems(text string) []string {
+	return c.stems(text)
+}
+
+// Sentiment returns sentiment.Analyze(text), cached.
+func (c *Cache) Sentiment(text string) sentiment.Result {
+	return c.sentiment(text)
+}
+
+// Entities returns ner.Recognize(text), cached. Date entities depend on
+// the current time ("sabah", "3 gün sonra"), so results that contain one
+// are recomputed on every call rather than stored; use EntitiesAt to
+// cache them against a fixed reference time.
+func (c *Cache) Entities(text string) []ner.Entity {
+	return c.entities(text)
+}
+
+// EntitiesAt returns ner.RecognizeAt(text, ref), cached under a key that
+// includes ref. A zero ref means the current time, as in Entities.
+func (c *Cache) EntitiesAt(text string, ref time.Time) []ner.Entity {
+	if ref.IsZero() {
+		return c.Entities(text)
+	}
+	version := entitiesVersion + "@" + ref.Format(time.RFC3339Nano)
+	return Memoize(c.store, "ner.RecognizeAt", version, func(s string) []ner.Entity {
+		return ner.RecognizeAt(s, ref)
+	})(text)
+}
+
+// undated reports whether entities hold no Date entity, so that they do
+// not depend on the time Recognize was called.
+func undated(entities []ner.Entity) bool {
+	return !slices.ContainsFunc(entities, func(e ner.Entity) bool { return e.Type == ner.Date })
+}
+
+func stemText(text string) []string {
+	return morph.Stems(tokenizer.Words(text))
+}
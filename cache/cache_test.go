@@ -0,0 +1,178 @@
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/sentiment"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const sample = "Bu kitab çox yaxşıdır. Əlaqə: +994501234567, info@gov.az"
+
+func TestKey(t *testing.T) {
+	k := Key("m", "1", "text")
+	if len(k) != 64 {
+		t.Errorf("Key length = %d, want 64", len(k))
+	}
+	if Key("m", "1", "text") != k {
+		t.Error("Key is not deterministic")
+	}
+	for _, other := range []string{
+		Key("m", "2", "text"),
+		Key("n", "1", "text"),
+		Key("m", "1", "text2"),
+		Key("m1", "", "text"), // part boundaries must matter
+	} {
+		if other == k {
+			t.Errorf("Key collision: %s", other)
+		}
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	fn := func(s string) []string {
+		calls++
+		return []string{s, s}
+	}
+	store := NewLRU(10)
+	m := Memoize(store, "dup", "1", fn)
+
+	for range 3 {
+		if got := m("a"); !reflect.DeepEqual(got, []string{"a", "a"}) {
+			t.Errorf("m(a) = %v", got)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+	m("b")
+	if calls != 2 {
+		t.Errorf("fn called %d times, want 2", calls)
+	}
+}
+
+func TestMemoizeCorruptEntry(t *testing.T) {
+	store := NewLRU(10)
+	store.Set(Key("len", "1", "abc"), []byte("not json"))
+	m := Memoize(store, "len", "1", func(s string) int { return len(s) })
+	if got := m("abc"); got != 3 {
+		t.Errorf("m(abc) = %d, want 3", got)
+	}
+	if data, _ := store.Get(Key("len", "1", "abc")); string(data) != "3" {
+		t.Errorf("corrupt entry not overwritten: %q", data)
+	}
+}
+
+func TestMemoizeNilStore(t *testing.T) {
+	calls := 0
+	m := Memoize[int](nil, "len", "1", func(s string) int { calls++; return len(s) })
+	m("a")
+	m("a")
+	if calls != 2 {
+		t.Errorf("fn called %d times, want 2", calls)
+	}
+}
+
+func TestCacheMatchesModules(t *testing.T) {
+	c := New(NewLRU(0))
+	for range 2 { // second pass is served from the store
+		if got, want := c.Tokens(sample), tokenizer.WordTokens(sample); !reflect.DeepEqual(got, want) {
+			t.Errorf("Tokens = %v, want %v", got, want)
+		}
+		if got, want := c.Stems(sample), morph.Stems(tokenizer.Words(sample)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Stems = %v, want %v", got, want)
+		}
+		if got, want := c.Sentiment(sample), sentiment.Analyze(sample); !reflect.DeepEqual(got, want) {
+			t.Errorf("Sentiment = %v, want %v", got, want)
+		}
+		if got, want := c.Entities(sample), ner.Recognize(sample); !reflect.DeepEqual(got, want) {
+			t.Errorf("Entities = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCacheModulesDoNotCollide(t *testing.T) {
+	store := NewLRU(0)
+	c := New(store)
+	c.Tokens(sample)
+	c.Stems(sample)
+	c.Sentiment(sample)
+	c.Entities(sample)
+	if n := store.Len(); n != 4 {
+		t.Errorf("store holds %d entries, want 4", n)
+	}
+}
+
+func TestCacheEntitiesDated(t *testing.T) {
+	const text = "Görüş 3 gün sonra, əlaqə: info@gov.az"
+	store := NewLRU(0)
+	c := New(store)
+
+	// Results with a relative date depend on the call time: not stored.
+	c.Entities(text)
+	if n := store.Len(); n != 0 {
+		t.Errorf("store holds %d entries after Entities, want 0", n)
+	}
+
+	ref := time.Date(2026, 3, 10, 9, 30, 0, 0, time.UTC)
+	for range 2 { // second pass is served from the store
+		if got, want := c.EntitiesAt(text, ref), ner.RecognizeAt(text, ref); !reflect.DeepEqual(got, want) {
+			t.Errorf("EntitiesAt = %v, want %v", got, want)
+		}
+	}
+	if n := store.Len(); n != 1 {
+		t.Errorf("store holds %d entries after EntitiesAt, want 1", n)
+	}
+	later := c.EntitiesAt(text, ref.AddDate(0, 0, 1))
+	if i := slices.IndexFunc(later, func(e ner.Entity) bool { return e.Type == ner.Date }); i < 0 ||
+		!later[i].Time.Equal(ref.AddDate(0, 0, 4)) {
+		t.Errorf("EntitiesAt(ref+1d) = %v, want date %v", later, ref.AddDate(0, 0, 4))
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	c := New(NewLRU(8))
+	var wg sync.WaitGroup
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			text := fmt.Sprintf("%s %d", sample, i%4)
+			for range 50 {
+				c.Entities(text)
+				c.Sentiment(text)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkCacheEntitiesHit(b *testing.B) {
+	c := New(NewLRU(0))
+	c.Entities(sample)
+	for b.Loop() {
+		c.Entities(sample)
+	}
+}
+
+func ExampleMemoize() {
+	store := NewLRU(100)
+	stems := Memoize(store, "stems", "1", func(s string) []string {
+		fmt.Println("computing")
+		return morph.Stems(tokenizer.Words(s))
+	})
+	fmt.Println(stems("kitablar evlərdə"))
+	fmt.Println(stems("kitablar evlərdə"))
+	// Output:
+	// computing
+	// [kitab ev]
+	// [kitab ev]
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+const defaultCapacity = 1024 // LRU capacity when NewLRU is given a non-positive size
+
+// LRU is an in-memory Store that evicts the least recently used entry
+// once it holds capacity entries. Safe for concurrent use.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List               // front = most recently used
+	items    map[string]*list.Element // key -> element holding *lruEntry
+	hits     uint64
+	misses   uint64
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// Stats reports LRU usage counters.
+type Stats struct {
+	Len    int    `json:"len"`    // entries currently held
+	Hits   uint64 `json:"hits"`   // Get calls that found the key
+	Misses uint64 `json:"misses"` // Get calls that did not
+}
+
+// NewLRU returns an LRU holding at most capacity entries.
+// A non-positive capacity uses a default of 1024.
+func NewLRU(capacity int) *LRU {
+	if capacity <= 0 {
+		capacity = defaultCapacity
+	}
+	return &LRU{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value stored under key and marks it most recently used.
+func (c *LRU) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry).value, true
+}
+
+// Set stores value under key, evicting the least recently used entry if
+// the cache is full. The LRU keeps value; callers must not modify it.
+func (c *LRU) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.order.PushFront(&lruEntry{key: key, value: value})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Len returns the number of entries held.
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Stats returns a snapshot of usage counters.
+func (c *LRU) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{Len: c.order.Len(), Hits: c.hits, Misses: c.misses}
+}
@@ -0,0 +1,62 @@
+package cache
+
+import "testing"
+
+func TestLRUEviction(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"))
+	c.Set("b", []byte("2"))
+	c.Get("a") // a is now most recently used
+	c.Set("c", []byte("3"))
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("b should have been evicted")
+	}
+	for _, k := range []string{"a", "c"} {
+		if _, ok := c.Get(k); !ok {
+			t.Errorf("%s should be present", k)
+		}
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len = %d, want 2", c.Len())
+	}
+}
+
+func TestLRUOverwrite(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", []byte("1"))
+	c.Set("a", []byte("2"))
+	if v, _ := c.Get("a"); string(v) != "2" {
+		t.Errorf("Get(a) = %q, want 2", v)
+	}
+	if c.Len() != 1 {
+		t.Errorf("Len = %d, want 1", c.Len())
+	}
+}
+
+func TestLRUStats(t *testing.T) {
+	c := NewLRU(0)
+	c.Set("a", nil)
+	c.Get("a")
+	c.Get("a")
+	c.Get("missing")
+	want := Stats{Len: 1, Hits: 2, Misses: 1}
+	if got := c.Stats(); got != want {
+		t.Errorf("Stats = %+v, want %+v", got, want)
+	}
+}
+
+func BenchmarkLRU(b *testing.B) {
+	c := NewLRU(128)
+	keys := make([]string, 256)
+	for i := range keys {
+		keys[i] = Key("bench", "1", string(rune('a'+i%26))+string(rune(i)))
+	}
+	for b.Loop() {
+		for _, k := range keys {
+			if _, ok := c.Get(k); !ok {
+				c.Set(k, []byte(k))
+			}
+		}
+	}
+}
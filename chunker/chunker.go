@@ -1,22 +1,36 @@
 // Package chunker splits Azerbaijani text into overlapping or non-overlapping
 // chunks suitable for RAG/LLM pipelines.
 //
-// Three strategies are provided:
+// Five strategies are provided:
 //
 //   - BySize: pure rune-count splitting with no language awareness.
 //   - BySentence: sentence-boundary aware splitting via the tokenizer package.
 //   - Recursive: hierarchical splitting (paragraph > sentence > word > rune)
 //     with greedy merge-back. This is the default used by the Chunks convenience
 //     function.
+//   - ByTokens: sentence packing measured in tokens by a pluggable
+//     TokenCounter (default WordCount), for LLM context windows.
+//   - ByStructure: Markdown/plain-text structure aware packing. Headings open
+//     sections; list items, table rows, and headings are never split from
+//     their section, and each chunk records its Heading and heading Path.
 //
 // Two API layers:
 //
-//   - Structured: BySize, BySentence, and Recursive return []Chunk with byte
+//   - Structured: BySize, BySentence, Recursive, ByTokens, and ByStructure return []Chunk with byte
 //     offsets and chunk index. The invariant text[c.Start:c.End] == c.Text
 //     holds for every chunk produced from valid UTF-8 input.
 //   - Convenience: Chunks returns []string for common use cases where offsets
 //     are not needed.
 //
+// BySizeIter yields the chunks of BySize lazily as an iter.Seq, with
+// constant memory and no cap on the chunk count, for streaming large
+// documents.
+//
+// Annotate prepares chunks for vector store ingestion: it sets a stable ID
+// (a hash of the source document ID and chunk text, plus the chunk index),
+// the source document ID, and a caller-supplied heading path. WriteJSONL
+// writes chunks one JSON object per line for embedding pipelines.
+//
 // All functions are safe for concurrent use by multiple goroutines.
 //
 // Known limitations (v1.0):
@@ -26,6 +40,8 @@
 //   - Recursive paragraph splitting handles "\n\n" only, not "\r\n\r\n".
 //   - The size parameter is a target for BySentence, not a hard cap.
 //     A single sentence exceeding size is emitted as-is.
+//   - ByStructure emits a list item or table row exceeding size as-is, and
+//     does not parse nested lists, block quotes, or HTML.
 package chunker
 
 import (
@@ -49,6 +65,14 @@ type Chunk struct {
 	Start int    `json:"start"` // Byte offset in original string (inclusive)
 	End   int    `json:"end"`   // Byte offset in original string (exclusive)
 	Index int    `json:"index"` // Zero-based chunk index
+
+	// ID and Source are set by Annotate only.
+	ID     string `json:"id,omitempty"`     // Stable identifier, see ChunkID
+	Source string `json:"source,omitempty"` // Source document ID
+
+	// Heading and Path are set by ByStructure, or by Annotate from Metadata.
+	Heading string   `json:"heading,omitempty"` // Nearest heading above the chunk
+	Path    []string `json:"path,omitempty"`    // Heading hierarchy, outermost first
 }
 
 // String returns a debug representation, e.g. Chunk(0)[0:42](42 bytes).
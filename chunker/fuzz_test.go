@@ -83,3 +83,22 @@ func FuzzRecursive(f *testing.F) {
 		verifyChunkInvariants(t, s, chunks)
 	})
 }
+
+func FuzzByStructure(f *testing.F) {
+	f.Add("# Başlıq\n\nMətn.\n\n- bir\n- iki\n", 15, 3)
+	f.Add("", 10, 0)
+	f.Add("Başlıq\n===\n\n| a | b |\n|---|---|\n| 1 | 2 |\n", 8, 0)
+	f.Add("```\n# kod\n```\n", 5, 1)
+	f.Add("## A\n# B\n### C\n1) x\n", 3, 2)
+
+	f.Fuzz(func(t *testing.T, s string, size, overlap int) {
+		if !utf8.ValidString(s) {
+			return
+		}
+		chunks := ByStructure(s, size, overlap)
+		if chunks == nil {
+			return
+		}
+		verifyChunkInvariants(t, s, chunks)
+	})
+}
@@ -0,0 +1,60 @@
+package chunker
+
+import (
+	"iter"
+	"unicode/utf8"
+)
+
+// BySizeIter yields the chunks of BySize one at a time, computing each
+// only when the caller asks for it. Memory use is constant in the length
+// of text: no chunk slice or rune offset table is built, and chunk Text
+// shares the memory of text. Unlike BySize, the output is not capped at
+// 10,000 chunks, so large documents can be streamed to an embedding
+// service in full. Breaking out of the range loop stops the iteration.
+//
+// The chunks are identical to those of BySize, including the merge of a
+// short trailing fragment into the last chunk. Yields nothing for empty
+// text, invalid UTF-8, or size <= 0.
+func BySizeIter(text string, size, overlap int) iter.Seq[Chunk] {
+	return func(yield func(Chunk) bool) {
+		if !validate(text) || size <= 0 {
+			return
+		}
+		step := max(size-clampOverlap(size, overlap), 1)
+		minTail := min(minChunkRunes, size)
+
+		start := 0
+		for index := 0; ; index++ {
+			end, _ := advanceRunes(text, start, size)
+			next, _ := advanceRunes(text, start, step)
+
+			// A fragment after the next step shorter than minTail runes is
+			// merged into this chunk, as in bySize.
+			if next < len(text) {
+				if _, n := advanceRunes(text, next, minTail); n < minTail {
+					end, next = len(text), len(text)
+				}
+			}
+
+			if !yield(Chunk{Text: text[start:end], Start: start, End: end, Index: index}) {
+				return
+			}
+			if next >= len(text) {
+				return
+			}
+			start = next
+		}
+	}
+}
+
+// advanceRunes returns the byte offset n runes after byte offset from,
+// stopping at the end of text, and the number of runes advanced.
+func advanceRunes(text string, from, n int) (int, int) {
+	pos, count := from, 0
+	for count < n && pos < len(text) {
+		_, size := utf8.DecodeRuneInString(text[pos:])
+		pos += size
+		count++
+	}
+	return pos, count
+}
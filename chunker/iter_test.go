@@ -0,0 +1,93 @@
+package chunker
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBySizeIterMatchesBySize(t *testing.T) {
+	inputs := []string{
+		"",
+		"\xff\xfe",
+		"a",
+		"abcde",
+		"abcdefghij",
+		"abcdefghijk",
+		"abcdefghijklmnop",
+		"Bakı şəhəri gözəldir.",
+		strings.Repeat("Azərbaycan dili türk dilləri ailəsinə aiddir. ", 20),
+	}
+	params := [][2]int{
+		{0, 0}, {-1, 0}, {1, 0}, {4, 3}, {4, 10}, {4, -5}, {5, 0},
+		{8, 3}, {10, 0}, {12, 11}, {15, 5}, {50, 10}, {100, 50}, {2000, 0},
+	}
+	for _, in := range inputs {
+		for _, p := range params {
+			want := BySize(in, p[0], p[1])
+			got := slices.Collect(BySizeIter(in, p[0], p[1]))
+			verifyInvariants(t, in, got)
+			if len(got) != len(want) {
+				t.Errorf("BySizeIter(%q, %d, %d) yielded %d chunks, BySize returned %d",
+					in, p[0], p[1], len(got), len(want))
+				continue
+			}
+			for i := range got {
+				if got[i].Start != want[i].Start || got[i].End != want[i].End || got[i].Text != want[i].Text {
+					t.Errorf("BySizeIter(%q, %d, %d) chunk %d = %v, BySize = %v",
+						in, p[0], p[1], i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestBySizeIterBreak(t *testing.T) {
+	text := strings.Repeat("abcdefghij", 10)
+	var got []Chunk
+	for c := range BySizeIter(text, 10, 0) {
+		got = append(got, c)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if len(got) != 3 || got[2].Text != "abcdefghij" || got[2].Start != 20 {
+		t.Errorf("chunks before break = %v", got)
+	}
+}
+
+func TestBySizeIterUncapped(t *testing.T) {
+	text := strings.Repeat("ə", maxChunks+5)
+	n := 0
+	for c := range BySizeIter(text, 1, 0) {
+		if c.Index != n {
+			t.Fatalf("chunk %d has Index=%d", n, c.Index)
+		}
+		n++
+	}
+	if n != maxChunks+5 {
+		t.Errorf("BySizeIter yielded %d chunks, want %d", n, maxChunks+5)
+	}
+	if got := len(BySize(text, 1, 0)); got != maxChunks {
+		t.Errorf("BySize returned %d chunks, want the cap %d", got, maxChunks)
+	}
+}
+
+func BenchmarkBySizeIter(b *testing.B) {
+	text := strings.Repeat("Azərbaycan dili türk dilləri ailəsinə aiddir. ", 1000)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		for range BySizeIter(text, 512, 50) {
+		}
+	}
+}
+
+func ExampleBySizeIter() {
+	for c := range BySizeIter("abcdefghijklmn", 5, 0) {
+		fmt.Printf("[%d:%d] %q\n", c.Start, c.End, c.Text)
+	}
+	// Output:
+	// [0:5] "abcde"
+	// [5:14] "fghijklmn"
+}
@@ -0,0 +1,76 @@
+package chunker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// idHashLen is the number of hex digits of the content hash kept in a
+// chunk ID: 64 bits, ample for the chunks of one corpus.
+const idHashLen = 16
+
+// Metadata is document-level information that Annotate copies onto chunks.
+type Metadata struct {
+	Source string   // Source document ID, e.g. a file path or database key
+	Path   []string // Heading path for chunks without one, outermost first
+}
+
+// ChunkID returns the stable identifier of c in the document source: the
+// first 16 hex digits of the SHA-256 of source and c.Text, NUL-separated,
+// followed by "-" and c.Index, e.g. "9f86d081884c7d65-3". The ID depends
+// only on its inputs, so re-chunking an unchanged document with the same
+// strategy yields the same IDs; the index keeps repeated text apart. IDs
+// are stable only for an unchanged document: an edit changes the IDs of
+// the chunks it touches, and one that adds or removes a chunk (or shifts
+// a BySize boundary) also changes the ID of every later chunk.
+func ChunkID(source string, c Chunk) string {
+	h := sha256.New()
+	h.Write([]byte(source))
+	h.Write([]byte{0})
+	h.Write([]byte(c.Text))
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(h.Sum(nil))[:idHashLen], c.Index)
+}
+
+// Annotate returns a copy of chunks with ID and Source set from meta. A
+// chunk without a heading path of its own gets meta.Path, and its Heading
+// becomes the last element of meta.Path; a chunk with one (from
+// ByStructure) gets meta.Path prepended to it, so sections chunked one at
+// a time keep their place in the whole document. The input slice is not
+// modified.
+// Returns nil for empty input.
+func Annotate(chunks []Chunk, meta Metadata) []Chunk {
+	if len(chunks) == 0 {
+		return nil
+	}
+	out := make([]Chunk, len(chunks))
+	for i, c := range chunks {
+		c.ID = ChunkID(meta.Source, c)
+		c.Source = meta.Source
+		if len(meta.Path) > 0 {
+			if c.Heading == "" && len(c.Path) == 0 {
+				c.Heading = meta.Path[len(meta.Path)-1]
+			}
+			c.Path = slices.Concat(meta.Path, c.Path)
+		}
+		out[i] = c
+	}
+	return out
+}
+
+// WriteJSONL writes chunks to w as JSON Lines: one JSON object per chunk,
+// each followed by a newline, with the field names of Chunk. HTML
+// characters are not escaped, so text is written as is.
+func WriteJSONL(w io.Writer, chunks []Chunk) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, c := range chunks {
+		if err := enc.Encode(c); err != nil {
+			return fmt.Errorf("chunker: writing chunk %d: %w", c.Index, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package chunker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var reChunkID = regexp.MustCompile(`^[0-9a-f]{16}-\d+$`)
+
+func TestChunkID(t *testing.T) {
+	c := Chunk{Text: "Bakı paytaxtdır.", Index: 2}
+	id := ChunkID("doc-1", c)
+	if !reChunkID.MatchString(id) || !strings.HasSuffix(id, "-2") {
+		t.Fatalf("ChunkID = %q, want 16 hex digits and -2", id)
+	}
+	if again := ChunkID("doc-1", c); again != id {
+		t.Errorf("ChunkID not deterministic: %q != %q", again, id)
+	}
+	tests := []struct {
+		name   string
+		source string
+		c      Chunk
+	}{
+		{"other source", "doc-2", c},
+		{"other text", "doc-1", Chunk{Text: "Gəncə şəhərdir.", Index: 2}},
+		{"other index", "doc-1", Chunk{Text: c.Text, Index: 3}},
+		{"source and text not concatenated", "doc-1Bakı", Chunk{Text: " paytaxtdır.", Index: 2}},
+	}
+	for _, tt := range tests {
+		if got := ChunkID(tt.source, tt.c); got == id {
+			t.Errorf("%s: ChunkID = %q, same as base", tt.name, got)
+		}
+	}
+}
+
+func TestChunkIDStableAcrossEdits(t *testing.T) {
+	before := "Birinci paraqraf.\n\nİkinci paraqraf.\n\nÜçüncü paraqraf."
+	after := "Birinci paraqraf.\n\nİkinci paraqraf.\n\nÜçüncü paraqraf dəyişdi."
+	a := Annotate(Recursive(before, 20, 0), Metadata{Source: "doc"})
+	b := Annotate(Recursive(after, 20, 0), Metadata{Source: "doc"})
+	if len(a) != 3 || len(b) != 3 {
+		t.Fatalf("got %d and %d chunks, want 3", len(a), len(b))
+	}
+	for i := range 2 {
+		if a[i].ID != b[i].ID {
+			t.Errorf("chunk %d ID changed: %q -> %q", i, a[i].ID, b[i].ID)
+		}
+	}
+	if a[2].ID == b[2].ID {
+		t.Errorf("edited chunk kept ID %q", a[2].ID)
+	}
+}
+
+func TestChunkIDShiftsAfterInsert(t *testing.T) {
+	before := "Birinci paraqraf.\n\nİkinci paraqraf.\n\nÜçüncü paraqraf."
+	after := "Yeni paraqraf.\n\nBirinci paraqraf.\n\nİkinci paraqraf.\n\nÜçüncü paraqraf."
+	a := Annotate(Recursive(before, 20, 0), Metadata{Source: "doc"})
+	b := Annotate(Recursive(after, 20, 0), Metadata{Source: "doc"})
+	if len(a) != 3 || len(b) != 4 {
+		t.Fatalf("got %d and %d chunks, want 3 and 4", len(a), len(b))
+	}
+	// Unchanged text after the inserted chunk gets a new index, so a new ID.
+	for i := range a {
+		if a[i].Text != b[i+1].Text || a[i].ID == b[i+1].ID {
+			t.Errorf("chunk %d: %q %q -> %q %q", i, a[i].Text, a[i].ID, b[i+1].Text, b[i+1].ID)
+		}
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	if got := Annotate(nil, Metadata{Source: "doc"}); got != nil {
+		t.Errorf("Annotate(nil) = %v, want nil", got)
+	}
+
+	text := "Birinci cümlə. İkinci cümlə daha uzundur."
+	chunks := BySize(text, 16, 4)
+	meta := Metadata{Source: "qanun.md", Path: []string{"Qanun", "Maddə 1"}}
+	got := Annotate(chunks, meta)
+	verifyInvariants(t, text, got)
+	for i, c := range got {
+		if c.ID != ChunkID("qanun.md", chunks[i]) || c.Source != "qanun.md" {
+			t.Errorf("chunk %d: ID %q Source %q", i, c.ID, c.Source)
+		}
+		if c.Heading != "Maddə 1" || !slices.Equal(c.Path, meta.Path) {
+			t.Errorf("chunk %d: Heading %q Path %q", i, c.Heading, c.Path)
+		}
+		if chunks[i].ID != "" || chunks[i].Source != "" || chunks[i].Path != nil {
+			t.Errorf("input chunk %d modified: %+v", i, chunks[i])
+		}
+	}
+
+	// ByStructure paths are nested under meta.Path.
+	doc := "# Tarix\n\nMətn.\n"
+	got = Annotate(ByStructure(doc, 100, 0), Metadata{Path: []string{"Bakı"}})
+	if len(got) != 1 || got[0].Heading != "Tarix" || !slices.Equal(got[0].Path, []string{"Bakı", "Tarix"}) {
+		t.Errorf("Annotate(ByStructure) = %+v", got)
+	}
+}
+
+// errWriter fails every write.
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, os.ErrClosed }
+
+func TestWriteJSONL(t *testing.T) {
+	chunks := Annotate(BySize("Bakı <paytaxt> & şəhər. Gəncə ikinci şəhərdir.", 20, 0), Metadata{Source: "doc"})
+	var buf bytes.Buffer
+	if err := WriteJSONL(&buf, chunks); err != nil {
+		t.Fatalf("WriteJSONL: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != len(chunks) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(chunks), buf.String())
+	}
+	for i, line := range lines {
+		var c Chunk
+		if err := json.Unmarshal([]byte(line), &c); err != nil {
+			t.Fatalf("line %d: %v", i, err)
+		}
+		if c.ID != chunks[i].ID || c.Text != chunks[i].Text || c.Source != "doc" {
+			t.Errorf("line %d = %+v, want %+v", i, c, chunks[i])
+		}
+	}
+	if !strings.Contains(buf.String(), "<paytaxt> &") {
+		t.Errorf("HTML characters escaped: %s", buf.String())
+	}
+
+	if err := WriteJSONL(errWriter{}, chunks); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("WriteJSONL(errWriter) = %v, want os.ErrClosed", err)
+	}
+}
+
+func BenchmarkAnnotate(b *testing.B) {
+	chunks := Recursive(strings.Repeat("Azərbaycan Respublikası Cənubi Qafqazda yerləşir. ", 1000), 512, 50)
+	meta := Metadata{Source: "doc", Path: []string{"Coğrafiya"}}
+	b.ResetTimer()
+	for b.Loop() {
+		Annotate(chunks, meta)
+	}
+}
+
+func ExampleAnnotate() {
+	chunks := Recursive("Birinci paraqraf.\n\nİkinci paraqraf.", 20, 0)
+	for _, c := range Annotate(chunks, Metadata{Source: "doc-42", Path: []string{"Giriş"}}) {
+		fmt.Println(c.Source, c.Path, c.ID == ChunkID("doc-42", c), strings.TrimSpace(c.Text))
+	}
+	// Output:
+	// doc-42 [Giriş] true Birinci paraqraf.
+	// doc-42 [Giriş] true İkinci paraqraf.
+}
+
+func ExampleWriteJSONL() {
+	chunks := Annotate(BySize("Bakı paytaxtdır.", 100, 0), Metadata{Source: "doc-42"})
+	WriteJSONL(os.Stdout, chunks)
+	// Output:
+	// {"text":"Bakı paytaxtdır.","start":0,"end":18,"index":0,"id":"3d967142bb32ded7-0","source":"doc-42"}
+}
@@ -0,0 +1,328 @@
+package chunker
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// blockKind classifies a structural block of a document.
+type blockKind int
+
+const (
+	blockParagraph blockKind = iota // blank-line-delimited prose
+	blockHeading                    // "# Title" or an underlined title line
+	blockListItem                   // "- item", "* item", "1. item" with continuation lines
+	blockTableRow                   // "| a | b |"
+	blockCode                       // fenced code block
+)
+
+// maxHeadingLevel is the deepest Markdown heading level ("######").
+const maxHeadingLevel = 6
+
+// block is a structural unit of a document. Blank lines after a block
+// belong to it, so consecutive blocks cover the text without gaps.
+type block struct {
+	fragment
+	kind  blockKind
+	level int    // heading level, 1 for "#"
+	title string // heading text without markers
+}
+
+// ByStructure splits Markdown or plain-text documents along their
+// structure. Headings ("# Title", or a title line underlined with "===" or
+// "---") open sections; within a section, paragraphs, list items ("-",
+// "*", "+", "•", "1.", "1)"), table rows, and fenced code blocks are
+// packed greedily into chunks of up to size runes.
+//
+// A chunk never spans two sections, never splits a list item or table
+// row, and never separates a heading from the text that follows it: the
+// heading is packed with the first block of its section, and a heading
+// directly followed by a subheading is carried into the subsection.
+// Paragraphs and code blocks longer than size are split like Recursive;
+// a single list item or table row longer than size is emitted whole.
+// Overlap re-includes whole trailing blocks from the previous chunk of
+// the same section whose runes fit in overlap runes.
+//
+// Each chunk records the nearest heading above it in Heading and the
+// heading hierarchy from the top level down in Path; both are empty for
+// text before the first heading. The offset invariant
+// text[c.Start:c.End] == c.Text holds for every chunk.
+//
+// Returns nil for empty text, invalid UTF-8, or size <= 0.
+func ByStructure(text string, size, overlap int) []Chunk {
+	if !validate(text) || size <= 0 {
+		return nil
+	}
+	overlap = clampOverlap(size, overlap)
+
+	var (
+		chunks  []Chunk
+		units   []unit
+		stack   []block  // open headings, outermost first
+		heading string   // title of the current section's heading
+		path    []string // titles of stack
+		bare    bool     // units holds only the current section's heading
+		carry   = -1     // start of a heading-only section carried into the next
+	)
+	flush := func() {
+		for _, c := range packUnits(text, units, size, overlap) {
+			if len(chunks) >= maxChunks {
+				break
+			}
+			c.Index = len(chunks)
+			c.Heading, c.Path = heading, path
+			chunks = append(chunks, c)
+		}
+		units = nil
+	}
+
+	for _, b := range parseBlocks(text) {
+		if b.kind == blockHeading {
+			if bare {
+				carry, units = units[0].start, nil
+			}
+			flush()
+			for len(stack) > 0 && stack[len(stack)-1].level >= b.level {
+				stack = stack[:len(stack)-1]
+			}
+			stack = append(stack, b)
+			heading, path = b.title, headingPath(stack)
+			if carry >= 0 {
+				b.start, carry = carry, -1
+			}
+			units = append(units, unit{b.fragment, runeCount(text, b.fragment)})
+			bare = true
+			continue
+		}
+
+		pieces := []fragment{b.fragment}
+		if (b.kind == blockParagraph || b.kind == blockCode) && runeCount(text, b.fragment) > size {
+			pieces = splitFragment(text, b.fragment, size, levelParagraph)
+		}
+		for _, p := range pieces {
+			if bare {
+				// Keep the heading with the first block of its section.
+				units[0].end = p.end
+				units[0].tokens = runeCount(text, units[0].fragment)
+				bare = false
+				continue
+			}
+			units = append(units, unit{p, runeCount(text, p)})
+		}
+	}
+	flush()
+
+	if len(chunks) == 0 {
+		return nil
+	}
+	return chunks
+}
+
+// parseBlocks splits text into structural blocks covering it from start
+// to end. Leading blank lines belong to the first block.
+func parseBlocks(text string) []block {
+	lines := splitLines(text)
+	var blocks []block
+	extend := func(end int) {
+		blocks[len(blocks)-1].end = end
+	}
+
+	for i := 0; i < len(lines); {
+		ln := lines[i]
+		content := lineContent(text, ln)
+		trimmed := strings.TrimSpace(content)
+
+		switch {
+		case trimmed == "":
+			if len(blocks) > 0 {
+				extend(ln.end)
+			}
+			i++
+			continue
+
+		case isFence(trimmed):
+			marker := trimmed[:3]
+			j := i + 1
+			for j < len(lines) && !strings.HasPrefix(strings.TrimSpace(lineContent(text, lines[j])), marker) {
+				j++
+			}
+			end := len(lines) - 1
+			if j < len(lines) {
+				end = j
+			}
+			blocks = append(blocks, block{fragment: fragment{ln.start, lines[end].end}, kind: blockCode})
+			i = end + 1
+
+		default:
+			if level, title, ok := atxHeading(content); ok {
+				blocks = append(blocks, block{fragment: ln, kind: blockHeading, level: level, title: title})
+				i++
+				break
+			}
+			if i+1 < len(lines) && startsBlock(text, lines, i) && !isListItem(content) && !isTableRow(trimmed) {
+				if level := setextLevel(lineContent(text, lines[i+1])); level > 0 {
+					blocks = append(blocks, block{
+						fragment: fragment{ln.start, lines[i+1].end},
+						kind:     blockHeading, level: level, title: trimmed,
+					})
+					i += 2
+					break
+				}
+			}
+
+			kind := blockParagraph
+			switch {
+			case isListItem(content):
+				kind = blockListItem
+			case isTableRow(trimmed):
+				kind = blockTableRow
+				if len(blocks) > 0 && blocks[len(blocks)-1].kind == blockTableRow && isTableSeparator(trimmed) {
+					// Keep the "|---|" line with the header row.
+					extend(ln.end)
+					i++
+					continue
+				}
+			}
+			j := i + 1
+			for j < len(lines) && continuesBlock(text, lines[j], kind) {
+				j++
+			}
+			blocks = append(blocks, block{fragment: fragment{ln.start, lines[j-1].end}, kind: kind})
+			i = j
+		}
+	}
+
+	if len(blocks) == 0 {
+		return []block{{fragment: fragment{0, len(text)}}}
+	}
+	blocks[0].start = 0
+	return blocks
+}
+
+// splitLines returns the lines of text, each including its line ending.
+func splitLines(text string) []fragment {
+	var lines []fragment
+	for start := 0; start < len(text); {
+		end := len(text)
+		if i := strings.IndexByte(text[start:], '\n'); i >= 0 {
+			end = start + i + 1
+		}
+		lines = append(lines, fragment{start, end})
+		start = end
+	}
+	return lines
+}
+
+// lineContent returns the text of a line without its line ending.
+func lineContent(text string, ln fragment) string {
+	return strings.TrimRight(text[ln.start:ln.end], "\r\n")
+}
+
+// startsBlock reports whether lines[i] begins a new block: it is the first
+// line, or follows a blank line or a heading.
+func startsBlock(text string, lines []fragment, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev := strings.TrimSpace(lineContent(text, lines[i-1]))
+	if prev == "" {
+		return true
+	}
+	_, _, ok := atxHeading(prev)
+	return ok
+}
+
+// continuesBlock reports whether line ln continues a block of the given
+// kind rather than starting a new one. List items continue with any line
+// that does not start another block (lazy continuation); table rows are
+// one line each.
+func continuesBlock(text string, ln fragment, kind blockKind) bool {
+	content := lineContent(text, ln)
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" || kind == blockTableRow || isFence(trimmed) ||
+		isListItem(content) || isTableRow(trimmed) {
+		return false
+	}
+	_, _, ok := atxHeading(content)
+	return !ok
+}
+
+// atxHeading parses a Markdown heading line: up to three spaces, one to
+// six '#', then a space or the end of the line. Closing '#'s are removed.
+func atxHeading(line string) (level int, title string, ok bool) {
+	s := strings.TrimLeft(line, " ")
+	if len(line)-len(s) > 3 {
+		return 0, "", false
+	}
+	for level < len(s) && s[level] == '#' {
+		level++
+	}
+	if level == 0 || level > maxHeadingLevel || level < len(s) && s[level] != ' ' && s[level] != '\t' {
+		return 0, "", false
+	}
+	title = strings.TrimSpace(s[level:])
+	if t := strings.TrimRight(title, "#"); t == "" || strings.HasSuffix(t, " ") {
+		title = strings.TrimSpace(t)
+	}
+	return level, title, true
+}
+
+// setextLevel returns 1 for a "===" underline, 2 for a "---" underline
+// (at least three dashes), or 0.
+func setextLevel(line string) int {
+	s := strings.TrimSpace(line)
+	switch {
+	case len(s) >= 2 && strings.Trim(s, "=") == "":
+		return 1
+	case len(s) >= 3 && strings.Trim(s, "-") == "":
+		return 2
+	}
+	return 0
+}
+
+// isFence reports whether a trimmed line opens or closes a code block.
+func isFence(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+// isListItem reports whether line starts a bullet ("-", "*", "+", "•",
+// "–") or numbered ("1.", "1)") list item.
+func isListItem(line string) bool {
+	s := strings.TrimLeft(line, " \t")
+	r, n := utf8.DecodeRuneInString(s)
+	switch r {
+	case '-', '*', '+', '•', '–':
+		return n < len(s) && (s[n] == ' ' || s[n] == '\t')
+	}
+	digits := 0
+	for digits < len(s) && s[digits] >= '0' && s[digits] <= '9' {
+		digits++
+	}
+	return digits > 0 && digits <= 3 && digits+1 < len(s) &&
+		(s[digits] == '.' || s[digits] == ')') && (s[digits+1] == ' ' || s[digits+1] == '\t')
+}
+
+// isTableRow reports whether a trimmed line is a Markdown table row.
+func isTableRow(trimmed string) bool {
+	return len(trimmed) > 1 && trimmed[0] == '|'
+}
+
+// isTableSeparator reports whether a table row is the "|---|:---:|" line
+// under the header.
+func isTableSeparator(trimmed string) bool {
+	return strings.Trim(trimmed, "|-: \t") == ""
+}
+
+// runeCount returns the number of runes in text[f.start:f.end].
+func runeCount(text string, f fragment) int {
+	return utf8.RuneCountInString(text[f.start:f.end])
+}
+
+// headingPath returns a copy of the titles of the open headings.
+func headingPath(stack []block) []string {
+	path := make([]string, len(stack))
+	for i, h := range stack {
+		path[i] = h.title
+	}
+	return path
+}
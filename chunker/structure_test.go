@@ -0,0 +1,226 @@
+package chunker
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const structureText = `Giriş mətni başlıqdan əvvəl gəlir.
+
+# Bakı
+
+Bakı Azərbaycanın paytaxtıdır.
+
+## Tarix
+
+- İçərişəhər XII əsrdə salınıb.
+- Qız qalası şəhərin simvoludur.
+- Şirvanşahlar sarayı XV əsrə aiddir.
+
+## Nəqliyyat
+
+1. Metro 1967-ci ildə açılıb.
+2. Dəniz limanı Xəzərdədir.
+
+# Gəncə
+
+Gəncə ikinci böyük şəhərdir.
+`
+
+func TestByStructure(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		size    int
+		overlap int
+		want    int // expected chunk count, -1 to skip count check
+	}{
+		{"empty string", "", 100, 0, 0},
+		{"size zero", structureText, 0, 0, 0},
+		{"invalid utf8", "\xff\xfe", 10, 0, 0},
+
+		{"one chunk per section", structureText, 500, 0, 5},
+		{"small size", structureText, 40, 0, -1},
+		{"with overlap", structureText, 70, 35, -1},
+		{"overlap clamped", structureText, 40, 100, -1},
+		{"plain prose", "Bir cümlə. İkinci cümlə.\n\nYeni paraqraf.", 100, 0, 1},
+		{"whitespace only", "  \n\n ", 10, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ByStructure(tt.input, tt.size, tt.overlap)
+			if tt.want == 0 {
+				if got != nil {
+					t.Errorf("expected nil, got %d chunks", len(got))
+				}
+				return
+			}
+			if tt.want > 0 && len(got) != tt.want {
+				t.Errorf("expected %d chunks, got %d: %v", tt.want, len(got), got)
+			}
+			verifyInvariants(t, tt.input, got)
+			if got[0].Start != 0 || got[len(got)-1].End != len(tt.input) {
+				t.Errorf("chunks do not cover the input: [%d:%d]", got[0].Start, got[len(got)-1].End)
+			}
+		})
+	}
+}
+
+func TestByStructureHeadings(t *testing.T) {
+	got := ByStructure(structureText, 500, 0)
+	want := []struct {
+		heading string
+		path    []string
+		prefix  string
+	}{
+		{"", nil, "Giriş"},
+		{"Bakı", []string{"Bakı"}, "# Bakı"},
+		{"Tarix", []string{"Bakı", "Tarix"}, "## Tarix"},
+		{"Nəqliyyat", []string{"Bakı", "Nəqliyyat"}, "## Nəqliyyat"},
+		{"Gəncə", []string{"Gəncə"}, "# Gəncə"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d chunks, want %d: %v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		c := got[i]
+		if c.Heading != w.heading || !slices.Equal(c.Path, w.path) || !strings.HasPrefix(c.Text, w.prefix) {
+			t.Errorf("chunk %d = %q %v %q, want %q %v prefix %q",
+				i, c.Heading, c.Path, c.Text, w.heading, w.path, w.prefix)
+		}
+	}
+}
+
+func TestByStructureKeepsItemsWhole(t *testing.T) {
+	items := []string{
+		"- İçərişəhər XII əsrdə salınıb.\n",
+		"- Qız qalası şəhərin simvoludur.\n",
+		"- Şirvanşahlar sarayı XV əsrə aiddir.\n",
+		"1. Metro 1967-ci ildə açılıb.\n",
+		"2. Dəniz limanı Xəzərdədir.\n",
+	}
+	for _, size := range []int{20, 40, 70} {
+		got := ByStructure(structureText, size, 0)
+		verifyInvariants(t, structureText, got)
+		for _, item := range items {
+			found := false
+			for _, c := range got {
+				if strings.Contains(c.Text, item) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("size %d: list item %q split across chunks", size, item)
+			}
+		}
+		for _, c := range got {
+			if strings.HasSuffix(strings.TrimSpace(c.Text), "# Tarix") {
+				t.Errorf("size %d: heading separated from its section: %q", size, c.Text)
+			}
+		}
+	}
+}
+
+func TestByStructureSectionsNotMixed(t *testing.T) {
+	for _, c := range ByStructure(structureText, 1000, 0) {
+		if strings.Count(c.Text, "#") > 2 {
+			t.Errorf("chunk spans several sections: %q", c.Text)
+		}
+	}
+}
+
+func TestByStructureOverlap(t *testing.T) {
+	input := "# Siyahı\n\n- birinci bənd\n- ikinci bənd\n- üçüncü bənd\n- dördüncü bənd\n"
+	got := ByStructure(input, 40, 15)
+	verifyInvariants(t, input, got)
+	if len(got) < 2 {
+		t.Fatalf("expected several chunks, got %d", len(got))
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Start >= got[i-1].End {
+			t.Errorf("chunk %d starts at %d, after previous end %d: no overlap", i, got[i].Start, got[i-1].End)
+		}
+		if got[i].Heading != "Siyahı" {
+			t.Errorf("chunk %d heading = %q, want %q", i, got[i].Heading, "Siyahı")
+		}
+	}
+}
+
+func TestParseBlocks(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []blockKind
+	}{
+		{"atx heading", "# A\nmətn\n", []blockKind{blockHeading, blockParagraph}},
+		{"closing hashes", "## A ##\n", []blockKind{blockHeading}},
+		{"hash without space", "#teq\n", []blockKind{blockParagraph}},
+		{"seven hashes", "####### A\n", []blockKind{blockParagraph}},
+		{"setext", "Başlıq\n======\nmətn\n", []blockKind{blockHeading, blockParagraph}},
+		{"setext dashes", "Başlıq\n---\n", []blockKind{blockHeading}},
+		{"bullets", "- a\n* b\n+ c\n• d\n", []blockKind{blockListItem, blockListItem, blockListItem, blockListItem}},
+		{"numbered", "1. a\n2) b\n", []blockKind{blockListItem, blockListItem}},
+		{"item continuation", "- a\n  davamı\n- b\n", []blockKind{blockListItem, blockListItem}},
+		{"number without space", "1.5 faiz\n", []blockKind{blockParagraph}},
+		{"table", "| a | b |\n|---|---|\n| 1 | 2 |\n", []blockKind{blockTableRow, blockTableRow}},
+		{"fenced code", "```\n# şərh\n- x\n```\nmətn\n", []blockKind{blockCode, blockParagraph}},
+		{"unclosed fence", "```\n# şərh\n", []blockKind{blockCode}},
+		{"paragraph then list", "mətn\n- a\n", []blockKind{blockParagraph, blockListItem}},
+		{"blank lines", "\n\na\n\n\nb\n\n", []blockKind{blockParagraph, blockParagraph}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			blocks := parseBlocks(tt.input)
+			kinds := make([]blockKind, len(blocks))
+			for i, b := range blocks {
+				kinds[i] = b.kind
+			}
+			if !slices.Equal(kinds, tt.want) {
+				t.Errorf("parseBlocks(%q) kinds = %v, want %v", tt.input, kinds, tt.want)
+			}
+			// Blocks cover the input without gaps.
+			pos := 0
+			for _, b := range blocks {
+				if b.start != pos {
+					t.Errorf("block starts at %d, want %d", b.start, pos)
+				}
+				pos = b.end
+			}
+			if pos != len(tt.input) {
+				t.Errorf("blocks end at %d, want %d", pos, len(tt.input))
+			}
+		})
+	}
+}
+
+func TestByStructureHeadingOnlySection(t *testing.T) {
+	input := "# Bakı\n## Tarix\n\nQədim şəhərdir.\n"
+	got := ByStructure(input, 100, 0)
+	if len(got) != 1 {
+		t.Fatalf("got %d chunks, want 1: %v", len(got), got)
+	}
+	if got[0].Text != input || got[0].Heading != "Tarix" || !slices.Equal(got[0].Path, []string{"Bakı", "Tarix"}) {
+		t.Errorf("got %q %q %v", got[0].Text, got[0].Heading, got[0].Path)
+	}
+}
+
+func BenchmarkByStructure(b *testing.B) {
+	text := strings.Repeat(structureText, 50)
+	for b.Loop() {
+		ByStructure(text, 256, 32)
+	}
+}
+
+func ExampleByStructure() {
+	text := "# Bakı\n\nPaytaxtdır.\n\n## Tarix\n\n- İçərişəhər\n- Qız qalası\n"
+	for _, c := range ByStructure(text, 100, 0) {
+		fmt.Printf("%v %q\n", c.Path, c.Text)
+	}
+	// Output:
+	// [Bakı] "# Bakı\n\nPaytaxtdır.\n\n"
+	// [Bakı Tarix] "## Tarix\n\n- İçərişəhər\n- Qız qalası\n"
+}
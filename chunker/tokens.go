@@ -0,0 +1,127 @@
+package chunker
+
+import "github.com/az-ai-labs/az-lang-nlp/tokenizer"
+
+// TokenCounter returns the number of tokens in s, e.g. the length of a
+// model tokenizer's encoding.
+type TokenCounter func(s string) int
+
+// WordCount is the default TokenCounter: the number of words in s as
+// returned by tokenizer.Words.
+func WordCount(s string) int {
+	return len(tokenizer.Words(s))
+}
+
+// unit is a packing unit for ByTokens: a sentence, or a word-level piece
+// of a sentence too long to fit in a chunk on its own.
+type unit struct {
+	fragment
+	tokens int
+}
+
+// ByTokens groups sentences into chunks of at most maxTokens tokens as
+// measured by counter, for LLM context windows. A nil counter uses
+// WordCount.
+//
+// Sentences are detected via tokenizer.SentenceTokens. A sentence that
+// alone exceeds maxTokens is split between words; a single word exceeding
+// maxTokens is emitted as its own chunk. Overlap re-includes whole
+// trailing units (sentences or words) from the previous chunk whose
+// tokens fit in overlap tokens.
+//
+// Chunk token counts are the sum of the counts of their units. This is
+// exact for WordCount; for subword tokenizers, merges across unit
+// boundaries may shift the true count by a token per boundary, so leave
+// a small margin below the model limit. The offset invariant
+// text[c.Start:c.End] == c.Text holds for every chunk.
+//
+// Returns nil for empty text, invalid UTF-8, or maxTokens <= 0.
+func ByTokens(text string, maxTokens, overlap int, counter TokenCounter) []Chunk {
+	if !validate(text) || maxTokens <= 0 {
+		return nil
+	}
+	if counter == nil {
+		counter = WordCount
+	}
+	overlap = clampOverlap(maxTokens, overlap)
+
+	units := tokenUnits(text, maxTokens, counter)
+	if len(units) == 0 {
+		return nil
+	}
+	return packUnits(text, units, maxTokens, overlap)
+}
+
+// tokenUnits splits text into sentences, breaking sentences that exceed
+// maxTokens into word-level pieces (each word with its trailing
+// punctuation and whitespace).
+func tokenUnits(text string, maxTokens int, counter TokenCounter) []unit {
+	var units []unit
+	for _, sent := range tokenizer.SentenceTokens(text) {
+		n := counter(sent.Text)
+		if n <= maxTokens {
+			units = append(units, unit{fragment{sent.Start, sent.End}, n})
+			continue
+		}
+
+		pieceStart := sent.Start
+		for _, tok := range tokenizer.WordTokens(sent.Text) {
+			if tok.Type != tokenizer.Space {
+				continue
+			}
+			end := sent.Start + tok.End
+			units = append(units, unit{fragment{pieceStart, end}, counter(text[pieceStart:end])})
+			pieceStart = end
+		}
+		if pieceStart < sent.End {
+			units = append(units, unit{fragment{pieceStart, sent.End}, counter(text[pieceStart:sent.End])})
+		}
+	}
+	return units
+}
+
+// packUnits greedily groups consecutive units up to maxTokens, then steps
+// back over trailing units that fit in the overlap budget.
+func packUnits(text string, units []unit, maxTokens, overlap int) []Chunk {
+	chunks := make([]Chunk, 0, len(units)/2+1)
+	groupStart := 0
+
+	for groupStart < len(units) && len(chunks) < maxChunks {
+		groupEnd := groupStart
+		tokens := 0
+		for groupEnd < len(units) {
+			if groupEnd > groupStart && tokens+units[groupEnd].tokens > maxTokens {
+				break
+			}
+			tokens += units[groupEnd].tokens
+			groupEnd++
+		}
+
+		startByte := units[groupStart].start
+		endByte := units[groupEnd-1].end
+		chunks = append(chunks, Chunk{
+			Text:  text[startByte:endByte],
+			Start: startByte,
+			End:   endByte,
+			Index: len(chunks),
+		})
+		if groupEnd == len(units) {
+			break
+		}
+
+		overlapUnits := 0
+		if overlap > 0 {
+			overlapTokens := 0
+			for i := groupEnd - 1; i > groupStart; i-- {
+				if overlapTokens+units[i].tokens > overlap {
+					break
+				}
+				overlapTokens += units[i].tokens
+				overlapUnits++
+			}
+		}
+		groupStart = groupEnd - overlapUnits
+	}
+
+	return chunks
+}
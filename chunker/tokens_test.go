@@ -0,0 +1,116 @@
+package chunker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+const tokensText = "Bakı Azərbaycanın paytaxtıdır. Şəhər Xəzər dənizinin sahilində yerləşir. " +
+	"Bakıda çoxlu tarixi abidə var. İçərişəhər UNESCO siyahısındadır. Qız qalası məşhurdur."
+
+// runeQuarters approximates a subword tokenizer: one token per four runes.
+func runeQuarters(s string) int {
+	return (utf8.RuneCountInString(s) + 3) / 4
+}
+
+func TestByTokens(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		maxTokens int
+		overlap   int
+		counter   TokenCounter
+		want      int // expected chunk count, -1 to skip count check
+	}{
+		{"empty string", "", 10, 0, nil, 0},
+		{"max zero", tokensText, 0, 0, nil, 0},
+		{"invalid utf8", "\xff\xfe", 10, 0, nil, 0},
+
+		{"fits in one chunk", tokensText, 100, 0, nil, 1},
+		{"one sentence per chunk", tokensText, 5, 0, nil, 5},
+		{"two sentences per chunk", tokensText, 9, 0, nil, 3},
+		{"with overlap", tokensText, 9, 4, nil, -1},
+		{"overlap clamped", tokensText, 9, 100, nil, -1},
+		{"long sentence split between words", tokensText, 2, 0, nil, -1},
+		{"custom counter", tokensText, 20, 0, runeQuarters, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ByTokens(tt.input, tt.maxTokens, tt.overlap, tt.counter)
+			if tt.want == 0 {
+				if got != nil {
+					t.Errorf("expected nil, got %d chunks", len(got))
+				}
+				return
+			}
+			if tt.want > 0 && len(got) != tt.want {
+				t.Errorf("expected %d chunks, got %d: %v", tt.want, len(got), got)
+			}
+			verifyInvariants(t, tt.input, got)
+
+			counter := tt.counter
+			if counter == nil {
+				counter = WordCount
+			}
+			for _, c := range got {
+				if n := counter(c.Text); n > tt.maxTokens && WordCount(c.Text) > 1 {
+					t.Errorf("chunk %d has %d tokens, max %d: %q", c.Index, n, tt.maxTokens, c.Text)
+				}
+			}
+			if last := got[len(got)-1]; !strings.HasSuffix(tt.input, last.Text) {
+				t.Errorf("last chunk %q does not reach the end of the input", last.Text)
+			}
+		})
+	}
+}
+
+func TestByTokensOverlapContent(t *testing.T) {
+	got := ByTokens(tokensText, 12, 6, nil)
+	if len(got) < 2 {
+		t.Fatalf("expected several chunks, got %d", len(got))
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Start >= got[i-1].End {
+			t.Errorf("chunk %d starts at %d, after previous end %d: no overlap", i, got[i].Start, got[i-1].End)
+		}
+		if got[i].Start <= got[i-1].Start {
+			t.Errorf("chunk %d does not advance: start %d after %d", i, got[i].Start, got[i-1].Start)
+		}
+	}
+}
+
+func TestByTokensOversizedWord(t *testing.T) {
+	word := strings.Repeat("a", 40)
+	input := "Qısa cümlə. " + word + " son."
+	got := ByTokens(input, 3, 0, runeQuarters)
+	verifyInvariants(t, input, got)
+	found := false
+	for _, c := range got {
+		if strings.Contains(c.Text, word) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("oversized word missing from chunks: %v", got)
+	}
+}
+
+func BenchmarkByTokens(b *testing.B) {
+	text := strings.Repeat(tokensText+" ", 50)
+	for b.Loop() {
+		ByTokens(text, 128, 16, nil)
+	}
+}
+
+func ExampleByTokens() {
+	text := "Bakı Azərbaycanın paytaxtıdır. Şəhər Xəzər sahilindədir. Qız qalası məşhurdur."
+	for _, c := range ByTokens(text, 6, 0, nil) {
+		fmt.Printf("[%d:%d] %d words %q\n", c.Start, c.End, WordCount(c.Text), c.Text)
+	}
+	// Output:
+	// [0:67] 6 words "Bakı Azərbaycanın paytaxtıdır. Şəhər Xəzər sahilindədir."
+	// [67:93] 3 words " Qız qalası məşhurdur."
+}
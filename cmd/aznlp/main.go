@@ -0,0 +1,412 @@
+// Command aznlp runs the library's modules from the shell and writes the
+// results as JSON lines, for use from pipelines and other languages.
+//
+// Usage:
+//
+//	aznlp <command> [flags] [file ...]
+//
+// Each command reads the named files, or standard input when none are
+// given, and writes one JSON object per non-blank input line:
+//
+//	{"file":"news.txt","line":3,"result":...}
+//
+// "file" is omitted for standard input. With -doc, each file is processed
+// as a single document and "line" is omitted. Inputs larger than 1 MiB
+// (per line, or per document with -doc) are rejected.
+//
+// Commands:
+//
+//	tokenize   tokens with offsets (-mode words|social|sentences)
+//	stem       stem of every word
+//	analyze    morphological analyses of every word
+//	ner        named entities
+//	sentiment  sentiment polarity and score
+//	detect     language and script (-all for every candidate)
+//	normalize  text with diacritics restored
+//	chunk      chunks with offsets (-strategy recursive|sentence|size, -size, -overlap)
+//	datetime   date and time expressions (-ref reference time, -tz location)
+//	keywords   top keywords (-method textrank|tfidf, -n)
+//
+// Example:
+//
+//	echo "Bakı gözəl şəhərdir." | go run ./cmd/aznlp stem
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/chunker"
+	"github.com/az-ai-labs/az-lang-nlp/datetime"
+	"github.com/az-ai-labs/az-lang-nlp/detect"
+	"github.com/az-ai-labs/az-lang-nlp/keywords"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/normalize"
+	"github.com/az-ai-labs/az-lang-nlp/sentiment"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const (
+	maxInputBytes   = 1 << 20 // per line, or per document with -doc
+	defaultChunk    = 512     // default chunk size in runes
+	defaultOverlap  = 50      // default chunk overlap in runes
+	defaultKeywords = 10      // default number of keywords
+)
+
+// errFlags reports a flag error already printed with the command usage.
+var errFlags = errors.New("invalid flags")
+
+// processFunc computes the result for one line or document.
+type processFunc func(text string) (any, error)
+
+// buildFunc validates the parsed flags of a command and returns the
+// function that processes its input.
+type buildFunc func() (processFunc, error)
+
+// command is a subcommand: setup registers its flags and returns the
+// function that builds the processFunc once the flags are parsed.
+type command struct {
+	name    string
+	summary string
+	setup   func(fs *flag.FlagSet) buildFunc
+}
+
+// record is one line of output.
+type record struct {
+	File   string `json:"file,omitempty"` // input file; empty for standard input
+	Line   int    `json:"line,omitempty"` // 1-based line number; zero with -doc
+	Result any    `json:"result"`         // command output
+}
+
+// wordStem is the output of the stem command for one word.
+type wordStem struct {
+	Word string `json:"word"`
+	Stem string `json:"stem"`
+}
+
+// wordAnalysis is the output of the analyze command for one word.
+type wordAnalysis struct {
+	Word     string           `json:"word"`
+	Analyses []morph.Analysis `json:"analyses"`
+}
+
+var commands = []command{
+	{"tokenize", "tokens with offsets", setupTokenize},
+	{"stem", "stem of every word", setupStem},
+	{"analyze", "morphological analyses of every word", setupAnalyze},
+	{"ner", "named entities", setupNER},
+	{"sentiment", "sentiment polarity and score", setupSentiment},
+	{"detect", "language and script", setupDetect},
+	{"normalize", "text with diacritics restored", setupNormalize},
+	{"chunk", "chunks with offsets", setupChunk},
+	{"datetime", "date and time expressions", setupDatetime},
+	{"keywords", "top keywords", setupKeywords},
+}
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		return
+	case errors.Is(err, errFlags):
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "aznlp: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command named by args[0] on the remaining arguments.
+// Usage and flag errors go to stderr.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		usage(stderr)
+		return errors.New("missing command")
+	}
+	if args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		usage(stderr)
+		return flag.ErrHelp
+	}
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == args[0] {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		usage(stderr)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	doc := fs.Bool("doc", false, "process each file as one document instead of line by line")
+	build := cmd.setup(fs)
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errFlags
+	}
+	process, err := build()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		fs.Usage()
+		return fmt.Errorf("%w: %w", errFlags, err)
+	}
+
+	w := bufio.NewWriter(stdout)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	err = processFiles(fs.Args(), stdin, *doc, process, enc)
+	if flushErr := w.Flush(); err == nil {
+		err = flushErr
+	}
+	return err
+}
+
+// processFiles runs process over each named file, or over stdin when no
+// files are named.
+func processFiles(files []string, stdin io.Reader, doc bool, process processFunc, enc *json.Encoder) error {
+	if len(files) == 0 {
+		return processReader(stdin, "", doc, process, enc)
+	}
+	for _, path := range files {
+		f, err := os.Open(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		err = processReader(f, path, doc, process, enc)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processReader runs process over r line by line, or over all of r when
+// doc is set, and encodes one record per result.
+func processReader(r io.Reader, name string, doc bool, process processFunc, enc *json.Encoder) error {
+	if doc {
+		data, err := io.ReadAll(io.LimitReader(r, maxInputBytes+1))
+		if err != nil {
+			return err
+		}
+		if len(data) > maxInputBytes {
+			return fmt.Errorf("%s: document exceeds 1 MiB", displayName(name))
+		}
+		result, err := process(string(data))
+		if err != nil {
+			return err
+		}
+		return enc.Encode(record{File: name, Result: result})
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxInputBytes)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		result, err := process(text)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %w", displayName(name), line, err)
+		}
+		if err := enc.Encode(record{File: name, Line: line, Result: result}); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%s:%d: %w", displayName(name), line+1, err)
+	}
+	return nil
+}
+
+// displayName names an input in error messages.
+func displayName(name string) string {
+	if name == "" {
+		return "stdin"
+	}
+	return name
+}
+
+// usage prints the command list to w.
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: aznlp <command> [flags] [file ...]")
+	fmt.Fprintln(w, "\nCommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(w, "\nRun 'aznlp <command> -h' for the flags of a command.")
+}
+
+// ── Commands ──────────────────────────────────────────────────────────
+
+// ready returns the buildFunc of a command with no flags to validate.
+func ready(process processFunc) buildFunc {
+	return func() (processFunc, error) { return process, nil }
+}
+
+func setupTokenize(fs *flag.FlagSet) buildFunc {
+	mode := fs.String("mode", "words", "token kind: words, social, or sentences")
+	return func() (processFunc, error) {
+		var tokens func(string) []tokenizer.Token
+		switch *mode {
+		case "words":
+			tokens = tokenizer.WordTokens
+		case "social":
+			tokens = tokenizer.SocialTokens
+		case "sentences":
+			tokens = tokenizer.SentenceTokens
+		default:
+			return nil, fmt.Errorf("unknown -mode %q", *mode)
+		}
+		return func(text string) (any, error) {
+			return tokens(text), nil
+		}, nil
+	}
+}
+
+func setupStem(*flag.FlagSet) buildFunc {
+	return ready(func(text string) (any, error) {
+		words := tokenizer.Words(text)
+		out := make([]wordStem, len(words))
+		for i, w := range words {
+			out[i] = wordStem{Word: w, Stem: morph.Stem(w)}
+		}
+		return out, nil
+	})
+}
+
+func setupAnalyze(*flag.FlagSet) buildFunc {
+	return ready(func(text string) (any, error) {
+		words := tokenizer.Words(text)
+		out := make([]wordAnalysis, len(words))
+		for i, w := range words {
+			out[i] = wordAnalysis{Word: w, Analyses: morph.Analyze(w)}
+		}
+		return out, nil
+	})
+}
+
+func setupNER(*flag.FlagSet) buildFunc {
+	return ready(func(text string) (any, error) {
+		return ner.Recognize(text), nil
+	})
+}
+
+func setupSentiment(*flag.FlagSet) buildFunc {
+	return ready(func(text string) (any, error) {
+		return sentiment.Analyze(text), nil
+	})
+}
+
+func setupDetect(fs *flag.FlagSet) buildFunc {
+	all := fs.Bool("all", false, "report every candidate language, best first")
+	return func() (processFunc, error) {
+		return func(text string) (any, error) {
+			if *all {
+				return detect.DetectAll(text), nil
+			}
+			return detect.Detect(text), nil
+		}, nil
+	}
+}
+
+func setupNormalize(*flag.FlagSet) buildFunc {
+	return ready(func(text string) (any, error) {
+		return normalize.Normalize(text), nil
+	})
+}
+
+func setupChunk(fs *flag.FlagSet) buildFunc {
+	strategy := fs.String("strategy", "recursive", "chunking strategy: recursive, sentence, or size")
+	size := fs.Int("size", defaultChunk, "target chunk size in runes")
+	overlap := fs.Int("overlap", defaultOverlap, "overlap between chunks in runes")
+	return func() (processFunc, error) {
+		var chunk func(text string, size, overlap int) []chunker.Chunk
+		switch *strategy {
+		case "recursive":
+			chunk = chunker.Recursive
+		case "sentence":
+			chunk = chunker.BySentence
+		case "size":
+			chunk = chunker.BySize
+		default:
+			return nil, fmt.Errorf("unknown -strategy %q", *strategy)
+		}
+		return func(text string) (any, error) {
+			return chunk(text, *size, *overlap), nil
+		}, nil
+	}
+}
+
+// setupDatetime resolves -ref once, so that every line of a run shares
+// the same reference time.
+func setupDatetime(fs *flag.FlagSet) buildFunc {
+	ref := fs.String("ref", "", "reference time as RFC 3339 or YYYY-MM-DD (default now)")
+	tz := fs.String("tz", "", "IANA location to resolve times in, e.g. Asia/Baku (default that of -ref)")
+	return func() (processFunc, error) {
+		t, err := parseRef(*ref)
+		if err != nil {
+			return nil, err
+		}
+		var opts datetime.Options
+		if *tz != "" {
+			if opts.Location, err = time.LoadLocation(*tz); err != nil {
+				return nil, fmt.Errorf("invalid -tz %q: %w", *tz, err)
+			}
+		}
+		return func(text string) (any, error) {
+			return datetime.ExtractWithOptions(text, t, opts), nil
+		}, nil
+	}
+}
+
+// parseRef parses the -ref flag of the datetime command.
+func parseRef(s string) (time.Time, error) {
+	if s == "" {
+		return time.Now(), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, s, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid -ref %q: want RFC 3339 or YYYY-MM-DD", s)
+	}
+	return t, nil
+}
+
+func setupKeywords(fs *flag.FlagSet) buildFunc {
+	method := fs.String("method", "textrank", "ranking method: textrank or tfidf")
+	n := fs.Int("n", defaultKeywords, "number of keywords")
+	return func() (processFunc, error) {
+		var extract func(text string, topN int, opts ...keywords.Option) []keywords.Keyword
+		switch *method {
+		case "textrank":
+			extract = keywords.ExtractTextRank
+		case "tfidf":
+			extract = keywords.ExtractTFIDF
+		default:
+			return nil, fmt.Errorf("unknown -method %q", *method)
+		}
+		return func(text string) (any, error) {
+			return extract(text, *n), nil
+		}, nil
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    []string
+		stdin   string
+		want    string // stdout
+		wantErr error  // nil, or matched with errors.Is
+		errText string // substring of the error; "" when wantErr is nil
+	}{
+		{
+			name:  "stem skips blank lines",
+			args:  []string{"stem"},
+			stdin: "Bakı gözəl şəhərdir.\n\nsalam\n",
+			want: `{"line":1,"result":[{"word":"Bakı","stem":"Bakı"},{"word":"gözəl","stem":"gözəl"},{"word":"şəhərdir","stem":"şəhər"}]}` + "\n" +
+				`{"line":3,"result":[{"word":"salam","stem":"salam"}]}` + "\n",
+		},
+		{
+			name:  "datetime with fixed ref",
+			args:  []string{"datetime", "-ref", "2026-01-01T00:00:00Z"},
+			stdin: "3 gün sonra\n",
+			want:  `{"line":1,"result":[{"text":"3 gün sonra","start":0,"end":12,"type":"Date","time":"2026-01-04T00:00:00Z","explicit":7}]}` + "\n",
+		},
+		{
+			name:  "doc mode",
+			args:  []string{"tokenize", "-mode", "sentences", "-doc"},
+			stdin: "Salam.\nSağ ol.\n",
+			want:  `{"result":[{"text":"Salam.","start":0,"end":6,"type":"Sentence"},{"text":"\nSağ ol.\n","start":6,"end":16,"type":"Sentence"}]}` + "\n",
+		},
+		{name: "empty input", args: []string{"ner"}, stdin: ""},
+		{name: "bad mode on empty input", args: []string{"tokenize", "-mode", "bogus"}, wantErr: errFlags, errText: `unknown -mode "bogus"`},
+		{name: "bad strategy", args: []string{"chunk", "-strategy", "bogus"}, stdin: "Salam.\n", wantErr: errFlags, errText: `unknown -strategy "bogus"`},
+		{name: "bad method", args: []string{"keywords", "-method", "bogus"}, wantErr: errFlags, errText: `unknown -method "bogus"`},
+		{name: "bad ref", args: []string{"datetime", "-ref", "yesterday"}, wantErr: errFlags, errText: `invalid -ref "yesterday"`},
+		{name: "bad tz", args: []string{"datetime", "-tz", "Nowhere/City"}, wantErr: errFlags, errText: `invalid -tz "Nowhere/City"`},
+		{name: "unknown flag", args: []string{"stem", "-bogus"}, wantErr: errFlags, errText: "invalid flags"},
+		{name: "help", args: []string{"stem", "-h"}, wantErr: flag.ErrHelp, errText: "help requested"},
+		{name: "unknown command", args: []string{"bogus"}, errText: `unknown command "bogus"`},
+		{name: "missing command", args: nil, errText: "missing command"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var stdout, stderr bytes.Buffer
+			err := run(tt.args, bytes.NewBufferString(tt.stdin), &stdout, &stderr)
+			switch {
+			case tt.errText == "":
+				if err != nil {
+					t.Fatalf("run(%q) error = %v, stderr %q", tt.args, err, stderr.String())
+				}
+			case err == nil || !strings.Contains(err.Error(), tt.errText):
+				t.Fatalf("run(%q) error = %v, want containing %q", tt.args, err, tt.errText)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Fatalf("run(%q) error = %v, want %v", tt.args, err, tt.wantErr)
+			}
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run(%q) wrote\n%s\nwant\n%s", tt.args, got, tt.want)
+			}
+		})
+	}
+}
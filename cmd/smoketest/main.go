@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -17,33 +18,28 @@ import (
 const (
 	chunkSize      = 4 << 20 // 4 MB per read chunk
 	maxWorkers     = 4
-	expectedArgs   = 2
 	bytesToMBShift = 20
 )
 
-type fileRatio struct {
-	path       string
-	sentences  int
-	paragraphs int
-	ratio      float64
-}
-
 type Stats struct {
 	mu                sync.Mutex
+	checks            checkSet
 	filesScanned      int
 	totalBytes        int64
 	reconOK           int
 	reconFail         int
 	sentenceOutliers  int
+	medianRatio       float64
 	cyrillicFiles     int
 	translitReconOK   int
 	translitReconFail int
 	tokenTypeCounts   map[tokenizer.TokenType]int
-	fileRatios        []fileRatio
+	files             []fileReport
 }
 
 type fileState struct {
 	path            string
+	checks          checkSet
 	tokenCounts     map[tokenizer.TokenType]int
 	totalBytes      int64
 	reconFailed     bool
@@ -56,18 +52,38 @@ type fileState struct {
 }
 
 func main() {
-	if len(os.Args) != expectedArgs {
-		fmt.Fprintf(os.Stderr, "Usage: %s <directory>\n", os.Args[0])
+	format := flag.String("format", formatText, "report format: text, json, or csv")
+	checksFlag := flag.String("checks", "all", "comma-separated checks to run ("+strings.Join(allChecks, ", ")+
+		"); prefix a name with - to disable it")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format text|json|csv] [-checks list] <directory>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	switch *format {
+	case formatText, formatJSON, formatCSV:
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown format %q (want text, json, or csv)\n", *format)
+		os.Exit(1)
+	}
+	checks, err := parseChecks(*checksFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid -checks: %v\n", err)
 		os.Exit(1)
 	}
 
-	dirPath := os.Args[1]
+	dirPath := flag.Arg(0)
 	stats := &Stats{
+		checks:          checks,
 		tokenTypeCounts: make(map[tokenizer.TokenType]int),
 	}
 
 	var filePaths []string
-	err := filepath.WalkDir(dirPath, func(path string, d os.DirEntry, err error) error {
+	err = filepath.WalkDir(dirPath, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
@@ -101,10 +117,23 @@ func main() {
 
 	wg.Wait()
 
-	flagSentenceOutliers(stats)
+	if checks[checkOutliers] {
+		flagSentenceOutliers(stats)
+	}
 
 	fmt.Fprintf(os.Stderr, "\nCompleted in %s\n\n", time.Since(start).Round(time.Millisecond))
-	printStats(stats)
+	switch *format {
+	case formatJSON:
+		err = writeJSON(os.Stdout, newReport(stats, checks))
+	case formatCSV:
+		err = writeCSV(os.Stdout, newReport(stats, checks))
+	default:
+		printStats(stats)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing report: %v\n", err)
+		os.Exit(1)
+	}
 }
 
 func processFile(path string, stats *Stats) {
@@ -126,6 +155,7 @@ func processFile(path string, stats *Stats) {
 
 	state := &fileState{
 		path:        path,
+		checks:      stats.checks,
 		tokenCounts: make(map[tokenizer.TokenType]int),
 	}
 
@@ -176,18 +206,19 @@ func (fs *fileState) processChunk(chunk []byte) {
 	fs.totalBytes += int64(len(chunk))
 
 	tokens := tokenizer.WordTokens(text)
+	recon := fs.checks[checkRecon] && !fs.reconFailed
 
 	var sb strings.Builder
-	if !fs.reconFailed {
+	if recon {
 		sb.Grow(len(text))
 	}
 	for _, token := range tokens {
 		fs.tokenCounts[token.Type]++
-		if !fs.reconFailed {
+		if recon {
 			sb.WriteString(token.Text)
 		}
 	}
-	if !fs.reconFailed {
+	if recon {
 		if sb.String() != text {
 			fs.reconFailed = true
 			if !fs.reconFailLogged {
@@ -205,7 +236,7 @@ func (fs *fileState) processChunk(chunk []byte) {
 		fs.hasCyrillic = true
 	}
 
-	if fs.hasCyrillic && !fs.translitFailed {
+	if fs.checks[checkTranslit] && fs.hasCyrillic && !fs.translitFailed {
 		latinText := translit.CyrillicToLatin(text)
 		ltokens := tokenizer.WordTokens(latinText)
 		var lsb strings.Builder
@@ -230,32 +261,44 @@ func mergeFileState(fs *fileState, stats *Stats) {
 	stats.filesScanned++
 	stats.totalBytes += fs.totalBytes
 
-	if fs.reconFailed {
-		stats.reconFail++
-	} else {
-		stats.reconOK++
+	fr := fileReport{
+		Path:       fs.path,
+		Bytes:      fs.totalBytes,
+		Sentences:  fs.sentences,
+		Paragraphs: fs.paragraphs,
+		Ratio:      float64(fs.sentences) / float64(fs.paragraphs),
+		Tokens:     tokenCounts(fs.tokenCounts),
+		Cyrillic:   fs.hasCyrillic,
+	}
+
+	if fs.checks[checkRecon] {
+		fr.Recon = statusOK
+		if fs.reconFailed {
+			fr.Recon = statusFail
+			stats.reconFail++
+		} else {
+			stats.reconOK++
+		}
 	}
 
 	for tokenType, count := range fs.tokenCounts {
 		stats.tokenTypeCounts[tokenType] += count
 	}
 
-	ratio := float64(fs.sentences) / float64(fs.paragraphs)
-	stats.fileRatios = append(stats.fileRatios, fileRatio{
-		path:       fs.path,
-		sentences:  fs.sentences,
-		paragraphs: fs.paragraphs,
-		ratio:      ratio,
-	})
-
 	if fs.hasCyrillic {
 		stats.cyrillicFiles++
-		if fs.translitFailed {
-			stats.translitReconFail++
-		} else {
-			stats.translitReconOK++
+		if fs.checks[checkTranslit] {
+			fr.Translit = statusOK
+			if fs.translitFailed {
+				fr.Translit = statusFail
+				stats.translitReconFail++
+			} else {
+				stats.translitReconOK++
+			}
 		}
 	}
+
+	stats.files = append(stats.files, fr)
 }
 
 func logReconstructionFailure(path, original, reconstructed string) {
@@ -267,21 +310,25 @@ func logReconstructionFailure(path, original, reconstructed string) {
 // flagSentenceOutliers computes the median sentence/paragraph ratio across all
 // files and flags any file whose ratio exceeds 3x the median.
 func flagSentenceOutliers(stats *Stats) {
-	if len(stats.fileRatios) == 0 {
+	if len(stats.files) == 0 {
 		return
 	}
 
-	ratios := make([]float64, len(stats.fileRatios))
-	for i, fr := range stats.fileRatios {
-		ratios[i] = fr.ratio
+	ratios := make([]float64, len(stats.files))
+	for i, fr := range stats.files {
+		ratios[i] = fr.Ratio
 	}
 	med := computeMedian(ratios)
+	stats.medianRatio = med
 
-	for _, fr := range stats.fileRatios {
-		if med > 0 && fr.ratio > 3*med {
+	for i := range stats.files {
+		fr := &stats.files[i]
+		outlier := med > 0 && fr.Ratio > 3*med
+		fr.Outlier = &outlier
+		if outlier {
 			stats.sentenceOutliers++
 			fmt.Fprintf(os.Stderr, "SENTENCE_OUTLIER: %s: %d sentences / %d paragraphs (ratio %.2f, median %.2f)\n",
-				fr.path, fr.sentences, fr.paragraphs, fr.ratio, med)
+				fr.Path, fr.Sentences, fr.Paragraphs, fr.Ratio, med)
 		}
 	}
 }
@@ -339,12 +386,18 @@ func computeMedian(values []float64) float64 {
 func printStats(stats *Stats) {
 	fmt.Printf("Files scanned:           %d\n", stats.filesScanned)
 	fmt.Printf("Total bytes:             %d\n", stats.totalBytes)
-	fmt.Printf("Reconstruction OK:       %d\n", stats.reconOK)
-	fmt.Printf("Reconstruction FAIL:     %d\n", stats.reconFail)
-	fmt.Printf("Sentence outliers:       %d\n", stats.sentenceOutliers)
+	if stats.checks[checkRecon] {
+		fmt.Printf("Reconstruction OK:       %d\n", stats.reconOK)
+		fmt.Printf("Reconstruction FAIL:     %d\n", stats.reconFail)
+	}
+	if stats.checks[checkOutliers] {
+		fmt.Printf("Sentence outliers:       %d\n", stats.sentenceOutliers)
+	}
 	fmt.Printf("Cyrillic files:          %d\n", stats.cyrillicFiles)
-	fmt.Printf("Translit recon OK:       %d\n", stats.translitReconOK)
-	fmt.Printf("Translit recon FAIL:     %d\n", stats.translitReconFail)
+	if stats.checks[checkTranslit] {
+		fmt.Printf("Translit recon OK:       %d\n", stats.translitReconOK)
+		fmt.Printf("Translit recon FAIL:     %d\n", stats.translitReconFail)
+	}
 	fmt.Println()
 
 	totalTokens := 0
@@ -353,13 +406,9 @@ func printStats(stats *Stats) {
 	}
 
 	fmt.Println("Token type distribution:")
-	printTokenTypeStats("Word", tokenizer.Word, stats.tokenTypeCounts, totalTokens)
-	printTokenTypeStats("Number", tokenizer.Number, stats.tokenTypeCounts, totalTokens)
-	printTokenTypeStats("Punctuation", tokenizer.Punctuation, stats.tokenTypeCounts, totalTokens)
-	printTokenTypeStats("Space", tokenizer.Space, stats.tokenTypeCounts, totalTokens)
-	printTokenTypeStats("Symbol", tokenizer.Symbol, stats.tokenTypeCounts, totalTokens)
-	printTokenTypeStats("URL", tokenizer.URL, stats.tokenTypeCounts, totalTokens)
-	printTokenTypeStats("Email", tokenizer.Email, stats.tokenTypeCounts, totalTokens)
+	for _, t := range reportedTypes {
+		printTokenTypeStats(t.String(), t, stats.tokenTypeCounts, totalTokens)
+	}
 }
 
 func printTokenTypeStats(label string, tokenType tokenizer.TokenType, counts map[tokenizer.TokenType]int, total int) {
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// Output formats selected by -format.
+const (
+	formatText = "text"
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+// Checks selected by -checks.
+const (
+	checkRecon    = "recon"    // tokens concatenate back to the input
+	checkTranslit = "translit" // the same, after Cyrillic-to-Latin transliteration
+	checkOutliers = "outliers" // sentence/paragraph ratio above 3x the median
+)
+
+// Check outcomes in a fileReport. A check that did not run is empty.
+const (
+	statusOK   = "ok"
+	statusFail = "fail"
+)
+
+var allChecks = []string{checkRecon, checkTranslit, checkOutliers}
+
+// reportedTypes are the token types counted per file, in report order.
+var reportedTypes = []tokenizer.TokenType{
+	tokenizer.Word, tokenizer.Number, tokenizer.Punctuation, tokenizer.Space,
+	tokenizer.Symbol, tokenizer.URL, tokenizer.Email,
+}
+
+// checkSet is the set of enabled checks.
+type checkSet map[string]bool
+
+// parseChecks parses a comma-separated list of check names. A name
+// prefixed with "-" disables that check, starting from all of them
+// ("-translit"); otherwise only the named checks run. "all" enables every
+// check and "none" disables them.
+func parseChecks(s string) (checkSet, error) {
+	set := checkSet{}
+	names := strings.Split(s, ",")
+	if strings.HasPrefix(strings.TrimSpace(names[0]), "-") {
+		for _, c := range allChecks {
+			set[c] = true
+		}
+	}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		on := true
+		if rest, ok := strings.CutPrefix(name, "-"); ok {
+			name, on = rest, false
+		}
+		switch name {
+		case "all":
+			for _, c := range allChecks {
+				set[c] = on
+			}
+		case "none":
+			clear(set)
+		case checkRecon, checkTranslit, checkOutliers:
+			set[name] = on
+		default:
+			return nil, fmt.Errorf("unknown check %q (want %s, all, or none)", name, strings.Join(allChecks, ", "))
+		}
+	}
+	return set, nil
+}
+
+// fileReport is the per-file record of the json and csv formats.
+type fileReport struct {
+	Path       string         `json:"path"`
+	Bytes      int64          `json:"bytes"`
+	Sentences  int            `json:"sentences"`
+	Paragraphs int            `json:"paragraphs"`
+	Ratio      float64        `json:"ratio"` // sentences per paragraph
+	Tokens     map[string]int `json:"tokens"`
+	Cyrillic   bool           `json:"cyrillic"`
+	Recon      string         `json:"recon,omitempty"`    // statusOK, statusFail, or empty when not checked
+	Translit   string         `json:"translit,omitempty"` // likewise; not checked without Cyrillic text
+	Outlier    *bool          `json:"outlier,omitempty"`  // nil when not checked
+}
+
+// summary is the aggregate part of the json format.
+type summary struct {
+	Files             int            `json:"files"`
+	Bytes             int64          `json:"bytes"`
+	Checks            []string       `json:"checks"`
+	ReconOK           int            `json:"recon_ok"`
+	ReconFail         int            `json:"recon_fail"`
+	SentenceOutliers  int            `json:"sentence_outliers"`
+	MedianRatio       float64        `json:"median_ratio"`
+	CyrillicFiles     int            `json:"cyrillic_files"`
+	TranslitReconOK   int            `json:"translit_recon_ok"`
+	TranslitReconFail int            `json:"translit_recon_fail"`
+	Tokens            map[string]int `json:"tokens"`
+}
+
+// report is the json format: the summary and the files sorted by path.
+type report struct {
+	Summary summary      `json:"summary"`
+	Files   []fileReport `json:"files"`
+}
+
+// newReport builds the report of stats, with files sorted by path so that
+// runs over the same corpus diff cleanly.
+func newReport(stats *Stats, checks checkSet) report {
+	files := make([]fileReport, len(stats.files))
+	copy(files, stats.files)
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	s := summary{
+		Files:             stats.filesScanned,
+		Bytes:             stats.totalBytes,
+		Checks:            []string{},
+		ReconOK:           stats.reconOK,
+		ReconFail:         stats.reconFail,
+		SentenceOutliers:  stats.sentenceOutliers,
+		MedianRatio:       stats.medianRatio,
+		CyrillicFiles:     stats.cyrillicFiles,
+		TranslitReconOK:   stats.translitReconOK,
+		TranslitReconFail: stats.translitReconFail,
+		Tokens:            tokenCounts(stats.tokenTypeCounts),
+	}
+	for _, c := range allChecks {
+		if checks[c] {
+			s.Checks = append(s.Checks, c)
+		}
+	}
+	return report{Summary: s, Files: files}
+}
+
+// tokenCounts keys counts by token type name.
+func tokenCounts(counts map[tokenizer.TokenType]int) map[string]int {
+	m := make(map[string]int, len(reportedTypes))
+	for _, t := range reportedTypes {
+		m[t.String()] = counts[t]
+	}
+	return m
+}
+
+func writeJSON(w io.Writer, r report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(r)
+}
+
+// writeCSV writes one row per file, with a header row.
+func writeCSV(w io.Writer, r report) error {
+	cw := csv.NewWriter(w)
+	header := []string{"path", "bytes", "sentences", "paragraphs", "ratio", "cyrillic", "recon", "translit", "outlier"}
+	for _, t := range reportedTypes {
+		header = append(header, strings.ToLower(t.String()))
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, f := range r.Files {
+		outlier := ""
+		if f.Outlier != nil {
+			outlier = strconv.FormatBool(*f.Outlier)
+		}
+		row := []string{
+			f.Path,
+			strconv.FormatInt(f.Bytes, 10),
+			strconv.Itoa(f.Sentences),
+			strconv.Itoa(f.Paragraphs),
+			strconv.FormatFloat(f.Ratio, 'f', 4, 64),
+			strconv.FormatBool(f.Cyrillic),
+			f.Recon,
+			f.Translit,
+			outlier,
+		}
+		for _, t := range reportedTypes {
+			row = append(row, strconv.Itoa(f.Tokens[t.String()]))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,167 @@
+// Package coref links mentions of the same entity within an Azerbaijani
+// document: repeated proper names and the pronouns that refer back to
+// them.
+//
+// Three kinds of mention are found:
+//
+//   - Name: a run of capitalized words ("İlham Əliyev", "Bakı Dövlət
+//     Universiteti"), in any case form ("Əliyevin", "SOCAR'ın"). A
+//     capitalized word at the start of a sentence is a name only when it
+//     is an acronym, carries suffixes after an apostrophe, is used as a
+//     name elsewhere in the document, or starts a name run with a surname
+//     ("Əli Həsənov").
+//   - Entity: an entity from ner.Recognize (email, phone, address, date,
+//     amount, ...). Capitalized words inside an entity are not names.
+//   - Pronoun: the third-person pronouns o and onlar and the
+//     demonstrative bu used as a pronoun, in all their case forms ("onu",
+//     "onların", "bunu"); "o kitab" and "bu gün" are not pronouns.
+//
+// Names with the same stems form one cluster, and a single-word name
+// joins the cluster of an earlier or later full name ending in it
+// ("Əliyev" and "İlham Əliyev"). Entities with the same type and text
+// form one cluster. A pronoun joins the cluster of the nearest compatible
+// mention before it, within the current sentence or the two before: o
+// refers to a singular name, preferring one in the nominative (the likely
+// subject) over case forms such as "Bakıya"; onlar to a plural name
+// ("Həsənovlar"); bu, within the current or the previous sentence, to a
+// name or an entity. Pronouns with no compatible mention are left out.
+//
+// Two API layers are provided:
+//
+//   - Structured: Resolve returns []Cluster with the mentions of each
+//     entity and their byte offsets. The invariant
+//     text[m.Start:m.End] == m.Text holds for every mention.
+//   - Convenience: Subject returns the name mentioned most often, the
+//     likely subject of an article.
+//
+// All functions are safe for concurrent use by multiple goroutines.
+//
+// Known limitations:
+//
+//   - Common-noun mentions ("şirkət", "prezident") are not linked.
+//   - onlar is not linked to coordinated names ("Əli və Vəli").
+//   - Gender is not marked in Azerbaijani, so o may link to a place or
+//     an organization as readily as to a person.
+//   - A sentence-initial name seen nowhere else in the document is
+//     missed unless it is an acronym or followed by a surname.
+package coref
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+)
+
+// maxInputBytes is the maximum input size. Larger inputs return nil.
+const maxInputBytes = 1 << 20 // 1 MiB
+
+// Kind classifies a mention.
+type Kind int
+
+const (
+	Name    Kind = iota // Proper name ("İlham Əliyev", "Bakıya")
+	Entity              // Entity from ner.Recognize ("info@example.az")
+	Pronoun             // Pronoun referring back (o, onlar, bu and their case forms)
+)
+
+// kindNames maps Kind values to their string names.
+var kindNames = [...]string{
+	Name:    "Name",
+	Entity:  "Entity",
+	Pronoun: "Pronoun",
+}
+
+// kindFromName maps string names back to Kind values.
+var kindFromName = map[string]Kind{
+	"Name":    Name,
+	"Entity":  Entity,
+	"Pronoun": Pronoun,
+}
+
+// String returns the name of the mention kind.
+func (k Kind) String() string {
+	if int(k) >= 0 && int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// MarshalJSON encodes the kind as a JSON string (e.g. "Name").
+func (k Kind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Name") into a Kind.
+func (k *Kind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := kindFromName[s]
+	if !ok {
+		return fmt.Errorf("coref: unknown kind: %q", s)
+	}
+	*k = v
+	return nil
+}
+
+// Mention is one reference to an entity in the text.
+type Mention struct {
+	Text   string      `json:"text"`             // The mention as written
+	Start  int         `json:"start"`            // Byte offset in the original string (inclusive)
+	End    int         `json:"end"`              // Byte offset in the original string (exclusive)
+	Kind   Kind        `json:"kind"`             // Name, Entity, or Pronoun
+	Entity *ner.Entity `json:"entity,omitempty"` // Entity: the entity recognized by ner
+}
+
+// String returns a debug representation, e.g. Name("Əliyevin")[10:19].
+func (m Mention) String() string {
+	return fmt.Sprintf("%s(%q)[%d:%d]", m.Kind, m.Text, m.Start, m.End)
+}
+
+// Cluster is a set of mentions of the same entity.
+type Cluster struct {
+	// Name is a display form of the entity: the longest name mention,
+	// preferring one whose last word has no case suffix ("İlham Əliyev"
+	// over "İlham Əliyevin"), or the text of the first entity mention.
+	Name     string    `json:"name"`
+	Mentions []Mention `json:"mentions"` // In text order; the first is never a pronoun
+}
+
+// String returns a debug representation, e.g. "İlham Əliyev"(3 mentions).
+func (c Cluster) String() string {
+	return fmt.Sprintf("%q(%d mentions)", c.Name, len(c.Mentions))
+}
+
+// Resolve finds the name, entity, and pronoun mentions of text and groups
+// those that refer to the same entity. Clusters are ordered by their
+// first mention. Every name and entity belongs to a cluster, even when
+// mentioned once; unresolved pronouns are left out.
+// Returns nil for empty or oversized (>1 MiB) input.
+func Resolve(text string) []Cluster {
+	if text == "" || len(text) > maxInputBytes {
+		return nil
+	}
+	return resolve(findMentions(text))
+}
+
+// Subject returns the display name of the name cluster with the most
+// mentions, pronouns included, the earliest on ties: the entity a news
+// article is most likely about. Returns "" when text has no names.
+func Subject(text string) string {
+	best := -1
+	clusters := Resolve(text)
+	for i, c := range clusters {
+		if c.Mentions[0].Kind != Name {
+			continue
+		}
+		if best < 0 || len(c.Mentions) > len(clusters[best].Mentions) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	return clusters[best].Name
+}
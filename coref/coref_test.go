@@ -0,0 +1,232 @@
+package coref
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+)
+
+// verifyOffsets checks the byte offset invariant of every mention and
+// that mentions are in text order within their cluster.
+func verifyOffsets(t *testing.T, text string, clusters []Cluster) {
+	t.Helper()
+	for _, c := range clusters {
+		if len(c.Mentions) == 0 {
+			t.Errorf("cluster %v has no mentions", c)
+			continue
+		}
+		if c.Mentions[0].Kind == Pronoun {
+			t.Errorf("cluster %v starts with a pronoun", c)
+		}
+		for i, m := range c.Mentions {
+			if got := text[m.Start:m.End]; got != m.Text {
+				t.Errorf("text[%d:%d] = %q, mention Text = %q", m.Start, m.End, got, m.Text)
+			}
+			if i > 0 && m.Start < c.Mentions[i-1].End {
+				t.Errorf("cluster %v: mentions out of order at %v", c, m)
+			}
+		}
+	}
+}
+
+// summarize renders clusters as "Name: mention, mention; ..." for
+// compact comparison.
+func summarize(clusters []Cluster) string {
+	var parts []string
+	for _, c := range clusters {
+		var ms []string
+		for _, m := range c.Mentions {
+			ms = append(ms, m.Text)
+		}
+		parts = append(parts, c.Name+": "+strings.Join(ms, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			"pronoun prefers nominative name",
+			"İlham Əliyev Bakıya gəldi. O, burada çıxış etdi. Əliyevin nitqi uzun idi.",
+			"İlham Əliyev: İlham Əliyev, O, Əliyevin; Bakıya: Bakıya",
+		},
+		{
+			"sentence-initial first name before surname",
+			"Əli Həsənov gəldi. Onu hamı tanıyır.",
+			"Əli Həsənov: Əli Həsənov, Onu",
+		},
+		{
+			"full name after surname",
+			"Məmmədova çıxış etdi. Sonra Nigar Məmmədova suallara cavab verdi.",
+			"Nigar Məmmədova: Məmmədova, Nigar Məmmədova",
+		},
+		{
+			"determiners are not pronouns",
+			"O kitabı Nigar aldı. Bu kitab yaxşıdır.",
+			"Nigar: Nigar",
+		},
+		{
+			"plural pronoun",
+			"Həsənovlar gəldilər. Onlar yorğun idi.",
+			"Həsənovlar: Həsənovlar, Onlar",
+		},
+		{
+			"plural pronoun skips singular names",
+			"Dünən Nigar gəldi. Onlar yorğun idi.",
+			"Dünən: Dünən; Nigar: Nigar",
+		},
+		{
+			"acronym with apostrophe",
+			"SOCAR yeni layihə açıqladı. SOCAR'ın rəhbəri çıxış etdi.",
+			"SOCAR: SOCAR, SOCAR'ın",
+		},
+		{
+			"pronoun too far back",
+			"Sonra Nigar gəldi. Hava isti idi. Küçə boş idi. Yollar bağlı idi. O, evə getdi.",
+			"Nigar: Nigar",
+		},
+		{
+			"demonstrative links to entity",
+			"Sorğular üçün info@example.az ünvanına yazın. Bunu yadda saxlayın. Yenə info@example.az yazın.",
+			"info@example.az: info@example.az, Bunu, info@example.az",
+		},
+		{
+			"demonstrative skips dates",
+			"Görüş sabah olacaq. Bunu unutmayın.",
+			"sabah: sabah",
+		},
+		{
+			"unresolved pronoun",
+			"O, gəldi. Bu, vacibdir.",
+			"",
+		},
+		{
+			"sentence-initial common noun",
+			"Şirkət yeni layihə açıqladı.",
+			"",
+		},
+		{
+			"name used elsewhere",
+			"Bakı böyük şəhərdir. Mən Bakıda yaşayıram.",
+			"Bakı: Bakı, Bakıda",
+		},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Resolve(tt.in)
+			verifyOffsets(t, tt.in, got)
+			if s := summarize(got); s != tt.want {
+				t.Errorf("Resolve(%q)\n got %s\nwant %s", tt.in, s, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveEntity(t *testing.T) {
+	text := "Zəng edin: +994 50 123 45 67. Bu nömrə işləyir."
+	clusters := Resolve(text)
+	if len(clusters) != 1 {
+		t.Fatalf("Resolve(%q) = %v, want one cluster", text, clusters)
+	}
+	m := clusters[0].Mentions[0]
+	if m.Kind != Entity || m.Entity == nil || m.Entity.Type != ner.Phone {
+		t.Errorf("mention = %+v, want a Phone entity", m)
+	}
+}
+
+func TestResolveOversized(t *testing.T) {
+	if got := Resolve(strings.Repeat("Nigar gəldi. ", maxInputBytes/10)); got != nil {
+		t.Errorf("Resolve(oversized) returned %d clusters, want nil", len(got))
+	}
+}
+
+func TestSubject(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"İlham Əliyev Bakıya gəldi. O, burada çıxış etdi. Əliyevin nitqi uzun idi.", "İlham Əliyev"},
+		{"Bakı böyük şəhərdir. Nigar Məmmədova Bakıda yaşayır.", "Bakı"},
+		{"Sonra Nigar gəldi. Vüqar da gəldi.", "Nigar"},
+		{"Zəng edin: +994 50 123 45 67.", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Subject(tt.in); got != tt.want {
+			t.Errorf("Subject(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestKindJSON(t *testing.T) {
+	for _, k := range []Kind{Name, Entity, Pronoun} {
+		data, err := json.Marshal(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Kind
+		if err := json.Unmarshal(data, &got); err != nil || got != k {
+			t.Errorf("round trip of %v = %v, %v", k, got, err)
+		}
+	}
+	var k Kind
+	if err := json.Unmarshal([]byte(`"Noun"`), &k); err == nil {
+		t.Error("Unmarshal(Noun) succeeded")
+	}
+	if got := Kind(9).String(); got != "Kind(9)" {
+		t.Errorf("Kind(9).String() = %q", got)
+	}
+}
+
+func TestMentionJSON(t *testing.T) {
+	clusters := Resolve("Sonra Nigar gəldi. O, evə getdi.")
+	data, err := json.Marshal(clusters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"name":"Nigar","mentions":[{"text":"Nigar","start":6,"end":11,"kind":"Name"},{"text":"O","start":20,"end":21,"kind":"Pronoun"}]}]`
+	if string(data) != want {
+		t.Errorf("JSON = %s\nwant %s", data, want)
+	}
+}
+
+func BenchmarkResolve(b *testing.B) {
+	text := strings.Repeat("İlham Əliyev Bakıya gəldi. O, burada çıxış etdi. Əliyevin nitqi uzun idi. ", 50)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		Resolve(text)
+	}
+}
+
+func ExampleResolve() {
+	text := "Nigar Məmmədova Gəncəyə getdi. O, orada dostları ilə görüşdü. Məmmədova sabah qayıdacaq."
+	for _, c := range Resolve(text) {
+		fmt.Println(c.Name)
+		for _, m := range c.Mentions {
+			fmt.Printf("  %s %q [%d:%d]\n", m.Kind, m.Text, m.Start, m.End)
+		}
+	}
+	// Output:
+	// Nigar Məmmədova
+	//   Name "Nigar Məmmədova" [0:17]
+	//   Pronoun "O" [36:37]
+	//   Name "Məmmədova" [73:84]
+	// Gəncəyə
+	//   Name "Gəncəyə" [18:28]
+	// sabah
+	//   Entity "sabah" [85:90]
+}
+
+func ExampleSubject() {
+	fmt.Println(Subject("SOCAR yeni layihə açıqladı. SOCAR'ın rəhbəri çıxış etdi. Bakıda toplantı keçirildi."))
+	// Output:
+	// SOCAR
+}
@@ -0,0 +1,247 @@
+package coref
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/pos"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// pronounClass tells which mentions a pronoun can refer to.
+type pronounClass int
+
+const (
+	notPronoun    pronounClass = iota
+	singular                   // o: a singular name
+	plural                     // onlar: a plural name
+	demonstrative              // bu: a name or an entity
+)
+
+// pronouns maps the case forms of the resolved pronouns to their class.
+var pronouns = map[string]pronounClass{
+	"o": singular, "onu": singular, "onun": singular, "ona": singular,
+	"onda": singular, "ondan": singular, "onunla": singular,
+
+	"onlar": plural, "onları": plural, "onların": plural, "onlara": plural,
+	"onlarda": plural, "onlardan": plural, "onlarla": plural,
+
+	"bu": demonstrative, "bunu": demonstrative, "bunun": demonstrative,
+	"buna": demonstrative, "bunda": demonstrative, "bundan": demonstrative,
+	"bununla": demonstrative,
+}
+
+// Sentence distance within which a pronoun finds its antecedent.
+const (
+	maxPersonalDistance      = 2 // o, onlar: the current sentence and the two before
+	maxDemonstrativeDistance = 1 // bu: the current sentence and the one before
+)
+
+// surnameSuffixes end the stems of Azerbaijani surnames. A surname ends a
+// name run, and a capitalized word before it at the start of a sentence is
+// a first name.
+var surnameSuffixes = []string{"ov", "ova", "ev", "eva", "zadə"}
+
+// mention is a Mention with the features used to resolve it.
+type mention struct {
+	Mention
+	sentence   int          // index of the sentence containing the mention
+	keys       []string     // Name: lowercase stem of each word; Entity: type and lowercase text
+	pronoun    pronounClass // Pronoun: what it can refer to
+	plural     bool         // Name: the last word has the plural suffix ("Həsənovlar")
+	nominative bool         // Name: the last word has no suffix
+}
+
+// word is a word token with the features used to find names.
+type word struct {
+	tok      tokenizer.Token
+	idx      int  // index among the non-space tokens
+	sentence int  // index of the sentence containing the word
+	initial  bool // first word of its sentence
+	capital  bool // starts with an uppercase letter
+	name     bool // part of a name
+}
+
+// findMentions returns the name, entity, and pronoun mentions of text,
+// ordered by Start.
+func findMentions(text string) []mention {
+	ents := ner.Recognize(text)
+	sents := tokenizer.SentenceTokens(text)
+
+	var (
+		tokens []string // non-space tokens, for pos.Tag
+		words  []word
+		ent    = 0
+		sent   = 0
+		seen   = -1 // sentence of the last word
+	)
+	for _, tok := range tokenizer.WordTokens(text) {
+		if tok.Type == tokenizer.Space {
+			continue
+		}
+		tokens = append(tokens, tok.Text)
+		if tok.Type != tokenizer.Word {
+			continue
+		}
+		for sent+1 < len(sents) && sents[sent].End <= tok.Start {
+			sent++
+		}
+		initial := sent != seen
+		seen = sent
+		for ent < len(ents) && ents[ent].End <= tok.Start {
+			ent++
+		}
+		if ent < len(ents) && ents[ent].Start < tok.End {
+			continue // part of an entity
+		}
+		r, _ := utf8.DecodeRuneInString(tok.Text)
+		words = append(words, word{
+			tok:      tok,
+			idx:      len(tokens) - 1,
+			sentence: sent,
+			initial:  initial,
+			capital:  unicode.IsUpper(r),
+		})
+	}
+	markNames(words)
+
+	tags := pos.Tag(tokens)
+	var out []mention
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		if w.name {
+			j := i + 1
+			for j < len(words) && words[j].name && words[j].idx == words[j-1].idx+1 && !endsName(words[j-1].tok.Text) {
+				j++
+			}
+			out = append(out, nameMention(text, words[i:j]))
+			i = j - 1
+			continue
+		}
+		class := pronouns[azcase.ToLower(w.tok.Text)]
+		if class == notPronoun {
+			continue
+		}
+		if tags != nil && tags[w.idx].Tag == pos.Det {
+			continue // "o kitab", "bu gün"
+		}
+		out = append(out, mention{
+			Mention:  Mention{Text: w.tok.Text, Start: w.tok.Start, End: w.tok.End, Kind: Pronoun},
+			sentence: w.sentence,
+			pronoun:  class,
+		})
+	}
+
+	sent = 0
+	for i := range ents {
+		e := &ents[i]
+		for sent+1 < len(sents) && sents[sent].End <= e.Start {
+			sent++
+		}
+		out = append(out, mention{
+			Mention:  Mention{Text: e.Text, Start: e.Start, End: e.End, Kind: Entity, Entity: e},
+			sentence: sent,
+			keys:     []string{e.Type.String(), azcase.ToLower(e.Text)},
+		})
+	}
+	slices.SortStableFunc(out, func(a, b mention) int { return a.Start - b.Start })
+	return out
+}
+
+// markNames sets the name flag of the words that belong to names: the
+// capitalized words inside a sentence, and the capitalized first words
+// that are acronyms, carry suffixes after an apostrophe, are used as a
+// name inside a sentence, are surnames, or precede a surname.
+func markNames(words []word) {
+	known := make(map[string]bool)
+	for i := range words {
+		w := &words[i]
+		if w.capital && !w.initial && !isPronoun(w.tok.Text) {
+			w.name = true
+			known[nameKey(w.tok.Text)] = true
+		}
+	}
+	for i := range words {
+		w := &words[i]
+		if !w.capital || !w.initial || isPronoun(w.tok.Text) {
+			continue
+		}
+		switch {
+		case isAcronym(w.tok.Text), strings.ContainsFunc(w.tok.Text, azcase.IsApostrophe):
+			w.name = true
+		case known[nameKey(w.tok.Text)], isSurname(nameKey(w.tok.Text)):
+			w.name = true
+		case i+1 < len(words) && words[i+1].name && words[i+1].idx == w.idx+1 && isSurname(nameKey(words[i+1].tok.Text)):
+			w.name = true
+		}
+	}
+}
+
+// nameMention builds the mention of a run of name words.
+func nameMention(text string, run []word) mention {
+	m := mention{
+		Mention:  Mention{Start: run[0].tok.Start, End: run[len(run)-1].tok.End, Kind: Name},
+		sentence: run[0].sentence,
+	}
+	m.Text = text[m.Start:m.End]
+	for _, w := range run {
+		m.keys = append(m.keys, nameKey(w.tok.Text))
+	}
+	last := run[len(run)-1].tok.Text
+	key := m.keys[len(m.keys)-1]
+	lower := azcase.ToLower(last)
+	m.nominative = lower == key
+	rest, ok := strings.CutPrefix(lower, key)
+	m.plural = ok && (strings.HasPrefix(rest, "lar") || strings.HasPrefix(rest, "lər"))
+	return m
+}
+
+// endsName reports whether a name word ends its name, so that the next
+// capitalized word starts another one: a word with a case or other
+// suffix ("Əliyevin", "Bakıya"), or a surname ("İlham Əliyev Bakıya").
+func endsName(w string) bool {
+	key := nameKey(w)
+	return azcase.ToLower(w) != key || isSurname(key)
+}
+
+// nameKey returns the lowercase stem of a name word: "Əliyevin" and
+// "Əliyev'in" give "əliyev". Acronyms are not stemmed ("SOCAR'ın" gives
+// "socar").
+func nameKey(w string) string {
+	if isAcronym(w) {
+		if i := strings.IndexFunc(w, azcase.IsApostrophe); i >= 0 {
+			w = w[:i]
+		}
+		return azcase.ToLower(w)
+	}
+	return morph.Stem(azcase.ToLower(w))
+}
+
+// isAcronym reports whether w is an all-caps word of two or more letters,
+// possibly followed by suffixes after an apostrophe ("SOCAR'ın").
+func isAcronym(w string) bool {
+	if i := strings.IndexFunc(w, azcase.IsApostrophe); i >= 0 {
+		w = w[:i]
+	}
+	return utf8.RuneCountInString(w) >= 2 && azcase.IsAllUpper(w)
+}
+
+// isSurname reports whether the name stem key ends in a surname suffix.
+func isSurname(key string) bool {
+	for _, s := range surnameSuffixes {
+		if strings.HasSuffix(key, s) && len(key) > len(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPronoun reports whether w is a form of a resolved pronoun.
+func isPronoun(w string) bool {
+	return pronouns[azcase.ToLower(w)] != notPronoun
+}
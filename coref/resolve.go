@@ -0,0 +1,156 @@
+package coref
+
+import (
+	"slices"
+
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+)
+
+// cluster is a Cluster under construction.
+type cluster struct {
+	Cluster
+	names   [][]string // keys of the distinct name mentions
+	words   int        // word count of the mention Name was taken from
+	nomName bool       // Name was taken from a nominative mention
+	last    int        // Start of the latest mention
+}
+
+// resolve groups mentions, ordered by Start, into clusters ordered by
+// their first mention.
+func resolve(mentions []mention) []Cluster {
+	var (
+		clusters []*cluster
+		of       = make([]int, len(mentions)) // cluster index of each mention, or -1
+	)
+	for i, m := range mentions {
+		of[i] = -1
+		var c int
+		switch m.Kind {
+		case Name:
+			c = matchName(clusters, m.keys)
+		case Entity:
+			c = matchEntity(mentions[:i], of, m.keys)
+		case Pronoun:
+			a := antecedent(mentions[:i], m)
+			if a < 0 {
+				continue
+			}
+			c = of[a]
+		}
+		if c < 0 {
+			c = len(clusters)
+			clusters = append(clusters, &cluster{Cluster: Cluster{Name: m.Text}, words: len(m.keys), nomName: m.nominative})
+		}
+		of[i] = c
+		cl := clusters[c]
+		cl.Mentions = append(cl.Mentions, m.Mention)
+		cl.last = m.Start
+		if m.Kind == Name {
+			cl.addName(m)
+		}
+	}
+
+	out := make([]Cluster, len(clusters))
+	for i, c := range clusters {
+		out[i] = c.Cluster
+	}
+	return out
+}
+
+// addName records the keys of a name mention and takes it as the display
+// name when it has more words, or as many and no case suffix where the
+// current one has one.
+func (c *cluster) addName(m mention) {
+	if !slices.ContainsFunc(c.names, func(k []string) bool { return slices.Equal(k, m.keys) }) {
+		c.names = append(c.names, m.keys)
+	}
+	if n := len(m.keys); n > c.words || n == c.words && m.nominative && !c.nomName {
+		c.Name, c.words, c.nomName = m.Text, n, m.nominative
+	}
+}
+
+// matchName returns the index of the most recently mentioned cluster
+// naming the same entity as a name with the given keys, or -1. Names
+// match when their stems are equal, or when one is a single word ending
+// the other ("Əliyev" and "İlham Əliyev").
+func matchName(clusters []*cluster, keys []string) int {
+	best := -1
+	for i, c := range clusters {
+		if best >= 0 && c.last < clusters[best].last {
+			continue
+		}
+		if slices.ContainsFunc(c.names, func(k []string) bool { return sameName(k, keys) }) {
+			best = i
+		}
+	}
+	return best
+}
+
+// sameName reports whether names with keys a and b refer to the same
+// entity.
+func sameName(a, b []string) bool {
+	switch {
+	case slices.Equal(a, b):
+		return true
+	case len(a) == 1 && len(b) > 1:
+		return b[len(b)-1] == a[0]
+	case len(b) == 1 && len(a) > 1:
+		return a[len(a)-1] == b[0]
+	}
+	return false
+}
+
+// matchEntity returns the cluster of an entity mention among mentions
+// with the same keys, or -1. of holds the clusters of mentions.
+func matchEntity(mentions []mention, of []int, keys []string) int {
+	for i, m := range mentions {
+		if of[i] >= 0 && m.Kind == Entity && slices.Equal(m.keys, keys) {
+			return of[i]
+		}
+	}
+	return -1
+}
+
+// antecedent returns the index in before of the mention the pronoun p
+// refers to, or -1. o prefers a nominative name over a case form; bu
+// takes the nearest name or entity other than a date or an amount.
+func antecedent(before []mention, p mention) int {
+	maxDist := maxPersonalDistance
+	if p.pronoun == demonstrative {
+		maxDist = maxDemonstrativeDistance
+	}
+	fallback := -1
+	for i := len(before) - 1; i >= 0; i-- {
+		m := before[i]
+		if p.sentence-m.sentence > maxDist {
+			break
+		}
+		switch p.pronoun {
+		case singular:
+			if m.Kind != Name || m.plural {
+				continue
+			}
+			if m.nominative {
+				return i
+			}
+			if fallback < 0 {
+				fallback = i
+			}
+		case plural:
+			if m.Kind == Name && m.plural {
+				return i
+			}
+		case demonstrative:
+			if m.Kind == Name || m.Kind == Entity && !isValue(m.Entity.Type) {
+				return i
+			}
+		}
+	}
+	return fallback
+}
+
+// isValue reports whether entities of type t are dates or amounts, which
+// bu does not refer back to.
+func isValue(t ner.EntityType) bool {
+	return t == ner.Date || t == ner.Money || t == ner.Percent
+}
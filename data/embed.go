@@ -9,8 +9,26 @@ var MorphDict []byte
 //go:embed spell_freq.txt
 var SpellFreq []byte
 
+//go:embed spell_bigrams.txt
+var SpellBigrams []byte
+
 //go:embed keywords_freq.txt
 var KeywordsFreq []byte
 
 //go:embed lexicon.txt
 var SentimentLexicon string
+
+//go:embed emotion_lexicon.txt
+var EmotionLexicon string
+
+// DetectAz, DetectTr, and DetectEn train the short-text profiles of
+// package detect.
+//
+//go:embed detect_az.txt
+var DetectAz string
+
+//go:embed detect_tr.txt
+var DetectTr string
+
+//go:embed detect_en.txt
+var DetectEn string
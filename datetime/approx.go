@@ -0,0 +1,253 @@
+// Approximate expressions: "təxminən saat 5-də", "saat 5 radələrində",
+// "mart ortalarında", "keçən ilin sonunda", "həftənin əvvəlində".
+package datetime
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Tolerances of a hedged expression ("təxminən ..."), by the finest
+// component written in the text.
+const (
+	toleranceMinute = 15 * time.Minute
+	toleranceHour   = 30 * time.Minute
+	toleranceDay    = 24 * time.Hour
+	toleranceMonth  = 15 * 24 * time.Hour
+	toleranceYear   = 182 * 24 * time.Hour
+
+	// durationTolerance divides a hedged duration: "təxminən 2 saat" is
+	// two hours, give or take 30 minutes.
+	durationTolerance = 4
+
+	monthsPerYear = 12
+)
+
+// hedgesBefore are the words that mark the following expression as
+// approximate ("təxminən saat 5-də").
+var hedgesBefore = map[string]bool{
+	"təxminən": true,
+	"təqribən": true,
+	"təxmini":  true,
+}
+
+// hedgesAfter are the words that mark the preceding expression as
+// approximate ("saat 5 radələrində", "saat 5-ə yaxın").
+var hedgesAfter = map[string]bool{
+	"radələrində": true,
+	"radələri":    true,
+	"civarında":   true,
+	"civarı":      true,
+	"yaxın":       true,
+}
+
+// partUnits maps the genitive period words to their kind: "ilin
+// sonunda", "ayın ortasında", "həftənin əvvəlində".
+var partUnits = map[string]periodKind{
+	"ilin":     periodYear,
+	"ayın":     periodMonth,
+	"həftənin": periodWeek,
+}
+
+// monthNouns are the forms of "ay" between a month name and its part
+// ("mart ayının sonunda").
+var monthNouns = map[string]bool{
+	"ayının": true,
+	"ayı":    true,
+}
+
+// appendParts matches parts of a year, month, or week: "ilin sonunda",
+// "keçən ayın ortasında", "2025-ci ilin əvvəlində", "gələn həftənin
+// birinci yarısı". The bare genitive names the current period; after
+// "hər" it is a recurrence, not a date.
+func appendParts(all []Result, s string, words []wordSpan, ref time.Time) []Result {
+	for i := 0; i+1 < len(words); i++ {
+		if words[i].lower == wordEvery {
+			i++
+			continue
+		}
+		j, offset, year := i, 0, 0
+		if o, ok := periodPrefix[words[i].lower]; ok {
+			j, offset = i+1, o
+		} else if y, ok := ordinalYear(words[i].text); ok {
+			j, year = i+1, y
+		}
+		if j+1 >= len(words) {
+			continue
+		}
+		pk, ok := partUnits[words[j].lower]
+		if !ok || (year != 0 && pk != periodYear) || words[j+1].lower == wordBefore {
+			continue
+		}
+		from, to, next, ok := partTenths(words, j+1)
+		if !ok {
+			continue
+		}
+
+		start := resolvePeriod(ref, offset, pk)
+		if year != 0 {
+			start = time.Date(year, time.January, 1, 0, 0, 0, 0, ref.Location())
+		}
+		explicit := HasYear
+		switch pk {
+		case periodMonth:
+			explicit |= HasMonth
+		case periodWeek:
+			explicit |= HasMonth | HasDay
+		}
+		r := Result{
+			Text:     s[words[i].start:words[next-1].end],
+			Start:    words[i].start,
+			End:      words[next-1].end,
+			Type:     TypeDate,
+			Explicit: explicit,
+		}
+		lo, hi := partWindow(start, pk, from, to)
+		setWindow(&r, lo, hi)
+		all = append(all, r)
+		i = next - 1
+	}
+	return all
+}
+
+// ordinalYear parses a year written as an ordinal ("2025-ci").
+func ordinalYear(text string) (int, bool) {
+	m := reOrdinalYear.FindStringSubmatch(text)
+	if m == nil {
+		return 0, false
+	}
+	return parse4DigitYear(m[1])
+}
+
+// partWindow returns the part [from, to), in tenths, of the period of
+// kind pk starting at start. Years are divided in months, months and
+// weeks in days: "ilin əvvəli" is January to March, "ayın sonu" the 22nd
+// to the end of a 31-day month, "həftənin sonu" Friday to Sunday.
+func partWindow(start time.Time, pk periodKind, from, to int) (lo, hi time.Time) {
+	switch pk {
+	case periodYear:
+		return start.AddDate(0, monthsPerYear*from/tenths, 0), start.AddDate(0, monthsPerYear*to/tenths, 0)
+	case periodMonth:
+		days := start.AddDate(0, 1, -1).Day()
+		return start.AddDate(0, 0, days*from/tenths), start.AddDate(0, 0, days*to/tenths)
+	default:
+		return start.AddDate(0, 0, daysPerWeek*from/tenths), start.AddDate(0, 0, daysPerWeek*to/tenths)
+	}
+}
+
+// setWindow marks r approximate, at the middle of [lo, hi).
+func setWindow(r *Result, lo, hi time.Time) {
+	half := hi.Sub(lo) / 2
+	r.Time = lo.Add(half)
+	r.Tolerance = half
+	r.Approximate = true
+}
+
+// applyApproximations narrows month dates to the part that follows them
+// ("mart ortalarında") and extends results over the hedges around them
+// ("təxminən", "radələrində"), marking both approximate.
+func applyApproximations(results []Result, s string, words []wordSpan) []Result {
+	for i := range results {
+		r := &results[i]
+		limit := len(s)
+		if i+1 < len(results) {
+			limit = results[i+1].Start
+		}
+		if r.Type == TypeDate && r.Explicit&HasMonth != 0 && r.Explicit&HasDay == 0 && !r.Approximate {
+			narrowMonth(r, s, words, limit)
+		}
+		if r.Type == TypeRecurrence || r.Type == TypePeriod {
+			continue
+		}
+
+		hedged := false
+		if k := precedingWord(s, words, r.Start); k >= 0 && hedgesBefore[words[k].lower] &&
+			(i == 0 || results[i-1].End <= words[k].start) {
+			r.Start, hedged = words[k].start, true
+		}
+		if k := followingWord(s, words, r.End); k >= 0 && hedgesAfter[words[k].lower] && words[k].end <= limit {
+			r.End, hedged = words[k].end, true
+		}
+		if !hedged {
+			continue
+		}
+		r.Text = s[r.Start:r.End]
+		if !r.Approximate {
+			r.Approximate = true
+			r.Tolerance = defaultTolerance(*r)
+		}
+	}
+	return results
+}
+
+// narrowMonth narrows the month date r to the part named after it,
+// within s[:limit]: "mart ortalarında", "martın sonunda", "mart ayının
+// əvvəlində".
+func narrowMonth(r *Result, s string, words []wordSpan, limit int) {
+	k := followingWord(s, words, r.End)
+	if k < 0 {
+		return
+	}
+	if monthNouns[words[k].lower] && k+1 < len(words) {
+		k++
+	}
+	if words[k].lower == wordBefore {
+		return
+	}
+	from, to, next, ok := partTenths(words, k)
+	if !ok || words[next-1].end > limit {
+		return
+	}
+	t := r.Time
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	lo, hi := partWindow(start, periodMonth, from, to)
+	setWindow(r, lo, hi)
+	r.End = words[next-1].end
+	r.Text = s[r.Start:r.End]
+}
+
+// defaultTolerance returns the tolerance of a hedged result: a quarter
+// of a duration, or by the finest component written in the text.
+func defaultTolerance(r Result) time.Duration {
+	switch {
+	case r.Type == TypeDuration:
+		return r.Duration / durationTolerance
+	case r.Explicit&(HasMinute|HasSecond) != 0:
+		return toleranceMinute
+	case r.Explicit&HasHour != 0:
+		return toleranceHour
+	case r.Explicit&HasDay != 0:
+		return toleranceDay
+	case r.Explicit&HasMonth != 0:
+		return toleranceMonth
+	default:
+		return toleranceYear
+	}
+}
+
+// followingWord returns the index of the word following offset end, with
+// only spaces between them, or -1.
+func followingWord(s string, words []wordSpan, end int) int {
+	k, _ := slices.BinarySearchFunc(words, end, func(w wordSpan, off int) int {
+		return cmp.Compare(w.start, off)
+	})
+	if k == len(words) || strings.TrimSpace(s[end:words[k].start]) != "" {
+		return -1
+	}
+	return k
+}
+
+// precedingWord returns the index of the word preceding offset start, with
+// only spaces between them, or -1.
+func precedingWord(s string, words []wordSpan, start int) int {
+	k, _ := slices.BinarySearchFunc(words, start, func(w wordSpan, off int) int {
+		return cmp.Compare(w.start, off)
+	})
+	k--
+	if k < 0 || words[k].end > start || strings.TrimSpace(s[words[k].end:start]) != "" {
+		return -1
+	}
+	return k
+}
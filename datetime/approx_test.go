@@ -0,0 +1,119 @@
+package datetime
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestExtractApproximate(t *testing.T) {
+	t.Parallel()
+
+	const day = 24 * time.Hour
+	tests := []struct {
+		name string
+		in   string
+		text string
+		typ  Type
+		time time.Time
+		tol  time.Duration
+	}{
+		// -- Hedges --
+		{"hedge before time", "təxminən saat 5-də gələcək", "təxminən saat 5-də", TypeTime, dt(2026, 2, 20, 5, 0, 0), 30 * time.Minute},
+		{"hedge after time", "saat 5 radələrində", "saat 5 radələrində", TypeTime, dt(2026, 2, 20, 5, 0, 0), 30 * time.Minute},
+		{"yaxın", "saat 5-ə yaxın", "saat 5-ə yaxın", TypeTime, dt(2026, 2, 20, 5, 0, 0), 30 * time.Minute},
+		{"minutes", "saat 14:30 civarında", "saat 14:30 civarında", TypeTime, dt(2026, 2, 20, 14, 30, 0), 15 * time.Minute},
+		{"date", "təqribən 5 mart", "təqribən 5 mart", TypeDate, d(2026, time.March, 5), day},
+		{"relative", "təxminən iki həftə sonra", "təxminən iki həftə sonra", TypeDate, dt(2026, 3, 6, 10, 30, 0), day},
+		{"duration", "təxminən 3 saat çəkəcək", "təxminən 3 saat", TypeDuration, time.Time{}, 45 * time.Minute},
+
+		// -- Parts of a month name --
+		{"month middle", "mart ortalarında", "mart ortalarında", TypeDate, d(2026, time.March, 16), 6 * day},
+		{"month genitive", "martın sonunda", "martın sonunda", TypeDate, d(2026, time.March, 27), 5 * day},
+		{"month noun", "mart ayının əvvəlində", "mart ayının əvvəlində", TypeDate, dt(2026, 3, 5, 12, 0, 0), 4*day + 12*time.Hour},
+		{"hedged month part", "təxminən mart ortalarında", "təxminən mart ortalarında", TypeDate, d(2026, time.March, 16), 6 * day},
+
+		// -- Parts of a year, month, or week --
+		{"year", "ilin sonunda", "ilin sonunda", TypeDate, d(2026, time.November, 1), 61 * day},
+		{"previous year", "keçən ilin əvvəlində", "keçən ilin əvvəlində", TypeDate, d(2025, time.February, 15), 45 * day},
+		{"ordinal year", "2025-ci ilin sonunda", "2025-ci ilin sonunda", TypeDate, d(2025, time.November, 1), 61 * day},
+		{"year half", "ilin birinci yarısında", "ilin birinci yarısında", TypeDate, dt(2026, 4, 1, 12, 0, 0), 90*day + 12*time.Hour},
+		{"current month", "ayın ortasında", "ayın ortasında", TypeDate, dt(2026, 2, 14, 12, 0, 0), 5*day + 12*time.Hour},
+		{"next month", "gələn ayın sonunda", "gələn ayın sonunda", TypeDate, d(2026, time.March, 27), 5 * day},
+		{"week", "həftənin sonunda", "həftənin sonunda", TypeDate, dt(2026, 2, 21, 12, 0, 0), 36 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Extract(tt.in, ref)
+			if len(got) != 1 {
+				t.Fatalf("Extract(%q) = %v, want one result", tt.in, got)
+			}
+			r := got[0]
+			if r.Text != tt.text || tt.in[r.Start:r.End] != r.Text || r.Type != tt.typ {
+				t.Errorf("got %v, want %s(%q)", r, tt.typ, tt.text)
+			}
+			if !r.Approximate {
+				t.Error("Approximate = false")
+			}
+			if !r.Time.Equal(tt.time) || r.Tolerance != tt.tol {
+				t.Errorf("Time = %v ± %v, want %v ± %v", r.Time, r.Tolerance, tt.time, tt.tol)
+			}
+		})
+	}
+}
+
+func TestExtractApproximateNegative(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{
+		"saat 5-də",            // no hedge
+		"mart ayından sonra",   // not a part of the month
+		"mart əvvəl",           // direction word, not a part
+		"5 mart sonunda",       // a day, not a month
+		"hər ayın sonunda",     // recurring, not a date
+		"XX əsrin ortalarında", // periods carry their own span
+		"təxminən",             // hedge alone
+	} {
+		for _, r := range Extract(in, ref) {
+			if r.Approximate || r.Tolerance != 0 {
+				t.Errorf("Extract(%q) = %v ± %v, want exact", in, r, r.Tolerance)
+			}
+		}
+	}
+}
+
+func TestApproximateJSON(t *testing.T) {
+	t.Parallel()
+
+	got := Extract("saat 5 radələrində", ref)
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back []Result
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if len(back) != 1 || !back[0].Approximate || back[0].Tolerance != got[0].Tolerance {
+		t.Errorf("round trip = %v", back)
+	}
+}
+
+func BenchmarkExtractApproximate(b *testing.B) {
+	const s = "Təmir işləri təxminən mart ortalarında başlayacaq və ilin sonunda, saat 5 radələrində bitəcək."
+	for b.Loop() {
+		Extract(s, ref)
+	}
+}
+
+func ExampleResult_approximate() {
+	ref := time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC)
+	for _, r := range Extract("Görüş mart ortalarında, təxminən saat 5-də olacaq.", ref) {
+		fmt.Println(r.Text, r.Time.Format(time.DateTime), r.Tolerance)
+	}
+	// Output:
+	// mart ortalarında 2026-03-16 00:00:00 144h0m0s
+	// təxminən saat 5-də 2026-01-10 05:00:00 30m0s
+}
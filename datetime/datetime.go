@@ -1,19 +1,73 @@
 // Package datetime parses Azerbaijani natural-language date and time
 // expressions into structured time values.
 //
-// The package recognizes three result types: dates, times, and combined
-// date-time expressions. It handles natural text ("5 mart 2026"),
-// numeric formats ("05.03.2026", "2026-03-05"), and relative expressions
-// ("bu gün", "3 gün əvvəl", "keçən həftə").
+// The package recognizes six result types: dates, times, combined
+// date-time expressions, durations, recurrences, and periods. It handles natural
+// text ("5 mart 2026"), numeric formats ("05.03.2026", "2026-03-05"),
+// relative expressions ("bu gün", "3 gün əvvəl", "keçən həftə"), and
+// durations ("iki saat yarım", "45 dəqiqə", "3 gün"). A quantity followed by a
+// direction word is a relative date ("3 gün sonra"); without one it is a
+// TypeDuration result carrying a time.Duration ("3 gün çəkəcək").
+//
+// Repeating schedules ("hər bazar ertəsi", "hər ayın 5-i", "iki həftədən
+// bir", "həftədə bir dəfə") are TypeRecurrence results. Result.Recurrence
+// holds the frequency, interval, and anchor, and Recurrence.RRULE renders
+// it as an RFC 5545 rule; Result.Time is the first occurrence at or after
+// the reference time, at the clock time when one is given ("hər gün saat
+// 9-da").
+//
+// Centuries and millennia ("XIX əsr", "21-ci əsrdə", "XIX-XX əsrlər",
+// "III minillik") are TypePeriod results with the span of years in
+// Result.Period. A part of a period narrows it: "XX əsrin əvvəlləri"
+// (the first three tenths), "ortaları", "sonları", "birinci yarısı", and
+// decades ("XX əsrin 30-cu illəri"). "eramızdan əvvəl" or "e.ə." before
+// the number counts back from the common era; Result.Time is the start
+// of the period, and zero for periods before the common era.
+//
+// Approximate expressions set Result.Approximate, and Result.Tolerance to
+// the half-width of the window around Result.Time. A hedge before or
+// after the expression ("təxminən saat 5-də", "saat 5 radələrində")
+// joins the span, with a tolerance by the finest component written. A
+// part of a month, year, or week ("mart ortalarında", "keçən ilin
+// sonunda", "həftənin əvvəlində") is a date at the middle of the part,
+// split in tenths as for periods.
 //
 // Two API layers are provided:
 //
 //   - Extract returns []Result with byte offsets for scanning running text.
 //   - Parse returns a single Result for isolated date/time expressions.
 //
+// ExtractWithOptions and ParseWithOptions accept an Options value that
+// controls how ambiguous numeric dates ("03/05/2026") are read; the chosen
+// reading is reported in Result.Order. Options.Anaphora enables document-level
+// resolution of "ertəsi gün", "həmin gün", and "bir gün əvvəl" against the
+// previously mentioned date.
+//
+// Named holidays and observances ("Novruz bayramı", "Qurban bayramı",
+// "Müstəqillik günü") are dates in the reference year, with the canonical
+// name in Result.Holiday. Ramazan and Qurban bayramı move with the Islamic
+// calendar and are resolved from an embedded table covering 2015-2035
+// (Umm al-Qura dates; the officially announced day may differ by one).
+// Outside that range they are not reported.
+//
 // Relative and partial expressions are resolved against a reference time.
-// When ref is the zero value, time.Now().UTC() is used. All returned times
-// use the location from the reference time (UTC by default).
+// When ref is the zero value, time.Now().UTC() is used. Returned times use
+// the location from the reference time (UTC by default), or
+// Options.Location when set.
+//
+// A timezone named next to a time overrides that location: "saat 15:00
+// (Bakı vaxtı ilə)" and "18:30 UTC+4" are read as wall-clock times in
+// UTC+04:00, the span covers the timezone, and Result.Explicit includes
+// HasZone. Place names map to fixed offsets, so only places without
+// daylight saving time are recognized (Bakı, Tbilisi, Moskva, İstanbul,
+// Tehran, Daşkənd, and their countries).
+//
+// Format is the inverse direction: it renders a time as Azerbaijani text
+// in a Style ("5 mart 2026-cı il, saat 14:30", "05.03.2026 14:30") that
+// Extract reads back, with the year's ordinal suffix in vowel harmony.
+// FormatRelative says it relative to a reference ("sabah axşam", "3 gün
+// sonra"), and FormatResult writes only the components of a Result that
+// were present in its input.
 //
 // All functions are safe for concurrent use by multiple goroutines.
 package datetime
@@ -28,26 +82,32 @@ import (
 type Type int
 
 const (
-	TypeDate     Type = iota // Only date components (year, month, day)
-	TypeTime                 // Only time components (hour, minute, second)
-	TypeDateTime             // Both date and time components
-	TypeDuration             // A time duration (e.g. "2 saat 30 dəqiqə")
+	TypeDate       Type = iota // Only date components (year, month, day)
+	TypeTime                   // Only time components (hour, minute, second)
+	TypeDateTime               // Both date and time components
+	TypeDuration               // A time duration (e.g. "2 saat 30 dəqiqə", "3 gün")
+	TypeRecurrence             // A repeating schedule (e.g. "hər bazar ertəsi", "hər ayın 5-i")
+	TypePeriod                 // A century or millennium (e.g. "XIX əsr", "XX əsrin əvvəlləri")
 )
 
 // typeNames maps Type values to their string names.
 var typeNames = [...]string{
-	TypeDate:     "Date",
-	TypeTime:     "Time",
-	TypeDateTime: "DateTime",
-	TypeDuration: "Duration",
+	TypeDate:       "Date",
+	TypeTime:       "Time",
+	TypeDateTime:   "DateTime",
+	TypeDuration:   "Duration",
+	TypeRecurrence: "Recurrence",
+	TypePeriod:     "Period",
 }
 
 // typeFromName maps string names back to Type values.
 var typeFromName = map[string]Type{
-	"Date":     TypeDate,
-	"Time":     TypeTime,
-	"DateTime": TypeDateTime,
-	"Duration": TypeDuration,
+	"Date":       TypeDate,
+	"Time":       TypeTime,
+	"DateTime":   TypeDateTime,
+	"Duration":   TypeDuration,
+	"Recurrence": TypeRecurrence,
+	"Period":     TypePeriod,
 }
 
 // String returns the name of the type.
@@ -81,6 +141,95 @@ func (t *Type) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// DateOrder reports how the numeric components of a date were read.
+type DateOrder int
+
+const (
+	OrderNone DateOrder = iota // Not a numeric date (natural text, relative, time)
+	OrderDMY                   // Day first: "03/05/2026" is 3 May 2026
+	OrderMDY                   // Month first: "03/05/2026" is 5 March 2026
+	OrderYMD                   // ISO year first: "2026-05-03"
+)
+
+// dateOrderNames maps DateOrder values to their string names.
+var dateOrderNames = [...]string{
+	OrderNone: "None",
+	OrderDMY:  "DMY",
+	OrderMDY:  "MDY",
+	OrderYMD:  "YMD",
+}
+
+// dateOrderFromName maps string names back to DateOrder values.
+var dateOrderFromName = map[string]DateOrder{
+	"None": OrderNone,
+	"DMY":  OrderDMY,
+	"MDY":  OrderMDY,
+	"YMD":  OrderYMD,
+}
+
+// String returns the name of the date order.
+func (o DateOrder) String() string {
+	if int(o) >= 0 && int(o) < len(dateOrderNames) {
+		return dateOrderNames[o]
+	}
+	return fmt.Sprintf("DateOrder(%d)", int(o))
+}
+
+// MarshalJSON encodes the date order as a JSON string (e.g. "DMY").
+func (o DateOrder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "DMY") into a DateOrder.
+func (o *DateOrder) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := dateOrderFromName[s]
+	if !ok {
+		const maxErrLen = 50
+		if len(s) > maxErrLen {
+			s = s[:maxErrLen] + "..."
+		}
+		return fmt.Errorf("datetime: unknown date order: %q", s)
+	}
+	*o = v
+	return nil
+}
+
+// Options configures extraction. The zero value matches Extract and Parse.
+//
+// Numeric dates separated by dots or slashes ("03/05/2026") are ambiguous
+// between day-first and month-first readings. Azerbaijani convention is
+// day-first, which is the default. Resolution rules:
+//
+//   - When both readings are valid calendar dates, the preferred order is
+//     used (day-first, or month-first when MonthFirst is set) and
+//     Result.Ambiguous is true.
+//   - When only one reading is valid (one component exceeds 12) and
+//     AutoOrder is set, that reading is used regardless of preference.
+//   - Otherwise only the preferred reading is accepted.
+//
+// The chosen interpretation is reported in Result.Order.
+//
+// When Anaphora is set, Extract also resolves narrative references to a
+// previously mentioned date: "ertəsi gün" (the next day), "həmin gün"
+// (that same day), and day/week/month/year quantity expressions such as
+// "bir gün əvvəl" or "iki həftə sonra". Each is anchored to the closest
+// preceding date result in the text and marked with Result.Anaphoric.
+// Without a preceding date they are resolved against ref as usual.
+//
+// When Location is set, the reference time is converted to it, so that
+// relative and partial expressions are resolved and returned in that
+// location rather than in the location of ref.
+type Options struct {
+	MonthFirst bool           // prefer MM/DD/YYYY over DD/MM/YYYY
+	AutoOrder  bool           // accept whichever reading is the only valid one
+	Anaphora   bool           // resolve "ertəsi gün", "bir gün əvvəl" against the previous date
+	Location   *time.Location // resolve in this location instead of ref's
+}
+
 // Components is a bitmask indicating which date/time fields were explicitly
 // present in the input (vs. inferred from the reference time).
 type Components uint8
@@ -92,6 +241,7 @@ const (
 	HasHour
 	HasMinute
 	HasSecond
+	HasZone // timezone named in the text ("Bakı vaxtı ilə", "UTC+4")
 )
 
 // String returns a debug representation of the components bitmask.
@@ -115,6 +265,9 @@ func (c Components) String() string {
 	if c&HasSecond != 0 {
 		parts = append(parts, 's')
 	}
+	if c&HasZone != 0 {
+		parts = append(parts, 'z')
+	}
 	if len(parts) == 0 {
 		return "none"
 	}
@@ -130,6 +283,17 @@ type Result struct {
 	Time     time.Time     `json:"time"`               // Resolved point in time
 	Duration time.Duration `json:"duration,omitempty"` // Populated when Type == TypeDuration
 	Explicit Components    `json:"explicit"`           // Which components came from input vs. ref
+
+	Order     DateOrder `json:"order,omitempty"`     // How numeric date components were read
+	Ambiguous bool      `json:"ambiguous,omitempty"` // Both day-first and month-first readings were valid
+	Anaphoric bool      `json:"anaphoric,omitempty"` // Resolved against a previously mentioned date, not ref
+	Holiday   string    `json:"holiday,omitempty"`   // Canonical name of a named holiday ("Novruz bayramı")
+
+	Approximate bool          `json:"approximate,omitempty"` // Hedged ("təxminən") or a part of a period ("mart ortalarında")
+	Tolerance   time.Duration `json:"tolerance,omitempty"`   // Half-width of the window around Time when Approximate
+
+	Recurrence *Recurrence `json:"recurrence,omitempty"` // Populated when Type == TypeRecurrence
+	Period     *Period     `json:"period,omitempty"`     // Populated when Type == TypePeriod
 }
 
 // String returns a debug representation, e.g. Date("5 mart 2026")[3:15].
@@ -141,31 +305,47 @@ func (r Result) String() string {
 // Returns nil for empty or oversized input.
 // When ref is the zero value, time.Now() is used.
 func Extract(s string, ref time.Time) []Result {
+	return ExtractWithOptions(s, ref, Options{})
+}
+
+// ExtractWithOptions is like Extract but applies opts.
+func ExtractWithOptions(s string, ref time.Time, opts Options) []Result {
 	if s == "" || len(s) > maxInputBytes {
 		return nil
 	}
-	if ref.IsZero() {
-		ref = time.Now().UTC()
-	}
-	return extract(s, ref)
+	return extract(s, resolveRef(ref, opts), opts)
 }
 
 // Parse parses a single date/time expression from s.
 // Returns a descriptive error for empty, unrecognized, or invalid input.
 // When ref is the zero value, time.Now() is used.
 func Parse(s string, ref time.Time) (Result, error) {
+	return ParseWithOptions(s, ref, Options{})
+}
+
+// ParseWithOptions is like Parse but applies opts.
+func ParseWithOptions(s string, ref time.Time, opts Options) (Result, error) {
 	if s == "" {
 		return Result{}, fmt.Errorf("datetime: empty input")
 	}
 	if len(s) > maxInputBytes {
 		return Result{}, fmt.Errorf("datetime: input exceeds %d bytes", maxInputBytes)
 	}
-	if ref.IsZero() {
-		ref = time.Now().UTC()
-	}
-	results := extract(s, ref)
+	results := extract(s, resolveRef(ref, opts), opts)
 	if len(results) == 0 {
 		return Result{}, fmt.Errorf("datetime: unrecognized input")
 	}
 	return results[0], nil
 }
+
+// resolveRef returns the reference time to resolve against: ref, or the
+// current time in UTC when ref is zero, converted to opts.Location if set.
+func resolveRef(ref time.Time, opts Options) time.Time {
+	if ref.IsZero() {
+		ref = time.Now().UTC()
+	}
+	if opts.Location != nil {
+		ref = ref.In(opts.Location)
+	}
+	return ref
+}
@@ -161,6 +161,214 @@ func TestExtractNumeric(t *testing.T) {
 	}
 }
 
+// TestExtractDateOrder tests day-first/month-first disambiguation of
+// numeric dates and the reported interpretation.
+func TestExtractDateOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		in            string
+		opts          Options
+		wantTime      time.Time
+		wantOrder     DateOrder
+		wantAmbiguous bool
+		wantNone      bool
+	}{
+		{"default day-first", "03/05/2026", Options{}, d(2026, time.May, 3), OrderDMY, true, false},
+		{"month-first", "03/05/2026", Options{MonthFirst: true}, d(2026, time.March, 5), OrderMDY, true, false},
+		{"month-first dot", "03.05.2026", Options{MonthFirst: true}, d(2026, time.March, 5), OrderMDY, true, false},
+		{"same day and month", "05/05/2026", Options{}, d(2026, time.May, 5), OrderDMY, false, false},
+		{"unambiguous day-first", "25/03/2026", Options{}, d(2026, time.March, 25), OrderDMY, false, false},
+		{"default rejects month-first only", "03/25/2026", Options{}, time.Time{}, OrderNone, false, true},
+		{"month-first rejects day-first only", "25/03/2026", Options{MonthFirst: true}, time.Time{}, OrderNone, false, true},
+		{"auto picks month-first", "03/25/2026", Options{AutoOrder: true}, d(2026, time.March, 25), OrderMDY, false, false},
+		{"auto picks day-first", "25/03/2026", Options{AutoOrder: true, MonthFirst: true}, d(2026, time.March, 25), OrderDMY, false, false},
+		{"auto ambiguous uses preference", "03/05/2026", Options{AutoOrder: true}, d(2026, time.May, 3), OrderDMY, true, false},
+		{"auto invalid both", "31/31/2026", Options{AutoOrder: true}, time.Time{}, OrderNone, false, true},
+		{"ISO unaffected", "2026-03-05", Options{MonthFirst: true}, d(2026, time.March, 5), OrderYMD, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ExtractWithOptions(tt.in, ref, tt.opts)
+			if tt.wantNone {
+				if len(got) != 0 {
+					t.Errorf("ExtractWithOptions(%q) = %v, want none", tt.in, got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("ExtractWithOptions(%q) = %v, want 1 result", tt.in, got)
+			}
+			if !got[0].Time.Equal(tt.wantTime) {
+				t.Errorf("Time = %v, want %v", got[0].Time, tt.wantTime)
+			}
+			if got[0].Order != tt.wantOrder {
+				t.Errorf("Order = %s, want %s", got[0].Order, tt.wantOrder)
+			}
+			if got[0].Ambiguous != tt.wantAmbiguous {
+				t.Errorf("Ambiguous = %v, want %v", got[0].Ambiguous, tt.wantAmbiguous)
+			}
+		})
+	}
+
+	t.Run("order survives date-time merge", func(t *testing.T) {
+		t.Parallel()
+		got := ExtractWithOptions("03/05/2026 14:30", ref, Options{MonthFirst: true})
+		if len(got) != 1 || got[0].Type != TypeDateTime {
+			t.Fatalf("got %v, want one DateTime", got)
+		}
+		if got[0].Order != OrderMDY || !got[0].Ambiguous {
+			t.Errorf("Order = %s, Ambiguous = %v, want MDY, true", got[0].Order, got[0].Ambiguous)
+		}
+	})
+
+	t.Run("natural text has no order", func(t *testing.T) {
+		t.Parallel()
+		got := Extract("5 mart 2026", ref)
+		if len(got) != 1 || got[0].Order != OrderNone {
+			t.Errorf("got %v, want Order None", got)
+		}
+	})
+
+	t.Run("ParseWithOptions", func(t *testing.T) {
+		t.Parallel()
+		r, err := ParseWithOptions("12/31/2026", ref, Options{AutoOrder: true})
+		if err != nil {
+			t.Fatalf("ParseWithOptions: %v", err)
+		}
+		if !r.Time.Equal(d(2026, time.December, 31)) || r.Order != OrderMDY {
+			t.Errorf("got %v %s, want 2026-12-31 MDY", r.Time, r.Order)
+		}
+	})
+}
+
+// TestExtractAnaphora tests resolution of "ertəsi gün", "həmin gün", and
+// quantity expressions against the previously mentioned date.
+func TestExtractAnaphora(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		text      string
+		time      time.Time
+		anaphoric bool
+	}
+	tests := []struct {
+		name string
+		in   string
+		want []want
+	}{
+		{
+			"next and same day",
+			"5 mart toy oldu, ertəsi gün qonaqlar getdi. Həmin gün yağış yağdı.",
+			[]want{
+				{"5 mart", d(2026, time.March, 5), false},
+				{"ertəsi gün", d(2026, time.March, 6), true},
+				{"Həmin gün", d(2026, time.March, 6), true},
+			},
+		},
+		{
+			"quantity relative to anchor",
+			"10.04.2026 tarixində müqavilə bağlandı, bir gün əvvəl danışıqlar aparıldı.",
+			[]want{
+				{"10.04.2026", d(2026, time.April, 10), false},
+				{"bir gün əvvəl", d(2026, time.April, 9), true},
+			},
+		},
+		{
+			"month unit",
+			"1 yanvar 2025, iki ay sonra",
+			[]want{
+				{"1 yanvar 2025", d(2025, time.January, 1), false},
+				{"iki ay sonra", d(2025, time.March, 1), true},
+			},
+		},
+		{
+			"günü form keeps clock",
+			"1 may 2026 ertəsi günü saat 10",
+			[]want{
+				{"1 may 2026", d(2026, time.May, 1), false},
+				{"ertəsi günü saat 10", time.Date(2026, time.May, 2, 10, 0, 0, 0, time.UTC), true},
+			},
+		},
+		{
+			"no anchor falls back to ref",
+			"ertəsi gün gəldi",
+			[]want{{"ertəsi gün", d(2026, time.February, 21), false}},
+		},
+		{
+			"hour quantity is not anaphoric",
+			"5 mart, iki saat sonra",
+			[]want{
+				{"5 mart", d(2026, time.March, 5), false},
+				{"iki saat sonra", time.Date(2026, time.February, 20, 12, 30, 0, 0, time.UTC), false},
+			},
+		},
+		{
+			"weekday wins over ertəsi",
+			"bazar ertəsi günü",
+			[]want{{"bazar ertəsi", d(2026, time.February, 23), false}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ExtractWithOptions(tt.in, ref, Options{Anaphora: true})
+			if len(got) != len(tt.want) {
+				t.Fatalf("ExtractWithOptions(%q) = %v, want %d results", tt.in, got, len(tt.want))
+			}
+			for i, w := range tt.want {
+				if got[i].Text != w.text || !got[i].Time.Equal(w.time) || got[i].Anaphoric != w.anaphoric {
+					t.Errorf("[%d] = %q %v anaphoric=%v, want %q %v anaphoric=%v",
+						i, got[i].Text, got[i].Time, got[i].Anaphoric, w.text, w.time, w.anaphoric)
+				}
+			}
+		})
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+		got := Extract("5 mart, ertəsi gün, bir gün əvvəl", ref)
+		for _, r := range got {
+			if r.Anaphoric {
+				t.Errorf("Extract returned anaphoric result %v", r)
+			}
+			if r.Text == "ertəsi gün" {
+				t.Errorf("Extract matched %q without Anaphora", r.Text)
+			}
+		}
+	})
+}
+
+// TestDateOrderEnum tests DateOrder.String(), MarshalJSON, and UnmarshalJSON.
+func TestDateOrderEnum(t *testing.T) {
+	t.Parallel()
+
+	for _, o := range []DateOrder{OrderNone, OrderDMY, OrderMDY, OrderYMD} {
+		data, err := json.Marshal(o)
+		if err != nil {
+			t.Fatalf("Marshal %s: %v", o, err)
+		}
+		var got DateOrder
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal %s: %v", o, err)
+		}
+		if got != o {
+			t.Errorf("round-trip: got %s, want %s", got, o)
+		}
+	}
+	if got := DateOrder(99).String(); !strings.HasPrefix(got, "DateOrder(") {
+		t.Errorf("got %q, want DateOrder(...) format", got)
+	}
+	var o DateOrder
+	if err := json.Unmarshal([]byte(`"XYZ"`), &o); err == nil {
+		t.Error("want error for unknown date order, got nil")
+	}
+}
+
 // TestExtractNaturalText tests Azerbaijani month-name-based patterns with case
 // forms, ordinal days, possessive compounds, and genitive constructs.
 func TestExtractNaturalText(t *testing.T) {
@@ -718,6 +926,35 @@ func TestExtractRelative(t *testing.T) {
 				Explicit: HasYear | HasMonth | HasDay,
 			}},
 		},
+		{
+			// "3 gün 2 saat sonra" = 19 bytes (ü=2); the direction word follows
+			// the last pair, so the whole span is relative, not a duration.
+			name: "3 gün 2 saat sonra",
+			in:   "3 gün 2 saat sonra",
+			ref:  ref,
+			want: []Result{{
+				Text:     "3 gün 2 saat sonra",
+				Start:    0,
+				End:      19,
+				Type:     TypeDateTime,
+				Time:     dt(2026, time.February, 23, 12, 30, 0),
+				Explicit: HasYear | HasMonth | HasDay | HasHour | HasMinute | HasSecond,
+			}},
+		},
+		{
+			// "1 saat 15 dəqiqə əvvəl" = 26 bytes (ə=2 ×5); 1h15m before ref.
+			name: "1 saat 15 dəqiqə əvvəl",
+			in:   "1 saat 15 dəqiqə əvvəl",
+			ref:  ref,
+			want: []Result{{
+				Text:     "1 saat 15 dəqiqə əvvəl",
+				Start:    0,
+				End:      26,
+				Type:     TypeDateTime,
+				Time:     dt(2026, time.February, 20, 9, 15, 0),
+				Explicit: HasYear | HasMonth | HasDay | HasHour | HasMinute | HasSecond,
+			}},
+		},
 		{
 			// "keçən bazar ertəsi" = 21 bytes; previous Monday before Friday 2026-02-20
 			// prevWeekday: days = Friday(5) - Monday(1) = 4 → 2026-02-20 - 4 = 2026-02-16
@@ -797,6 +1034,56 @@ func TestExtractDuration(t *testing.T) {
 			in:   "2 saat əvvəl",
 			want: nil, // handled by appendRelative, not duration; but extract returns relative result
 		},
+		{
+			name: "not duration when direction follows last pair",
+			in:   "1 saat 15 dəqiqə əvvəl",
+			want: nil,
+		},
+		{
+			name: "days",
+			in:   "3 gün çəkəcək",
+			want: []Result{{Text: "3 gün", Start: 0, End: 6, Type: TypeDuration, Duration: 72 * time.Hour}},
+		},
+		{
+			name: "weeks",
+			in:   "2 həftə davam edəcək",
+			want: []Result{{Text: "2 həftə", Start: 0, End: 9, Type: TypeDuration, Duration: 14 * 24 * time.Hour}},
+		},
+		{
+			name: "days+hours",
+			in:   "1 gün 3 saat",
+			want: []Result{{Text: "1 gün 3 saat", Start: 0, End: 13, Type: TypeDuration, Duration: 27 * time.Hour}},
+		},
+		{
+			name: "trailing half",
+			in:   "iki saat yarım",
+			want: []Result{{Text: "iki saat yarım", Start: 0, End: 15, Type: TypeDuration, Duration: 2*time.Hour + 30*time.Minute}},
+		},
+		{
+			name: "leading half",
+			in:   "yarım saat gözlədik",
+			want: []Result{{Text: "yarım saat", Start: 0, End: 11, Type: TypeDuration, Duration: 30 * time.Minute}},
+		},
+		{
+			name: "adjectival form",
+			in:   "5 günlük səfər",
+			want: []Result{{Text: "5 günlük", Start: 0, End: 10, Type: TypeDuration, Duration: 5 * 24 * time.Hour}},
+		},
+		{
+			name: "days with direction is a date",
+			in:   "3 gün sonra",
+			want: nil,
+		},
+		{
+			name: "bir gün is not a duration",
+			in:   "bir gün gələcək",
+			want: nil,
+		},
+		{
+			name: "huge quantity clamped",
+			in:   "doxsan min həftə doxsan min saat",
+			want: []Result{{Text: "doxsan min həftə doxsan min saat", Start: 0, End: 34, Type: TypeDuration, Duration: maxDuration}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1323,6 +1610,11 @@ func TestComponentsString(t *testing.T) {
 			c:    HasSecond,
 			want: "s",
 		},
+		{
+			name: "time with zone",
+			c:    HasHour | HasMinute | HasZone,
+			want: "hmz",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1358,7 +1650,7 @@ func TestResultString(t *testing.T) {
 func TestTypeMapsComplete(t *testing.T) {
 	t.Parallel()
 
-	for i := Type(0); i <= TypeDuration; i++ {
+	for i := Type(0); i <= TypePeriod; i++ {
 		name := i.String()
 		if strings.HasPrefix(name, "Type(") {
 			t.Errorf("Type %d has no name in typeNames", i)
@@ -1448,7 +1740,7 @@ func ExampleExtract() {
 		fmt.Println(res)
 	}
 	// Output:
-	// DateTime("5 mart 2026 saat 14")[0:19]
+	// DateTime("5 mart 2026 saat 14:30")[0:22]
 }
 
 // ExampleParse demonstrates parsing a single relative date expression.
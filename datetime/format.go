@@ -0,0 +1,245 @@
+// Formatting times back into Azerbaijani text: "5 mart 2026-cı il, saat
+// 14:30", "sabah axşam".
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/numtext"
+)
+
+// Style selects how Format renders a time.
+type Style int
+
+const (
+	StyleLong     Style = iota // "5 mart 2026-cı il, saat 14:30"
+	StyleDate                  // "5 mart 2026-cı il"
+	StyleTime                  // "saat 14:30"
+	StyleNumeric               // "05.03.2026 14:30"
+	StyleRelative              // "sabah axşam", "3 gün əvvəl"
+)
+
+// styleNames maps Style values to their string names.
+var styleNames = [...]string{
+	StyleLong:     "Long",
+	StyleDate:     "Date",
+	StyleTime:     "Time",
+	StyleNumeric:  "Numeric",
+	StyleRelative: "Relative",
+}
+
+// String returns the name of the style.
+func (s Style) String() string {
+	if int(s) >= 0 && int(s) < len(styleNames) {
+		return styleNames[s]
+	}
+	return fmt.Sprintf("Style(%d)", int(s))
+}
+
+// monthNames holds the bare Azerbaijani month names, indexed by time.Month.
+var monthNames = [...]string{
+	time.January:   "yanvar",
+	time.February:  "fevral",
+	time.March:     "mart",
+	time.April:     "aprel",
+	time.May:       "may",
+	time.June:      "iyun",
+	time.July:      "iyul",
+	time.August:    "avqust",
+	time.September: "sentyabr",
+	time.October:   "oktyabr",
+	time.November:  "noyabr",
+	time.December:  "dekabr",
+}
+
+// relativeDayWords holds the day words of StyleRelative, indexed by the day
+// offset from the reference plus 2; the inverse of dayOffsets.
+var relativeDayWords = [...]string{"srağagün", "dünən", "bu gün", "sabah", "birigün"}
+
+// Parts of the day named by StyleRelative, by starting hour. Hours from
+// midnight to dayPartMorning are "gecə" of the same day.
+const (
+	dayPartMorning = 5  // "səhər" from 05:00
+	dayPartMidday  = 12 // "gündüz" from 12:00
+	dayPartEvening = 17 // "axşam" from 17:00
+	dayPartNight   = 22 // "gecə" from 22:00
+)
+
+// maxRelativeDays is the largest day offset StyleRelative writes as a
+// quantity ("6 gün sonra"); dates further away are written in full.
+const maxRelativeDays = daysPerWeek - 1
+
+// Format renders t as Azerbaijani text in the given style, in t's location.
+// It is the inverse of Extract: for years 1000-9999, the output of every
+// style except StyleRelative parses back to the components it writes,
+// down to the second. Years take an ordinal suffix in vowel harmony with the spoken
+// number ("2026-cı", "2025-ci", "2023-cü", "2030-cu"); days are written
+// as cardinals before the month ("5 mart"), the common Azerbaijani form.
+//
+// StyleRelative is resolved against time.Now(); use FormatRelative for a
+// fixed reference time. An unknown style formats like StyleLong.
+func Format(t time.Time, style Style) string {
+	switch style {
+	case StyleRelative:
+		return FormatRelative(t, time.Now())
+	case StyleDate:
+		return formatDate(t)
+	case StyleTime:
+		return formatClock(t)
+	case StyleNumeric:
+		s := fmt.Sprintf("%02d.%02d.%04d %02d:%02d", t.Day(), int(t.Month()), t.Year(), t.Hour(), t.Minute())
+		if t.Second() != 0 {
+			s += fmt.Sprintf(":%02d", t.Second())
+		}
+		return s
+	default:
+		return formatDate(t) + ", " + formatClock(t)
+	}
+}
+
+// FormatRelative renders t relative to ref as a listener would say it:
+// a day word and the part of the day for the two days around ref
+// ("sabah axşam", "bu gün səhər", "dünən gecə"), a number of days within
+// a week ("3 gün sonra", "5 gün əvvəl"), and the full date beyond that
+// ("5 mart 2026-cı il"). Days are counted between calendar dates in t's
+// location, so 23:00 to 01:00 the next morning is "sabah". A time at
+// exactly midnight is taken as a bare date and gets no part of the day.
+//
+// The output is coarse by design and does not parse back to the same
+// time. When ref is the zero value, time.Now() is used.
+func FormatRelative(t, ref time.Time) string {
+	if ref.IsZero() {
+		ref = time.Now()
+	}
+	ref = ref.In(t.Location())
+	days := calendarDays(ref, t)
+
+	switch {
+	case days >= -2 && days <= 2:
+		word := relativeDayWords[days+2]
+		if part := dayPart(t); part != "" {
+			return word + " " + part
+		}
+		return word
+	case days > 0 && days <= maxRelativeDays:
+		return strconv.Itoa(days) + " gün sonra"
+	case days < 0 && -days <= maxRelativeDays:
+		return strconv.Itoa(-days) + " gün əvvəl"
+	default:
+		return formatDate(t)
+	}
+}
+
+// FormatResult renders r back to Azerbaijani text, writing only the
+// components present in the input: a date without an explicit year is
+// "5 mart", a time without minutes "saat 9:00". Durations are written in
+// days, hours, minutes, and seconds ("2 saat 30 dəqiqə"), and holidays by
+// their canonical name. Recurrences and periods have no canonical form
+// and return r.Text.
+func FormatResult(r Result) string {
+	switch {
+	case r.Holiday != "":
+		return r.Holiday
+	case r.Type == TypeDuration:
+		return formatDuration(r.Duration)
+	case r.Type == TypeRecurrence || r.Type == TypePeriod:
+		return r.Text
+	case r.Type == TypeTime:
+		return formatClock(r.Time)
+	}
+
+	var b strings.Builder
+	if r.Explicit&HasDay != 0 {
+		b.WriteString(strconv.Itoa(r.Time.Day()))
+		b.WriteByte(' ')
+	}
+	b.WriteString(monthNames[r.Time.Month()])
+	if r.Explicit&HasYear != 0 {
+		b.WriteByte(' ')
+		b.WriteString(ordinalYearText(r.Time.Year()))
+	}
+	if r.Type == TypeDateTime {
+		b.WriteString(", ")
+		b.WriteString(formatClock(r.Time))
+	}
+	return b.String()
+}
+
+// formatDate writes "5 mart 2026-cı il".
+func formatDate(t time.Time) string {
+	return strconv.Itoa(t.Day()) + " " + monthNames[t.Month()] + " " + ordinalYearText(t.Year())
+}
+
+// ordinalYearText writes year as "2026-cı il". The digit ordinal suffix
+// is the last syllable of the spelled-out ordinal ("iki min iyirmi
+// altıncı"), so it follows the same vowel harmony.
+func ordinalYearText(year int) string {
+	ord := []rune(numtext.ConvertOrdinal(int64(year)))
+	return strconv.Itoa(year) + "-" + string(ord[len(ord)-2:]) + " il"
+}
+
+// formatClock writes "saat 14:30", adding seconds when they are set.
+func formatClock(t time.Time) string {
+	s := fmt.Sprintf("saat %d:%02d", t.Hour(), t.Minute())
+	if t.Second() != 0 {
+		s += fmt.Sprintf(":%02d", t.Second())
+	}
+	return s
+}
+
+// formatDuration writes d in days, hours, minutes, and whole seconds,
+// omitting zero units: "1 gün 2 saat", "45 dəqiqə". Zero is "0 saniyə".
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	units := []struct {
+		size time.Duration
+		word string
+	}{
+		{24 * time.Hour, "gün"},
+		{time.Hour, "saat"},
+		{time.Minute, "dəqiqə"},
+		{time.Second, "saniyə"},
+	}
+	var parts []string
+	for _, u := range units {
+		if n := d / u.size; n > 0 {
+			parts = append(parts, strconv.FormatInt(int64(n), 10)+" "+u.word)
+			d -= n * u.size
+		}
+	}
+	if len(parts) == 0 {
+		return "0 saniyə"
+	}
+	return strings.Join(parts, " ")
+}
+
+// dayPart returns the part of the day t falls in ("səhər", "gündüz",
+// "axşam", "gecə"), or "" at exactly midnight.
+func dayPart(t time.Time) string {
+	h := t.Hour()
+	switch {
+	case h == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0:
+		return ""
+	case h < dayPartMorning || h >= dayPartNight:
+		return "gecə"
+	case h < dayPartMidday:
+		return "səhər"
+	case h < dayPartEvening:
+		return "gündüz"
+	default:
+		return "axşam"
+	}
+}
+
+// calendarDays returns the number of calendar days from ref's date to t's
+// date, both in t's location.
+func calendarDays(ref, t time.Time) int {
+	a := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a) / (24 * time.Hour))
+}
@@ -0,0 +1,182 @@
+package datetime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	tm := dt(2026, time.March, 5, 14, 30, 0)
+	tests := []struct {
+		name  string
+		t     time.Time
+		style Style
+		want  string
+	}{
+		{"long", tm, StyleLong, "5 mart 2026-cı il, saat 14:30"},
+		{"date", tm, StyleDate, "5 mart 2026-cı il"},
+		{"time", tm, StyleTime, "saat 14:30"},
+		{"numeric", tm, StyleNumeric, "05.03.2026 14:30"},
+		{"seconds", dt(2026, time.March, 5, 9, 5, 7), StyleLong, "5 mart 2026-cı il, saat 9:05:07"},
+		{"numeric seconds", dt(2026, time.March, 5, 9, 5, 7), StyleNumeric, "05.03.2026 09:05:07"},
+		{"unknown style", tm, Style(42), "5 mart 2026-cı il, saat 14:30"},
+		{"harmony i", d(2025, time.December, 31), StyleDate, "31 dekabr 2025-ci il"},
+		{"harmony ü", d(2023, time.April, 1), StyleDate, "1 aprel 2023-cü il"},
+		{"harmony u", d(2030, time.June, 15), StyleDate, "15 iyun 2030-cu il"},
+		{"harmony round thousand", d(2000, time.January, 1), StyleDate, "1 yanvar 2000-ci il"},
+		{"harmony hundred", d(2100, time.May, 9), StyleDate, "9 may 2100-cü il"},
+		{"harmony forty", d(1940, time.May, 9), StyleDate, "9 may 1940-cı il"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Format(tt.t, tt.style); got != tt.want {
+				t.Errorf("Format(%v, %v) = %q, want %q", tt.t, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	times := []time.Time{
+		dt(2026, time.March, 5, 14, 30, 0),
+		dt(1999, time.November, 30, 0, 0, 0),
+		dt(2024, time.February, 29, 23, 59, 59),
+		dt(2013, time.August, 1, 7, 0, 0),
+	}
+	for _, tm := range times {
+		for _, style := range []Style{StyleLong, StyleNumeric} {
+			s := Format(tm, style)
+			r, err := Parse(s, ref)
+			if err != nil {
+				t.Errorf("Parse(%q): %v", s, err)
+				continue
+			}
+			if r.Text != s || !r.Time.Equal(tm) {
+				t.Errorf("Parse(%q) = %q at %v, want the whole text at %v", s, r.Text, r.Time, tm)
+			}
+		}
+		s := Format(tm, StyleDate)
+		if r, err := Parse(s, ref); err != nil || r.Text != s || r.Explicit != HasYear|HasMonth|HasDay {
+			t.Errorf("Parse(%q) = %v, %v", s, r, err)
+		}
+	}
+}
+
+func TestFormatRelative(t *testing.T) {
+	t.Parallel()
+
+	now := dt(2026, time.March, 4, 10, 0, 0)
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{dt(2026, time.March, 4, 9, 0, 0), "bu gün səhər"},
+		{dt(2026, time.March, 4, 13, 0, 0), "bu gün gündüz"},
+		{dt(2026, time.March, 5, 19, 30, 0), "sabah axşam"},
+		{dt(2026, time.March, 5, 0, 0, 0), "sabah"},
+		{dt(2026, time.March, 5, 2, 0, 0), "sabah gecə"},
+		{dt(2026, time.March, 6, 23, 0, 0), "birigün gecə"},
+		{dt(2026, time.March, 3, 20, 0, 0), "dünən axşam"},
+		{dt(2026, time.March, 2, 8, 0, 0), "srağagün səhər"},
+		{dt(2026, time.March, 7, 12, 0, 0), "3 gün sonra"},
+		{dt(2026, time.February, 26, 12, 0, 0), "6 gün əvvəl"},
+		{dt(2026, time.March, 11, 12, 0, 0), "11 mart 2026-cı il"},
+		{dt(1990, time.March, 11, 12, 0, 0), "11 mart 1990-cı il"},
+	}
+	for _, tt := range tests {
+		if got := FormatRelative(tt.t, now); got != tt.want {
+			t.Errorf("FormatRelative(%v) = %q, want %q", tt.t, got, tt.want)
+		}
+	}
+
+	// Days are counted in t's location: 22:00 UTC on 4 March is already
+	// 5 March in Baku.
+	baku := time.FixedZone("AZT", 4*60*60)
+	if got := FormatRelative(dt(2026, time.March, 4, 22, 0, 0).In(baku), now); got != "sabah gecə" {
+		t.Errorf("FormatRelative(Baku) = %q, want %q", got, "sabah gecə")
+	}
+}
+
+func TestFormatResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"martın 5-i", "5 mart"},
+		{"mart ayının 15-i, saat 10:00", "15 mart, saat 10:00"},
+		{"05.03.2026", "5 mart 2026-cı il"},
+		{"mart 2026", "mart 2026-cı il"},
+		{"saat 9", "saat 9:00"},
+		{"2 saat 30 dəqiqə", "2 saat 30 dəqiqə"},
+		{"3 gün", "3 gün"},
+		{"Novruz bayramında", "Novruz bayramı"},
+		{"hər bazar ertəsi", "hər bazar ertəsi"},
+		{"XIX əsr", "XIX əsr"},
+	}
+	for _, tt := range tests {
+		r, err := Parse(tt.in, ref)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.in, err)
+		}
+		if got := FormatResult(r); got != tt.want {
+			t.Errorf("FormatResult(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0 saniyə"},
+		{45 * time.Minute, "45 dəqiqə"},
+		{26 * time.Hour, "1 gün 2 saat"},
+		{-90 * time.Second, "1 dəqiqə 30 saniyə"},
+		{1500 * time.Millisecond, "1 saniyə"},
+	}
+	for _, tt := range tests {
+		if got := formatDuration(tt.d); got != tt.want {
+			t.Errorf("formatDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestStyleString(t *testing.T) {
+	t.Parallel()
+
+	if got := StyleRelative.String(); got != "Relative" {
+		t.Errorf("StyleRelative.String() = %q", got)
+	}
+	if got := Style(9).String(); got != "Style(9)" {
+		t.Errorf("Style(9).String() = %q", got)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	tm := dt(2026, time.March, 5, 14, 30, 0)
+	for b.Loop() {
+		Format(tm, StyleLong)
+	}
+}
+
+func ExampleFormat() {
+	t := time.Date(2026, 3, 5, 14, 30, 0, 0, time.UTC)
+	fmt.Println(Format(t, StyleLong))
+	fmt.Println(Format(t, StyleNumeric))
+	fmt.Println(FormatRelative(t, time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC)))
+	// Output:
+	// 5 mart 2026-cı il, saat 14:30
+	// 05.03.2026 14:30
+	// sabah gündüz
+}
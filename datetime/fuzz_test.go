@@ -48,6 +48,19 @@ func FuzzExtract(f *testing.F) {
 		"səhər saat 7",
 		// Combined
 		"5 mart 2026 14:30",
+		// Recurrences
+		"hər bazar ertəsi və cümə saat 18:00-da",
+		"hər ayın 5-i",
+		"hər ayın son günü",
+		"iki həftədən bir",
+		"hər il 29 fevralda",
+		// Approximate
+		"təxminən saat 5-də",
+		"mart ortalarında",
+		"2025-ci ilin sonunda",
+		// Timezones
+		"saat 15:00-da (Bakı vaxtı ilə)",
+		"20:00 GMT-05:00",
 		// Edge cases
 		"",
 		"abc xyz",
@@ -76,12 +89,24 @@ func FuzzExtract(f *testing.F) {
 			}
 
 			// Type must be valid.
-			if r.Type < TypeDate || r.Type > TypeDuration {
+			if r.Type < TypeDate || r.Type > TypePeriod {
 				t.Errorf("invalid type: %d", r.Type)
 			}
+			if (r.Type == TypeRecurrence) != (r.Recurrence != nil) {
+				t.Errorf("%v: Recurrence = %v", r, r.Recurrence)
+			}
+			if (r.Type == TypePeriod) != (r.Period != nil) {
+				t.Errorf("%v: Period = %v", r, r.Period)
+			}
+			if p := r.Period; p != nil && (p.FirstYear > p.LastYear || p.FirstYear == 0 || p.LastYear == 0) {
+				t.Errorf("%v: invalid period %+v", r, *p)
+			}
+			if r.Tolerance < 0 || (r.Tolerance != 0 && !r.Approximate) {
+				t.Errorf("%v: Tolerance = %v, Approximate = %v", r, r.Tolerance, r.Approximate)
+			}
 
-			// Time must be UTC.
-			if r.Time.Location() != time.UTC {
+			// Time must be UTC unless the text names a timezone.
+			if r.Explicit&HasZone == 0 && r.Time.Location() != time.UTC {
 				t.Errorf("non-UTC time: %v", r.Time.Location())
 			}
 		}
@@ -0,0 +1,178 @@
+// Named Azerbaijani holidays and observances.
+package datetime
+
+import (
+	"strings"
+	"time"
+)
+
+// holidayKind selects how a holiday is resolved to a date.
+type holidayKind int
+
+const (
+	holidayFixed holidayKind = iota // same month and day every year
+	holidayFitr                     // Ramazan bayramı, from fitrDates
+	holidayAdha                     // Qurban bayramı, from adhaDates
+)
+
+// holiday describes one named holiday.
+type holiday struct {
+	name  string      // canonical name reported in Result.Holiday
+	kind  holidayKind // how the date is resolved
+	month time.Month  // for holidayFixed
+	day   int         // for holidayFixed
+}
+
+// holidayPhrase maps a lowercase word sequence to the holiday it names.
+// The last word also matches its inflected forms ("bayramında", "günündə").
+type holidayPhrase struct {
+	words   []string
+	holiday *holiday
+}
+
+var (
+	newYear         = &holiday{name: "Yeni il bayramı", month: time.January, day: 1}
+	mourningDay     = &holiday{name: "Ümumxalq hüzn günü", month: time.January, day: 20}
+	womensDay       = &holiday{name: "Qadınlar günü", month: time.March, day: 8}
+	novruz          = &holiday{name: "Novruz bayramı", month: time.March, day: 20}
+	victoryFascism  = &holiday{name: "Faşizm üzərində qələbə günü", month: time.May, day: 9}
+	independenceDay = &holiday{name: "Müstəqillik günü", month: time.May, day: 28}
+	salvationDay    = &holiday{name: "Milli qurtuluş günü", month: time.June, day: 15}
+	armedForcesDay  = &holiday{name: "Silahlı qüvvələr günü", month: time.June, day: 26}
+	restorationDay  = &holiday{name: "Müstəqilliyin bərpası günü", month: time.October, day: 18}
+	victoryDay      = &holiday{name: "Zəfər günü", month: time.November, day: 8}
+	flagDay         = &holiday{name: "Dövlət bayrağı günü", month: time.November, day: 9}
+	constitutionDay = &holiday{name: "Konstitusiya günü", month: time.November, day: 12}
+	revivalDay      = &holiday{name: "Milli dirçəliş günü", month: time.November, day: 17}
+	solidarityDay   = &holiday{name: "Həmrəylik günü", month: time.December, day: 31}
+	ramadanBayram   = &holiday{name: "Ramazan bayramı", kind: holidayFitr}
+	qurbanBayram    = &holiday{name: "Qurban bayramı", kind: holidayAdha}
+)
+
+// holidayPhrases lists the recognized names, longest first so that
+// "beynəlxalq qadınlar günü" wins over "qadınlar günü" at the same start.
+var holidayPhrases = []holidayPhrase{
+	{[]string{"dünya", "azərbaycanlılarının", "həmrəyliyi", "günü"}, solidarityDay},
+	{[]string{"faşizm", "üzərində", "qələbə", "günü"}, victoryFascism},
+	{[]string{"beynəlxalq", "qadınlar", "günü"}, womensDay},
+	{[]string{"ümumxalq", "hüzn", "günü"}, mourningDay},
+	{[]string{"yeni", "il", "bayramı"}, newYear},
+	{[]string{"milli", "qurtuluş", "günü"}, salvationDay},
+	{[]string{"silahlı", "qüvvələr", "günü"}, armedForcesDay},
+	{[]string{"müstəqilliyin", "bərpası", "günü"}, restorationDay},
+	{[]string{"dövlət", "müstəqilliyi", "günü"}, restorationDay},
+	{[]string{"dövlət", "bayrağı", "günü"}, flagDay},
+	{[]string{"milli", "dirçəliş", "günü"}, revivalDay},
+	{[]string{"qadınlar", "günü"}, womensDay},
+	{[]string{"novruz", "bayramı"}, novruz},
+	{[]string{"müstəqillik", "günü"}, independenceDay},
+	{[]string{"respublika", "günü"}, independenceDay},
+	{[]string{"zəfər", "günü"}, victoryDay},
+	{[]string{"konstitusiya", "günü"}, constitutionDay},
+	{[]string{"həmrəylik", "günü"}, solidarityDay},
+	{[]string{"ramazan", "bayramı"}, ramadanBayram},
+	{[]string{"orucluq", "bayramı"}, ramadanBayram},
+	{[]string{"qurban", "bayramı"}, qurbanBayram},
+}
+
+// calDate is a Gregorian calendar date.
+type calDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// fitrDates lists the first day of Ramazan bayramı (Eid al-Fitr) by the
+// Umm al-Qura calendar, in order. 2033 has two.
+var fitrDates = []calDate{
+	{2015, time.July, 17}, {2016, time.July, 6}, {2017, time.June, 25},
+	{2018, time.June, 15}, {2019, time.June, 4}, {2020, time.May, 24},
+	{2021, time.May, 13}, {2022, time.May, 2}, {2023, time.April, 21},
+	{2024, time.April, 10}, {2025, time.March, 30}, {2026, time.March, 20},
+	{2027, time.March, 9}, {2028, time.February, 26}, {2029, time.February, 14},
+	{2030, time.February, 4}, {2031, time.January, 24}, {2032, time.January, 14},
+	{2033, time.January, 2}, {2033, time.December, 23}, {2034, time.December, 12},
+	{2035, time.December, 1},
+}
+
+// adhaDates lists the first day of Qurban bayramı (Eid al-Adha) by the
+// Umm al-Qura calendar, in order.
+var adhaDates = []calDate{
+	{2015, time.September, 24}, {2016, time.September, 12}, {2017, time.September, 1},
+	{2018, time.August, 21}, {2019, time.August, 11}, {2020, time.July, 31},
+	{2021, time.July, 20}, {2022, time.July, 9}, {2023, time.June, 28},
+	{2024, time.June, 16}, {2025, time.June, 6}, {2026, time.May, 27},
+	{2027, time.May, 16}, {2028, time.May, 5}, {2029, time.April, 24},
+	{2030, time.April, 13}, {2031, time.April, 2}, {2032, time.March, 22},
+	{2033, time.March, 11}, {2034, time.March, 1}, {2035, time.February, 18},
+}
+
+// holidayDate resolves h to its date in year. For a moving holiday that
+// falls twice in year (Ramazan bayramı in 2033) the first is used; years
+// outside the fitrDates/adhaDates range report false.
+func holidayDate(h *holiday, year int, loc *time.Location) (time.Time, bool) {
+	var table []calDate
+	switch h.kind {
+	case holidayFixed:
+		return time.Date(year, h.month, h.day, 0, 0, 0, 0, loc), true
+	case holidayFitr:
+		table = fitrDates
+	case holidayAdha:
+		table = adhaDates
+	}
+	for _, d := range table {
+		if d.year == year {
+			return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, loc), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// appendHolidays matches named holidays ("Novruz bayramı", "Zəfər günü")
+// and resolves them to their date in the reference year.
+func appendHolidays(all []Result, s string, words []wordSpan, ref time.Time) []Result {
+	for i := 0; i < len(words); i++ {
+		p, ok := matchHoliday(s, words, i)
+		if !ok {
+			continue
+		}
+		t, ok := holidayDate(p.holiday, ref.Year(), ref.Location())
+		if !ok {
+			continue
+		}
+		last := words[i+len(p.words)-1]
+		all = append(all, Result{
+			Text:     s[words[i].start:last.end],
+			Start:    words[i].start,
+			End:      last.end,
+			Type:     TypeDate,
+			Time:     t,
+			Explicit: HasMonth | HasDay,
+			Holiday:  p.holiday.name,
+		})
+		i += len(p.words) - 1
+	}
+	return all
+}
+
+// matchHoliday returns the longest holiday phrase starting at words[i].
+// The words of a phrase must be separated by whitespace only.
+func matchHoliday(s string, words []wordSpan, i int) (holidayPhrase, bool) {
+next:
+	for _, p := range holidayPhrases {
+		n := len(p.words)
+		if i+n > len(words) {
+			continue
+		}
+		for k, w := range p.words[:n-1] {
+			gap := s[words[i+k].end:words[i+k+1].start]
+			if words[i+k].lower != w || strings.TrimSpace(gap) != "" {
+				continue next
+			}
+		}
+		if strings.HasPrefix(words[i+n-1].lower, p.words[n-1]) {
+			return p, true
+		}
+	}
+	return holidayPhrase{}, false
+}
@@ -0,0 +1,138 @@
+package datetime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestExtractHolidays(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		text    string
+		time    time.Time
+		holiday string
+	}
+	tests := []struct {
+		name string
+		in   string
+		ref  time.Time
+		want []want
+	}{
+		{
+			"fixed holiday",
+			"Novruz bayramı yaxınlaşır",
+			ref,
+			[]want{{"Novruz bayramı", d(2026, time.March, 20), "Novruz bayramı"}},
+		},
+		{
+			"inflected last word",
+			"Müstəqillik gününə həsr olunmuş tədbir",
+			ref,
+			[]want{{"Müstəqillik gününə", d(2026, time.May, 28), "Müstəqillik günü"}},
+		},
+		{
+			"longest phrase wins",
+			"Beynəlxalq Qadınlar günündə",
+			ref,
+			[]want{{"Beynəlxalq Qadınlar günündə", d(2026, time.March, 8), "Qadınlar günü"}},
+		},
+		{
+			"former name",
+			"Dövlət Müstəqilliyi günü",
+			ref,
+			[]want{{"Dövlət Müstəqilliyi günü", d(2026, time.October, 18), "Müstəqilliyin bərpası günü"}},
+		},
+		{
+			"moving holidays from the table",
+			"Ramazan bayramı və Qurban bayramı",
+			ref,
+			[]want{
+				{"Ramazan bayramı", d(2026, time.March, 20), "Ramazan bayramı"},
+				{"Qurban bayramı", d(2026, time.May, 27), "Qurban bayramı"},
+			},
+		},
+		{
+			"moving holiday follows the reference year",
+			"Qurban bayramında",
+			d(2024, time.January, 1),
+			[]want{{"Qurban bayramında", d(2024, time.June, 16), "Qurban bayramı"}},
+		},
+		{
+			"first of two in one year",
+			"Orucluq bayramı",
+			d(2033, time.June, 1),
+			[]want{{"Orucluq bayramı", d(2033, time.January, 2), "Ramazan bayramı"}},
+		},
+		{
+			"moving holiday outside the table",
+			"Qurban bayramı",
+			d(2050, time.January, 1),
+			nil,
+		},
+		{
+			"merged with time",
+			"Zəfər günü saat 10",
+			ref,
+			[]want{{"Zəfər günü saat 10", time.Date(2026, time.November, 8, 10, 0, 0, 0, time.UTC), "Zəfər günü"}},
+		},
+		{
+			"punctuation breaks the phrase",
+			"Novruz, bayramı",
+			ref,
+			nil,
+		},
+		{
+			"name alone is not a holiday",
+			"Novruz gəldi",
+			ref,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Extract(tt.in, tt.ref)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Extract(%q) = %v, want %d results", tt.in, got, len(tt.want))
+			}
+			for i, w := range tt.want {
+				r := got[i]
+				if r.Text != w.text || !r.Time.Equal(w.time) || r.Holiday != w.holiday {
+					t.Errorf("[%d] = %q %v %q, want %q %v %q", i, r.Text, r.Time, r.Holiday, w.text, w.time, w.holiday)
+				}
+				if tt.in[r.Start:r.End] != r.Text {
+					t.Errorf("[%d] s[%d:%d] = %q, want %q", i, r.Start, r.End, tt.in[r.Start:r.End], r.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestHolidayTablesOrdered(t *testing.T) {
+	t.Parallel()
+
+	for name, table := range map[string][]calDate{"fitr": fitrDates, "adha": adhaDates} {
+		for i := 1; i < len(table); i++ {
+			a, b := table[i-1], table[i]
+			ta := time.Date(a.year, a.month, a.day, 0, 0, 0, 0, time.UTC)
+			tb := time.Date(b.year, b.month, b.day, 0, 0, 0, 0, time.UTC)
+			// Lunar years are about 354 days long.
+			if days := tb.Sub(ta).Hours() / 24; days < 353 || days > 356 {
+				t.Errorf("%s: %v to %v is %.0f days", name, ta, tb, days)
+			}
+		}
+	}
+}
+
+func ExampleExtract_holiday() {
+	ref := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, r := range Extract("Novruz bayramında və Qurban bayramında işləmirik", ref) {
+		fmt.Println(r.Holiday, r.Time.Format("2006-01-02"))
+	}
+	// Output:
+	// Novruz bayramı 2026-03-20
+	// Qurban bayramı 2026-05-27
+}
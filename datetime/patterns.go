@@ -23,25 +23,36 @@ type wordSpan struct {
 }
 
 // extract is the internal implementation of Extract.
-func extract(s string, ref time.Time) []Result {
+func extract(s string, ref time.Time, opts Options) []Result {
 	const minCap = 4
 	all := make([]Result, 0, len(s)/100+minCap)
 
 	words := splitWords(s)
 	lower := azcase.ToLower(s)
 
-	all = appendNumeric(all, s, ref)
+	all = appendNumeric(all, s, ref, opts)
+	all = appendHolidays(all, s, words, ref)
 	all = appendText(all, s, lower, words, ref)
 	all = appendRelative(all, s, words, ref)
 	all = appendDuration(all, s, words)
+	all = appendRecurrences(all, s, words, ref)
+	all = appendPeriods(all, s, words, ref)
+	all = appendParts(all, s, words, ref)
+	if opts.Anaphora {
+		all = appendAnaphoric(all, s, words, ref)
+	}
 
 	if len(all) == 0 {
 		return nil
 	}
 
 	all = resolveOverlaps(all)
-	all = mergeAdjacent(all, s)
-	return all
+	all = mergeAdjacent(all, s, ref)
+	if opts.Anaphora {
+		resolveAnaphora(all, words)
+	}
+	all = applyApproximations(all, s, words)
+	return applyZones(all, s, words)
 }
 
 // ---------- appendNumeric ----------
@@ -54,10 +65,10 @@ const (
 )
 
 // appendNumeric matches ISO, dot, slash date formats and HH:MM(:SS) times.
-func appendNumeric(all []Result, s string, ref time.Time) []Result {
-	all = appendRegexDate(all, s, ref, reISO, grpFirst, grpSecond, grpThird)   // YYYY-MM-DD
-	all = appendRegexDate(all, s, ref, reDot, grpThird, grpSecond, grpFirst)   // DD.MM.YYYY
-	all = appendRegexDate(all, s, ref, reSlash, grpThird, grpSecond, grpFirst) // DD/MM/YYYY
+func appendNumeric(all []Result, s string, ref time.Time, opts Options) []Result {
+	all = appendRegexDate(all, s, ref, reISO, grpFirst, grpSecond, grpThird) // YYYY-MM-DD
+	all = appendDayMonthDate(all, s, ref, reDot, opts)                       // DD.MM.YYYY
+	all = appendDayMonthDate(all, s, ref, reSlash, opts)                     // DD/MM/YYYY
 	all = appendTimeFmt(all, s, ref)
 	return all
 }
@@ -84,6 +95,60 @@ func appendRegexDate(all []Result, s string, ref time.Time, re *regexp.Regexp, y
 			Type:     TypeDate,
 			Time:     t,
 			Explicit: HasYear | HasMonth | HasDay,
+			Order:    OrderYMD,
+		})
+	}
+	return all
+}
+
+// appendDayMonthDate extracts NN<sep>NN<sep>YYYY dates from s using re,
+// whose first two capture groups may be read as day/month or month/day.
+// The reading is chosen by opts; see Options for the rules.
+func appendDayMonthDate(all []Result, s string, ref time.Time, re *regexp.Regexp, opts Options) []Result {
+	loc := ref.Location()
+	for _, m := range re.FindAllStringSubmatchIndex(s, -1) {
+		firstStr := s[m[grpFirst*2]:m[grpFirst*2+1]]
+		secondStr := s[m[grpSecond*2]:m[grpSecond*2+1]]
+		yearStr := s[m[grpThird*2]:m[grpThird*2+1]]
+
+		y1, mo1, d1, dmyOK := parseDateParts(yearStr, secondStr, firstStr)
+		y2, mo2, d2, mdyOK := parseDateParts(yearStr, firstStr, secondStr)
+
+		preferred := OrderDMY
+		if opts.MonthFirst {
+			preferred = OrderMDY
+		}
+
+		var order DateOrder
+		switch {
+		case dmyOK && mdyOK:
+			order = preferred
+		case opts.AutoOrder && dmyOK:
+			order = OrderDMY
+		case opts.AutoOrder && mdyOK:
+			order = OrderMDY
+		case preferred == OrderDMY && dmyOK:
+			order = OrderDMY
+		case preferred == OrderMDY && mdyOK:
+			order = OrderMDY
+		default:
+			continue
+		}
+
+		year, month, day := y1, mo1, d1
+		if order == OrderMDY {
+			year, month, day = y2, mo2, d2
+		}
+
+		all = append(all, Result{
+			Text:      s[m[0]:m[1]],
+			Start:     m[0],
+			End:       m[1],
+			Type:      TypeDate,
+			Time:      time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc),
+			Explicit:  HasYear | HasMonth | HasDay,
+			Order:     order,
+			Ambiguous: dmyOK && mdyOK && d1 != d2,
 		})
 	}
 	return all
@@ -226,6 +291,14 @@ func appendText(all []Result, s, lower string, words []wordSpan, ref time.Time)
 				spanEnd = words[nextIdx].end
 				explicit |= HasYear
 				used[nextIdx] = true
+			} else if y, ok := ordinalYear(words[nextIdx].lower); ok &&
+				nextIdx+1 < len(words) && yearNouns[words[nextIdx+1].lower] {
+				// "5 mart 2026-cı il", the form Format writes.
+				year = y
+				spanEnd = words[nextIdx+1].end
+				explicit |= HasYear
+				used[nextIdx] = true
+				used[nextIdx+1] = true
 			}
 		}
 
@@ -362,6 +435,29 @@ func appendSaatTime(all []Result, s string, words []wordSpan, used []bool, ref t
 		spanEnd := words[i+1].end
 		explicit := HasHour
 
+		// "saat 14:30": take the minutes (and seconds) of the clock time.
+		mn, sec := 0, 0
+		if m := reTime.FindStringSubmatchIndex(s[words[i+1].start:]); m != nil && m[0] == 0 {
+			clockMin, err := strconv.Atoi(s[words[i+1].start+m[4] : words[i+1].start+m[5]])
+			if err != nil || clockMin > maxMinute {
+				continue
+			}
+			clockSec := 0
+			if m[6] != -1 {
+				clockSec, err = strconv.Atoi(s[words[i+1].start+m[6] : words[i+1].start+m[7]])
+				if err != nil || clockSec > maxSecond {
+					continue
+				}
+				explicit |= HasSecond
+			}
+			mn, sec = clockMin, clockSec
+			explicit |= HasMinute
+			spanEnd = words[i+1].start + m[1]
+			for j := i + 2; j < len(words) && words[j].start < spanEnd; j++ {
+				used[j] = true
+			}
+		}
+
 		// Check for time-of-day modifier before "saat" (e.g. "axşam saat 7").
 		if i > 0 && !used[i-1] {
 			if shift, ok := timeOfDayWords[words[i-1].lower]; ok {
@@ -376,7 +472,7 @@ func appendSaatTime(all []Result, s string, words []wordSpan, used []bool, ref t
 		used[i] = true
 		used[i+1] = true
 
-		t := time.Date(ref.Year(), ref.Month(), ref.Day(), hour, 0, 0, 0, ref.Location())
+		t := time.Date(ref.Year(), ref.Month(), ref.Day(), hour, mn, sec, 0, ref.Location())
 		all = append(all, Result{
 			Text:     s[spanStart:spanEnd],
 			Start:    spanStart,
@@ -397,7 +493,7 @@ func appendSaatTime(all []Result, s string, words []wordSpan, used []bool, ref t
 func appendRelative(all []Result, s string, words []wordSpan, ref time.Time) []Result {
 	used := make([]bool, len(words))
 
-	// Pass 1: quantity-direction ("3 gün əvvəl", "iki saat sonra")
+	// Pass 1: quantity-direction ("3 gün əvvəl", "iki saat sonra", "3 gün 2 saat sonra")
 	// Must run before keyword matching so "3 gün" isn't consumed as partial.
 	// Tries bare digit first, then numtext word-form numbers.
 	for i := range words {
@@ -405,23 +501,19 @@ func appendRelative(all []Result, s string, words []wordSpan, ref time.Time) []R
 			continue
 		}
 
-		// Try to parse a quantity: bare digit or numtext word-form.
-		qty, consumed, ok := parseQuantity(words, i)
-		if !ok || qty <= 0 {
-			continue
-		}
-
-		unitIdx := i + consumed
-		if unitIdx >= len(words) {
-			continue
-		}
-		unit, ok := quantityUnits[words[unitIdx].lower]
-		if !ok {
-			continue
+		// One or more <quantity> <unit> pairs ("3 gün 2 saat"), then the
+		// direction word.
+		var pairs []quantityPair
+		dirIdx := i
+		for dirIdx < len(words) {
+			p, next, ok := parseQuantityPair(words, dirIdx)
+			if !ok {
+				break
+			}
+			pairs = append(pairs, p)
+			dirIdx = next
 		}
-
-		dirIdx := unitIdx + 1
-		if dirIdx >= len(words) {
+		if len(pairs) == 0 || dirIdx >= len(words) {
 			continue
 		}
 		dir, ok := directionWords[words[dirIdx].lower]
@@ -429,12 +521,15 @@ func appendRelative(all []Result, s string, words []wordSpan, ref time.Time) []R
 			continue
 		}
 
-		t := applyQuantityOffset(ref, int(qty), unit, dir)
+		t := ref
 		explicit := HasYear | HasMonth | HasDay
 		typ := TypeDate
-		if unit == qtyHour || unit == qtyMinute || unit == qtySecond {
-			explicit |= HasHour | HasMinute | HasSecond
-			typ = TypeDateTime
+		for _, p := range pairs {
+			t = applyQuantityOffset(t, int(p.qty), p.unit, dir)
+			if p.unit == qtyHour || p.unit == qtyMinute || p.unit == qtySecond {
+				explicit |= HasHour | HasMinute | HasSecond
+				typ = TypeDateTime
+			}
 		}
 
 		for j := i; j <= dirIdx; j++ {
@@ -623,8 +718,9 @@ func resolveOverlaps(results []Result) []Result {
 }
 
 // mergeAdjacent combines adjacent TypeDate + TypeTime results into TypeDateTime
-// when they are separated by at most maxMergeGap bytes.
-func mergeAdjacent(results []Result, s string) []Result {
+// when they are separated by at most maxMergeGap bytes, and folds a time or
+// date into an adjacent recurrence (see mergeRecurrence).
+func mergeAdjacent(results []Result, s string, ref time.Time) []Result {
 	if len(results) < 2 { //nolint:mnd
 		return results
 	}
@@ -641,6 +737,11 @@ func mergeAdjacent(results []Result, s string) []Result {
 					i += 2
 					continue
 				}
+				if merged, ok := mergeRecurrence(a, b, s, ref); ok {
+					out = append(out, merged)
+					i += 2
+					continue
+				}
 			}
 		}
 		out = append(out, results[i])
@@ -665,11 +766,14 @@ func tryMerge(a, b Result, s string) (Result, bool) {
 	end := max(dateR.End, timeR.End)
 
 	merged := Result{
-		Text:     s[start:end],
-		Start:    start,
-		End:      end,
-		Type:     TypeDateTime,
-		Explicit: dateR.Explicit | timeR.Explicit,
+		Text:      s[start:end],
+		Start:     start,
+		End:       end,
+		Type:      TypeDateTime,
+		Explicit:  dateR.Explicit | timeR.Explicit,
+		Order:     dateR.Order,
+		Ambiguous: dateR.Ambiguous,
+		Holiday:   dateR.Holiday,
 	}
 
 	merged.Time = time.Date(
@@ -681,6 +785,90 @@ func tryMerge(a, b Result, s string) (Result, bool) {
 	return merged, true
 }
 
+// ---------- anaphora ----------
+
+// appendAnaphoric matches "ertəsi gün" and "həmin gün" (optionally "günü"),
+// resolved against ref. resolveAnaphora later re-anchors them to the
+// preceding date when there is one.
+func appendAnaphoric(all []Result, s string, words []wordSpan, ref time.Time) []Result {
+	for i := 0; i+1 < len(words); i++ {
+		offset, ok := anaphorDays[words[i].lower]
+		if !ok || !anaphorDayNouns[words[i+1].lower] {
+			continue
+		}
+		t := ref.AddDate(0, 0, offset)
+		all = append(all, Result{
+			Text:     s[words[i].start:words[i+1].end],
+			Start:    words[i].start,
+			End:      words[i+1].end,
+			Type:     TypeDate,
+			Time:     time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, ref.Location()),
+			Explicit: HasYear | HasMonth | HasDay,
+		})
+		i++
+	}
+	return all
+}
+
+// resolveAnaphora re-anchors anaphoric results to the closest preceding
+// date or datetime result, in place. Results must be sorted by Start.
+// Anaphoric results become anchors themselves, so chains such as
+// "5 mart ... ertəsi gün ... həmin gün" resolve transitively.
+func resolveAnaphora(results []Result, words []wordSpan) {
+	var anchor time.Time
+	hasAnchor := false
+	for i := range results {
+		r := &results[i]
+		if hasAnchor {
+			if qty, unit, dir, ok := anaphorOffset(r.Start, r.End, words); ok {
+				t := applyQuantityOffset(anchor, qty, unit, dir)
+				hour, minute, sec := 0, 0, 0
+				if r.Explicit&HasHour != 0 {
+					hour, minute, sec = r.Time.Clock()
+				}
+				r.Time = time.Date(t.Year(), t.Month(), t.Day(), hour, minute, sec, 0, r.Time.Location())
+				r.Anaphoric = true
+			}
+		}
+		if r.Type == TypeDate || r.Type == TypeDateTime {
+			anchor = r.Time
+			hasAnchor = true
+		}
+	}
+}
+
+// anaphorOffset reports whether the words spanning [start, end) begin with
+// an anaphoric day reference or a day/week/month/year quantity-direction
+// expression, and returns its offset. Hour, minute, and second quantities
+// ("iki saat sonra") are not anaphoric at day granularity and are skipped.
+func anaphorOffset(start, end int, words []wordSpan) (qty int, unit qtyUnit, dir dirKind, ok bool) {
+	i, _ := slices.BinarySearchFunc(words, start, func(w wordSpan, off int) int {
+		return cmp.Compare(w.start, off)
+	})
+	if i+1 >= len(words) || words[i].start != start || words[i+1].end > end {
+		return 0, 0, 0, false
+	}
+
+	if offset, found := anaphorDays[words[i].lower]; found && anaphorDayNouns[words[i+1].lower] {
+		return offset, qtyDay, dirAfter, true
+	}
+
+	n, consumed, found := parseQuantity(words, i)
+	unitIdx, dirIdx := i+consumed, i+consumed+1
+	if !found || n <= 0 || dirIdx >= len(words) || words[dirIdx].end > end {
+		return 0, 0, 0, false
+	}
+	unit, found = quantityUnits[words[unitIdx].lower]
+	if !found || unit > qtyYear {
+		return 0, 0, 0, false
+	}
+	dir, found = directionWords[words[dirIdx].lower]
+	if !found {
+		return 0, 0, 0, false
+	}
+	return int(n), unit, dir, true
+}
+
 // ---------- time computation helpers ----------
 
 // nextWeekday returns the next occurrence of the given weekday.
@@ -776,89 +964,67 @@ const maxNumtextWords = 5
 // well within int64 range even when multiplied by time.Hour.
 const maxDurationQty = 1_000_000
 
+// maxDuration caps a whole duration, and the quantity of day and week
+// segments, at maxDurationQty hours.
+const maxDuration = maxDurationQty * time.Hour
+
+// Day-based duration units. Months and years vary in length and are not
+// durations.
+const (
+	durationDay  = 24 * time.Hour
+	durationWeek = 7 * durationDay
+)
+
 // durationUnits maps unit words to their time.Duration multiplier.
+// Adjectival -lıq/-lik forms ("5 günlük səfər") measure a duration too.
 var durationUnits = map[string]time.Duration{
-	"saat":   time.Hour,
-	"dəqiqə": time.Minute,
-	"saniyə": time.Second,
+	"saat":      time.Hour,
+	"dəqiqə":    time.Minute,
+	"saniyə":    time.Second,
+	"gün":       durationDay,
+	"həftə":     durationWeek,
+	"saatlıq":   time.Hour,
+	"dəqiqəlik": time.Minute,
+	"saniyəlik": time.Second,
+	"günlük":    durationDay,
+	"həftəlik":  durationWeek,
 }
 
-// appendDuration matches standalone duration expressions like "2 saat 30 dəqiqə".
-// A duration is a sequence of <quantity> <unit> pairs WITHOUT a direction word (əvvəl/sonra).
+// wordHalf is "half": "yarım saat" is 30 minutes, "iki saat yarım" is 2.5 hours.
+const wordHalf = "yarım"
+
+// appendDuration matches standalone duration expressions like "2 saat 30 dəqiqə",
+// "iki saat yarım", or "3 gün". A duration is a sequence of <quantity> <unit>
+// pairs WITHOUT a direction word (əvvəl/sonra) after the last pair.
 // If a direction word follows, it's a relative expression handled by appendRelative.
 func appendDuration(all []Result, s string, words []wordSpan) []Result {
-	used := make([]bool, len(words))
-
 	for i := 0; i < len(words); {
-		if used[i] {
-			i++
-			continue
-		}
-
-		// Try to parse a quantity at position i.
-		qty, consumed, ok := parseQuantity(words, i)
-		if !ok || qty <= 0 {
-			i++
-			continue
-		}
-
-		unitIdx := i + consumed
-		if unitIdx >= len(words) {
-			i++
-			continue
-		}
-
-		mul, ok := durationUnits[words[unitIdx].lower]
+		dur, pos, ok := parseDurationPair(words, i)
 		if !ok {
 			i++
 			continue
 		}
 
-		// Check that NO direction word follows the unit — that would be relative, not duration.
-		dirIdx := unitIdx + 1
-		if dirIdx < len(words) {
-			if _, isDir := directionWords[words[dirIdx].lower]; isDir {
-				i++
-				continue
-			}
-		}
-
 		// We have at least one <qty> <unit> pair. Greedily consume more pairs.
-		if qty > maxDurationQty {
-			qty = maxDurationQty
-		}
-		dur := time.Duration(qty) * mul
 		spanStart := words[i].start
-		spanEnd := words[unitIdx].end
-
-		for j := i; j <= unitIdx; j++ {
-			used[j] = true
-		}
-
-		// Try to extend: look for more <qty> <unit> pairs.
-		pos := unitIdx + 1
+		spanEnd := words[pos-1].end
 		for pos < len(words) {
-			nQty, nConsumed, nOk := parseQuantity(words, pos)
-			if !nOk || nQty <= 0 {
-				break
-			}
-			nUnitIdx := pos + nConsumed
-			if nUnitIdx >= len(words) {
-				break
-			}
-			nMul, nOk := durationUnits[words[nUnitIdx].lower]
+			nDur, nPos, nOk := parseDurationPair(words, pos)
 			if !nOk {
 				break
 			}
-			if nQty > maxDurationQty {
-				nQty = maxDurationQty
-			}
-			dur += time.Duration(nQty) * nMul
-			spanEnd = words[nUnitIdx].end
-			for j := pos; j <= nUnitIdx; j++ {
-				used[j] = true
+			dur = min(dur+nDur, maxDuration)
+			spanEnd = words[nPos-1].end
+			pos = nPos
+		}
+
+		// A direction word after the last pair makes the whole span relative
+		// ("3 gün 2 saat sonra"), not a duration.
+		if pos < len(words) {
+			if _, isDir := directionWords[words[pos].lower]; isDir {
+				i = pos + 1
+				continue
 			}
-			pos = nUnitIdx + 1
 		}
 
 		all = append(all, Result{
@@ -875,6 +1041,73 @@ func appendDuration(all []Result, s string, words []wordSpan) []Result {
 	return all
 }
 
+// parseDurationPair parses one "<quantity> <unit> [yarım]" or "yarım <unit>"
+// pair starting at words[idx]. Returns the duration and the index of the
+// first word after the pair.
+func parseDurationPair(words []wordSpan, idx int) (time.Duration, int, bool) {
+	if words[idx].lower == wordHalf {
+		if idx+1 < len(words) {
+			if mul, ok := durationUnits[words[idx+1].lower]; ok {
+				return mul / 2, idx + 2, true
+			}
+		}
+		return 0, 0, false
+	}
+
+	qty, consumed, ok := parseQuantity(words, idx)
+	if !ok || qty <= 0 {
+		return 0, 0, false
+	}
+	unitIdx := idx + consumed
+	if unitIdx >= len(words) {
+		return 0, 0, false
+	}
+	unit := words[unitIdx].lower
+	mul, ok := durationUnits[unit]
+	if !ok {
+		return 0, 0, false
+	}
+	// "bir gün" is far more often "one day, someday" than a 24-hour span.
+	if unit == "gün" && words[idx].lower == "bir" {
+		return 0, 0, false
+	}
+
+	if limit := int64(maxDuration / mul); qty > limit {
+		qty = limit
+	}
+	dur := time.Duration(qty) * mul
+	next := unitIdx + 1
+	if next < len(words) && words[next].lower == wordHalf {
+		dur += mul / 2
+		next++
+	}
+	return dur, next, true
+}
+
+// quantityPair is one <quantity> <unit> pair of a relative expression.
+type quantityPair struct {
+	qty  int64
+	unit qtyUnit
+}
+
+// parseQuantityPair parses one "<quantity> <unit>" pair starting at
+// words[idx]. Returns the pair and the index of the first word after it.
+func parseQuantityPair(words []wordSpan, idx int) (quantityPair, int, bool) {
+	qty, consumed, ok := parseQuantity(words, idx)
+	if !ok || qty <= 0 {
+		return quantityPair{}, 0, false
+	}
+	unitIdx := idx + consumed
+	if unitIdx >= len(words) {
+		return quantityPair{}, 0, false
+	}
+	unit, ok := quantityUnits[words[unitIdx].lower]
+	if !ok {
+		return quantityPair{}, 0, false
+	}
+	return quantityPair{qty: qty, unit: unit}, unitIdx + 1, true
+}
+
 // parseQuantity tries to parse a quantity starting at words[idx].
 // Returns (value, wordsConsumed, ok). Tries bare digit first, then numtext.
 func parseQuantity(words []wordSpan, idx int) (int64, int, bool) {
@@ -0,0 +1,242 @@
+// Century and millennium expressions: "XIX əsr", "21-ci əsrdə",
+// "XX əsrin əvvəlləri", "eramızdan əvvəl V əsr", "III minillik".
+package datetime
+
+import (
+	"strings"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/numtext"
+)
+
+// Period is the span of years named by a century or millennium
+// expression. Years are counted as historians do: there is no year 0,
+// and years before the common era are negative (-500 is 500 BC).
+type Period struct {
+	FirstYear int `json:"first_year"` // First year of the period (1801 for "XIX əsr")
+	LastYear  int `json:"last_year"`  // Last year of the period, inclusive (1900 for "XIX əsr")
+}
+
+const (
+	yearsPerCentury    = 100
+	yearsPerMillennium = 1000
+
+	// maxPeriodYears bounds the number of the century or millennium: the
+	// 100th century, the 10th millennium.
+	maxPeriodYears = 10000
+
+	// Parts of a period, in tenths of its length: "əvvəlləri" is the
+	// first 3/10, "ortaları" the middle 4/10, and "sonları" the last 3/10.
+	earlyTenths = 3
+	lateTenths  = 7
+	tenths      = 10
+
+	// yearsPerDecade is the length of "XX əsrin 30-cu illəri".
+	yearsPerDecade = 10
+)
+
+// Words of period expressions.
+const (
+	wordEra      = "eramızdan" // "eramızdan əvvəl": before the common era
+	wordBefore   = "əvvəl"
+	abbrEra      = "e.ə"  // "e.ə.", with the final dot outside the word
+	prefixHalf   = "yarı" // "yarısı", "yarısında"
+	wordFirst    = "birinci"
+	wordSecond   = "ikinci"
+	prefixYears  = "il" // "illəri", "illərində"
+	prefixEarly  = "əvvəl"
+	prefixMiddle = "orta"
+	prefixLate   = "son"
+	wordAfter    = "sonra"
+)
+
+// genitiveEndings are the endings of a period word in the genitive case
+// ("əsrin", "minilliyin"), after which a part of the period may follow.
+var genitiveEndings = []string{"in", "ın", "un", "ün"}
+
+// periodWords maps the stems of period words to their length in years.
+var periodWords = []struct {
+	stem  string
+	years int
+}{
+	{"əsr", yearsPerCentury},
+	{"yüzillik", yearsPerCentury},
+	{"yüzilliy", yearsPerCentury}, // "yüzilliyin", "yüzilliyə"
+	{"minillik", yearsPerMillennium},
+	{"minilliy", yearsPerMillennium},
+}
+
+// appendPeriods matches century and millennium expressions. Result.Time
+// is the start of the period, or zero before the common era.
+func appendPeriods(all []Result, s string, words []wordSpan, ref time.Time) []Result {
+	for i := 0; i < len(words); i++ {
+		p, next, ok := matchPeriod(words, i)
+		if !ok {
+			continue
+		}
+		r := Result{
+			Text:     s[words[i].start:words[next-1].end],
+			Start:    words[i].start,
+			End:      words[next-1].end,
+			Type:     TypePeriod,
+			Explicit: HasYear,
+			Period:   &p,
+		}
+		if p.FirstYear > 0 {
+			r.Time = time.Date(p.FirstYear, time.January, 1, 0, 0, 0, 0, ref.Location())
+		}
+		all = append(all, r)
+		i = next - 1
+	}
+	return all
+}
+
+// matchPeriod matches a period expression starting at words[i] and
+// returns it with the index of the first word after it.
+func matchPeriod(words []wordSpan, i int) (Period, int, bool) {
+	j, bce := i, false
+	switch {
+	case words[j].lower == abbrEra:
+		j, bce = j+1, true
+	case words[j].lower == wordEra && j+1 < len(words) && words[j+1].lower == wordBefore:
+		j, bce = j+2, true
+	}
+	if j+1 >= len(words) {
+		return Period{}, 0, false
+	}
+	first, last, ok := periodNumbers(words[j].text)
+	if !ok {
+		return Period{}, 0, false
+	}
+	unit, years, ok := periodUnit(words[j+1].lower)
+	if !ok || last*years > maxPeriodYears {
+		return Period{}, 0, false
+	}
+	p := Period{FirstYear: (first-1)*years + 1, LastYear: last * years}
+	if bce {
+		// Counted backwards: "e.ə. V-IV əsrlər" is 500-301 BC.
+		p = Period{FirstYear: -last * years, LastYear: -(first-1)*years - 1}
+	}
+	next := j + 2
+
+	// A part of a single period follows its genitive: "əsrin əvvəlləri".
+	if first == last && isGenitive(words[j+1].lower, unit) && next < len(words) {
+		if part, n, ok := periodPart(p, years, words, next); ok {
+			p, next = part, n
+		}
+	}
+	return p, next, true
+}
+
+// periodNumbers parses the number of a period: a Roman numeral ("XIX"),
+// a range of two ("XIX-XX"), or a digit ordinal ("19-cu"). first and
+// last are equal for a single period.
+func periodNumbers(text string) (first, last int, ok bool) {
+	if n, ok := parseOrdinalWord(text); ok && n > 0 {
+		return n, n, true
+	}
+	if a, b, found := strings.Cut(text, "-"); found {
+		x, okA := parseUpperRoman(a)
+		y, okB := parseUpperRoman(b)
+		if !okA || !okB || x == y {
+			return 0, 0, false
+		}
+		return min(x, y), max(x, y), true
+	}
+	n, ok := parseUpperRoman(text)
+	return n, n, ok
+}
+
+// parseUpperRoman parses an uppercase Roman numeral. Lowercase letters
+// are words ("mix", "dil"), not numerals.
+func parseUpperRoman(s string) (int, bool) {
+	if s == "" || strings.ToUpper(s) != s {
+		return 0, false
+	}
+	n, err := numtext.ParseRoman(s)
+	if err != nil {
+		return 0, false
+	}
+	return int(n), true
+}
+
+// periodUnit returns the stem and length in years of a period word, in
+// any case form ("əsr", "əsrdə", "əsrin", "minilliyin").
+func periodUnit(lower string) (stem string, years int, ok bool) {
+	for _, u := range periodWords {
+		if strings.HasPrefix(lower, u.stem) {
+			return u.stem, u.years, true
+		}
+	}
+	return "", 0, false
+}
+
+// isGenitive reports whether the period word lower, with the given stem,
+// is in the genitive case.
+func isGenitive(lower, stem string) bool {
+	if len(lower) <= len(stem) {
+		return false
+	}
+	for _, end := range genitiveEndings {
+		if strings.HasSuffix(lower, end) {
+			return true
+		}
+	}
+	return false
+}
+
+// periodPart narrows p to the part named at words[k]: "əvvəlləri",
+// "ortaları", "sonları", "birinci/ikinci yarısı", or for a century, a
+// decade ("30-cu illəri"). It returns the part with the index of the
+// first word after it.
+func periodPart(p Period, years int, words []wordSpan, k int) (Period, int, bool) {
+	from, to, next, ok := partTenths(words, k)
+	if !ok {
+		return decadeOf(p, years, words, k)
+	}
+	return Period{
+		FirstYear: p.FirstYear + (p.LastYear-p.FirstYear+1)*from/tenths,
+		LastYear:  p.FirstYear + (p.LastYear-p.FirstYear+1)*to/tenths - 1,
+	}, next, true
+}
+
+// partTenths returns the part named at words[k] as the range [from, to)
+// in tenths of the whole: "əvvəlləri", "ortaları", "sonları", or
+// "birinci/ikinci yarısı". next is the index of the first word after it.
+func partTenths(words []wordSpan, k int) (from, to, next int, ok bool) {
+	w := words[k].lower
+	from, to, next = 0, tenths, k+1
+	switch {
+	case strings.HasPrefix(w, prefixEarly):
+		to = earlyTenths
+	case strings.HasPrefix(w, prefixMiddle):
+		from, to = earlyTenths, lateTenths
+	case strings.HasPrefix(w, prefixLate) && !strings.HasPrefix(w, wordAfter):
+		from = lateTenths
+	case (w == wordFirst || w == wordSecond) && k+1 < len(words) && strings.HasPrefix(words[k+1].lower, prefixHalf):
+		if w == wordFirst {
+			to = tenths / 2
+		} else {
+			from = tenths / 2
+		}
+		next = k + 2
+	default:
+		return 0, 0, 0, false
+	}
+	return from, to, next, true
+}
+
+// decadeOf narrows a century of the common era to the decade named at
+// words[k]: "XX əsrin 30-cu illəri" is 1930-1939.
+func decadeOf(p Period, years int, words []wordSpan, k int) (Period, int, bool) {
+	if years != yearsPerCentury || p.FirstYear < 1 || k+1 >= len(words) ||
+		!strings.HasPrefix(words[k+1].lower, prefixYears) {
+		return Period{}, 0, false
+	}
+	d, ok := parseOrdinalWord(words[k].text)
+	if !ok || d%yearsPerDecade != 0 || d >= yearsPerCentury {
+		return Period{}, 0, false
+	}
+	first := p.FirstYear - 1 + d
+	return Period{FirstYear: first, LastYear: first + yearsPerDecade - 1}, k + 2, true
+}
@@ -0,0 +1,145 @@
+package datetime
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestExtractPeriod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		in          string
+		text        string
+		first, last int
+	}{
+		// -- Centuries --
+		{"roman century", "XIX əsr ədəbiyyatı", "XIX əsr", 1801, 1900},
+		{"locative", "Biz XXI əsrdə yaşayırıq.", "XXI əsrdə", 2001, 2100},
+		{"digit ordinal", "19-cu əsrin şairləri", "19-cu əsrin", 1801, 1900},
+		{"digit ordinal locative", "21-ci əsrdə", "21-ci əsrdə", 2001, 2100},
+		{"first century", "I əsr", "I əsr", 1, 100},
+		{"range", "XIX-XX əsrlərdə", "XIX-XX əsrlərdə", 1801, 2000},
+		{"yüzillik", "XX yüzillikdə", "XX yüzillikdə", 1901, 2000},
+
+		// -- Millennia --
+		{"millennium", "III minillik", "III minillik", 2001, 3000},
+		{"millennium digit", "2-ci minillikdə", "2-ci minillikdə", 1001, 2000},
+
+		// -- Parts --
+		{"early", "XIX əsrin əvvəllərində", "XIX əsrin əvvəllərində", 1801, 1830},
+		{"middle", "XX əsrin ortalarında", "XX əsrin ortalarında", 1931, 1970},
+		{"late", "XVIII əsrin sonlarında", "XVIII əsrin sonlarında", 1771, 1800},
+		{"end", "XX əsrin sonu", "XX əsrin sonu", 1971, 2000},
+		{"first half", "XX əsrin birinci yarısı", "XX əsrin birinci yarısı", 1901, 1950},
+		{"second half", "XIX əsrin ikinci yarısında", "XIX əsrin ikinci yarısında", 1851, 1900},
+		{"decade", "XX əsrin 30-cu illərində", "XX əsrin 30-cu illərində", 1930, 1939},
+		{"not a part", "XX əsrin sonra", "XX əsrin", 1901, 2000},
+
+		// -- Before the common era --
+		{"bce", "eramızdan əvvəl V əsr", "eramızdan əvvəl V əsr", -500, -401},
+		{"bce abbreviated", "e.ə. I əsrdə", "e.ə. I əsrdə", -100, -1},
+		{"bce range", "e.ə. V-IV əsrlər", "e.ə. V-IV əsrlər", -500, -301},
+		{"bce early", "e.ə. V əsrin əvvəlləri", "e.ə. V əsrin əvvəlləri", -500, -471},
+		{"bce millennium", "e.ə. II minillik", "e.ə. II minillik", -2000, -1001},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Extract(tt.in, ref)
+			if len(got) != 1 {
+				t.Fatalf("Extract(%q) = %v, want one result", tt.in, got)
+			}
+			r := got[0]
+			if r.Type != TypePeriod || r.Period == nil {
+				t.Fatalf("Extract(%q) = %v, want a period", tt.in, r)
+			}
+			if r.Text != tt.text || tt.in[r.Start:r.End] != r.Text {
+				t.Errorf("Text = %q [%d:%d], want %q", r.Text, r.Start, r.End, tt.text)
+			}
+			if r.Period.FirstYear != tt.first || r.Period.LastYear != tt.last {
+				t.Errorf("Period = %+v, want %d-%d", *r.Period, tt.first, tt.last)
+			}
+			if r.Explicit != HasYear {
+				t.Errorf("Explicit = %s, want Y", r.Explicit)
+			}
+			if tt.first > 0 && !r.Time.Equal(d(tt.first, time.January, 1)) {
+				t.Errorf("Time = %v, want start of %d", r.Time, tt.first)
+			}
+			if tt.first < 0 && !r.Time.IsZero() {
+				t.Errorf("Time = %v, want zero before the common era", r.Time)
+			}
+		})
+	}
+}
+
+func TestExtractPeriodNegative(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{
+		"XIX",          // no period word
+		"xix əsr",      // lowercase is not a numeral
+		"IIII əsr",     // non-canonical numeral
+		"MMMM əsr",     // out of range
+		"C vitamini",   // not a period word
+		"əsrlər boyu",  // no number
+		"XX-XX əsrlər", // degenerate range
+		"19 əsr",       // cardinal, not ordinal
+	} {
+		for _, r := range Extract(in, ref) {
+			if r.Type == TypePeriod {
+				t.Errorf("Extract(%q) = %v, want no period", in, r)
+			}
+		}
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	r, err := Parse("XIX əsr", ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Type != TypePeriod || r.Period == nil || *r.Period != (Period{FirstYear: 1801, LastYear: 1900}) {
+		t.Errorf("Parse = %v %+v", r, r.Period)
+	}
+}
+
+func TestPeriodJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{"XIX əsr", "e.ə. V əsr"} {
+		got := Extract(in, ref)
+		data, err := json.Marshal(got)
+		if err != nil {
+			t.Fatalf("Marshal(%q): %v", in, err)
+		}
+		var back []Result
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+		if len(back) != 1 || back[0].Type != TypePeriod || *back[0].Period != *got[0].Period {
+			t.Errorf("round trip of %q = %v", in, back)
+		}
+	}
+}
+
+func BenchmarkExtractPeriod(b *testing.B) {
+	const s = "Bu abidə XII əsrin ikinci yarısında, Atabəylər dövründə tikilib."
+	for b.Loop() {
+		Extract(s, ref)
+	}
+}
+
+func ExamplePeriod() {
+	for _, r := range Extract("XIX əsrin sonlarında və eramızdan əvvəl V əsrdə", time.Time{}) {
+		fmt.Println(r.Text, r.Period.FirstYear, r.Period.LastYear)
+	}
+	// Output:
+	// XIX əsrin sonlarında 1871 1900
+	// eramızdan əvvəl V əsrdə -500 -401
+}
@@ -0,0 +1,427 @@
+// Recurring expressions: "hər gün", "hər bazar ertəsi", "hər ayın 5-i",
+// "iki həftədən bir".
+package datetime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the unit a Recurrence repeats in.
+type Frequency int
+
+const (
+	FreqDaily   Frequency = iota // "hər gün"
+	FreqWeekly                   // "hər həftə", "hər cümə"
+	FreqMonthly                  // "hər ay", "hər ayın 5-i"
+	FreqYearly                   // "hər il", "hər il 8 mart"
+)
+
+// frequencyNames maps Frequency values to their string names.
+var frequencyNames = [...]string{
+	FreqDaily:   "Daily",
+	FreqWeekly:  "Weekly",
+	FreqMonthly: "Monthly",
+	FreqYearly:  "Yearly",
+}
+
+// frequencyFromName maps string names back to Frequency values.
+var frequencyFromName = map[string]Frequency{
+	"Daily":   FreqDaily,
+	"Weekly":  FreqWeekly,
+	"Monthly": FreqMonthly,
+	"Yearly":  FreqYearly,
+}
+
+// String returns the name of the frequency.
+func (f Frequency) String() string {
+	if int(f) >= 0 && int(f) < len(frequencyNames) {
+		return frequencyNames[f]
+	}
+	return fmt.Sprintf("Frequency(%d)", int(f))
+}
+
+// MarshalJSON encodes the frequency as a JSON string (e.g. "Weekly").
+func (f Frequency) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Weekly") into a Frequency.
+func (f *Frequency) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := frequencyFromName[s]
+	if !ok {
+		const maxErrLen = 50
+		if len(s) > maxErrLen {
+			s = s[:maxErrLen] + "..."
+		}
+		return fmt.Errorf("datetime: unknown frequency: %q", s)
+	}
+	*f = v
+	return nil
+}
+
+// Recurrence describes a repeating schedule, the RFC 5545 recurrence rule
+// of a TypeRecurrence result. The result's Time is the first occurrence
+// (DTSTART), including the time of day when one is given.
+type Recurrence struct {
+	Frequency Frequency      `json:"frequency"`
+	Interval  int            `json:"interval"`            // repeat every Interval units; 1 is every
+	Weekdays  []time.Weekday `json:"weekdays,omitempty"`  // BYDAY: "hər bazar ertəsi və cümə"
+	MonthDay  int            `json:"month_day,omitempty"` // BYMONTHDAY: 1-31, or -1 for the last day
+	Month     time.Month     `json:"month,omitempty"`     // BYMONTH, for yearly rules with a date
+}
+
+// rruleDays maps weekdays to their RFC 5545 BYDAY codes.
+var rruleDays = [...]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// RRULE returns the rule as an RFC 5545 RRULE value, e.g.
+// "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO". INTERVAL is omitted when it is 1.
+func (r Recurrence) RRULE() string {
+	var b strings.Builder
+	b.WriteString("FREQ=")
+	b.WriteString(strings.ToUpper(r.Frequency.String()))
+	if r.Interval > 1 {
+		b.WriteString(";INTERVAL=")
+		b.WriteString(strconv.Itoa(r.Interval))
+	}
+	if r.Month != 0 {
+		b.WriteString(";BYMONTH=")
+		b.WriteString(strconv.Itoa(int(r.Month)))
+	}
+	if r.MonthDay != 0 {
+		b.WriteString(";BYMONTHDAY=")
+		b.WriteString(strconv.Itoa(r.MonthDay))
+	}
+	for i, wd := range r.Weekdays {
+		if i == 0 {
+			b.WriteString(";BYDAY=")
+		} else {
+			b.WriteByte(',')
+		}
+		b.WriteString(rruleDays[wd])
+	}
+	return b.String()
+}
+
+// Recurrence vocabulary.
+const (
+	wordEvery   = "hər"     // "hər gün"
+	wordOnce    = "bir"     // "iki həftədən bir", "həftədə bir dəfə"
+	wordTimes   = "dəfə"    // "həftədə bir dəfə"
+	wordAnd     = "və"      // "hər bazar ertəsi və cümə"
+	wordOfMonth = "ayın"    // "hər ayın 5-i"
+	wordLast    = "son"     // "hər ayın son günü"
+	wordDay     = "günü"    // "hər ayın son günü", "hər cümə günü"
+	wordDays    = "günləri" // "hər cümə günləri"
+
+	// maxRecurrenceInterval caps the interval of "N həftədən bir".
+	maxRecurrenceInterval = 999
+
+	// maxOccurrenceSearch bounds the days searched for the first
+	// occurrence: a yearly rule on 29 February may skip eight years.
+	maxOccurrenceSearch = 8*366 + 1
+)
+
+// recurrenceUnits maps the bare unit after "hər" ("hər həftə") to its
+// frequency.
+var recurrenceUnits = map[string]Frequency{
+	"gün":   FreqDaily,
+	"həftə": FreqWeekly,
+	"ay":    FreqMonthly,
+	"il":    FreqYearly,
+}
+
+// recurrenceUnitCases maps the locative ("həftədə bir dəfə") and ablative
+// ("iki həftədən bir") forms of the units to their frequency.
+var recurrenceUnitCases = map[string]Frequency{
+	"gündə":    FreqDaily,
+	"gündən":   FreqDaily,
+	"həftədə":  FreqWeekly,
+	"həftədən": FreqWeekly,
+	"ayda":     FreqMonthly,
+	"aydan":    FreqMonthly,
+	"ildə":     FreqYearly,
+	"ildən":    FreqYearly,
+}
+
+// appendRecurrences matches recurring expressions and resolves each to its
+// first occurrence on or after the day of ref.
+func appendRecurrences(all []Result, s string, words []wordSpan, ref time.Time) []Result {
+	for i := 0; i < len(words); i++ {
+		rec, next, ok := matchRecurrence(words, i)
+		if !ok {
+			continue
+		}
+		t, ok := firstOccurrence(rec, ref, time.Time{}, false)
+		if !ok {
+			continue
+		}
+		end := words[next-1].end
+		all = append(all, Result{
+			Text:       s[words[i].start:end],
+			Start:      words[i].start,
+			End:        end,
+			Type:       TypeRecurrence,
+			Time:       t,
+			Explicit:   HasYear | HasMonth | HasDay,
+			Recurrence: &rec,
+		})
+		i = next - 1
+	}
+	return all
+}
+
+// matchRecurrence matches a recurring expression starting at words[i] and
+// returns it with the index of the first word after it.
+func matchRecurrence(words []wordSpan, i int) (Recurrence, int, bool) {
+	if words[i].lower == wordEvery {
+		return matchEvery(words, i+1)
+	}
+
+	// "həftədə bir dəfə": once a week.
+	if freq, ok := recurrenceUnitCases[words[i].lower]; ok && i+2 < len(words) &&
+		words[i+1].lower == wordOnce && words[i+2].lower == wordTimes {
+		return Recurrence{Frequency: freq, Interval: 1}, i + 3, true
+	}
+
+	// "iki həftədən bir": every two weeks.
+	if n, unitIdx, ok := recurrenceInterval(words, i); ok && unitIdx+1 < len(words) &&
+		isAblative(words[unitIdx].lower) && words[unitIdx+1].lower == wordOnce {
+		freq := recurrenceUnitCases[words[unitIdx].lower]
+		return Recurrence{Frequency: freq, Interval: n}, skipTimes(words, unitIdx+2), true
+	}
+	return Recurrence{}, 0, false
+}
+
+// matchEvery matches the rest of a recurring expression after "hər",
+// starting at words[j].
+func matchEvery(words []wordSpan, j int) (Recurrence, int, bool) {
+	if j >= len(words) {
+		return Recurrence{}, 0, false
+	}
+
+	// "hər bazar ertəsi və cümə (günləri)"
+	if wds, next := matchWeekdayList(words, j); len(wds) > 0 {
+		if next < len(words) && (words[next].lower == wordDay || words[next].lower == wordDays) {
+			next++
+		}
+		return Recurrence{Frequency: FreqWeekly, Interval: 1, Weekdays: wds}, next, true
+	}
+
+	// "hər ayın 5-i", "hər ayın son günü"
+	if words[j].lower == wordOfMonth && j+1 < len(words) {
+		if d, ok := parseNumberWithSuffix(words[j+1].lower); ok && d >= minDay && d <= maxDay &&
+			words[j+1].lower != strconv.Itoa(d) {
+			return Recurrence{Frequency: FreqMonthly, Interval: 1, MonthDay: d}, j + 2, true
+		}
+		if j+2 < len(words) && words[j+1].lower == wordLast && words[j+2].lower == wordDay {
+			return Recurrence{Frequency: FreqMonthly, Interval: 1, MonthDay: -1}, j + 3, true
+		}
+		return Recurrence{}, 0, false
+	}
+
+	// "hər həftə"
+	if freq, ok := recurrenceUnits[words[j].lower]; ok {
+		return Recurrence{Frequency: freq, Interval: 1}, j + 1, true
+	}
+
+	// "hər iki həftə", "hər 3 gündə (bir)", "hər iki həftədən bir"
+	if n, unitIdx, ok := recurrenceInterval(words, j); ok {
+		next := unitIdx + 1
+		if _, inflected := recurrenceUnitCases[words[unitIdx].lower]; inflected &&
+			next < len(words) && words[next].lower == wordOnce {
+			next = skipTimes(words, next+1)
+		}
+		freq, ok := recurrenceUnits[words[unitIdx].lower]
+		if !ok {
+			freq = recurrenceUnitCases[words[unitIdx].lower]
+		}
+		return Recurrence{Frequency: freq, Interval: n}, next, true
+	}
+	return Recurrence{}, 0, false
+}
+
+// recurrenceInterval parses "<quantity> <unit>" at words[i], the unit in
+// its bare, locative, or ablative form. Returns the quantity and the index
+// of the unit word.
+func recurrenceInterval(words []wordSpan, i int) (n, unitIdx int, ok bool) {
+	qty, consumed, ok := parseQuantity(words, i)
+	if !ok || qty < 1 || qty > maxRecurrenceInterval {
+		return 0, 0, false
+	}
+	unitIdx = i + consumed
+	if unitIdx >= len(words) {
+		return 0, 0, false
+	}
+	unit := words[unitIdx].lower
+	if _, bare := recurrenceUnits[unit]; !bare {
+		if _, inflected := recurrenceUnitCases[unit]; !inflected {
+			return 0, 0, false
+		}
+	}
+	return int(qty), unitIdx, true
+}
+
+// isAblative reports whether a unit word is in the ablative case
+// ("həftədən").
+func isAblative(unit string) bool {
+	return strings.HasSuffix(unit, "dən") || strings.HasSuffix(unit, "dan")
+}
+
+// skipTimes returns the index after an optional "dəfə" at words[j].
+func skipTimes(words []wordSpan, j int) int {
+	if j < len(words) && words[j].lower == wordTimes {
+		return j + 1
+	}
+	return j
+}
+
+// matchWeekdayList matches one or more weekday names starting at
+// words[j], optionally joined by "və". Returns the weekdays in text order
+// and the index of the first word after them.
+func matchWeekdayList(words []wordSpan, j int) ([]time.Weekday, int) {
+	var wds []time.Weekday
+	for j < len(words) {
+		wd, next, ok := matchWeekdayAt(words, j)
+		if !ok {
+			break
+		}
+		wds = append(wds, wd)
+		j = next
+		if j+1 < len(words) && words[j].lower == wordAnd {
+			if _, _, ok := matchWeekdayAt(words, j+1); ok {
+				j++
+			}
+		}
+	}
+	return wds, j
+}
+
+// matchWeekdayAt matches a weekday name starting at words[j] and returns
+// the index of the first word after it.
+func matchWeekdayAt(words []wordSpan, j int) (time.Weekday, int, bool) {
+	for _, wd := range weekdays {
+		parts := strings.Fields(wd.name)
+		if j+len(parts) > len(words) {
+			continue
+		}
+		match := true
+		for k, p := range parts {
+			if words[j+k].lower != p {
+				match = false
+				break
+			}
+		}
+		if match {
+			return wd.weekday, j + len(parts), true
+		}
+	}
+	return 0, 0, false
+}
+
+// firstOccurrence returns the first day on or after the day of ref that
+// matches rec, at midnight in the location of ref. With hasClock, the
+// occurrence is at the wall-clock time of clock instead and must not be
+// before ref. Reports false when no day matches within
+// maxOccurrenceSearch days.
+func firstOccurrence(rec Recurrence, ref, clock time.Time, hasClock bool) (time.Time, bool) {
+	day := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, ref.Location())
+	for range maxOccurrenceSearch {
+		if rec.matches(day) {
+			if !hasClock {
+				return day, true
+			}
+			t := time.Date(day.Year(), day.Month(), day.Day(),
+				clock.Hour(), clock.Minute(), clock.Second(), 0, day.Location())
+			if !t.Before(ref) {
+				return t, true
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}, false
+}
+
+// matches reports whether day satisfies the BYDAY, BYMONTHDAY, and BYMONTH
+// parts of the rule.
+func (r Recurrence) matches(day time.Time) bool {
+	if len(r.Weekdays) > 0 {
+		found := false
+		for _, wd := range r.Weekdays {
+			found = found || day.Weekday() == wd
+		}
+		if !found {
+			return false
+		}
+	}
+	if r.Month != 0 && day.Month() != r.Month {
+		return false
+	}
+	switch {
+	case r.MonthDay > 0:
+		return day.Day() == r.MonthDay
+	case r.MonthDay < 0:
+		return day.AddDate(0, 0, 1).Day() == 1
+	}
+	return true
+}
+
+// mergeRecurrence folds a time of day ("hər gün saat 9-da") or, into a
+// yearly rule without a date, a day and month ("hər il 8 martda") into a
+// recurrence adjacent to it.
+func mergeRecurrence(a, b Result, s string, ref time.Time) (Result, bool) {
+	recR, other := a, b
+	if b.Type == TypeRecurrence {
+		recR, other = b, a
+	}
+	if recR.Type != TypeRecurrence || other.Type == TypeRecurrence {
+		return Result{}, false
+	}
+	rec := *recR.Recurrence
+	ref = ref.In(recR.Time.Location())
+
+	var (
+		t        time.Time
+		ok       bool
+		explicit = recR.Explicit
+	)
+	switch {
+	case other.Type == TypeTime:
+		t, ok = firstOccurrence(rec, ref, other.Time, true)
+		explicit |= other.Explicit
+	case other.Type == TypeDate && recR.Start < other.Start && rec.Frequency == FreqYearly && rec.Month == 0 &&
+		other.Explicit&(HasYear|HasMonth|HasDay) == HasMonth|HasDay && other.Holiday == "":
+		rec.Month, rec.MonthDay = other.Time.Month(), other.Time.Day()
+		t, ok = firstOccurrence(rec, ref, time.Time{}, false)
+	}
+	if !ok {
+		return Result{}, false
+	}
+
+	start := min(recR.Start, other.Start)
+	end := max(recR.End, other.End)
+	return Result{
+		Text:       s[start:end],
+		Start:      start,
+		End:        end,
+		Type:       TypeRecurrence,
+		Time:       t,
+		Explicit:   explicit,
+		Recurrence: &rec,
+	}, true
+}
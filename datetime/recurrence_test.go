@@ -0,0 +1,186 @@
+package datetime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExtractRecurrence(t *testing.T) {
+	t.Parallel()
+
+	// ref is Friday, 2026-02-20 10:30 UTC.
+	tests := []struct {
+		name     string
+		in       string
+		text     string
+		time     time.Time
+		rrule    string
+		explicit Components
+	}{
+		// -- Every unit --
+		{"every day", "Hər gün idmanla məşğul olur.", "Hər gün", d(2026, time.February, 20), "FREQ=DAILY", HasYear | HasMonth | HasDay},
+		{"every week", "hər həftə görüşürük", "hər həftə", d(2026, time.February, 20), "FREQ=WEEKLY", HasYear | HasMonth | HasDay},
+		{"every month", "hər ay ödənilir", "hər ay", d(2026, time.February, 20), "FREQ=MONTHLY", HasYear | HasMonth | HasDay},
+		{"every year", "hər il keçirilir", "hər il", d(2026, time.February, 20), "FREQ=YEARLY", HasYear | HasMonth | HasDay},
+
+		// -- Weekdays --
+		{"weekday", "hər bazar ertəsi iclas var", "hər bazar ertəsi", d(2026, time.February, 23), "FREQ=WEEKLY;BYDAY=MO", HasYear | HasMonth | HasDay},
+		{"weekday today", "hər cümə günü", "hər cümə günü", d(2026, time.February, 20), "FREQ=WEEKLY;BYDAY=FR", HasYear | HasMonth | HasDay},
+		{"two-word weekday", "hər cümə axşamı", "hər cümə axşamı", d(2026, time.February, 26), "FREQ=WEEKLY;BYDAY=TH", HasYear | HasMonth | HasDay},
+		{"weekday list", "hər çərşənbə axşamı və şənbə günləri", "hər çərşənbə axşamı və şənbə günləri", d(2026, time.February, 21), "FREQ=WEEKLY;BYDAY=TU,SA", HasYear | HasMonth | HasDay},
+
+		// -- Day of month --
+		{"day of month", "Maaş hər ayın 5-i verilir.", "hər ayın 5-i", d(2026, time.March, 5), "FREQ=MONTHLY;BYMONTHDAY=5", HasYear | HasMonth | HasDay},
+		{"day of month today", "hər ayın 20-si", "hər ayın 20-si", d(2026, time.February, 20), "FREQ=MONTHLY;BYMONTHDAY=20", HasYear | HasMonth | HasDay},
+		{"last day of month", "hər ayın son günü", "hər ayın son günü", d(2026, time.February, 28), "FREQ=MONTHLY;BYMONTHDAY=-1", HasYear | HasMonth | HasDay},
+		{"day of year", "hər il 8 martda qeyd olunur", "hər il 8 martda", d(2026, time.March, 8), "FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=8", HasYear | HasMonth | HasDay},
+		{"day of year passed", "hər il 5 yanvarda", "hər il 5 yanvarda", d(2027, time.January, 5), "FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=5", HasYear | HasMonth | HasDay},
+
+		// -- Intervals --
+		{"ablative interval", "iki həftədən bir görüşürük", "iki həftədən bir", d(2026, time.February, 20), "FREQ=WEEKLY;INTERVAL=2", HasYear | HasMonth | HasDay},
+		{"every N ablative", "hər 3 gündən bir", "hər 3 gündən bir", d(2026, time.February, 20), "FREQ=DAILY;INTERVAL=3", HasYear | HasMonth | HasDay},
+		{"every N locative", "hər iki ayda bir dəfə", "hər iki ayda bir dəfə", d(2026, time.February, 20), "FREQ=MONTHLY;INTERVAL=2", HasYear | HasMonth | HasDay},
+		{"every N bare", "hər 2 il", "hər 2 il", d(2026, time.February, 20), "FREQ=YEARLY;INTERVAL=2", HasYear | HasMonth | HasDay},
+		{"once per unit", "həftədə bir dəfə", "həftədə bir dəfə", d(2026, time.February, 20), "FREQ=WEEKLY", HasYear | HasMonth | HasDay},
+
+		// -- Time of day --
+		{"time later today", "hər gün saat 11:00", "hər gün saat 11:00", dt(2026, time.February, 20, 11, 0, 0), "FREQ=DAILY", HasYear | HasMonth | HasDay | HasHour | HasMinute},
+		{"time passed today", "Hər gün saat 9-da dərman iç.", "Hər gün saat 9-da", dt(2026, time.February, 21, 9, 0, 0), "FREQ=DAILY", HasYear | HasMonth | HasDay | HasHour},
+		{"weekday with time", "hər bazar ertəsi və cümə saat 18:00-da", "hər bazar ertəsi və cümə saat 18:00", dt(2026, time.February, 20, 18, 0, 0), "FREQ=WEEKLY;BYDAY=MO,FR", HasYear | HasMonth | HasDay | HasHour | HasMinute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Extract(tt.in, ref)
+			if len(got) != 1 {
+				t.Fatalf("Extract(%q) = %v, want one result", tt.in, got)
+			}
+			r := got[0]
+			if r.Type != TypeRecurrence || r.Recurrence == nil {
+				t.Fatalf("Extract(%q) = %v, want a recurrence", tt.in, r)
+			}
+			if r.Text != tt.text || tt.in[r.Start:r.End] != r.Text {
+				t.Errorf("Text = %q [%d:%d], want %q", r.Text, r.Start, r.End, tt.text)
+			}
+			if !r.Time.Equal(tt.time) {
+				t.Errorf("Time = %v, want %v", r.Time, tt.time)
+			}
+			if got := r.Recurrence.RRULE(); got != tt.rrule {
+				t.Errorf("RRULE() = %q, want %q", got, tt.rrule)
+			}
+			if r.Explicit != tt.explicit {
+				t.Errorf("Explicit = %s, want %s", r.Explicit, tt.explicit)
+			}
+		})
+	}
+}
+
+func TestExtractRecurrenceNegative(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{
+		"hər iki tərəf razılaşdı",
+		"hər kəs gəldi",
+		"hər ayın",
+		"hər ayın 5 günü",
+		"hər",
+		"iki həftə bir",
+		"bir gündən",
+	} {
+		for _, r := range Extract(in, ref) {
+			if r.Type == TypeRecurrence {
+				t.Errorf("Extract(%q) = %v, want no recurrence", in, r)
+			}
+		}
+	}
+}
+
+func TestExtractRecurrenceWithDuration(t *testing.T) {
+	t.Parallel()
+
+	got := Extract("hər gün 2 saat oxuyur", ref)
+	if len(got) != 2 || got[0].Type != TypeRecurrence || got[1].Type != TypeDuration {
+		t.Errorf("Extract = %v, want a recurrence and a duration", got)
+	}
+}
+
+func TestRecurrenceRRULE(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rec  Recurrence
+		want string
+	}{
+		{Recurrence{Frequency: FreqDaily, Interval: 1}, "FREQ=DAILY"},
+		{Recurrence{Frequency: FreqWeekly}, "FREQ=WEEKLY"},
+		{Recurrence{Frequency: FreqWeekly, Interval: 2, Weekdays: []time.Weekday{time.Sunday, time.Wednesday}}, "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU,WE"},
+		{Recurrence{Frequency: FreqMonthly, Interval: 1, MonthDay: -1}, "FREQ=MONTHLY;BYMONTHDAY=-1"},
+		{Recurrence{Frequency: FreqYearly, Interval: 1, Month: time.May, MonthDay: 28}, "FREQ=YEARLY;BYMONTH=5;BYMONTHDAY=28"},
+	}
+	for _, tt := range tests {
+		if got := tt.rec.RRULE(); got != tt.want {
+			t.Errorf("RRULE() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestFrequencyEnum(t *testing.T) {
+	t.Parallel()
+
+	for f := FreqDaily; f <= FreqYearly; f++ {
+		data, err := json.Marshal(f)
+		if err != nil {
+			t.Fatalf("Marshal %s: %v", f, err)
+		}
+		var got Frequency
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal %s: %v", data, err)
+		}
+		if got != f {
+			t.Errorf("round-trip: got %s, want %s", got, f)
+		}
+	}
+	if got := Frequency(99).String(); !strings.HasPrefix(got, "Frequency(") {
+		t.Errorf("String() = %q, want Frequency(...) format", got)
+	}
+	var f Frequency
+	if err := json.Unmarshal([]byte(`"Hourly"`), &f); err == nil {
+		t.Error("want error for unknown frequency, got nil")
+	}
+}
+
+func TestRecurrenceJSON(t *testing.T) {
+	t.Parallel()
+
+	got := Extract("hər ayın 5-i", ref)
+	if len(got) != 1 {
+		t.Fatalf("Extract = %v, want one result", got)
+	}
+	data, err := json.Marshal(got[0].Recurrence)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want := `{"frequency":"Monthly","interval":1,"month_day":5}`; string(data) != want {
+		t.Errorf("JSON = %s, want %s", data, want)
+	}
+}
+
+func BenchmarkExtractRecurrence(b *testing.B) {
+	in := strings.Repeat("Hər bazar ertəsi saat 10:00-da iclas, hər ayın 5-i hesabat, iki həftədən bir görüş. ", 20)
+	for b.Loop() {
+		Extract(in, ref)
+	}
+}
+
+func ExampleRecurrence_RRULE() {
+	ref := time.Date(2026, time.February, 20, 10, 30, 0, 0, time.UTC)
+	for _, r := range Extract("Hər bazar ertəsi saat 10:00-da iclas, hər ayın 5-i hesabat.", ref) {
+		fmt.Println(r.Text, "|", r.Time.Format(time.DateTime), "|", r.Recurrence.RRULE())
+	}
+	// Output:
+	// Hər bazar ertəsi saat 10:00 | 2026-02-23 10:00:00 | FREQ=WEEKLY;BYDAY=MO
+	// hər ayın 5-i | 2026-03-05 00:00:00 | FREQ=MONTHLY;BYMONTHDAY=5
+}
@@ -0,0 +1,165 @@
+// Timezone hints: "Bakı vaxtı ilə", "UTC+4".
+package datetime
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// maxZoneOffset bounds UTC offsets in hints (UTC-12 to UTC+14).
+const maxZoneOffset = 14 * time.Hour
+
+// zoneHint is a timezone named in the text.
+type zoneHint struct {
+	start, end int
+	loc        *time.Location
+}
+
+// zonePlaces maps place names used in "<place> vaxtı ilə" to their
+// offsets. Only places without daylight saving time are listed, so that a
+// fixed offset is exact all year.
+var zonePlaces = map[string]time.Duration{
+	"bakı":       4 * time.Hour,
+	"azərbaycan": 4 * time.Hour,
+	"tbilisi":    4 * time.Hour,
+	"gürcüstan":  4 * time.Hour,
+	"moskva":     3 * time.Hour,
+	"istanbul":   3 * time.Hour,
+	"ankara":     3 * time.Hour,
+	"türkiyə":    3 * time.Hour,
+	"tehran":     3*time.Hour + 30*time.Minute,
+	"iran":       3*time.Hour + 30*time.Minute,
+	"daşkənd":    5 * time.Hour,
+	"özbəkistan": 5 * time.Hour,
+	"qrinviç":    0,
+}
+
+// zoneTimeWords are the forms of "vaxt" (time) following a place name.
+var zoneTimeWords = map[string]bool{
+	"vaxtı":    true,
+	"vaxtıyla": true,
+	"vaxtilə":  true,
+	"saatı":    true,
+	"saatıyla": true,
+}
+
+// reUTCOffset matches "UTC", "GMT", "UTC+4", "GMT-05:00", "UTC+0330".
+// Groups: sign, hours, minutes.
+var reUTCOffset = regexp.MustCompile(`\b(?:UTC|GMT)(?:\s?([+\-−])\s?(\d{1,2})(?::?(\d{2}))?)?\b`)
+
+// reZoneGap matches the text allowed between a time and its timezone
+// hint: a case suffix of the time ("15:00-da") and spaces, commas, or
+// parentheses.
+var reZoneGap = regexp.MustCompile(`^(?:-[\p{L}]{1,4})?[\s(),]*$`)
+
+// fixedZone returns a location with the given offset, named like "UTC+04:00".
+func fixedZone(offset time.Duration) *time.Location {
+	if offset == 0 {
+		return time.UTC
+	}
+	sign, abs := '+', offset
+	if offset < 0 {
+		sign, abs = '-', -offset
+	}
+	name := fmt.Sprintf("UTC%c%02d:%02d", sign, int(abs.Hours()), int(abs.Minutes())%60)
+	return time.FixedZone(name, int(offset.Seconds()))
+}
+
+// findZoneHints returns the timezone hints in s in order of position.
+func findZoneHints(s string, words []wordSpan) []zoneHint {
+	var hints []zoneHint
+	for i := 0; i+1 < len(words); i++ {
+		offset, ok := zonePlaces[words[i].lower]
+		if !ok || !zoneTimeWords[words[i+1].lower] {
+			continue
+		}
+		end := words[i+1].end
+		if i+2 < len(words) && words[i+2].lower == "ilə" && words[i+2].start-end <= 1 {
+			end = words[i+2].end
+		}
+		hints = append(hints, zoneHint{start: words[i].start, end: end, loc: fixedZone(offset)})
+		i++
+	}
+
+	for _, m := range reUTCOffset.FindAllStringSubmatchIndex(s, -1) {
+		var offset time.Duration
+		if m[2] >= 0 {
+			hours, _ := strconv.Atoi(s[m[4]:m[5]])
+			minutes := 0
+			if m[6] >= 0 {
+				minutes, _ = strconv.Atoi(s[m[6]:m[7]])
+			}
+			offset = time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+			if minutes > maxMinute || offset > maxZoneOffset {
+				continue
+			}
+			if s[m[2]:m[3]] != "+" {
+				offset = -offset
+			}
+		}
+		hints = append(hints, zoneHint{start: m[0], end: m[1], loc: fixedZone(offset)})
+	}
+	slices.SortFunc(hints, func(a, b zoneHint) int { return cmp.Compare(a.start, b.start) })
+	return hints
+}
+
+// applyZones reads the wall clock of a time or datetime result in the
+// timezone of an adjacent hint, extending the result over the hint. A hint
+// following a result ("saat 15:00 (Bakı vaxtı ilə)") is preferred over one
+// preceding it ("Bakı vaxtı ilə saat 15:00"). Results without a hint keep
+// their location. Results inside a hint ("05:00" of "GMT-05:00") are
+// dropped.
+func applyZones(results []Result, s string, words []wordSpan) []Result {
+	hints := findZoneHints(s, words)
+	if len(hints) == 0 {
+		return results
+	}
+	results = slices.DeleteFunc(results, func(r Result) bool {
+		return slices.ContainsFunc(hints, func(h zoneHint) bool {
+			return r.Start >= h.start && r.End <= h.end
+		})
+	})
+	for _, h := range hints {
+		if i := zoneTarget(results, s, h); i >= 0 {
+			r := &results[i]
+			t := r.Time
+			r.Time = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), h.loc)
+			r.Explicit |= HasZone
+			r.Start, r.End = min(r.Start, h.start), max(r.End, h.end)
+			r.Text = s[r.Start:r.End]
+		}
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	return results
+}
+
+// zoneTarget returns the index of the result hint h applies to, or -1.
+func zoneTarget(results []Result, s string, h zoneHint) int {
+	eligible := func(r Result) bool {
+		return (r.Type == TypeTime || r.Type == TypeDateTime) && r.Explicit&HasZone == 0
+	}
+	for i := len(results) - 1; i >= 0; i-- {
+		r := results[i]
+		if r.End <= h.start {
+			if eligible(r) && reZoneGap.MatchString(s[r.End:h.start]) {
+				return i
+			}
+			break
+		}
+	}
+	for i, r := range results {
+		if r.Start >= h.end {
+			if eligible(r) && reZoneGap.MatchString(s[h.end:r.Start]) {
+				return i
+			}
+			break
+		}
+	}
+	return -1
+}
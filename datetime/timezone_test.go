@@ -0,0 +1,163 @@
+package datetime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestExtractTimezones(t *testing.T) {
+	t.Parallel()
+
+	baku := time.FixedZone("UTC+04:00", 4*60*60)
+	tests := []struct {
+		name     string
+		in       string
+		wantText string
+		want     time.Time
+		zone     bool
+	}{
+		{
+			"place after time",
+			"Oyun saat 15:00-da (Bakı vaxtı ilə) başlayacaq.",
+			"saat 15:00-da (Bakı vaxtı ilə",
+			time.Date(2026, 2, 20, 15, 0, 0, 0, baku),
+			true,
+		},
+		{
+			"place before time",
+			"Bakı vaxtı ilə saat 15:00-da başlayacaq.",
+			"Bakı vaxtı ilə saat 15:00",
+			time.Date(2026, 2, 20, 15, 0, 0, 0, baku),
+			true,
+		},
+		{
+			"place with datetime",
+			"sabah saat 9 Moskva vaxtı ilə",
+			"sabah saat 9 Moskva vaxtı ilə",
+			time.Date(2026, 2, 21, 9, 0, 0, 0, time.FixedZone("UTC+03:00", 3*60*60)),
+			true,
+		},
+		{
+			"utc offset",
+			"Görüş 5 mart 2026, 18:30 UTC+4 keçiriləcək.",
+			"5 mart 2026, 18:30 UTC+4",
+			time.Date(2026, 3, 5, 18, 30, 0, 0, baku),
+			true,
+		},
+		{
+			"negative gmt offset with minutes",
+			"Yayım 20:00 GMT-05:00 başlayır.",
+			"20:00 GMT-05:00",
+			time.Date(2026, 2, 20, 20, 0, 0, 0, time.FixedZone("UTC-05:00", -5*60*60)),
+			true,
+		},
+		{
+			"half-hour offset",
+			"Tehran vaxtı ilə 10:00",
+			"Tehran vaxtı ilə 10:00",
+			time.Date(2026, 2, 20, 10, 0, 0, 0, time.FixedZone("UTC+03:30", 3*60*60+30*60)),
+			true,
+		},
+		{
+			"bare utc",
+			"12:00 UTC",
+			"12:00 UTC",
+			time.Date(2026, 2, 20, 12, 0, 0, 0, time.UTC),
+			true,
+		},
+		{
+			"hint not adjacent",
+			"14:00 görüş olacaq, Bakı vaxtı ilə",
+			"14:00",
+			time.Date(2026, 2, 20, 14, 0, 0, 0, time.UTC),
+			false,
+		},
+		{
+			"date alone keeps location",
+			"5 mart Bakı vaxtı ilə",
+			"5 mart",
+			time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Extract(tt.in, ref)
+			if len(got) != 1 {
+				t.Fatalf("Extract(%q) = %v, want 1 result", tt.in, got)
+			}
+			r := got[0]
+			if r.Text != tt.wantText {
+				t.Errorf("Text = %q, want %q", r.Text, tt.wantText)
+			}
+			if !r.Time.Equal(tt.want) {
+				t.Errorf("Time = %v, want %v", r.Time, tt.want)
+			}
+			_, gotOff := r.Time.Zone()
+			_, wantOff := tt.want.Zone()
+			if gotOff != wantOff {
+				t.Errorf("offset = %d, want %d", gotOff, wantOff)
+			}
+			if zone := r.Explicit&HasZone != 0; zone != tt.zone {
+				t.Errorf("HasZone = %v, want %v", zone, tt.zone)
+			}
+		})
+	}
+}
+
+func TestExtractWithLocation(t *testing.T) {
+	t.Parallel()
+
+	baku := time.FixedZone("Asia/Baku", 4*60*60)
+	// 22:00 UTC on 20 Feb is already 21 Feb in Baku.
+	lateRef := time.Date(2026, 2, 20, 22, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   string
+		opts Options
+		want time.Time
+	}{
+		{"relative date in location", "sabah", Options{Location: baku}, time.Date(2026, 2, 22, 0, 0, 0, 0, baku)},
+		{"relative date in ref location", "sabah", Options{}, time.Date(2026, 2, 21, 0, 0, 0, 0, time.UTC)},
+		{"time in location", "saat 10", Options{Location: baku}, time.Date(2026, 2, 21, 10, 0, 0, 0, baku)},
+		{"hint overrides location", "10:00 UTC", Options{Location: baku}, time.Date(2026, 2, 21, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := ParseWithOptions(tt.in, lateRef, tt.opts)
+			if err != nil {
+				t.Fatalf("ParseWithOptions(%q): %v", tt.in, err)
+			}
+			if !r.Time.Equal(tt.want) || r.Time.Location() != tt.want.Location() {
+				t.Errorf("Time = %v, want %v", r.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaatClockTime(t *testing.T) {
+	t.Parallel()
+
+	r, err := Parse("saat 14:30:15", ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := time.Date(2026, 2, 20, 14, 30, 15, 0, time.UTC)
+	if r.Text != "saat 14:30:15" || !r.Time.Equal(want) || r.Explicit != HasHour|HasMinute|HasSecond {
+		t.Errorf("Parse = %q %v %s, want %q %v hms", r.Text, r.Time, r.Explicit, "saat 14:30:15", want)
+	}
+}
+
+func ExampleOptions_location() {
+	ref := time.Date(2026, 2, 20, 9, 0, 0, 0, time.UTC)
+	for _, r := range Extract("Oyun saat 19:00-da (Bakı vaxtı ilə) başlayır.", ref) {
+		fmt.Println(r.Text, r.Time.Format(time.RFC3339), r.Time.UTC().Format(time.Kitchen))
+	}
+	// Output:
+	// saat 19:00-da (Bakı vaxtı ilə 2026-02-20T19:00:00+04:00 3:00PM
+}
@@ -177,6 +177,20 @@ var dayOffsets = map[string]int{
 	"srağagün": -2,
 }
 
+// anaphorDays maps determiners of anaphoric day references ("ertəsi gün",
+// "həmin gün") to their day offset from the previously mentioned date.
+var anaphorDays = map[string]int{
+	"ertəsi": 1,
+	"həmin":  0,
+}
+
+// anaphorDayNouns lists the forms of "gün" accepted after an anaphoric
+// determiner ("ertəsi gün", "ertəsi günü").
+var anaphorDayNouns = map[string]bool{
+	"gün":  true,
+	"günü": true,
+}
+
 // periodPrefix maps modifier words to their period offset.
 // -1 = previous, 0 = current, +1 = next.
 var periodPrefix = map[string]int{
@@ -257,6 +271,13 @@ var timeOfDayWords = map[string]timeShift{
 	"gecə":   shiftPM,
 }
 
+// yearNouns lists the forms of "il" accepted after an ordinal year in a
+// full date ("5 mart 2026-cı il", "5 mart 2026-cı ildə").
+var yearNouns = map[string]bool{
+	"il":   true,
+	"ildə": true,
+}
+
 // bridgeWord is the possessive compound connector "ayının"
 // in formal date patterns like "mart ayının 15-i".
 const bridgeWord = "ayının"
@@ -281,4 +302,8 @@ var (
 	// Uses ^/$ instead of \b because Go's \b is ASCII-only and fails on ü/ı suffixes.
 	// This regex is only applied to pre-split words via parseOrdinalWord.
 	reOrdinalDay = regexp.MustCompile(`^(\d{1,2})[-.]?(?:(?:[iıuü])?nc[iıuü]|c[iıuü]|[iıuü])$`)
+
+	// Ordinal suffix on a four-digit year: 2025-ci, 2026-cı.
+	// Group 1: the year.
+	reOrdinalYear = regexp.MustCompile(`^(\d{4})-?(?:(?:[iıuü])?nc[iıuü]|c[iıuü])$`)
 )
@@ -3,14 +3,45 @@
 // Four languages are supported: Azerbaijani (Latin and Cyrillic scripts),
 // Russian, English, and Turkish. Detection uses a hybrid approach: character-set
 // scoring as the primary path with a short trigram fallback for ambiguous cases
-// (Azerbaijani vs Turkish when no schwa ə is present).
+// (Azerbaijani vs Turkish when no schwa ə is present). When the Azerbaijani
+// and Turkish scores remain close, a wordlist layer counts exclusive function
+// words (üçün/için, deyil/değil, və/ve) and suffix shapes (-yor, -ırıq) to
+// break the tie.
 //
 // Two API layers are provided:
 //
-//   - Structured: Detect returns a Result with language, script, and confidence.
-//     DetectAll returns all four languages ranked by confidence.
+//   - Structured: Detect returns a Result with language, script, confidence,
+//     and a length-calibrated probability. DetectAll returns all four
+//     languages ranked by confidence (an n-best list), and Ambiguous
+//     reports when its top two entries are too close to trust.
 //   - Convenience: Lang returns the ISO 639-1 code as a string.
 //
+// DetectShort and DetectAllShort are tuned for messages, titles, and
+// search queries under 50 letters, where the character-set scores rest on
+// a handful of letters: Latin input is scored with character trigram
+// models and the rates of the letters ə, ğ, ş, ç, ı, ö, ü, x, q, and w,
+// trained on embedded Azerbaijani, Turkish, and English text that includes
+// ASCII-typed Azerbaijani and Turkish. They need only 3 letters.
+//
+// Segments splits a mixed-language document into contiguous spans, each
+// with its own language, script, and byte offsets.
+//
+// For Azerbaijani, Result.Register classifies the text as standard, South
+// Azerbaijani (Iranian vocabulary and spelling such as xeyli, daneşgah,
+// yazmağ), or Russified colloquial (koroçe, davay, Cyrillic words in Latin
+// text) from marker words.
+//
+// Latin text typed with Russian romanization habits is reported with
+// Script ScriptLatnTranslit ("Latn-translit"): romanized Russian (privet,
+// kak dela) as Russian, and Azerbaijani written with digraphs for the
+// letters without an ASCII key (chox for çox, yakhshi for yaxşı) as
+// Azerbaijani, so that downstream normalization can respond.
+//
+// Encoding guesses the character encoding of raw bytes (UTF-8, UTF-16,
+// Windows-1251, Windows-1254, ISO-8859-9, and the Azerbaijani variant of
+// Windows-1254 with ə in place of ä), and Decode converts them to UTF-8,
+// so that older corpora reach the rest of the pipeline as clean text.
+//
 // Input longer than 1 MiB is silently truncated (rune-safe). Input with fewer
 // than 10 letter runes returns the zero Result (Lang: Unknown).
 //
@@ -98,6 +129,11 @@ const (
 	ScriptUnknown Script = iota // zero value or not applicable
 	ScriptLatn                  // ISO 15924: Latin
 	ScriptCyrl                  // ISO 15924: Cyrillic
+
+	// ScriptLatnTranslit is Latin text following Russian romanization
+	// habits: romanized Russian, or Azerbaijani with digraphs such as sh
+	// and ch for the letters ş and ç.
+	ScriptLatnTranslit
 )
 
 // scriptNames maps Script values to their ISO 15924 string codes.
@@ -105,6 +141,8 @@ var scriptNames = [...]string{
 	ScriptUnknown: "",
 	ScriptLatn:    "Latn",
 	ScriptCyrl:    "Cyrl",
+
+	ScriptLatnTranslit: "Latn-translit",
 }
 
 // scriptFromName maps ISO 15924 string codes back to Script values.
@@ -112,9 +150,12 @@ var scriptFromName = map[string]Script{
 	"":     ScriptUnknown,
 	"Latn": ScriptLatn,
 	"Cyrl": ScriptCyrl,
+
+	"Latn-translit": ScriptLatnTranslit,
 }
 
-// String returns the ISO 15924 code of the script, or "" for ScriptUnknown.
+// String returns the ISO 15924 code of the script ("Latn-translit" for
+// ScriptLatnTranslit), or "" for ScriptUnknown.
 func (s Script) String() string {
 	if int(s) >= 0 && int(s) < len(scriptNames) {
 		return scriptNames[s]
@@ -146,10 +187,24 @@ func (s *Script) UnmarshalJSON(data []byte) error {
 // Confidence is a sum-normalized score in [0.0, 1.0]. All four language scores
 // are divided by their total, so Confidence reflects the relative strength of
 // the detection within this input, not an absolute probability.
+//
+// Probability is Confidence calibrated for input length: it is shrunk
+// toward a uniform guess over the languages written in the input's script
+// (Azerbaijani and Russian for Cyrillic; Azerbaijani, English, and Turkish
+// for Latin), the more so the fewer letters the input has. A 10-letter
+// input keeps half of its Confidence; a 1000-letter input keeps 99%.
+// Probabilities also sum to 1.0 and rank languages in the same order.
+//
+// Register is set on the Azerbaijani result only (RegisterUnknown for the
+// other languages): it tells standard written Azerbaijani from South
+// Azerbaijani (Iranian) conventions and from colloquial text with heavy
+// Russian borrowing.
 type Result struct {
-	Lang       Language `json:"lang"`
-	Script     Script   `json:"script"`
-	Confidence float64  `json:"confidence"`
+	Lang        Language `json:"lang"`
+	Script      Script   `json:"script"`
+	Confidence  float64  `json:"confidence"`
+	Probability float64  `json:"probability"`
+	Register    Register `json:"register,omitempty"`
 }
 
 const (
@@ -189,6 +244,14 @@ const (
 	// qız, etc.), their absence in a Turkic text is mild evidence for
 	// Turkish over Azerbaijani.
 	noXQTurkishBias = 0.05
+
+	// calibrationLetters is the letter count at which Probability keeps
+	// half of Confidence and takes half from the uniform script prior.
+	calibrationLetters = 10.0
+
+	// ambiguityMargin is the Probability gap between the two best
+	// languages below which Ambiguous reports a detection as unreliable.
+	ambiguityMargin = 0.2
 )
 
 // Detect identifies the most likely language of s.
@@ -215,18 +278,18 @@ func Lang(s string) string {
 // DetectAll returns all four supported languages ranked by descending
 // confidence, or nil when detection is not possible.
 func DetectAll(s string) []Result {
+	return detectAll(s, minLetters, true)
+}
+
+// detectAll implements DetectAll for input with at least minLetters
+// letters. wordlist controls the Azerbaijani/Turkish disambiguation layer;
+// it is only disabled by tests measuring its effect.
+func detectAll(s string, minLetters int, wordlist bool) []Result {
 	if s == "" {
 		return nil
 	}
 
-	// Truncate to maxInputBytes rune-safely.
-	if len(s) > maxInputBytes {
-		pos := maxInputBytes
-		for pos > 0 && !utf8.RuneStart(s[pos]) {
-			pos--
-		}
-		s = s[:pos]
-	}
+	s = truncate(s)
 
 	// Single-pass character classification.
 	//
@@ -287,6 +350,7 @@ func DetectAll(s string) []Result {
 	// normalized to sum to 1.0 before building the Result slice.
 	var azScore, ruScore, enScore, trScore float64
 	var azScript Script
+	ruScript := ScriptCyrl
 
 	if isCyrillicDominant {
 		azScript = ScriptCyrl
@@ -341,6 +405,25 @@ func DetectAll(s string) []Result {
 		}
 
 		ruScore = 0
+
+		// Close Azerbaijani/Turkish scores: consult exclusive function words.
+		if wordlist {
+			azScore, trScore = disambiguateTurkic(s, azScore, trScore)
+		}
+
+		// Romanized Russian and digraph-typed Azerbaijani.
+		ruTranslit, azTranslit, words := translitMarkers(s)
+		switch {
+		case ruTranslit >= minTranslitWords && ruTranslit > azTranslit &&
+			float64(ruTranslit) >= minRuTranslitShare*float64(words):
+			share := float64(ruTranslit) / float64(words)
+			ruScore = share * ruTranslitMultiplier
+			ruScript = ScriptLatnTranslit
+		case azTranslit >= minTranslitWords:
+			azScore += float64(azTranslit) * azTranslitWeight
+			enScore *= englishTurkicDampener
+			azScript = ScriptLatnTranslit
+		}
 	}
 
 	// Normalize scores so they sum to 1.0.
@@ -350,15 +433,77 @@ func DetectAll(s string) []Result {
 	}
 
 	results := []Result{
-		{Lang: Azerbaijani, Script: azScript, Confidence: azScore / total},
-		{Lang: Russian, Script: ScriptCyrl, Confidence: ruScore / total},
+		{Lang: Azerbaijani, Script: azScript, Confidence: azScore / total, Register: registerOf(s)},
+		{Lang: Russian, Script: ruScript, Confidence: ruScore / total},
 		{Lang: English, Script: ScriptLatn, Confidence: enScore / total},
 		{Lang: Turkish, Script: ScriptLatn, Confidence: trScore / total},
 	}
+	calibrate(results, totalLetters, isCyrillicDominant, ruScript == ScriptLatnTranslit)
 
+	sortResults(results)
+
+	return results
+}
+
+// sortResults orders results by descending Probability. Probability
+// orders languages like Confidence, and also ranks a language plausible
+// for the script above one that is not on ties.
+func sortResults(results []Result) {
 	slices.SortStableFunc(results, func(a, b Result) int {
-		return cmp.Compare(b.Confidence, a.Confidence)
+		return cmp.Or(cmp.Compare(b.Probability, a.Probability), cmp.Compare(b.Confidence, a.Confidence))
 	})
+}
 
-	return results
+// calibrate sets the Probability of each result from its Confidence,
+// mixing in a uniform prior over the languages of the input's script with
+// weight calibrationLetters/(letters+calibrationLetters). Romanized
+// Russian makes Russian plausible for Latin input.
+func calibrate(results []Result, letters int, cyrillic, romanized bool) {
+	w := float64(letters) / (float64(letters) + calibrationLetters)
+	plausible := func(l Language) bool {
+		if cyrillic {
+			return l == Azerbaijani || l == Russian
+		}
+		return l != Russian || romanized
+	}
+	prior := 1.0 / 3 //nolint:mnd // Azerbaijani, English, Turkish
+	switch {
+	case cyrillic:
+		prior = 1.0 / 2 //nolint:mnd // Azerbaijani, Russian
+	case romanized:
+		prior = 1.0 / 4 //nolint:mnd // all four languages
+	}
+	for i := range results {
+		results[i].Probability = w * results[i].Confidence
+		if plausible(results[i].Lang) {
+			results[i].Probability += (1 - w) * prior
+		}
+	}
+}
+
+// Ambiguous reports whether a DetectAll ranking is too close to trust:
+// results is empty, or the two most probable languages differ in
+// Probability by less than 0.2. Short texts and Azerbaijani/Turkish texts
+// without distinguishing letters are typical cases; callers can route them
+// to human review instead of acting on the top guess.
+func Ambiguous(results []Result) bool {
+	if len(results) == 0 {
+		return true
+	}
+	if len(results) == 1 {
+		return false
+	}
+	return results[0].Probability-results[1].Probability < ambiguityMargin
+}
+
+// truncate cuts s to at most maxInputBytes, on a rune boundary.
+func truncate(s string) string {
+	if len(s) <= maxInputBytes {
+		return s
+	}
+	pos := maxInputBytes
+	for pos > 0 && !utf8.RuneStart(s[pos]) {
+		pos--
+	}
+	return s[:pos]
 }
@@ -130,6 +130,77 @@ func TestDetectAll(t *testing.T) {
 	})
 }
 
+func TestProbability(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sums to 1.0 in confidence order", func(t *testing.T) {
+		t.Parallel()
+		for _, in := range []string{
+			"Salam, necəsən? Bu gün hava çox gözəldir.",
+			"Привет, как у тебя дела сегодня?",
+			"Hello, how are you doing today?",
+			"Onlar dün akşam gelmedi",
+		} {
+			results := DetectAll(in)
+			var sum float64
+			for i, r := range results {
+				sum += r.Probability
+				if i > 0 && r.Probability > results[i-1].Probability {
+					t.Errorf("%q: results[%d].Probability=%v > results[%d].Probability=%v",
+						in, i, r.Probability, i-1, results[i-1].Probability)
+				}
+			}
+			if math.Abs(sum-1.0) > 1e-9 {
+				t.Errorf("%q: sum of probabilities = %v, want 1.0", in, sum)
+			}
+		}
+	})
+
+	t.Run("grows with input length", func(t *testing.T) {
+		t.Parallel()
+		short := Detect("Bu gün hava çox gözəldir.")
+		long := Detect(strings.Repeat("Bu gün hava çox gözəldir. ", 20))
+		if short.Probability >= long.Probability {
+			t.Errorf("short %v >= long %v", short.Probability, long.Probability)
+		}
+		if long.Probability > long.Confidence {
+			t.Errorf("Probability %v > Confidence %v", long.Probability, long.Confidence)
+		}
+	})
+
+	t.Run("other script gets zero", func(t *testing.T) {
+		t.Parallel()
+		for _, r := range DetectAll("Привет, как у тебя дела сегодня?") {
+			if (r.Lang == English || r.Lang == Turkish) && r.Probability != 0 {
+				t.Errorf("%v Probability = %v, want 0 for Cyrillic input", r.Lang, r.Probability)
+			}
+		}
+	})
+}
+
+func TestAmbiguous(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		in   string
+		want bool
+	}{
+		{"schwa", "Salam, necəsən? Bu gün hava çox gözəldir.", false},
+		{"english", "Hello, how are you doing today?", false},
+		{"cyrillic without distinguishing letters", "Привет, как дела?", true},
+		{"azerbaijani or turkish", "Onlar dün akşam gelmedi", true},
+		{"undetectable", "ok", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Ambiguous(DetectAll(tt.in)); got != tt.want {
+				t.Errorf("Ambiguous(DetectAll(%q)) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestLang(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -222,7 +293,7 @@ func TestLanguageJSON(t *testing.T) {
 
 func TestScriptJSON(t *testing.T) {
 	t.Parallel()
-	scripts := []Script{ScriptUnknown, ScriptLatn, ScriptCyrl}
+	scripts := []Script{ScriptUnknown, ScriptLatn, ScriptCyrl, ScriptLatnTranslit}
 
 	for _, sc := range scripts {
 		name := sc.String()
@@ -298,6 +369,7 @@ func TestScriptString(t *testing.T) {
 		{ScriptUnknown, ""},
 		{ScriptLatn, "Latn"},
 		{ScriptCyrl, "Cyrl"},
+		{ScriptLatnTranslit, "Latn-translit"},
 		{Script(99), "Script(99)"},
 	}
 
@@ -381,6 +453,16 @@ func ExampleDetectAll() {
 	// Russian
 }
 
+func ExampleAmbiguous() {
+	results := DetectAll("Onlar dün akşam gelmedi")
+	if Ambiguous(results) {
+		fmt.Printf("review: %s %.2f, %s %.2f\n",
+			results[0].Lang, results[0].Probability, results[1].Lang, results[1].Probability)
+	}
+	// Output:
+	// review: Turkish 0.45, Azerbaijani 0.40
+}
+
 func ExampleLanguage_String() {
 	fmt.Println(Azerbaijani)
 	fmt.Println(Russian)
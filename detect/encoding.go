@@ -0,0 +1,350 @@
+// Encoding detection for byte input that is not UTF-8: older Azerbaijani
+// corpora are often in Windows-1251 (Cyrillic), Windows-1254 or
+// ISO-8859-9 (Latin), or UTF-16.
+package detect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Charset identifies the character encoding of byte input.
+type Charset int
+
+const (
+	UTF8        Charset = iota // zero value: UTF-8, with or without a byte order mark
+	UTF16LE                    // UTF-16, little-endian
+	UTF16BE                    // UTF-16, big-endian
+	Windows1251                // Windows-1251 (Cyrillic)
+	Windows1254                // Windows-1254 (Turkish Latin)
+	ISO88599                   // ISO-8859-9 (Latin-5), Windows-1254 without 0x80-0x9F
+
+	// Windows1254Az is Windows-1254 with ə and Ə at the positions of ä and
+	// Ä (0xE4, 0xC4), the layout of the Azerbaijani Latin fonts of the
+	// 1990s, which typed the schwa over the unused a-umlaut.
+	Windows1254Az
+)
+
+// charsetNames maps Charset values to their names, as registered with IANA
+// where one exists.
+var charsetNames = [...]string{
+	UTF8:          "UTF-8",
+	UTF16LE:       "UTF-16LE",
+	UTF16BE:       "UTF-16BE",
+	Windows1251:   "windows-1251",
+	Windows1254:   "windows-1254",
+	ISO88599:      "ISO-8859-9",
+	Windows1254Az: "windows-1254-az",
+}
+
+// charsetFromName maps names back to Charset values.
+var charsetFromName = map[string]Charset{
+	"UTF-8":           UTF8,
+	"UTF-16LE":        UTF16LE,
+	"UTF-16BE":        UTF16BE,
+	"windows-1251":    Windows1251,
+	"windows-1254":    Windows1254,
+	"ISO-8859-9":      ISO88599,
+	"windows-1254-az": Windows1254Az,
+}
+
+// String returns the name of the charset (e.g. "windows-1251").
+func (c Charset) String() string {
+	if int(c) >= 0 && int(c) < len(charsetNames) {
+		return charsetNames[c]
+	}
+	return fmt.Sprintf("Charset(%d)", int(c))
+}
+
+// MarshalJSON encodes the charset as a JSON string (e.g. "windows-1251").
+func (c Charset) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "windows-1251") into a Charset.
+func (c *Charset) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	v, ok := charsetFromName[str]
+	if !ok {
+		return fmt.Errorf("detect: unknown charset: %q", str)
+	}
+	*c = v
+	return nil
+}
+
+// Byte order marks.
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+)
+
+// utf16HighShare is the share of the bytes at one parity that must be
+// high bytes of the Latin, Latin Extended, or Cyrillic blocks
+// (0x00-0x04) for input without a byte order mark to be UTF-16; at most
+// utf16LowShare of the bytes at the other parity may be.
+const (
+	utf16HighShare = 0.9
+	utf16LowShare  = 0.5
+	maxUTF16High   = 0x04
+)
+
+// cp1251 maps the bytes 0x80-0xFF of Windows-1251 to runes; U+FFFD marks
+// the undefined 0x98.
+var cp1251 = [128]rune{
+	0x0402, 0x0403, 0x201A, 0x0453, 0x201E, 0x2026, 0x2020, 0x2021,
+	0x20AC, 0x2030, 0x0409, 0x2039, 0x040A, 0x040C, 0x040B, 0x040F,
+	0x0452, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+	0xFFFD, 0x2122, 0x0459, 0x203A, 0x045A, 0x045C, 0x045B, 0x045F,
+	0x00A0, 0x040E, 0x045E, 0x0408, 0x00A4, 0x0490, 0x00A6, 0x00A7,
+	0x0401, 0x00A9, 0x0404, 0x00AB, 0x00AC, 0x00AD, 0x00AE, 0x0407,
+	0x00B0, 0x00B1, 0x0406, 0x0456, 0x0491, 0x00B5, 0x00B6, 0x00B7,
+	0x0451, 0x2116, 0x0454, 0x00BB, 0x0458, 0x0405, 0x0455, 0x0457,
+	0x0410, 0x0411, 0x0412, 0x0413, 0x0414, 0x0415, 0x0416, 0x0417,
+	0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E, 0x041F,
+	0x0420, 0x0421, 0x0422, 0x0423, 0x0424, 0x0425, 0x0426, 0x0427,
+	0x0428, 0x0429, 0x042A, 0x042B, 0x042C, 0x042D, 0x042E, 0x042F,
+	0x0430, 0x0431, 0x0432, 0x0433, 0x0434, 0x0435, 0x0436, 0x0437,
+	0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E, 0x043F,
+	0x0440, 0x0441, 0x0442, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447,
+	0x0448, 0x0449, 0x044A, 0x044B, 0x044C, 0x044D, 0x044E, 0x044F,
+}
+
+// cp1254 maps the bytes 0x80-0xFF of Windows-1254 to runes; U+FFFD marks
+// the undefined bytes. ISO-8859-9 shares 0xA0-0xFF and has the C1
+// controls at 0x80-0x9F.
+var cp1254 = [128]rune{
+	0x20AC, 0xFFFD, 0x201A, 0x0192, 0x201E, 0x2026, 0x2020, 0x2021,
+	0x02C6, 0x2030, 0x0160, 0x2039, 0x0152, 0xFFFD, 0xFFFD, 0xFFFD,
+	0xFFFD, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+	0x02DC, 0x2122, 0x0161, 0x203A, 0x0153, 0xFFFD, 0xFFFD, 0x0178,
+	0x00A0, 0x00A1, 0x00A2, 0x00A3, 0x00A4, 0x00A5, 0x00A6, 0x00A7,
+	0x00A8, 0x00A9, 0x00AA, 0x00AB, 0x00AC, 0x00AD, 0x00AE, 0x00AF,
+	0x00B0, 0x00B1, 0x00B2, 0x00B3, 0x00B4, 0x00B5, 0x00B6, 0x00B7,
+	0x00B8, 0x00B9, 0x00BA, 0x00BB, 0x00BC, 0x00BD, 0x00BE, 0x00BF,
+	0x00C0, 0x00C1, 0x00C2, 0x00C3, 0x00C4, 0x00C5, 0x00C6, 0x00C7,
+	0x00C8, 0x00C9, 0x00CA, 0x00CB, 0x00CC, 0x00CD, 0x00CE, 0x00CF,
+	0x011E, 0x00D1, 0x00D2, 0x00D3, 0x00D4, 0x00D5, 0x00D6, 0x00D7,
+	0x00D8, 0x00D9, 0x00DA, 0x00DB, 0x00DC, 0x0130, 0x015E, 0x00DF,
+	0x00E0, 0x00E1, 0x00E2, 0x00E3, 0x00E4, 0x00E5, 0x00E6, 0x00E7,
+	0x00E8, 0x00E9, 0x00EA, 0x00EB, 0x00EC, 0x00ED, 0x00EE, 0x00EF,
+	0x011F, 0x00F1, 0x00F2, 0x00F3, 0x00F4, 0x00F5, 0x00F6, 0x00F7,
+	0x00F8, 0x00F9, 0x00FA, 0x00FB, 0x00FC, 0x0131, 0x015F, 0x00FF,
+}
+
+// Azerbaijani Latin letters typed at the positions of ä and Ä in
+// Windows1254Az, and the bytes of the letters shared with Turkish that
+// mark such text as Azerbaijani or Turkish rather than German or Finnish.
+const (
+	azSchwaLower = 0xE4
+	azSchwaUpper = 0xC4
+	c1Lo, c1Hi   = 0x80, 0x9F
+)
+
+var turkicBytes = [...]byte{0xD0, 0xDD, 0xDE, 0xF0, 0xFD, 0xFE} // Ğ İ Ş ğ ı ş
+
+// Encoding returns the most likely character encoding of b: UTF-8 or
+// UTF-16 by their byte order mark; UTF-16 without a mark when the bytes
+// at one parity are almost all 0x00-0x04; UTF-8 when b is valid UTF-8;
+// and otherwise a single-byte codepage. Between those, Windows-1251 is
+// chosen when the words with non-ASCII bytes are mostly made of them
+// (Cyrillic words), and a Latin codepage when they mix them with ASCII
+// letters: Windows1254Az when ä stands in for ə next to the Turkic
+// letters ğ, ı, ş, Windows-1254 when b has bytes in 0x80-0x9F, and
+// ISO-8859-9 otherwise.
+//
+// Only the first 1 MiB of b is examined. Empty input is UTF8.
+//
+// Azerbaijani Cyrillic letters missing from Windows-1251 (ә, ғ, ҹ, һ, ө,
+// ү, ҝ) were typed with font-specific substitutions that are not
+// recognized.
+func Encoding(b []byte) Charset {
+	if len(b) > maxInputBytes {
+		b = b[:maxInputBytes]
+	}
+	switch {
+	case bytes.HasPrefix(b, bomUTF8):
+		return UTF8
+	case bytes.HasPrefix(b, bomUTF16LE):
+		return UTF16LE
+	case bytes.HasPrefix(b, bomUTF16BE):
+		return UTF16BE
+	}
+	if cs, ok := utf16WithoutBOM(b); ok {
+		return cs
+	}
+	if utf8.Valid(trimPartialRune(b)) {
+		return UTF8
+	}
+	return singleByte(b)
+}
+
+// Decode converts b to UTF-8 from the charset returned by Encoding and
+// reports that charset.
+func Decode(b []byte) (string, Charset) {
+	cs := Encoding(b)
+	return cs.Decode(b), cs
+}
+
+// Decode converts b from c to UTF-8, dropping a byte order mark. Invalid
+// and undefined sequences become U+FFFD. A Charset outside the defined
+// values decodes as UTF8.
+func (c Charset) Decode(b []byte) string {
+	switch c {
+	case UTF16LE, UTF16BE:
+		return decodeUTF16(b, c == UTF16BE)
+	case Windows1251, Windows1254, ISO88599, Windows1254Az:
+		return decodeSingleByte(b, c)
+	default:
+		return strings.ToValidUTF8(string(bytes.TrimPrefix(b, bomUTF8)), "\uFFFD")
+	}
+}
+
+// utf16WithoutBOM reports whether b looks like UTF-16 without a byte order
+// mark, and its byte order.
+func utf16WithoutBOM(b []byte) (Charset, bool) {
+	pairs := len(b) / 2
+	if pairs < 2 {
+		return 0, false
+	}
+	var even, odd int
+	for i := 0; i+1 < len(b); i += 2 {
+		if b[i] <= maxUTF16High {
+			even++
+		}
+		if b[i+1] <= maxUTF16High {
+			odd++
+		}
+	}
+	n := float64(pairs)
+	switch {
+	case float64(odd) >= utf16HighShare*n && float64(even) <= utf16LowShare*n:
+		return UTF16LE, true
+	case float64(even) >= utf16HighShare*n && float64(odd) <= utf16LowShare*n:
+		return UTF16BE, true
+	}
+	return 0, false
+}
+
+// trimPartialRune drops an incomplete UTF-8 sequence at the end of b, left
+// by cutting b at maxInputBytes.
+func trimPartialRune(b []byte) []byte {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		if c := b[len(b)-i]; c < utf8.RuneSelf {
+			return b
+		} else if utf8.RuneStart(c) {
+			if !utf8.FullRune(b[len(b)-i:]) {
+				return b[:len(b)-i]
+			}
+			return b
+		}
+	}
+	return b
+}
+
+// singleByte chooses among the single-byte codepages by the words that
+// hold non-ASCII bytes.
+func singleByte(b []byte) Charset {
+	var cyrillic, latin, c1, schwa, turkic int
+	word := func(start, end int) {
+		ascii, high := 0, 0
+		for _, c := range b[start:end] {
+			if c >= utf8.RuneSelf {
+				high++
+			} else {
+				ascii++
+			}
+		}
+		switch {
+		case high == 0:
+		case ascii == 0:
+			cyrillic++
+		default:
+			latin++
+		}
+	}
+	start := 0
+	for i, c := range b {
+		switch {
+		case c >= c1Lo && c <= c1Hi:
+			c1++
+		case c == azSchwaLower || c == azSchwaUpper:
+			schwa++
+		case bytes.IndexByte(turkicBytes[:], c) >= 0:
+			turkic++
+		}
+		if c < utf8.RuneSelf && !isASCIILetter(c) {
+			word(start, i)
+			start = i + 1
+		}
+	}
+	word(start, len(b))
+
+	switch {
+	case cyrillic > latin:
+		return Windows1251
+	case schwa > 0 && turkic > 0:
+		return Windows1254Az
+	case c1 > 0:
+		return Windows1254
+	default:
+		return ISO88599
+	}
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// decodeSingleByte decodes b from the single-byte codepage c.
+func decodeSingleByte(b []byte, c Charset) string {
+	var sb strings.Builder
+	sb.Grow(len(b) + len(b)/2)
+	for _, x := range b {
+		switch {
+		case x < utf8.RuneSelf:
+			sb.WriteByte(x)
+		case c == Windows1251:
+			sb.WriteRune(cp1251[x-utf8.RuneSelf])
+		case c == ISO88599 && x <= c1Hi:
+			sb.WriteRune(rune(x))
+		case c == Windows1254Az && x == azSchwaLower:
+			sb.WriteRune('ə')
+		case c == Windows1254Az && x == azSchwaUpper:
+			sb.WriteRune('Ə')
+		default:
+			sb.WriteRune(cp1254[x-utf8.RuneSelf])
+		}
+	}
+	return sb.String()
+}
+
+// decodeUTF16 decodes b as UTF-16 in the given byte order, dropping a
+// byte order mark. A trailing odd byte becomes U+FFFD.
+func decodeUTF16(b []byte, bigEndian bool) string {
+	bom := bomUTF16LE
+	if bigEndian {
+		bom = bomUTF16BE
+	}
+	b = bytes.TrimPrefix(b, bom)
+	units := make([]uint16, len(b)/2)
+	for i := range units {
+		if bigEndian {
+			units[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+		} else {
+			units[i] = uint16(b[2*i+1])<<8 | uint16(b[2*i])
+		}
+	}
+	s := string(utf16.Decode(units))
+	if len(b)%2 != 0 {
+		s += string(utf8.RuneError)
+	}
+	return s
+}
@@ -0,0 +1,153 @@
+package detect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+// encodeSingleByte encodes s in the codepage of table, for fixtures.
+func encodeSingleByte(t *testing.T, s string, table *[128]rune) []byte {
+	t.Helper()
+	var b []byte
+	for _, r := range s {
+		if r < 0x80 {
+			b = append(b, byte(r))
+			continue
+		}
+		i := slices.Index(table[:], r)
+		if i < 0 {
+			t.Fatalf("%q has no byte in the codepage", r)
+		}
+		b = append(b, byte(0x80+i))
+	}
+	return b
+}
+
+// encodeUTF16 encodes s as UTF-16 in the given byte order, with bom
+// prepended.
+func encodeUTF16(s string, bigEndian bool, bom []byte) []byte {
+	b := bytes.Clone(bom)
+	for _, u := range utf16.Encode([]rune(s)) {
+		if bigEndian {
+			b = append(b, byte(u>>8), byte(u))
+		} else {
+			b = append(b, byte(u), byte(u>>8))
+		}
+	}
+	return b
+}
+
+const (
+	textAz = "Azərbaycan dili türk dilləri ailəsinə aiddir. Şəhər çox gözəldir, ağacları yaşıldır."
+	textTr = "Türkiye'nin başkenti Ankara'dır. Şehir çok güzel, ağaçlar yeşil."
+	textRu = "Москва — столица России. Город очень красивый, ёлки зелёные."
+)
+
+func TestEncoding(t *testing.T) {
+	t.Parallel()
+	azLegacy := strings.NewReplacer("ə", "ä", "Ə", "Ä").Replace(textAz)
+	tests := []struct {
+		name string
+		in   []byte
+		want Charset
+		text string
+	}{
+		{"utf-8", []byte(textAz), UTF8, textAz},
+		{"utf-8 bom", append(bytes.Clone(bomUTF8), textAz...), UTF8, textAz},
+		{"utf-8 cut inside a rune", []byte(textAz)[:3], UTF8, "Az�"},
+		{"utf-16le bom", encodeUTF16(textAz, false, bomUTF16LE), UTF16LE, textAz},
+		{"utf-16be bom", encodeUTF16(textRu, true, bomUTF16BE), UTF16BE, textRu},
+		{"utf-16le", encodeUTF16(textAz, false, nil), UTF16LE, textAz},
+		{"utf-16be cyrillic", encodeUTF16(textRu, true, nil), UTF16BE, textRu},
+		{"windows-1251", encodeSingleByte(t, textRu, &cp1251), Windows1251, textRu},
+		{"iso-8859-9", encodeSingleByte(t, textTr, &cp1254), ISO88599, textTr},
+		{"windows-1254 quotes", encodeSingleByte(t, "“"+textTr+"”", &cp1254), Windows1254, "“" + textTr + "”"},
+		{"windows-1254 azerbaijani", encodeSingleByte(t, azLegacy, &cp1254), Windows1254Az, textAz},
+		{"empty", nil, UTF8, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, cs := Decode(tt.in)
+			if cs != tt.want || got != tt.text {
+				t.Errorf("Decode = %v %q, want %v %q", cs, got, tt.want, tt.text)
+			}
+			if cs := Encoding(tt.in); cs != tt.want {
+				t.Errorf("Encoding = %v, want %v", cs, tt.want)
+			}
+		})
+	}
+}
+
+func TestCharsetDecode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		cs   Charset
+		in   []byte
+		want string
+	}{
+		{Windows1251, []byte("\xcc\xee\xf1\xea\xe2\xe0 \x98"), "Москва �"},
+		{Windows1254, []byte("\xfd\xf0\xfe\xdd\x80"), "ığşİ€"},
+		{ISO88599, []byte("\xe4\x80"), "ä\u0080"},
+		{Windows1254Az, []byte("\xe4\xc4"), "əƏ"},
+		{UTF16LE, []byte("a\x00b"), "a�"},
+		{UTF8, []byte("a\xffb"), "a�b"},
+		{Charset(42), []byte("ab"), "ab"},
+	}
+	for _, tt := range tests {
+		if got := tt.cs.Decode(tt.in); got != tt.want {
+			t.Errorf("%v.Decode(%q) = %q, want %q", tt.cs, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEncodingDetectsDecodedText(t *testing.T) {
+	t.Parallel()
+	text, _ := Decode(encodeSingleByte(t, textRu, &cp1251))
+	if r := Detect(text); r.Lang != Russian || r.Script != ScriptCyrl {
+		t.Errorf("Detect(%q) = %v", text, r)
+	}
+}
+
+func TestCharsetJSON(t *testing.T) {
+	t.Parallel()
+	for c := UTF8; c <= Windows1254Az; c++ {
+		data, err := json.Marshal(c)
+		if err != nil {
+			t.Fatalf("MarshalJSON: %v", err)
+		}
+		var got Charset
+		if err := json.Unmarshal(data, &got); err != nil || got != c {
+			t.Errorf("round trip of %v = %v, %v", c, got, err)
+		}
+	}
+	var c Charset
+	if err := json.Unmarshal([]byte(`"koi8-r"`), &c); err == nil {
+		t.Error("expected error for unknown charset")
+	}
+	if got := Charset(42).String(); got != "Charset(42)" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	in := []byte(strings.Repeat("\xcc\xee\xf1\xea\xe2\xe0 \xf1\xf2\xee\xeb\xe8\xf6\xe0. ", 100))
+	for b.Loop() {
+		Decode(in)
+	}
+}
+
+func ExampleDecode() {
+	text, cs := Decode([]byte("Az\xe4rbaycan dili \xe7ox g\xf6z\xe4ldir, a\xf0ac\xfd."))
+	fmt.Println(cs, text)
+	text, cs = Decode([]byte("\xcc\xee\xf1\xea\xe2\xe0"))
+	fmt.Println(cs, text)
+	// Output:
+	// windows-1254-az Azərbaycan dili çox gözəldir, ağacı.
+	// windows-1251 Москва
+}
@@ -2,6 +2,7 @@ package detect
 
 import (
 	"strings"
+	"unicode/utf8"
 	"sync"
 	"testing"
 	"time"
@@ -144,3 +145,24 @@ func TestReDoSResistance(t *testing.T) {
 		t.Errorf("detection took %v on adversarial input, want < 2s", elapsed)
 	}
 }
+
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte("Azərbaycan dili"))
+	f.Add([]byte("\xcc\xee\xf1\xea\xe2\xe0"))
+	f.Add([]byte("Az\xe4rbaycan \xe7ox g\xf6z\xe4l, a\xf0ac\xfd"))
+	f.Add([]byte("\xff\xfea\x00b\x00"))
+	f.Add([]byte("\xfe\xff\x04\x10"))
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		// Decode must never panic and must return valid UTF-8.
+		s, cs := Decode(b)
+		if !utf8.ValidString(s) {
+			t.Errorf("Decode(%q) = %q as %v, not valid UTF-8", b, s, cs)
+		}
+		// Valid UTF-8 passes through unchanged, without its BOM.
+		if cs == UTF8 && utf8.Valid(b) && s != strings.TrimPrefix(string(b), "\uFEFF") {
+			t.Errorf("Decode(%q) = %q, want the input", b, s)
+		}
+	})
+}
@@ -0,0 +1,169 @@
+package detect
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Register classifies the variety and register of an Azerbaijani text.
+type Register int
+
+const (
+	RegisterUnknown   Register = iota // zero value: not Azerbaijani
+	RegisterStandard                  // standard written (North) Azerbaijani
+	RegisterSouthern                  // South Azerbaijani (Iranian) vocabulary and spelling
+	RegisterRussified                 // colloquial text with heavy Russian borrowing
+)
+
+// registerNames maps Register values to their string names.
+var registerNames = [...]string{
+	RegisterUnknown:   "Unknown",
+	RegisterStandard:  "Standard",
+	RegisterSouthern:  "Southern",
+	RegisterRussified: "Russified",
+}
+
+// registerFromName maps string names back to Register values.
+var registerFromName = map[string]Register{
+	"Unknown":   RegisterUnknown,
+	"Standard":  RegisterStandard,
+	"Southern":  RegisterSouthern,
+	"Russified": RegisterRussified,
+}
+
+// String returns the name of the register.
+func (r Register) String() string {
+	if int(r) >= 0 && int(r) < len(registerNames) {
+		return registerNames[r]
+	}
+	return fmt.Sprintf("Register(%d)", int(r))
+}
+
+// MarshalJSON encodes the register as a JSON string (e.g. "Standard").
+func (r Register) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Standard") into a Register.
+func (r *Register) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	reg, ok := registerFromName[s]
+	if !ok {
+		return fmt.Errorf("detect: unknown register: %q", s)
+	}
+	*r = reg
+	return nil
+}
+
+// Register classification layer.
+//
+// Both non-standard varieties are recognized by marker words: Persian
+// loanwords and spellings that South Azerbaijani uses where the standard
+// language has a Turkic or Russian-era word, and Russian discourse words
+// written in Latin letters (or left in Cyrillic) in colloquial Baku text.
+// A text is classified as non-standard when its markers reach both a
+// minimum count and a minimum share of its words.
+// The markers are Latin-script, so Cyrillic-script Azerbaijani is always
+// classified as standard.
+
+const (
+	// minRegisterMarkers is the minimum number of markers for a
+	// non-standard register.
+	minRegisterMarkers = 2
+
+	// minRegisterShare is the minimum share of words that must be markers
+	// for a non-standard register.
+	minRegisterShare = 0.05
+)
+
+// southernMarkers are South Azerbaijani words with a different standard
+// counterpart: Persian loans (xeyli/çox, vəli/amma, hətmən/mütləq) and
+// Iranian institutions (daneşgah/universitet, bimarestan/xəstəxana).
+var southernMarkers = map[string]bool{
+	"xeyli": true, "vəli": true, "hətmən": true, "dəqiqən": true, "təqriba": true,
+	"baləxərə": true, "bələxərə": true, "xub": true, "xanəvadə": true, "amuzeş": true,
+	"daneşgah": true, "danişgah": true, "bimarestan": true, "ruzname": true,
+	"ostan": true, "ostandar": true, "şəhrdari": true,
+}
+
+// southernSuffix is the South Azerbaijani spelling of the back-vowel
+// infinitive, with -q written as -ğ (yazmağ, olmağ).
+const southernSuffix = "mağ"
+
+// russianMarkers are Russian discourse words and loans common in
+// colloquial Azerbaijani, in Latin spelling (koroçe, voobşe, davay).
+var russianMarkers = map[string]bool{
+	"koroçe": true, "voobşe": true, "davay": true, "normalno": true, "prosto": true,
+	"koneçno": true, "tipa": true, "blin": true, "spasibo": true, "privet": true,
+	"poka": true, "kstati": true, "ladno": true, "nado": true, "mojet": true,
+	"uje": true, "toje": true, "daje": true, "vsyo": true, "xoroşo": true,
+	"ponyal": true, "ponyatno": true, "siças": true, "seyças": true, "zna4it": true,
+	"znaçit": true, "vabşe": true, "qorod": true, "rabota": true, "mojno": true,
+}
+
+// registerOf classifies the Azerbaijani text s.
+func registerOf(s string) Register {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '-'
+	})
+	var south, russian, latin, cyrillic int
+	n := 0
+	for _, w := range words {
+		if w = strings.Trim(w, "-"); w == "" {
+			continue
+		}
+		n++
+		if isCyrillicWord(w) {
+			cyrillic++
+		} else {
+			latin++
+		}
+		w = azcase.ToLower(w)
+		switch {
+		case southernMarkers[w]:
+			south++
+		case russianMarkers[w]:
+			russian++
+		case hasSouthernSuffix(w):
+			south++
+		}
+	}
+	// Russian words left in Cyrillic inside Latin Azerbaijani text.
+	if latin >= cyrillic {
+		russian += cyrillic
+	}
+
+	switch {
+	case russian >= minRegisterMarkers && float64(russian) >= minRegisterShare*float64(n) && russian >= south:
+		return RegisterRussified
+	case south >= minRegisterMarkers && float64(south) >= minRegisterShare*float64(n):
+		return RegisterSouthern
+	}
+	return RegisterStandard
+}
+
+// hasSouthernSuffix reports whether w is an infinitive in the southern
+// spelling. Stems shorter than two letters are skipped.
+func hasSouthernSuffix(w string) bool {
+	stem, ok := strings.CutSuffix(w, southernSuffix)
+	return ok && utf8.RuneCountInString(stem) >= 2
+}
+
+// isCyrillicWord reports whether every letter of w is Cyrillic. Words
+// with a single look-alike letter (Bаkı) are Latin.
+func isCyrillicWord(w string) bool {
+	for _, r := range w {
+		if unicode.IsLetter(r) && !isCyrillic(r) {
+			return false
+		}
+	}
+	return true
+}
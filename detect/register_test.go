@@ -0,0 +1,89 @@
+package detect
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		input string
+		want  Register
+	}{
+		{"standard", "Azərbaycan Respublikası Cənubi Qafqazda yerləşən dövlətdir.", RegisterStandard},
+		{"single southern word", "Bu film xeyli maraqlıdır, hamıya tövsiyə edirəm.", RegisterStandard},
+		{"southern vocabulary", "Bu gün xeyli yorulmuşam, vəli daneşgaha getməliyəm.", RegisterSouthern},
+		{"southern infinitive", "Mən kitab oxumağ istəyirəm, həm də yazmağ.", RegisterSouthern},
+		{"russian discourse words", "Koroçe, sabah işə gedəcəm, davay görüşək.", RegisterRussified},
+		{"cyrillic words in latin text", "Salam, как дела? Mən yaxşıyam, spasibo, а ты?", RegisterRussified},
+		{"homoglyph is not cyrillic word", "Bаkı çox gözəl şəhərdir, hamı bunu bilir.", RegisterStandard},
+		{"cyrillic azerbaijani", "Мән китаб охуйурам вә мәктәбә кедирәм.", RegisterStandard},
+		{"not azerbaijani", "The weather is nice today in London.", RegisterUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Detect(tt.input).Register; got != tt.want {
+				t.Errorf("Detect(%q).Register = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterOnlyOnAzerbaijani(t *testing.T) {
+	t.Parallel()
+	for _, r := range DetectAll("Koroçe, sabah işə gedəcəm, davay görüşək.") {
+		if want := RegisterUnknown; r.Lang != Azerbaijani && r.Register != want {
+			t.Errorf("%v: Register = %v, want %v", r.Lang, r.Register, want)
+		}
+	}
+}
+
+func TestRegisterJSON(t *testing.T) {
+	t.Parallel()
+	for _, reg := range []Register{RegisterUnknown, RegisterStandard, RegisterSouthern, RegisterRussified} {
+		data, err := json.Marshal(reg)
+		if err != nil {
+			t.Fatalf("MarshalJSON: %v", err)
+		}
+		var decoded Register
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+		}
+		if decoded != reg {
+			t.Errorf("round-trip: got %v, want %v", decoded, reg)
+		}
+	}
+	var r Register
+	if err := r.UnmarshalJSON([]byte(`"Klingon"`)); err == nil {
+		t.Error("want error for unknown register, got nil")
+	}
+	if got := Register(99).String(); got != "Register(99)" {
+		t.Errorf("String() = %q, want %q", got, "Register(99)")
+	}
+
+	// Non-Azerbaijani results omit the field.
+	data, _ := json.Marshal(Detect("The weather is nice today in London."))
+	var m map[string]any
+	_ = json.Unmarshal(data, &m)
+	if _, ok := m["register"]; ok {
+		t.Errorf("English result JSON has register: %s", data)
+	}
+}
+
+func ExampleResult_register() {
+	for _, s := range []string{
+		"Bu gün hava çox gözəldir, parka gedəcəyik.",
+		"Bu gün xeyli yorulmuşam, vəli daneşgaha getməliyəm.",
+		"Koroçe, sabah işə gedəcəm, davay görüşək.",
+	} {
+		fmt.Println(Detect(s).Register)
+	}
+	// Output:
+	// Standard
+	// Southern
+	// Russified
+}
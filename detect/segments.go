@@ -0,0 +1,132 @@
+package detect
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// Segment is a contiguous span of text in a single language.
+type Segment struct {
+	Result        // language, script, and confidence of the span
+	Text   string `json:"text"`  // span text, including surrounding whitespace
+	Start  int    `json:"start"` // byte offset in the original text (inclusive)
+	End    int    `json:"end"`   // byte offset in the original text (exclusive)
+}
+
+// segUnit is a detection unit (a sentence or a line) with its result.
+type segUnit struct {
+	start, end int
+	res        Result
+	letters    int
+}
+
+// Segments splits text into contiguous spans by language, for documents
+// that mix languages (e.g. Russian and Azerbaijani paragraphs in web text).
+//
+// Text is divided into sentences (tokenizer.SentenceTokens) and sentences
+// into lines; each unit is detected separately and adjacent units with the
+// same language and script are merged. Units too short to detect on their
+// own (fewer than 10 letters: headings, numbers, "OK") join the preceding
+// segment, or the following one at the start of the text. A segment's
+// Confidence and Probability are the letter-weighted means of its units'.
+//
+// Segments cover text without gaps or overlaps: concatenating their Text
+// reconstructs the input, and text[s.Start:s.End] == s.Text holds for every
+// segment. When no unit is detectable, a single segment with the zero
+// Result is returned. Returns nil for empty input. Input longer than 1 MiB
+// is truncated (rune-safe).
+func Segments(text string) []Segment {
+	if text == "" {
+		return nil
+	}
+	text = truncate(text)
+
+	units := segmentUnits(text)
+	var segs []Segment
+	var weight []int // letters of detected units per segment, for averaging
+
+	for _, u := range units {
+		if u.res.Lang == Unknown {
+			if len(segs) > 0 {
+				segs[len(segs)-1].End = u.end
+			}
+			continue
+		}
+		if n := len(segs); n > 0 && sameLanguage(segs[n-1].Result, u.res) {
+			last := &segs[n-1]
+			total := weight[n-1] + u.letters
+			last.Confidence = (last.Confidence*float64(weight[n-1]) + u.res.Confidence*float64(u.letters)) / float64(total)
+			last.Probability = (last.Probability*float64(weight[n-1]) + u.res.Probability*float64(u.letters)) / float64(total)
+			weight[n-1] = total
+			last.End = u.end
+			continue
+		}
+		start := u.start
+		if len(segs) == 0 {
+			start = 0 // leading undetected units join the first segment
+		}
+		segs = append(segs, Segment{Result: u.res, Start: start, End: u.end})
+		weight = append(weight, u.letters)
+	}
+
+	if len(segs) == 0 {
+		return []Segment{{Text: text, Start: 0, End: len(text)}}
+	}
+	for i := range segs {
+		segs[i].Text = text[segs[i].Start:segs[i].End]
+	}
+	return segs
+}
+
+// segmentUnits splits text into sentences, then sentences into lines, and
+// detects each. Units are contiguous and cover text.
+func segmentUnits(text string) []segUnit {
+	var units []segUnit
+	add := func(start, end int) {
+		part := text[start:end]
+		u := segUnit{start: start, end: end, letters: countLetters(part)}
+		if u.letters >= minLetters {
+			u.res = Detect(part)
+		}
+		units = append(units, u)
+	}
+	for _, sent := range tokenizer.SentenceTokens(text) {
+		start := sent.Start
+		for {
+			nl := strings.IndexByte(text[start:sent.End], '\n')
+			if nl < 0 {
+				break
+			}
+			// Keep the newline (and any run of them) with the line it ends.
+			end := start + nl + 1
+			for end < sent.End && text[end] == '\n' {
+				end++
+			}
+			if end == sent.End {
+				break
+			}
+			add(start, end)
+			start = end
+		}
+		add(start, sent.End)
+	}
+	return units
+}
+
+// sameLanguage reports whether two results name the same language and script.
+func sameLanguage(a, b Result) bool {
+	return a.Lang == b.Lang && a.Script == b.Script
+}
+
+// countLetters returns the number of letter runes in s.
+func countLetters(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			n++
+		}
+	}
+	return n
+}
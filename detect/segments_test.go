@@ -0,0 +1,131 @@
+package detect
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const mixedDoc = "Bakı şəhərində bu gün hava çox gözəl olacaq. " +
+	"Сегодня в Москве идёт сильный снег и ветер.\n" +
+	"This is an English sentence about the weather.\n\n" +
+	"OK\n\n" +
+	"Azərbaycan Respublikasının paytaxtı Bakıdır."
+
+func TestSegments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []Language
+	}{
+		{"single language", "Salam, necəsən? Bu gün hava çox gözəldir.", []Language{Azerbaijani}},
+		{"mixed document", mixedDoc, []Language{Azerbaijani, Russian, English, Azerbaijani}},
+		{"merged same language", "Bu gün hava çox gözəldir. Sabah da yağış yağmayacaq.", []Language{Azerbaijani}},
+		{"line-level switch", "Bu gün hava çox gözəldir\nСегодня в Москве идёт снег", []Language{Azerbaijani, Russian}},
+		{"too short", "ok", []Language{Unknown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			segs := Segments(tt.input)
+			got := make([]Language, len(segs))
+			for i, s := range segs {
+				got[i] = s.Lang
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Segments(%q) languages = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentsCoverage(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		mixedDoc,
+		"OK\n\nСегодня в Москве идёт сильный снег.",
+		"  \n\n",
+		"Salam!\nПривет, как дела у тебя сегодня?",
+	}
+	for _, in := range inputs {
+		segs := Segments(in)
+		var b strings.Builder
+		prevEnd := 0
+		for _, s := range segs {
+			if s.Start != prevEnd {
+				t.Errorf("%q: segment starts at %d, previous ended at %d", in, s.Start, prevEnd)
+			}
+			if in[s.Start:s.End] != s.Text {
+				t.Errorf("%q: text[%d:%d] = %q, segment text %q", in, s.Start, s.End, in[s.Start:s.End], s.Text)
+			}
+			b.WriteString(s.Text)
+			prevEnd = s.End
+		}
+		if b.String() != in {
+			t.Errorf("%q: segments reconstruct %q", in, b.String())
+		}
+	}
+}
+
+func TestSegmentsShortUnitsAttach(t *testing.T) {
+	t.Parallel()
+
+	segs := Segments(mixedDoc)
+	if len(segs) != 4 {
+		t.Fatalf("got %d segments, want 4", len(segs))
+	}
+	if !strings.Contains(segs[2].Text, "OK") {
+		t.Errorf("short unit %q should join the preceding segment, got %q", "OK", segs[2].Text)
+	}
+	if segs[1].Script != ScriptCyrl {
+		t.Errorf("Russian segment script = %v, want Cyrl", segs[1].Script)
+	}
+	for _, s := range segs {
+		if s.Confidence <= 0 || s.Confidence > 1 {
+			t.Errorf("segment %q confidence %v out of range", s.Text, s.Confidence)
+		}
+	}
+}
+
+func TestSegmentsEmpty(t *testing.T) {
+	t.Parallel()
+
+	if got := Segments(""); got != nil {
+		t.Errorf(`Segments("") = %v, want nil`, got)
+	}
+}
+
+func TestSegmentJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(Segments("Сегодня в Москве идёт сильный снег.")[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{`"lang":"Russian"`, `"script":"Cyrl"`, `"text":`, `"start":0`, `"end":`} {
+		if !strings.Contains(string(data), key) {
+			t.Errorf("JSON %s missing %s", data, key)
+		}
+	}
+}
+
+func BenchmarkSegments(b *testing.B) {
+	for b.Loop() {
+		Segments(mixedDoc)
+	}
+}
+
+func ExampleSegments() {
+	text := "Bu gün hava çox gözəldir.\n\nСегодня в Москве идёт сильный снег."
+	for _, s := range Segments(text) {
+		fmt.Printf("%s [%d:%d]\n", s.Lang, s.Start, s.End)
+	}
+	// Output:
+	// Azerbaijani [0:31]
+	// Russian [31:95]
+}
@@ -0,0 +1,216 @@
+package detect
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+	"github.com/az-ai-labs/az-lang-nlp/lm"
+)
+
+// Short-text detection.
+//
+// Below about 50 letters the character-set scores of DetectAll rest on a
+// handful of letters, and Azerbaijani text without ə is a coin toss
+// against Turkish. The short-text profiles instead score every letter:
+// a character trigram model per Latin-script language, trained on the
+// embedded data.DetectAz, data.DetectTr, and data.DetectEn texts, plus
+// the rates of the letters that tell the languages apart (ə, ğ, ş, ç, ı,
+// ö, ü, x, q, w) in the same texts. The Turkic marker words of the
+// wordlist layer add a fixed bonus on top.
+
+const (
+	// shortTextLetters is the letter count from which DetectShort defers
+	// to DetectAll, whose character-set scores are reliable at that length.
+	shortTextLetters = 50
+
+	// minShortLetters is the minimum letter count for DetectShort.
+	minShortLetters = 3
+
+	// shortOrder is the n-gram order of the short-text models.
+	shortOrder = 3
+
+	// letterRateFloor is the smallest rate given to a signal letter, so
+	// that one ASCII-degraded or foreign letter cannot veto a language.
+	letterRateFloor = 1e-4
+
+	// markerLogBonus is the log-likelihood added per Turkic marker word or
+	// suffix shape (üçün/için, -yor): about a factor of e² per marker.
+	markerLogBonus = 2.0
+)
+
+// signalLetters are the letters whose rates differ most between
+// Azerbaijani, Turkish, and English.
+const signalLetters = "əğşçıöüxqw"
+
+// asciiFold maps the Turkic letters to the ASCII letters typed in their
+// place on keyboards without them.
+var asciiFold = strings.NewReplacer(
+	"ə", "e", "ğ", "g", "ş", "s", "ç", "c", "ı", "i", "ö", "o", "ü", "u",
+	"Ə", "E", "Ğ", "G", "Ş", "S", "Ç", "C", "İ", "I", "Ö", "O", "Ü", "U",
+)
+
+// schwaFold maps ə to e, the most common degradation of Azerbaijani text.
+var schwaFold = strings.NewReplacer("ə", "e", "Ə", "E")
+
+// shortProfile is the short-text model of one language.
+type shortProfile struct {
+	lang  Language
+	model *lm.Model
+	rates map[rune]float64 // share of each signal letter among all letters
+	other float64          // share of the remaining letters
+}
+
+// shortProfiles are trained on first use from the embedded texts.
+// Azerbaijani is also trained on its ə-less and ASCII-folded forms, and
+// Turkish on its ASCII-folded form, so that degraded input still matches.
+var shortProfiles = sync.OnceValue(func() []shortProfile {
+	return []shortProfile{
+		trainShortProfile(Azerbaijani, data.DetectAz, schwaFold, asciiFold),
+		trainShortProfile(English, data.DetectEn),
+		trainShortProfile(Turkish, data.DetectTr, asciiFold),
+	}
+})
+
+// trainShortProfile trains the profile of lang on the lines of text, each
+// also added through every fold. Lines starting with '#' are comments.
+// Letter rates are counted on the unfolded text only.
+func trainShortProfile(lang Language, text string, folds ...*strings.Replacer) shortProfile {
+	p := shortProfile{lang: lang, model: lm.NewModel(lm.Char, shortOrder), rates: make(map[rune]float64)}
+	var letters float64
+	for line := range strings.Lines(text) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p.model.Add(line)
+		for _, f := range folds {
+			p.model.Add(f.Replace(line))
+		}
+		for _, r := range line {
+			if !unicode.IsLetter(r) {
+				continue
+			}
+			letters++
+			if r = azcase.Lower(r); strings.ContainsRune(signalLetters, r) {
+				p.rates[r]++
+			}
+		}
+	}
+	p.other = 1
+	for _, r := range signalLetters {
+		p.rates[r] = max(p.rates[r]/letters, letterRateFloor)
+		p.other -= p.rates[r]
+	}
+	return p
+}
+
+// letterLogProb returns the log probability of the letters of s under the
+// signal letter rates of p: each signal letter by its rate, every other
+// letter by the remaining share.
+func (p *shortProfile) letterLogProb(s string) float64 {
+	var lp float64
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if rate, ok := p.rates[azcase.Lower(r)]; ok {
+			lp += math.Log(rate)
+		} else {
+			lp += math.Log(p.other)
+		}
+	}
+	return lp
+}
+
+// DetectShort is like Detect, tuned for short input such as messages,
+// titles, and search queries: Latin text under 50 letters is scored with
+// the short-text character trigram profiles, and input needs only 3
+// letters. Longer input is passed to Detect.
+func DetectShort(s string) Result {
+	results := DetectAllShort(s)
+	if len(results) == 0 {
+		return Result{}
+	}
+	return results[0]
+}
+
+// DetectAllShort is like DetectAll, tuned for short input as DetectShort
+// is. Returns nil for input with fewer than 3 letters.
+func DetectAllShort(s string) []Result {
+	if s == "" {
+		return nil
+	}
+	s = truncate(s)
+
+	var letters, cyrillic int
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			letters++
+			if isCyrillic(r) {
+				cyrillic++
+			}
+		}
+	}
+	switch {
+	case letters < minShortLetters:
+		return nil
+	case letters >= shortTextLetters:
+		return DetectAll(s)
+	case cyrillic > letters-cyrillic:
+		// Russian and Azerbaijani Cyrillic differ by letters of their own,
+		// which the character-set scores count at any length.
+		return detectAll(s, minShortLetters, true)
+	}
+	if ru, az, _ := translitMarkers(s); ru >= minTranslitWords || az >= minTranslitWords {
+		// Romanized Russian and digraph-typed Azerbaijani have no
+		// short-text profile; their marker words decide instead.
+		return detectAll(s, minShortLetters, true)
+	}
+	return detectShortLatin(s, letters)
+}
+
+// detectShortLatin scores Latin input with the short-text profiles. The
+// log-likelihoods of the languages are turned into Confidence by softmax;
+// Russian, which has no Latin profile, gets zero.
+func detectShortLatin(s string, letters int) []Result {
+	profiles := shortProfiles()
+	azMarkers, trMarkers := turkicMarkers(s)
+
+	scores := make([]float64, len(profiles))
+	best := math.Inf(-1)
+	for i := range profiles {
+		p := &profiles[i]
+		scores[i] = p.model.Score(s) + p.letterLogProb(s)
+		switch p.lang {
+		case Azerbaijani:
+			scores[i] += float64(azMarkers) * markerLogBonus
+		case Turkish:
+			scores[i] += float64(trMarkers) * markerLogBonus
+		}
+		best = max(best, scores[i])
+	}
+
+	var total float64
+	for i := range scores {
+		scores[i] = math.Exp(scores[i] - best)
+		total += scores[i]
+	}
+
+	conf := make(map[Language]float64, len(profiles))
+	for i, p := range profiles {
+		conf[p.lang] = scores[i] / total
+	}
+	results := []Result{
+		{Lang: Azerbaijani, Script: ScriptLatn, Confidence: conf[Azerbaijani], Register: registerOf(s)},
+		{Lang: Russian, Script: ScriptCyrl},
+		{Lang: English, Script: ScriptLatn, Confidence: conf[English]},
+		{Lang: Turkish, Script: ScriptLatn, Confidence: conf[Turkish]},
+	}
+	calibrate(results, letters, false, false)
+	sortResults(results)
+	return results
+}
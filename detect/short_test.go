@@ -0,0 +1,143 @@
+package detect
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestShortAccuracy measures DetectShort against Detect on the short-text
+// set and the Azerbaijani/Turkish set. Short mode must beat Detect on
+// short text and must not lose accuracy on the tr/az confusion cases.
+func TestShortAccuracy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		file        string
+		minAccuracy float64
+	}{
+		{"testdata/short.tsv", 0.95},
+		{"testdata/aztr.tsv", 0.95},
+	}
+	for _, tt := range tests {
+		f, err := os.Open(tt.file)
+		if err != nil {
+			t.Fatalf("open test set: %v", err)
+		}
+
+		var base, short, total int
+		sc := bufio.NewScanner(f)
+		for sc.Scan() {
+			line := sc.Text()
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			code, text, ok := strings.Cut(line, "\t")
+			if !ok {
+				t.Fatalf("%s: malformed line %q", tt.file, line)
+			}
+			total++
+			if languageCodes[Detect(text).Lang] == code {
+				base++
+			}
+			if r := DetectShort(text); languageCodes[r.Lang] == code {
+				short++
+			} else {
+				t.Logf("%s: miss: %s %q -> %s", tt.file, code, text, r.Lang)
+			}
+		}
+		err = sc.Err()
+		f.Close()
+		if err != nil {
+			t.Fatalf("read test set: %v", err)
+		}
+
+		baseAcc := float64(base) / float64(total)
+		acc := float64(short) / float64(total)
+		t.Logf("%s: Detect %.2f, DetectShort %.2f (%d lines)", tt.file, baseAcc, acc, total)
+
+		if short < base {
+			t.Errorf("%s: DetectShort %d/%d below Detect %d/%d", tt.file, short, total, base, total)
+		}
+		if acc < tt.minAccuracy {
+			t.Errorf("%s: accuracy %.2f below %.2f", tt.file, acc, tt.minAccuracy)
+		}
+	}
+}
+
+func TestDetectShort(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in     string
+		lang   Language
+		script Script
+	}{
+		{"", Unknown, 0},
+		{"ok", Unknown, 0},
+		{"12345 !!", Unknown, 0},
+		{"Gəl", Azerbaijani, ScriptLatn},
+		{"yaxsi yol", Azerbaijani, ScriptLatn},
+		{"Neredesin?", Turkish, ScriptLatn},
+		{"Good evening", English, ScriptLatn},
+		{"Где ты?", Russian, ScriptCyrl},
+		{"Мән евдәјәм", Azerbaijani, ScriptCyrl},
+		{"privet kak dela", Russian, ScriptLatnTranslit},
+		{"chox sagh ol", Azerbaijani, ScriptLatnTranslit},
+	}
+	for _, tt := range tests {
+		r := DetectShort(tt.in)
+		if r.Lang != tt.lang || r.Script != tt.script {
+			t.Errorf("DetectShort(%q) = %s/%s, want %s/%s", tt.in, r.Lang, r.Script, tt.lang, tt.script)
+		}
+	}
+}
+
+func TestDetectAllShort(t *testing.T) {
+	t.Parallel()
+
+	results := DetectAllShort("bu gun hava necedir")
+	if len(results) != 4 {
+		t.Fatalf("got %d results, want 4", len(results))
+	}
+	var sum float64
+	for i, r := range results {
+		sum += r.Confidence
+		if i > 0 && r.Probability > results[i-1].Probability {
+			t.Errorf("results not sorted by Probability: %v", results)
+		}
+		if r.Lang == Russian && r.Confidence != 0 {
+			t.Errorf("Russian Confidence = %v for Latin text, want 0", r.Confidence)
+		}
+	}
+	if sum < 0.999 || sum > 1.001 {
+		t.Errorf("Confidence sums to %v, want 1", sum)
+	}
+
+	// From 50 letters on, DetectAllShort is DetectAll.
+	long := "Bu gün hava çox yaxşıdır, ona görə biz də uşaqlarla birlikdə parka getmək istəyirik."
+	if got, want := DetectAllShort(long), DetectAll(long); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("DetectAllShort(long) = %v, want DetectAll %v", got, want)
+	}
+}
+
+func BenchmarkDetectShortTurkic(b *testing.B) {
+	// Azerbaijani without ə: a coin toss against Turkish for Detect.
+	input := "Çox sağ ol, qardaş"
+	DetectShort(input) // train the profiles
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		DetectShort(input)
+	}
+}
+
+func ExampleDetectShort() {
+	fmt.Println(Detect("bu gun hava necedir").Lang)
+	fmt.Println(DetectShort("bu gun hava necedir").Lang)
+	fmt.Println(DetectShort("Kızım hasta").Lang)
+	// Output:
+	// English
+	// Azerbaijani
+	// Turkish
+}
@@ -0,0 +1,138 @@
+package detect
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Transliteration layer.
+//
+// Latin text typed by writers used to Cyrillic follows Russian
+// romanization habits. Romanized Russian ("privet, kak dela") is
+// recognized by common Russian words and letter clusters in their usual
+// Latin spelling. Azerbaijani typed the same way writes the letters
+// without an ASCII key as Russian-style digraphs (sh for ş, ch for ç, kh
+// for x, gh for ğ, dzh for c); such a word is recognized when undoing the
+// digraphs yields a common Azerbaijani word or verb ending. Both are
+// reported with Script ScriptLatnTranslit.
+
+const (
+	// minTranslitWords is the minimum number of marker words for a
+	// transliteration to be reported.
+	minTranslitWords = 2
+
+	// minRuTranslitShare is the minimum share of words that must be
+	// romanized Russian markers for the text to be detected as Russian.
+	minRuTranslitShare = 0.3
+
+	// ruTranslitMultiplier scales the share of romanized Russian markers
+	// into the Russian score, so that it outweighs the English score
+	// (at most 1.0) once the share threshold is met.
+	ruTranslitMultiplier = 4.0
+
+	// azTranslitWeight is the Azerbaijani score added per digraph word.
+	// It exceeds markerWeight: a digraph word is evidence of both the
+	// language and the writing habit.
+	azTranslitWeight = 1.0
+)
+
+// ruTranslitWords are common Russian words in their usual Latin spelling.
+// Words that are also English, Azerbaijani, or Turkish (on, my, no, da,
+// ya, ne, tam) are deliberately excluded.
+var ruTranslitWords = map[string]bool{
+	"kak": true, "chto": true, "shto": true, "eto": true, "vot": true, "nu": true,
+	"menya": true, "tebya": true, "sebya": true, "mne": true, "tebe": true, "ty": true,
+	"vy": true, "oni": true, "ona": true, "yego": true, "kto": true,
+	"gde": true, "kogda": true, "pochemu": true, "zachem": true, "skolko": true,
+	"tozhe": true, "uzhe": true, "dazhe": true, "seychas": true, "sejchas": true,
+	"khorosho": true, "horosho": true, "privet": true, "spasibo": true, "pozhaluysta": true,
+	"poka": true, "dela": true, "ochen": true, "segodnya": true, "zavtra": true,
+	"vchera": true, "budet": true, "byl": true, "byla": true, "bylo": true,
+	"yest": true, "mozhno": true, "nado": true, "nuzhno": true, "tolko": true,
+	"yeshche": true, "eshche": true, "ili": true, "dlya": true, "etot": true,
+	"zdes": true, "potom": true, "vsegda": true, "nichego": true, "kakoy": true,
+	"znayu": true, "khochu": true, "hochu": true, "mogu": true, "ponyal": true,
+	"tak": true, "prosto": true, "konechno": true, "ladno": true,
+	"lyublyu": true,
+}
+
+// ruTranslitShapes are letter clusters of romanized Russian with no
+// counterpart in English or Azerbaijani spelling: shch (щ) and the
+// reflexive -tsya (-ться).
+var ruTranslitShapes = []string{"shch", "tsya"}
+
+// azDigraphs maps Russian-style digraphs to the Azerbaijani letters they
+// stand for, longest first.
+var azDigraphs = strings.NewReplacer(
+	"dzh", "c", "dj", "c", "sh", "ş", "ch", "ç", "kh", "x", "gh", "ğ", "zh", "j",
+)
+
+// asciiVowels folds the Azerbaijani vowels to the ASCII letters typed for
+// them, so that reference words match digraph spellings (yakhshi, uchun).
+var asciiVowels = strings.NewReplacer("ə", "e", "ı", "i", "ö", "o", "ü", "u")
+
+// azTranslitWords are common Azerbaijani words spelled with ş, ç, x, ğ,
+// c, or j, in addition to azMarkerWords.
+var azTranslitWords = []string{
+	"şəhər", "şəhərdə", "şey", "işdə", "işə", "xahiş", "xoş", "sağ", "sağol",
+	"axşam", "gecə", "çay", "çıx", "baxın", "uşaq", "uşaqlar", "qardaş", "danış",
+	"çalış", "bağışla", "keçən", "gələcək", "cavab", "xəbər", "xalq",
+	"azərbaycan", "həmçinin", "içində", "kiçik", "qışda",
+}
+
+// azTranslitEndings are ASCII-folded Azerbaijani verb endings with no
+// Turkish counterpart: the present tense 1sg and 1pl (işləyirəm,
+// çalışırıq) and the future (gələcəyik).
+var azTranslitEndings = []string{"iram", "irem", "uram", "urem", "iriq", "irik", "uruq", "uruk", "eceyik", "acagiq"}
+
+// azTranslitFolded holds the ASCII-folded reference words.
+var azTranslitFolded = func() map[string]bool {
+	m := make(map[string]bool, len(azMarkerWords)+len(azTranslitWords))
+	for w := range azMarkerWords {
+		m[asciiVowels.Replace(w)] = true
+	}
+	for _, w := range azTranslitWords {
+		m[asciiVowels.Replace(w)] = true
+	}
+	return m
+}()
+
+// translitMarkers counts the romanized Russian and digraph Azerbaijani
+// words in s, and its words in total.
+func translitMarkers(s string) (ru, az, words int) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, w := range fields {
+		words++
+		w = azcase.ToLower(w)
+		switch {
+		case ruTranslitWords[w] || containsAny(w, ruTranslitShapes):
+			ru++
+		case isAzDigraphWord(w):
+			az++
+		}
+	}
+	return ru, az, words
+}
+
+// isAzDigraphWord reports whether the lowercase word w spells an
+// Azerbaijani word with Russian-style digraphs.
+func isAzDigraphWord(w string) bool {
+	undone := azDigraphs.Replace(w)
+	if undone == w {
+		return false
+	}
+	folded := asciiVowels.Replace(undone)
+	if azTranslitFolded[folded] {
+		return true
+	}
+	for _, end := range azTranslitEndings {
+		if strings.HasSuffix(folded, end) && len(folded) > len(end)+1 {
+			return true
+		}
+	}
+	return false
+}
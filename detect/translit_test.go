@@ -0,0 +1,88 @@
+package detect
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDetectTranslit(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		input      string
+		wantLang   Language
+		wantScript Script
+	}{
+		{"romanized russian", "privet, kak dela", Russian, ScriptLatnTranslit},
+		{"romanized russian sentence", "Privet! Kak u tebya dela? Vsyo khorosho, spasibo.", Russian, ScriptLatnTranslit},
+		{"romanized russian shapes", "Ya tebya lyublyu ochen silno", Russian, ScriptLatnTranslit},
+		{"azerbaijani digraphs", "Men seni chox sevirem, sen yakhshi insansan", Azerbaijani, ScriptLatnTranslit},
+		{"azerbaijani verb endings", "Salam, men bu gun ishleyirem, sabah gelecem, sagh ol", Azerbaijani, ScriptLatnTranslit},
+
+		// -- Not transliterated --
+		{"english digraphs", "The chef should check the shipment of fish and chips.", English, ScriptLatn},
+		{"english single russian word", "Hello, how are you doing today? Spasibo for asking.", English, ScriptLatn},
+		{"russified azerbaijani", "Koroçe, sabah işə gedəcəm, davay görüşək.", Azerbaijani, ScriptLatn},
+		{"standard azerbaijani", "Salam, necəsən? Bu gün hava çox gözəldir.", Azerbaijani, ScriptLatn},
+		{"turkish", "Bugün hava çok güzel, ama yarın yağmur yağacak.", Turkish, ScriptLatn},
+		{"cyrillic russian", "Привет, как дела? Всё хорошо.", Russian, ScriptCyrl},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Detect(tt.input)
+			if got.Lang != tt.wantLang || got.Script != tt.wantScript {
+				t.Errorf("Detect(%q) = %v %v, want %v %v", tt.input, got.Lang, got.Script, tt.wantLang, tt.wantScript)
+			}
+		})
+	}
+}
+
+func TestDetectTranslitRussianPlausible(t *testing.T) {
+	t.Parallel()
+	var sum float64
+	for _, r := range DetectAll("privet, kak dela") {
+		sum += r.Probability
+		if r.Lang == English && r.Probability >= 0.5 {
+			t.Errorf("English Probability = %v, want < 0.5", r.Probability)
+		}
+	}
+	if sum < 0.999 || sum > 1.001 {
+		t.Errorf("probabilities sum to %v, want 1.0", sum)
+	}
+}
+
+func TestTranslitMarkers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input         string
+		ru, az, words int
+	}{
+		{"privet kak dela", 3, 0, 3},
+		{"chox yakhshi uchun", 0, 3, 3},
+		{"ishleyirem dzhavab", 0, 2, 2},
+		{"gelirem dzhavab", 0, 1, 2},
+		{"chef check shop", 0, 0, 3},
+		{"çox yaxşı", 0, 0, 2},
+	}
+	for _, tt := range tests {
+		ru, az, words := translitMarkers(tt.input)
+		if ru != tt.ru || az != tt.az || words != tt.words {
+			t.Errorf("translitMarkers(%q) = %d, %d, %d; want %d, %d, %d", tt.input, ru, az, words, tt.ru, tt.az, tt.words)
+		}
+	}
+}
+
+func BenchmarkDetectTranslit(b *testing.B) {
+	s := "Privet! Kak u tebya dela? Vsyo khorosho, spasibo. Men seni chox sevirem."
+	for b.Loop() {
+		Detect(s)
+	}
+}
+
+func ExampleScript_translit() {
+	r := Detect("Privet! Kak u tebya dela?")
+	fmt.Println(r.Lang, r.Script)
+	// Output:
+	// Russian Latn-translit
+}
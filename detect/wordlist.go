@@ -0,0 +1,145 @@
+package detect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Azerbaijani/Turkish disambiguation layer.
+//
+// The two languages share most characters and many trigrams, so when the
+// trigram scores end up close, exclusive function words and suffix shapes
+// are far more reliable than further n-gram statistics. Each marker found
+// adds markerWeight to the matching language.
+
+const (
+	// turkicCloseMargin is the maximum relative gap |az-tr|/(az+tr) at
+	// which the wordlist layer is applied.
+	turkicCloseMargin = 0.5
+
+	// markerWeight is the score added per exclusive marker. It matches
+	// xqBoostPerChar so that a single function word is as strong as a
+	// single x/q letter.
+	markerWeight = 0.5
+)
+
+// azMarkerWords are common Azerbaijani words whose Turkish counterparts
+// are spelled differently (üçün/için, deyil/değil, çox/çok, və/ve). Words
+// that are also ordinary Turkish words (kimi, indi, amma) are deliberately
+// excluded.
+var azMarkerWords = map[string]bool{
+	"üçün": true, "deyil": true, "çox": true, "yox": true, "yoxdur": true,
+	"ancaq": true, "çünki": true, "heç": true, "haqqında": true, "hansı": true,
+	"artıq": true, "yaxşı": true, "və": true, "ilə": true, "isə": true,
+	"görə": true, "qədər": true, "mən": true, "sən": true, "nə": true,
+	"niyə": true, "necə": true,
+}
+
+// trMarkerWords are common Turkish words whose Azerbaijani counterparts
+// are spelled differently. Words that also occur in ASCII-degraded
+// Azerbaijani (her for hər, ne for nə) are deliberately excluded.
+var trMarkerWords = map[string]bool{
+	"ve": true, "için": true, "değil": true, "çok": true, "yok": true,
+	"ama": true, "ancak": true, "çünkü": true, "hiç": true, "şimdi": true,
+	"hakkında": true, "gibi": true, "hangi": true, "zaten": true, "iyi": true,
+	"ile": true, "ise": true, "göre": true, "kadar": true, "ben": true,
+	"evet": true, "hayır": true, "nasıl": true, "neden": true, "veya": true,
+}
+
+// trSuffixShapes lists Turkish suffix shapes with no Azerbaijani
+// counterpart: the present progressive -yor (geliyor, okuyor, görüyor)
+// and the future 1sg -acağım/-eceğim.
+var trSuffixShapes = []string{"iyor", "ıyor", "uyor", "üyor", "acağım", "eceğim"}
+
+// azSuffixShapes lists Azerbaijani suffix shapes with no Turkish
+// counterpart: the present tense after a vowel stem (oynayır, oxuyur),
+// the present 1pl -ırıq/-irik (gedirik), and the future 1sg -acağam.
+// Several also occur inside Turkish words (büyür, çürük, birikim,
+// ayırmak, duyuru), so they are matched only as suffixes; see
+// hasAzSuffix.
+var azSuffixShapes = []string{
+	"ayır", "əyir", "uyur", "üyür", "ıyır", "iyir",
+	"ırıq", "irik", "uruq", "ürük", "acağam", "əcəyəm",
+}
+
+// azPersonEndings are the plural and person endings that may follow an
+// Azerbaijani suffix shape (oynayırlar, oxuyuram, gedirik).
+var azPersonEndings = []string{
+	"", "lar", "lər", "am", "əm", "san", "sən", "ıq", "ik", "uq", "ük",
+	"sınız", "siniz", "sunuz", "sünüz",
+}
+
+// minAzSuffixStem is the minimum number of letters before an Azerbaijani
+// suffix shape: the Turkish words ending in a shape have a stem of one
+// letter or none (uyur, büyür, çürük).
+const minAzSuffixStem = 2
+
+// turkicMarkers counts// turkicMarkers counts Azerbaijani and Turkish exclusive markers in s.
+func turkicMarkers(s string) (az, tr int) {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, w := range words {
+		w = azcase.ToLower(w)
+		switch {
+		case azMarkerWords[w]:
+			az++
+		case trMarkerWords[w]:
+			tr++
+		case containsAny(w, trSuffixShapes):
+			tr++
+		case hasAzSuffix(w):
+			az++
+		}
+	}
+	return az, tr
+}
+
+// containsAny reports whether s contains any of the substrings.
+func containsAny(s string, subs []string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAzSuffix reports whether w ends in an Azerbaijani suffix shape,
+// optionally followed by a person or plural ending, after a stem of at
+// least minAzSuffixStem letters.
+func hasAzSuffix(w string) bool {
+	for _, end := range azPersonEndings {
+		rest, ok := strings.CutSuffix(w, end)
+		if !ok {
+			continue
+		}
+		for _, shape := range azSuffixShapes {
+			if stem, ok := strings.CutSuffix(rest, shape); ok && utf8.RuneCountInString(stem) >= minAzSuffixStem {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// disambiguateTurkic adjusts close Azerbaijani/Turkish scores using the
+// marker wordlists. Scores that are zero or already well separated are
+// returned unchanged.
+func disambiguateTurkic(s string, azScore, trScore float64) (az, tr float64) {
+	if azScore <= 0 || trScore <= 0 {
+		return azScore, trScore
+	}
+	gap := azScore - trScore
+	if gap < 0 {
+		gap = -gap
+	}
+	if gap > turkicCloseMargin*(azScore+trScore) {
+		return azScore, trScore
+	}
+	azCount, trCount := turkicMarkers(s)
+	return azScore + float64(azCount)*markerWeight, trScore + float64(trCount)*markerWeight
+}
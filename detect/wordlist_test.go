@@ -0,0 +1,130 @@
+package detect
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTurkicMarkers(t *testing.T) {
+	tests := []struct {
+		in     string
+		az, tr int
+	}{
+		{"Çünki bu iş üçün vaxt yoxdur", 3, 0},
+		{"Çünkü bu iş için zaman yok", 0, 3},
+		{"Çocuklar bahçede oynuyorlar", 0, 1},
+		{"Uşaqlar bağda oynayırlar", 1, 0},
+		{"Sabah Şuşaya gedirik", 1, 0},
+		{"O kitab oxuyuram, sonra gələcəyəm", 2, 0},
+		// Azerbaijani shapes inside Turkish words are not markers.
+		{"Çiçekler yazın hızla büyür", 0, 0},
+		{"Elma çürük çıktı", 0, 0},
+		{"Bu iki kavramı ayırmak zor", 0, 0},
+		{"Bu duyuru önemli", 0, 0},
+		{"Birikimlerimiz bitti", 0, 0},
+		{"ÇOX SAĞ OL", 1, 0},
+		{"Bakı şəhəri", 0, 0},
+	}
+	for _, tt := range tests {
+		az, tr := turkicMarkers(tt.in)
+		if az != tt.az || tr != tt.tr {
+			t.Errorf("turkicMarkers(%q) = %d, %d, want %d, %d", tt.in, az, tr, tt.az, tt.tr)
+		}
+	}
+}
+
+func TestDisambiguateTurkicOnlyWhenClose(t *testing.T) {
+	s := "Çünkü bu iş için zaman yok"
+	// Well separated scores are left alone.
+	if az, tr := disambiguateTurkic(s, 10, 1); az != 10 || tr != 1 {
+		t.Errorf("separated scores changed: %v, %v", az, tr)
+	}
+	// Zero scores are left alone.
+	if az, tr := disambiguateTurkic(s, 0, 1); az != 0 || tr != 1 {
+		t.Errorf("zero score changed: %v, %v", az, tr)
+	}
+	// Close scores get the marker boost.
+	az, tr := disambiguateTurkic(s, 1, 1)
+	if az != 1 || tr != 1+3*markerWeight {
+		t.Errorf("close scores = %v, %v, want 1, %v", az, tr, 1+3*markerWeight)
+	}
+}
+
+// TestAzTrAccuracy measures the wordlist layer on testdata/aztr.tsv. The
+// layer must strictly improve on the trigram-only baseline.
+func TestAzTrAccuracy(t *testing.T) {
+	f, err := os.Open("testdata/aztr.tsv")
+	if err != nil {
+		t.Fatalf("open test set: %v", err)
+	}
+	defer f.Close()
+
+	var base, layered, total int
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		code, text, ok := strings.Cut(line, "\t")
+		if !ok {
+			t.Fatalf("malformed line %q", line)
+		}
+		total++
+		if r := detectAll(text, minLetters, false); len(r) > 0 && languageCodes[r[0].Lang] == code {
+			base++
+		}
+		if r := detectAll(text, minLetters, true); len(r) > 0 && languageCodes[r[0].Lang] == code {
+			layered++
+		} else {
+			t.Logf("miss: %s %q", code, text)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("read test set: %v", err)
+	}
+
+	baseAcc := float64(base) / float64(total)
+	acc := float64(layered) / float64(total)
+	t.Logf("az/tr accuracy: trigram-only %.2f, with wordlist %.2f (%d lines)", baseAcc, acc, total)
+
+	const minAccuracy = 0.95
+	if layered <= base {
+		t.Errorf("wordlist layer did not improve accuracy: %d/%d vs baseline %d/%d", layered, total, base, total)
+	}
+	if acc < minAccuracy {
+		t.Errorf("accuracy %.2f below %.2f", acc, minAccuracy)
+	}
+}
+
+// TestTurkishSharedWords checks that Azerbaijani words and suffix shapes
+// that also occur in ordinary Turkish words do not pull Turkish text to
+// Azerbaijani.
+func TestTurkishSharedWords(t *testing.T) {
+	for _, s := range []string{
+		"Bunu kimi gördün orada?",
+		"Kimi gördün dün akşam?",
+		"Kadın otobüsten indi",
+		"Amma da güzel olmuş bu",
+		// Azerbaijani suffix shapes inside Turkish words.
+		"Çiçekler yazın hızla büyür",
+		"Elma çürük çıktı",
+		"Bu iki kavramı ayırmak zor",
+		"Bu duyuru önemli",
+		"Yürük atlar koşar",
+		"Ağaç büyürken dallar uzar",
+	} {
+		if got := Detect(s).Lang; got != Turkish {
+			t.Errorf("Detect(%q) = %s, want Turkish", s, got)
+		}
+	}
+}
+
+func BenchmarkDetectAmbiguousTurkic(b *testing.B) {
+	s := "Çünki bu iş üçün vaxt yoxdur, uşaqlar bağda oynayırlar"
+	for b.Loop() {
+		Detect(s)
+	}
+}
@@ -0,0 +1,83 @@
+package keywords
+
+import (
+	"math"
+	"slices"
+)
+
+// BM25 parameters, the common defaults.
+const (
+	bm25K1 = 1.2  // term frequency saturation
+	bm25B  = 0.75 // document length normalization
+)
+
+// ExtractBM25 returns the top keywords from text scored by Okapi BM25
+// with document frequencies and the average document length taken from
+// corpus. Unlike ExtractTFIDF, repeated terms saturate (the k1 = 1.2
+// parameter) and term frequency is normalized against the corpus average
+// length rather than divided by the text length (b = 0.75), so long texts
+// are neither favored nor penalized for their length alone.
+//
+// IDF is ln(1 + (N-df+0.5)/(df+0.5)), which is positive even for stems
+// present in every document. A nil or empty corpus gives every stem the
+// same IDF, ranking by saturated term frequency alone; a corpus loaded
+// from a file saved before lengths were recorded normalizes against the
+// text's own length. The text is not added to the corpus.
+//
+// Results are sorted by score descending, with lexicographic tie-breaking.
+// Options are those of ExtractTFIDF. Returns nil for empty text or text
+// exceeding maxInputBytes.
+func ExtractBM25(text string, topN int, corpus *Corpus, opts ...Option) []Keyword {
+	o := newOptions(opts)
+	filtered := pipeline(text, o)
+	if len(filtered) == 0 {
+		return nil
+	}
+	if topN <= 0 {
+		topN = defaultTopN
+	}
+	if corpus == nil {
+		corpus = NewCorpus()
+	}
+
+	candidates := o.applyBoost(corpus.scoreBM25(filtered))
+	slices.SortStableFunc(candidates, cmpKeyword)
+	if len(candidates) > topN {
+		candidates = candidates[:topN]
+	}
+	return candidates
+}
+
+// scoreBM25 scores stems as one document against the corpus statistics.
+func (c *Corpus) scoreBM25(stems []string) []Keyword {
+	tf := make(map[string]int, len(stems))
+	for _, s := range stems {
+		if _, exists := tf[s]; !exists && len(tf) >= maxCandidates {
+			continue
+		}
+		tf[s]++
+	}
+
+	docLen := float64(len(stems))
+	result := make([]Keyword, 0, len(tf))
+	c.mu.RLock()
+	avgLen := c.avgLength()
+	if avgLen == 0 {
+		avgLen = docLen
+	}
+	norm := bm25K1 * (1 - bm25B + bm25B*docLen/avgLen)
+	for stem, count := range tf {
+		f := float64(count)
+		score := c.bm25IDF(stem) * f * (bm25K1 + 1) / (f + norm)
+		result = append(result, Keyword{Stem: stem, Score: score, Count: count})
+	}
+	c.mu.RUnlock()
+	return result
+}
+
+// bm25IDF computes the BM25 inverse document frequency. Caller must hold
+// c.mu.
+func (c *Corpus) bm25IDF(stem string) float64 {
+	n, df := float64(c.docs), float64(c.df[stem])
+	return math.Log(1 + (n-df+0.5)/(df+0.5))
+}
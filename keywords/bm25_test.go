@@ -0,0 +1,128 @@
+package keywords
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCorpusAverageLength(t *testing.T) {
+	if got := NewCorpus().AverageLength(); got != 0 {
+		t.Errorf("empty AverageLength() = %v, want 0", got)
+	}
+	c := NewCorpus()
+	c.AddDocument("Neft qaz.")              // 2 stems
+	c.AddDocument("Neft qaz ixracı artdı.") // 4 stems
+	if got := c.AverageLength(); got != 3 {
+		t.Errorf("AverageLength() = %v, want 3", got)
+	}
+}
+
+func TestExtractBM25(t *testing.T) {
+	c := trainedCorpus()
+	got := ExtractBM25("Neft və qaz ixracı artdı, qaz kəmərləri genişlənir.", 3, c)
+	if len(got) == 0 {
+		t.Fatal("ExtractBM25 returned no keywords")
+	}
+	found := false
+	for _, k := range got {
+		found = found || k.Stem == "qaz"
+		if k.Stem == "neft" {
+			t.Errorf("corpus-wide term neft ranked in top 3: %v", got)
+		}
+		if k.Score <= 0 {
+			t.Errorf("keyword %v has non-positive score", k)
+		}
+	}
+	if !found {
+		t.Errorf("repeated term qaz not in top 3: %v", got)
+	}
+	if len(got) > 3 {
+		t.Errorf("got %d keywords, want at most 3", len(got))
+	}
+	if c.Documents() != len(corpusDocs) {
+		t.Error("ExtractBM25 added the scored text to the corpus")
+	}
+}
+
+func TestExtractBM25Saturation(t *testing.T) {
+	c := trainedCorpus()
+	idf := math.Log(1 + (float64(len(corpusDocs))+0.5)/0.5) // unseen stem
+	for _, n := range []int{1, 10, 100} {
+		text := strings.Repeat("zəlzələ ", n)
+		got := ExtractBM25(text, 1, c)
+		if len(got) != 1 || got[0].Stem != "zəlzələ" {
+			t.Fatalf("ExtractBM25(%d × zəlzələ) = %v", n, got)
+		}
+		if got[0].Score > idf*(bm25K1+1) {
+			t.Errorf("score %v for %d repetitions exceeds the saturation bound %v", got[0].Score, n, idf*(bm25K1+1))
+		}
+	}
+}
+
+func TestExtractBM25LengthNormalization(t *testing.T) {
+	c := trainedCorpus()
+	short := "Qaz ixracı artdı."
+	long := short + strings.Repeat(" Hasilat sabit qalıb, yataq işləyir, şirkət danışır.", 5)
+	score := func(text string) float64 {
+		for _, k := range ExtractBM25(text, 100, c) {
+			if k.Stem == "qaz" {
+				return k.Score
+			}
+		}
+		t.Fatalf("qaz not extracted from %q", text)
+		return 0
+	}
+	if s, l := score(short), score(long); l >= s {
+		t.Errorf("one mention in a long text scored %v, not below %v in a short one", l, s)
+	}
+}
+
+func TestExtractBM25NilCorpus(t *testing.T) {
+	got := ExtractBM25("Qaz qaz qaz neft.", 2, nil)
+	if len(got) != 2 || got[0].Stem != "qaz" || got[0].Count != 3 {
+		t.Errorf("ExtractBM25 with nil corpus = %v, want qaz first", got)
+	}
+}
+
+func TestExtractBM25Options(t *testing.T) {
+	c := trainedCorpus()
+	got := ExtractBM25("Neft və qaz ixracı artdı.", 5, c, WithStopwords("qaz"))
+	for _, k := range got {
+		if k.Stem == "qaz" {
+			t.Errorf("stopword qaz extracted: %v", got)
+		}
+	}
+}
+
+func TestExtractBM25Empty(t *testing.T) {
+	c := trainedCorpus()
+	if got := ExtractBM25("", 5, c); got != nil {
+		t.Errorf("ExtractBM25(\"\") = %v, want nil", got)
+	}
+	if got := ExtractBM25(strings.Repeat("a", maxInputBytes+1), 5, c); got != nil {
+		t.Errorf("ExtractBM25(oversized) = %v, want nil", got)
+	}
+}
+
+func BenchmarkExtractBM25(b *testing.B) {
+	c := trainedCorpus()
+	for b.Loop() {
+		ExtractBM25("Neft və qaz ixracı artdı, qaz kəmərləri genişlənir.", 5, c)
+	}
+}
+
+func ExampleExtractBM25() {
+	c := NewCorpus()
+	c.AddDocument("Neft hasilatı artır.")
+	c.AddDocument("Neft ixracı azalır.")
+	c.AddDocument("Neft şirkəti qaz yatağı kəşf etdi.")
+	for _, k := range ExtractBM25("Qaz ixracı artdı, qaz neft ixracını ötdü.", 3, c) {
+		fmt.Printf("%s %.2f\n", k.Stem, k.Score)
+	}
+	// Output:
+	// öt 1.52
+	// ixrac 1.07
+	// qaz 1.07
+}
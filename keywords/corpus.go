@@ -0,0 +1,228 @@
+package keywords
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+)
+
+const (
+	corpusFormatVersion = 1       // version of the serialized Corpus format
+	maxCorpusStems      = 1 << 20 // Corpus ignores new stems beyond this many
+)
+
+// Corpus holds document frequencies collected over a collection of
+// documents, giving true document-level IDF weights for a domain instead
+// of the embedded token-frequency proxy used by ExtractTFIDF.
+//
+// Train it with AddDocument, persist it with Save (JSON), restore it with
+// LoadCorpus, and score new texts with ExtractTFIDF. A Corpus is safe for
+// concurrent use. At most 2^20 distinct stems are tracked; once full, new
+// stems are ignored and scored as unseen.
+type Corpus struct {
+	mu     sync.RWMutex
+	docs   int
+	tokens int // keyword stems over all documents, for ExtractBM25
+	df     map[string]int
+}
+
+// corpusJSON is the serialized form of a Corpus.
+type corpusJSON struct {
+	Version   int            `json:"version"`
+	Documents int            `json:"documents"`
+	Tokens    int            `json:"tokens,omitempty"` // keyword stems over all documents
+	DF        map[string]int `json:"df"`               // stem -> number of documents containing it
+}
+
+// NewCorpus returns an empty Corpus.
+func NewCorpus() *Corpus {
+	return &Corpus{df: make(map[string]int)}
+}
+
+// AddDocument records the keyword stems of text as one document.
+// Stems go through the same pipeline as ExtractTFIDF (normalization,
+// stemming, stopword filtering). Empty or oversized input is ignored.
+func (c *Corpus) AddDocument(text string) {
+	stems := pipeline(text, nil)
+	if len(stems) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.docs++
+	c.tokens += len(stems)
+	seen := make(map[string]bool, len(stems))
+	for _, s := range stems {
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		if _, ok := c.df[s]; !ok && len(c.df) >= maxCorpusStems {
+			continue
+		}
+		c.df[s]++
+	}
+}
+
+// Documents returns the number of documents added to the corpus.
+func (c *Corpus) Documents() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.docs
+}
+
+// AverageLength returns the mean number of keyword stems per document,
+// the length ExtractBM25 normalizes against, or 0 for an empty corpus or
+// one saved before lengths were recorded.
+func (c *Corpus) AverageLength() float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.avgLength()
+}
+
+// avgLength computes AverageLength. Caller must hold c.mu.
+func (c *Corpus) avgLength() float64 {
+	if c.docs == 0 {
+		return 0
+	}
+	return float64(c.tokens) / float64(c.docs)
+}
+
+// DocumentFrequency returns the number of documents containing stem.
+// stem must be a lowercase stem as produced by the keyword pipeline.
+func (c *Corpus) DocumentFrequency(stem string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.df[stem]
+}
+
+// IDF returns the smoothed inverse document frequency of stem:
+// ln((1+N)/(1+df)) + 1, where N is the number of documents. Unseen stems
+// get the maximum weight; a stem present in every document gets 1.
+func (c *Corpus) IDF(stem string) float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.idf(stem)
+}
+
+// idf computes IDF. Caller must hold c.mu.
+func (c *Corpus) idf(stem string) float64 {
+	return math.Log(float64(1+c.docs)/float64(1+c.df[stem])) + 1
+}
+
+// ExtractTFIDF returns the top keywords from text scored by TF-IDF with
+// IDF taken from the corpus. TF is normalized by document length.
+// Results are sorted by score descending, with lexicographic tie-breaking.
+// Returns nil for empty text or text exceeding maxInputBytes.
+// The text is not added to the corpus. Options are those of the
+// package-level ExtractTFIDF.
+func (c *Corpus) ExtractTFIDF(text string, topN int, opts ...Option) []Keyword {
+	o := newOptions(opts)
+	filtered := pipeline(text, o)
+	if len(filtered) == 0 {
+		return nil
+	}
+	if topN <= 0 {
+		topN = defaultTopN
+	}
+
+	candidates := o.applyBoost(c.scoreTFIDF(filtered))
+	slices.SortStableFunc(candidates, cmpKeyword)
+	if len(candidates) > topN {
+		candidates = candidates[:topN]
+	}
+	return candidates
+}
+
+// scoreTFIDF scores stems like the package-level scoreTFIDF but with IDF
+// taken from the corpus.
+func (c *Corpus) scoreTFIDF(stems []string) []Keyword {
+	tf := make(map[string]int, len(stems))
+	for _, s := range stems {
+		if _, exists := tf[s]; !exists && len(tf) >= maxCandidates {
+			continue
+		}
+		tf[s]++
+	}
+
+	docLen := float64(len(stems))
+	result := make([]Keyword, 0, len(tf))
+	c.mu.RLock()
+	for stem, count := range tf {
+		score := float64(count) / docLen * c.idf(stem)
+		result = append(result, Keyword{Stem: stem, Score: score, Count: count})
+	}
+	c.mu.RUnlock()
+	return result
+}
+
+// MarshalJSON encodes the corpus as a versioned JSON document:
+// {"version":1,"documents":N,"tokens":T,"df":{"stem":n,...}}.
+func (c *Corpus) MarshalJSON() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return json.Marshal(corpusJSON{Version: corpusFormatVersion, Documents: c.docs, Tokens: c.tokens, DF: c.df})
+}
+
+// UnmarshalJSON decodes a document produced by MarshalJSON, replacing the
+// corpus contents. Returns an error for unsupported versions or
+// inconsistent counts.
+func (c *Corpus) UnmarshalJSON(data []byte) error {
+	var cj corpusJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return fmt.Errorf("keywords: decoding corpus: %w", err)
+	}
+	if cj.Version != corpusFormatVersion {
+		return fmt.Errorf("keywords: unsupported corpus version %d", cj.Version)
+	}
+	if cj.Documents < 0 {
+		return fmt.Errorf("keywords: negative corpus document count %d", cj.Documents)
+	}
+	if cj.Tokens < 0 {
+		return fmt.Errorf("keywords: negative corpus token count %d", cj.Tokens)
+	}
+	if len(cj.DF) > maxCorpusStems {
+		return fmt.Errorf("keywords: corpus has %d stems, limit is %d", len(cj.DF), maxCorpusStems)
+	}
+	for stem, n := range cj.DF {
+		if n < 1 || n > cj.Documents {
+			return fmt.Errorf("keywords: document frequency %d for %q out of range [1, %d]", n, stem, cj.Documents)
+		}
+	}
+	if cj.DF == nil {
+		cj.DF = make(map[string]int)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.docs, c.tokens, c.df = cj.Documents, cj.Tokens, cj.DF
+	return nil
+}
+
+// Save writes the corpus to w as JSON (see MarshalJSON).
+func (c *Corpus) Save(w io.Writer) error {
+	data, err := c.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// LoadCorpus reads a corpus written by Save.
+func LoadCorpus(r io.Reader) (*Corpus, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("keywords: reading corpus: %w", err)
+	}
+	c := NewCorpus()
+	if err := c.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,158 @@
+package keywords
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// corpusDocs is a small oil-industry corpus: "neft" appears in every
+// document, so it should carry the minimum IDF.
+var corpusDocs = []string{
+	"Neft hasilatı artır, neft qiyməti bazarda sabitdir.",
+	"Neft şirkəti yeni yataq kəşf etdi.",
+	"Neft ixracı Avropaya yönəldi, qaz ixracı da artdı.",
+	"Neft sektorunda investisiya həcmi böyüdü.",
+}
+
+func trainedCorpus() *Corpus {
+	c := NewCorpus()
+	for _, d := range corpusDocs {
+		c.AddDocument(d)
+	}
+	return c
+}
+
+func TestCorpusAddDocument(t *testing.T) {
+	c := trainedCorpus()
+	if got := c.Documents(); got != len(corpusDocs) {
+		t.Errorf("Documents() = %d, want %d", got, len(corpusDocs))
+	}
+	if got := c.DocumentFrequency("neft"); got != len(corpusDocs) {
+		t.Errorf("DocumentFrequency(neft) = %d, want %d", got, len(corpusDocs))
+	}
+	// Repeated within one document, counted once.
+	if got := c.DocumentFrequency("ixrac"); got != 1 {
+		t.Errorf("DocumentFrequency(ixrac) = %d, want 1", got)
+	}
+	if got := c.IDF("neft"); math.Abs(got-1) > 1e-9 {
+		t.Errorf("IDF(neft) = %v, want 1", got)
+	}
+	if c.IDF("zəlzələ") <= c.IDF("ixrac") {
+		t.Errorf("unseen IDF %v not above rare IDF %v", c.IDF("zəlzələ"), c.IDF("ixrac"))
+	}
+
+	c.AddDocument("")
+	c.AddDocument(strings.Repeat("a", maxInputBytes+1))
+	if got := c.Documents(); got != len(corpusDocs) {
+		t.Errorf("Documents() after empty input = %d, want %d", got, len(corpusDocs))
+	}
+}
+
+func TestCorpusExtractTFIDF(t *testing.T) {
+	c := trainedCorpus()
+	got := c.ExtractTFIDF("Neft və qaz ixracı artdı, qaz kəmərləri genişlənir.", 3)
+	if len(got) == 0 {
+		t.Fatal("ExtractTFIDF returned no keywords")
+	}
+	if got[0].Stem != "qaz" {
+		t.Errorf("top keyword = %q, want qaz", got[0].Stem)
+	}
+	for _, k := range got {
+		if k.Stem == "neft" {
+			t.Errorf("corpus-wide term neft ranked in top 3: %v", got)
+		}
+	}
+	if len(got) > 3 {
+		t.Errorf("got %d keywords, want at most 3", len(got))
+	}
+	if c.Documents() != len(corpusDocs) {
+		t.Error("ExtractTFIDF added the scored text to the corpus")
+	}
+	if got := c.ExtractTFIDF("", 3); got != nil {
+		t.Errorf("ExtractTFIDF(\"\") = %v, want nil", got)
+	}
+}
+
+func TestCorpusSaveLoad(t *testing.T) {
+	c := trainedCorpus()
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	loaded, err := LoadCorpus(&buf)
+	if err != nil {
+		t.Fatalf("LoadCorpus: %v", err)
+	}
+	if loaded.Documents() != c.Documents() {
+		t.Errorf("Documents() = %d, want %d", loaded.Documents(), c.Documents())
+	}
+	if loaded.AverageLength() != c.AverageLength() {
+		t.Errorf("AverageLength() = %v, want %v", loaded.AverageLength(), c.AverageLength())
+	}
+	text := "Neft və qaz ixracı artdı."
+	a, b := c.ExtractTFIDF(text, 5), loaded.ExtractTFIDF(text, 5)
+	if fmt.Sprint(a) != fmt.Sprint(b) {
+		t.Errorf("scores differ after round trip:\n got  %v\n want %v", b, a)
+	}
+}
+
+func TestLoadCorpusErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"syntax", `{"version":`},
+		{"version", `{"version":2,"documents":1,"df":{}}`},
+		{"negative docs", `{"version":1,"documents":-1,"df":{}}`},
+		{"df above docs", `{"version":1,"documents":1,"df":{"neft":2}}`},
+		{"zero df", `{"version":1,"documents":1,"df":{"neft":0}}`},
+		{"negative tokens", `{"version":1,"documents":1,"tokens":-1,"df":{}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadCorpus(strings.NewReader(tt.data)); err == nil {
+				t.Errorf("LoadCorpus(%s) = nil error, want error", tt.data)
+			}
+		})
+	}
+}
+
+func TestCorpusConcurrent(t *testing.T) {
+	c := NewCorpus()
+	var wg sync.WaitGroup
+	for _, d := range corpusDocs {
+		wg.Go(func() {
+			c.AddDocument(d)
+			c.ExtractTFIDF(d, 3)
+		})
+	}
+	wg.Wait()
+	if got := c.DocumentFrequency("neft"); got != len(corpusDocs) {
+		t.Errorf("DocumentFrequency(neft) = %d, want %d", got, len(corpusDocs))
+	}
+}
+
+func BenchmarkCorpusExtractTFIDF(b *testing.B) {
+	c := trainedCorpus()
+	for b.Loop() {
+		c.ExtractTFIDF("Neft və qaz ixracı artdı, qaz kəmərləri genişlənir.", 5)
+	}
+}
+
+func ExampleCorpus() {
+	c := NewCorpus()
+	c.AddDocument("Neft hasilatı artır.")
+	c.AddDocument("Neft ixracı azalır.")
+	c.AddDocument("Neft şirkəti qaz yatağı kəşf etdi.")
+	for _, k := range c.ExtractTFIDF("Qaz ixracı artdı, qaz neft ixracını ötdü.", 3) {
+		fmt.Printf("%s %.2f\n", k.Stem, k.Score)
+	}
+	// Output:
+	// ixrac 0.48
+	// qaz 0.48
+	// öt 0.34
+}
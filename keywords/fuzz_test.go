@@ -1,6 +1,7 @@
 package keywords
 
 import (
+	"reflect"
 	"slices"
 	"testing"
 )
@@ -61,3 +62,28 @@ func FuzzKeywords(f *testing.F) {
 		}
 	})
 }
+
+func FuzzExtractPhrases(f *testing.F) {
+	f.Add("neft hasilatı")
+	f.Add("Azərbaycan iqtisadiyyatı inkişaf edir")
+	f.Add("")
+	f.Add("və bu da o")
+	f.Add("\xff\xfe")
+	f.Add("Azerbaycan neft sektoru")
+	f.Add("yeni\nmetro stansiyası")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		a := ExtractPhrases(text, 5)
+		b := ExtractPhrases(text, 5)
+		if !reflect.DeepEqual(a, b) {
+			t.Errorf("non-deterministic:\n  a = %v\n  b = %v", a, b)
+		}
+		for _, p := range a {
+			for _, o := range p.Occurrences {
+				if o.Start < 0 || o.Start > o.End || o.End > len(text) {
+					t.Fatalf("occurrence [%d:%d] out of range for %d bytes", o.Start, o.End, len(text))
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package keywords
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Edge is an undirected, weighted co-occurrence link between two stems.
+// Weight counts how many times the stems appeared within the TextRank
+// sliding window of each other.
+type Edge struct {
+	Source string  `json:"source"` // lexicographically smaller stem
+	Target string  `json:"target"` // lexicographically larger stem
+	Weight float64 `json:"weight"` // co-occurrence count
+}
+
+// TermGraph is the co-occurrence graph TextRank ranks over. Nodes carry
+// their TextRank score and frequency. Encode with encoding/json for a
+// {"nodes": [...], "edges": [...]} document, or call DOT for Graphviz.
+type TermGraph struct {
+	Nodes []Keyword `json:"nodes"` // sorted by score descending, then stem
+	Edges []Edge    `json:"edges"` // sorted by source, then target
+}
+
+// Graph returns the TextRank co-occurrence graph of text, built with the
+// same pipeline and window as ExtractTextRank. Each undirected edge is
+// listed once. Returns a zero TermGraph for empty text or text exceeding
+// maxInputBytes.
+func Graph(text string) TermGraph {
+	filtered := pipeline(text, nil)
+	if len(filtered) == 0 {
+		return TermGraph{}
+	}
+
+	nodes, adj := buildGraph(filtered)
+	keywords := rankNodes(filtered, nodes, adj)
+	slices.SortStableFunc(keywords, cmpKeyword)
+
+	var edges []Edge
+	for i, neighbors := range adj {
+		for _, e := range neighbors {
+			a, b := nodes[i], nodes[e.to]
+			if a < b {
+				edges = append(edges, Edge{Source: a, Target: b, Weight: e.weight})
+			}
+		}
+	}
+	slices.SortFunc(edges, func(x, y Edge) int {
+		if c := strings.Compare(x.Source, y.Source); c != 0 {
+			return c
+		}
+		return strings.Compare(x.Target, y.Target)
+	})
+
+	return TermGraph{Nodes: keywords, Edges: edges}
+}
+
+// DOT renders the graph in Graphviz DOT format as an undirected graph.
+// Node attributes carry score and count; edge attributes carry weight.
+func (g TermGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("graph keywords {\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&b, "  %s [score=%.4f, count=%d];\n", strconv.Quote(n.Stem), n.Score, n.Count)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s -- %s [weight=%g];\n", strconv.Quote(e.Source), strconv.Quote(e.Target), e.Weight)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
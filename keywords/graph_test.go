@@ -0,0 +1,109 @@
+package keywords
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	text := "Azərbaycan iqtisadiyyatı sürətlə inkişaf edir"
+	g := Graph(text)
+
+	want := ExtractTextRank(text, 100)
+	if len(g.Nodes) != len(want) {
+		t.Fatalf("got %d nodes, want %d", len(g.Nodes), len(want))
+	}
+	for i := range want {
+		if g.Nodes[i] != want[i] {
+			t.Errorf("node[%d] = %+v, want %+v", i, g.Nodes[i], want[i])
+		}
+	}
+
+	wantEdges := []Edge{
+		{"azərbaycan", "iqtisadiyyat", 1},
+		{"azərbaycan", "sürət", 1},
+		{"inkişaf", "iqtisadiyyat", 1},
+		{"inkişaf", "sürət", 1},
+		{"iqtisadiyyat", "sürət", 1},
+	}
+	if len(g.Edges) != len(wantEdges) {
+		t.Fatalf("got %d edges, want %d: %+v", len(g.Edges), len(wantEdges), g.Edges)
+	}
+	for i, e := range wantEdges {
+		if g.Edges[i] != e {
+			t.Errorf("edge[%d] = %+v, want %+v", i, g.Edges[i], e)
+		}
+	}
+}
+
+func TestGraphEdgeWeights(t *testing.T) {
+	// "kitab" and "məktəb" co-occur in both sentences.
+	g := Graph("Kitab məktəb. Kitab məktəb.")
+	for _, e := range g.Edges {
+		if e.Source == "kitab" && e.Target == "məktəb" {
+			if e.Weight != 3 {
+				t.Errorf("kitab--məktəb weight = %v, want 3", e.Weight)
+			}
+			return
+		}
+	}
+	t.Errorf("kitab--məktəb edge not found: %+v", g.Edges)
+}
+
+func TestGraphEmpty(t *testing.T) {
+	for _, in := range []string{"", "və də", strings.Repeat("a", maxInputBytes+1)} {
+		g := Graph(in)
+		if g.Nodes != nil || g.Edges != nil {
+			t.Errorf("Graph(%.20q) = %+v, want zero", in, g)
+		}
+	}
+}
+
+func TestTermGraphJSON(t *testing.T) {
+	g := Graph("Azərbaycan iqtisadiyyatı sürətlə inkişaf edir")
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var back TermGraph
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(back.Nodes) != len(g.Nodes) || len(back.Edges) != len(g.Edges) {
+		t.Errorf("round-trip mismatch: %s", data)
+	}
+}
+
+func TestTermGraphDOT(t *testing.T) {
+	g := TermGraph{
+		Nodes: []Keyword{{Stem: "kitab", Score: 0.5, Count: 2}, {Stem: `a"b`, Score: 0.25, Count: 1}},
+		Edges: []Edge{{Source: `a"b`, Target: "kitab", Weight: 2}},
+	}
+	want := "graph keywords {\n" +
+		"  \"kitab\" [score=0.5000, count=2];\n" +
+		"  \"a\\\"b\" [score=0.2500, count=1];\n" +
+		"  \"a\\\"b\" -- \"kitab\" [weight=2];\n" +
+		"}\n"
+	if got := g.DOT(); got != want {
+		t.Errorf("DOT() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func BenchmarkGraph(b *testing.B) {
+	text := strings.Repeat("Azərbaycan iqtisadiyyatı sürətlə inkişaf edir. Neft sektoru liderdir. ", 50)
+	for b.Loop() {
+		Graph(text)
+	}
+}
+
+func ExampleGraph() {
+	g := Graph("Azərbaycan iqtisadiyyatı sürətlə inkişaf edir")
+	for _, e := range g.Edges[:2] {
+		fmt.Printf("%s -- %s (%g)\n", e.Source, e.Target, e.Weight)
+	}
+	// Output:
+	// azərbaycan -- iqtisadiyyat (1)
+	// azərbaycan -- sürət (1)
+}
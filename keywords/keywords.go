@@ -15,6 +15,39 @@
 //     stems, scores, and counts.
 //   - Convenience: Keywords returns []string of keyword stems.
 //
+// Options tune extraction for a domain without post-filtering results:
+// WithStopwords adds stopwords, WithMinStemLength changes the minimum stem
+// length, WithBoostTerms scales the scores of domain terms, and
+// WithPOSFilter keeps only words with the given part-of-speech tags.
+//
+// ExtractPhrases returns multi-word keyphrases ("neft hasilatı", "xarici
+// siyasət") scored RAKE-style over runs of nouns and adjectives, with the
+// byte offsets of every occurrence.
+//
+// ExtractFromStems runs TextRank over stems the caller has already
+// computed, optionally split into sentences, so that pipelines sharing
+// tokenizer and morph output across modules do not analyze the text twice.
+//
+// Graph exposes the TextRank co-occurrence graph itself, serializable to
+// JSON or Graphviz DOT for visualizing term networks.
+//
+// Corpus collects document frequencies over many documents for true
+// document-level IDF; it can be saved, loaded, and used to score new texts
+// for domain-specific extraction.
+//
+// ExtractBM25 scores a text against a Corpus with Okapi BM25, whose
+// saturating term frequency and length normalization against the corpus
+// average keep long documents from dominating as they do under TF-IDF.
+//
+// Vector turns a text into a sparse stem TF-IDF vector for document
+// comparison with Similarity (cosine); SimHash and SimHashDistance give
+// compact fingerprints for near-duplicate detection across large corpora.
+//
+// Trending and Tracker find rising terms in timestamped document streams
+// using exponentially time-decayed term statistics. TrendingWindow and
+// NewWindowTracker also restrict them to a sliding window, so terms that
+// stopped appearing drop out of a dashboard instead of fading slowly.
+//
 // All functions are safe for concurrent use by multiple goroutines.
 //
 // Known limitations:
@@ -44,6 +77,7 @@ import (
 	"github.com/az-ai-labs/az-lang-nlp/azcase"
 	"github.com/az-ai-labs/az-lang-nlp/morph"
 	"github.com/az-ai-labs/az-lang-nlp/normalize"
+	"github.com/az-ai-labs/az-lang-nlp/pos"
 	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
 )
 
@@ -69,12 +103,16 @@ type Keyword struct {
 	Count int     `json:"count"`
 }
 
-// pipeline runs normalize -> tokenize -> hyphen filter -> stem -> lowercase -> stopword filter.
-// Returns the filtered lowercase stems ready for scoring.
-func pipeline(text string) []string {
+// pipeline runs normalize -> tokenize -> hyphen filter -> [POS filter] -> stem -> lowercase -> stopword filter.
+// Returns the filtered lowercase stems ready for scoring. A nil o applies
+// the default settings.
+func pipeline(text string, o *options) []string {
 	if text == "" || len(text) > maxInputBytes {
 		return nil
 	}
+	if o == nil {
+		o = newOptions(nil)
+	}
 
 	clean := normalize.Normalize(text)
 	words := tokenizer.Words(clean)
@@ -88,15 +126,22 @@ func pipeline(text string) []string {
 		}
 	}
 
+	if o.posTags != nil {
+		kept := safe[:0]
+		for i, tr := range pos.Tag(safe) {
+			if slices.Contains(o.posTags, tr.Tag) {
+				kept = append(kept, safe[i])
+			}
+		}
+		safe = kept
+	}
+
 	stems := morph.Stems(safe)
 
 	filtered := make([]string, 0, len(stems))
 	for _, s := range stems {
 		low := azcase.ToLower(s)
-		if utf8.RuneCountInString(low) < minStemRunes {
-			continue
-		}
-		if isStopword(low) {
+		if o.isFiltered(low, utf8.RuneCountInString(low)) {
 			continue
 		}
 		filtered = append(filtered, low)
@@ -109,9 +154,12 @@ func pipeline(text string) []string {
 // TF is normalized by document length. IDF uses corpus-frequency proxy
 // from the embedded frequency dictionary.
 // Results are sorted by score descending, with lexicographic tie-breaking.
+// Options add stopwords, change the minimum stem length, boost domain
+// terms, or restrict keywords to parts of speech.
 // Returns nil for empty text or text exceeding maxInputBytes.
-func ExtractTFIDF(text string, topN int) []Keyword {
-	filtered := pipeline(text)
+func ExtractTFIDF(text string, topN int, opts ...Option) []Keyword {
+	o := newOptions(opts)
+	filtered := pipeline(text, o)
 	if len(filtered) == 0 {
 		return nil
 	}
@@ -119,7 +167,7 @@ func ExtractTFIDF(text string, topN int) []Keyword {
 		topN = defaultTopN
 	}
 
-	candidates := scoreTFIDF(filtered)
+	candidates := o.applyBoost(scoreTFIDF(filtered))
 	slices.SortStableFunc(candidates, cmpKeyword)
 
 	if len(candidates) > topN {
@@ -131,9 +179,11 @@ func ExtractTFIDF(text string, topN int) []Keyword {
 // ExtractTextRank returns the top keywords from text scored by TextRank.
 // Builds a co-occurrence graph and runs PageRank to rank terms.
 // Results are sorted by score descending, with lexicographic tie-breaking.
+// Options are those of ExtractTFIDF; boosts scale the PageRank scores.
 // Returns nil for empty text or text exceeding maxInputBytes.
-func ExtractTextRank(text string, topN int) []Keyword {
-	filtered := pipeline(text)
+func ExtractTextRank(text string, topN int, opts ...Option) []Keyword {
+	o := newOptions(opts)
+	filtered := pipeline(text, o)
 	if len(filtered) == 0 {
 		return nil
 	}
@@ -141,7 +191,7 @@ func ExtractTextRank(text string, topN int) []Keyword {
 		topN = defaultTopN
 	}
 
-	candidates := scoreTextRank(filtered)
+	candidates := o.applyBoost(scoreTextRank(filtered))
 	slices.SortStableFunc(candidates, cmpKeyword)
 
 	if len(candidates) > topN {
@@ -151,10 +201,10 @@ func ExtractTextRank(text string, topN int) []Keyword {
 }
 
 // Keywords returns the stems of the top 10 keywords from text using
-// TextRank with default parameters. Convenience wrapper over ExtractTextRank.
-// Returns nil when no keywords are found.
-func Keywords(text string) []string {
-	kws := ExtractTextRank(text, defaultTopN)
+// TextRank with default parameters, tuned by opts. Convenience wrapper over
+// ExtractTextRank. Returns nil when no keywords are found.
+func Keywords(text string, opts ...Option) []string {
+	kws := ExtractTextRank(text, defaultTopN, opts...)
 	if len(kws) == 0 {
 		return nil
 	}
@@ -0,0 +1,118 @@
+package keywords
+
+import (
+	"slices"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/pos"
+)
+
+// Option tunes keyword extraction by ExtractTFIDF, ExtractTextRank,
+// Keywords, and Corpus.ExtractTFIDF.
+type Option func(*options)
+
+// options holds the extraction settings. The zero value is not valid;
+// use newOptions.
+type options struct {
+	stopwords    map[string]struct{} // lowercase stems filtered in addition to the built-in list
+	minStemRunes int                 // minimum stem length in runes
+	boost        map[string]float64  // lowercase stem -> score multiplier
+	posTags      []pos.UPOS          // keep only words with these tags; nil keeps all
+}
+
+// newOptions returns the default settings with opts applied.
+func newOptions(opts []Option) *options {
+	o := &options{minStemRunes: minStemRunes}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithStopwords filters words in addition to the built-in stopword list,
+// e.g. boilerplate of a domain ("maddə", "bənd" in legal text). Words are
+// matched case-insensitively by their stem, so inflected forms are
+// filtered too.
+func WithStopwords(words ...string) Option {
+	return func(o *options) {
+		if o.stopwords == nil {
+			o.stopwords = make(map[string]struct{}, len(words))
+		}
+		for _, w := range words {
+			if w != "" {
+				o.stopwords[termStem(w)] = struct{}{}
+			}
+		}
+	}
+}
+
+// WithMinStemLength sets the minimum length in runes of a keyword stem.
+// The default is 2; values below 1 are treated as 1.
+func WithMinStemLength(n int) Option {
+	return func(o *options) {
+		o.minStemRunes = max(n, 1)
+	}
+}
+
+// WithBoostTerms multiplies the score of each listed term by its weight,
+// so that domain vocabulary ranks higher (weight > 1) or lower
+// (0 < weight < 1); a weight of 0 or less removes the term. Terms are
+// matched case-insensitively by their stem. Later calls add to earlier
+// ones.
+func WithBoostTerms(terms map[string]float64) Option {
+	return func(o *options) {
+		if o.boost == nil {
+			o.boost = make(map[string]float64, len(terms))
+		}
+		for term, w := range terms {
+			if term != "" {
+				o.boost[termStem(term)] = w
+			}
+		}
+	}
+}
+
+// WithPOSFilter keeps only words tagged with one of tags by pos.Tag, e.g.
+// pos.Noun and pos.PropN for topic terms. Without tags, words are not
+// filtered. Tagging adds the cost of the part-of-speech tagger to
+// extraction.
+func WithPOSFilter(tags ...pos.UPOS) Option {
+	return func(o *options) {
+		o.posTags = slices.Clone(tags)
+	}
+}
+
+// termStem returns the lowercase stem a user-supplied term is matched by.
+func termStem(term string) string {
+	return azcase.ToLower(morph.Stem(azcase.ComposeNFC(term)))
+}
+
+// isFiltered reports whether the lowercase stem is excluded as a
+// stopword or for its length.
+func (o *options) isFiltered(stem string, runes int) bool {
+	if runes < o.minStemRunes || isStopword(stem) {
+		return true
+	}
+	_, ok := o.stopwords[stem]
+	return ok
+}
+
+// applyBoost scales the scores of boosted terms, dropping terms whose
+// weight is 0 or less.
+func (o *options) applyBoost(kws []Keyword) []Keyword {
+	if len(o.boost) == 0 {
+		return kws
+	}
+	out := kws[:0]
+	for _, kw := range kws {
+		if w, ok := o.boost[kw.Stem]; ok {
+			if w <= 0 {
+				continue
+			}
+			kw.Score *= w
+		}
+		out = append(out, kw)
+	}
+	return out
+}
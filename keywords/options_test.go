@@ -0,0 +1,138 @@
+package keywords
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/pos"
+)
+
+const legalText = "Məhkəmə iddianı təmin etdi. Maddə 5 üzrə məhkəmə qərarı qəti oldu. " +
+	"Bakı məhkəməsi iddiaçının tələbini qismən təmin etdi. Maddənin ikinci bəndi tətbiq edildi. " +
+	"Şirkət məhkəmə qərarından narazıdır."
+
+func stemsOf(kws []Keyword) []string {
+	out := make([]string, len(kws))
+	for i, kw := range kws {
+		out[i] = kw.Stem
+	}
+	return out
+}
+
+func TestWithStopwords(t *testing.T) {
+	extractors := map[string]func(string, int, ...Option) []Keyword{
+		"TFIDF":    ExtractTFIDF,
+		"TextRank": ExtractTextRank,
+		"Corpus":   NewCorpus().ExtractTFIDF,
+	}
+	for name, extract := range extractors {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := stemsOf(extract(legalText, 20)); !slices.Contains(got, "maddə") {
+				t.Fatalf("default keywords %v lack maddə", got)
+			}
+			// "Maddənin" and "bəndi" are filtered by their stems.
+			got := stemsOf(extract(legalText, 20, WithStopwords("Maddə", "bəndlər")))
+			if slices.Contains(got, "maddə") || slices.Contains(got, "bənd") {
+				t.Errorf("keywords %v contain custom stopwords", got)
+			}
+			if !slices.Contains(got, "məhkəmə") {
+				t.Errorf("keywords %v lack məhkəmə", got)
+			}
+		})
+	}
+}
+
+func TestWithMinStemLength(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		min  int
+	}{
+		{"longer", 6, 6},
+		{"default", 2, 2},
+		{"clamped", -3, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ExtractTFIDF(legalText, 50, WithMinStemLength(tt.n))
+			if len(got) == 0 {
+				t.Fatal("no keywords")
+			}
+			for _, kw := range got {
+				if n := utf8.RuneCountInString(kw.Stem); n < tt.min {
+					t.Errorf("stem %q has %d runes, want at least %d", kw.Stem, n, tt.min)
+				}
+			}
+		})
+	}
+	if got := ExtractTFIDF("Bakı və Gəncə", 10, WithMinStemLength(5)); !slices.Equal(stemsOf(got), []string{"gəncə"}) {
+		t.Errorf("got %v, want [gəncə]", stemsOf(got))
+	}
+}
+
+func TestWithBoostTerms(t *testing.T) {
+	boost := WithBoostTerms(map[string]float64{"qərarlar": 5, "Məhkəmə": 0})
+	for name, extract := range map[string]func(string, int, ...Option) []Keyword{
+		"TFIDF":    ExtractTFIDF,
+		"TextRank": ExtractTextRank,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			base := extract(legalText, 50)
+			got := extract(legalText, 50, boost)
+			if len(got) == 0 || got[0].Stem != "qərar" {
+				t.Fatalf("top keyword = %v, want qərar", got)
+			}
+			if slices.Contains(stemsOf(got), "məhkəmə") {
+				t.Errorf("keywords %v contain a term with weight 0", stemsOf(got))
+			}
+			i := slices.IndexFunc(base, func(kw Keyword) bool { return kw.Stem == "qərar" })
+			if i < 0 || got[0].Score != base[i].Score*5 {
+				t.Errorf("boosted score = %v, want 5 × default", got[0].Score)
+			}
+		})
+	}
+}
+
+func TestWithPOSFilter(t *testing.T) {
+	nouns := stemsOf(ExtractTextRank(legalText, 50, WithPOSFilter(pos.Noun, pos.PropN)))
+	if !slices.Contains(nouns, "məhkəmə") || !slices.Contains(nouns, "bakı") {
+		t.Errorf("noun keywords %v lack məhkəmə or bakı", nouns)
+	}
+	for _, adj := range []string{"qəti", "qismən", "ikinci"} {
+		if slices.Contains(nouns, adj) {
+			t.Errorf("noun keywords %v contain %q", nouns, adj)
+		}
+	}
+	if got, want := ExtractTFIDF(legalText, 50, WithPOSFilter()), ExtractTFIDF(legalText, 50); !slices.Equal(got, want) {
+		t.Errorf("empty POS filter = %v, want %v", stemsOf(got), stemsOf(want))
+	}
+}
+
+func TestKeywordsOptions(t *testing.T) {
+	got := Keywords(legalText, WithStopwords("maddə"), WithBoostTerms(map[string]float64{"şirkət": 10}))
+	if len(got) == 0 || got[0] != "şirkət" {
+		t.Errorf("Keywords = %v, want şirkət first", got)
+	}
+	if slices.Contains(got, "maddə") {
+		t.Errorf("Keywords = %v contain a custom stopword", got)
+	}
+}
+
+func BenchmarkExtractTextRankPOSFilter(b *testing.B) {
+	opt := WithPOSFilter(pos.Noun, pos.PropN, pos.Adj)
+	for b.Loop() {
+		ExtractTextRank(benchText, 10, opt)
+	}
+}
+
+func ExampleWithStopwords() {
+	text := "Maddə 5 üzrə məhkəmə qərarı qəti oldu. Maddənin ikinci bəndi tətbiq edildi."
+	fmt.Println(Keywords(text, WithStopwords("maddə", "bənd"), WithMinStemLength(4)))
+	// Output:
+	// [ikinci qərar qəti məhkəmə tətbiq edil üzrə]
+}
@@ -0,0 +1,238 @@
+package keywords
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/normalize"
+	"github.com/az-ai-labs/az-lang-nlp/pos"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const (
+	minPhraseWords = 2 // shortest phrase returned by ExtractPhrases
+	maxPhraseWords = 4 // longer candidate runs are discarded, as in RAKE
+)
+
+// Keyphrase is a multi-word phrase ranked by ExtractPhrases.
+type Keyphrase struct {
+	Text        string       `json:"text"`        // most frequent surface form, lowercased
+	Stems       []string     `json:"stems"`       // lowercase stems of the phrase words
+	Score       float64      `json:"score"`       // sum of the degree/frequency ratios of its words
+	Count       int          `json:"count"`       // number of occurrences
+	Occurrences []Occurrence `json:"occurrences"` // byte spans in the input text, in text order
+}
+
+// Occurrence is one appearance of a keyphrase in the input text.
+type Occurrence struct {
+	Start int `json:"start"` // byte offset (inclusive)
+	End   int `json:"end"`   // byte offset (exclusive)
+}
+
+// candidate is one run of content words found by phraseCandidates.
+type candidate struct {
+	stems   []string
+	surface string // lowercased normalized text of the run
+	start   int    // byte offset in the input text (inclusive)
+	end     int    // byte offset in the input text (exclusive)
+}
+
+// ExtractPhrases returns the top multi-word keyphrases of text ("neft
+// hasilatı", "xarici siyasət") using RAKE-style scoring.
+//
+// Candidates are runs of nouns, proper nouns, and adjectives (tagged with
+// pos.Tag) delimited by stopwords, other parts of speech, punctuation, and
+// line breaks; a word carrying a case suffix other than the genitive ends
+// its run. Runs of more than four words are discarded. Each stem scores
+// its co-occurrence degree divided by its frequency across all runs, and a
+// phrase scores the sum of its stems' scores. Occurrences whose words
+// share the same stems are merged into one Keyphrase with the offsets of
+// each occurrence. Single words take part in scoring but are not returned;
+// use ExtractTextRank or ExtractTFIDF for those.
+//
+// Results are sorted by score descending, then by text. Returns nil for
+// empty text or text exceeding maxInputBytes.
+func ExtractPhrases(text string, topN int) []Keyphrase {
+	if text == "" || len(text) > maxInputBytes {
+		return nil
+	}
+	if topN <= 0 {
+		topN = defaultTopN
+	}
+
+	cands := phraseCandidates(text)
+	freq := make(map[string]int)
+	degree := make(map[string]int)
+	for _, c := range cands {
+		for _, s := range c.stems {
+			freq[s]++
+			degree[s] += len(c.stems)
+		}
+	}
+
+	var phrases []Keyphrase
+	index := make(map[string]int)
+	surfaces := make(map[string]map[string]int)
+	for _, c := range cands {
+		if len(c.stems) < minPhraseWords {
+			continue
+		}
+		key := strings.Join(c.stems, " ")
+		i, ok := index[key]
+		if !ok {
+			i = len(phrases)
+			index[key] = i
+			surfaces[key] = make(map[string]int)
+			score := 0.0
+			for _, s := range c.stems {
+				score += float64(degree[s]) / float64(freq[s])
+			}
+			phrases = append(phrases, Keyphrase{Text: c.surface, Stems: c.stems, Score: score})
+		}
+		p := &phrases[i]
+		p.Count++
+		p.Occurrences = append(p.Occurrences, Occurrence{Start: c.start, End: c.end})
+		surfaces[key][c.surface]++
+		if n := surfaces[key][c.surface]; n > surfaces[key][p.Text] {
+			p.Text = c.surface
+		}
+	}
+	if len(phrases) == 0 {
+		return nil
+	}
+
+	slices.SortStableFunc(phrases, func(a, b Keyphrase) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Text, b.Text)
+	})
+	if len(phrases) > topN {
+		phrases = phrases[:topN]
+	}
+	return phrases
+}
+
+// phraseCandidates splits text into runs of content words, with offsets
+// mapped back to text.
+func phraseCandidates(text string) []candidate {
+	norm, offsets := normalize.New().NormalizeWithMap(text)
+	tokens := tokenizer.WordTokens(norm)
+
+	var (
+		words []string
+		idx   []int // token index of each word
+	)
+	for i, tok := range tokens {
+		if tok.Type != tokenizer.Space {
+			words = append(words, tok.Text)
+			idx = append(idx, i)
+		}
+	}
+	tags := pos.Tag(words)
+
+	var (
+		cands []candidate
+		run   []int // indices into words
+	)
+	flush := func() {
+		if len(run) > 0 && len(run) <= maxPhraseWords {
+			first, last := tokens[idx[run[0]]], tokens[idx[run[len(run)-1]]]
+			stems := make([]string, len(run))
+			for i, w := range run {
+				stems[i] = azcase.ToLower(morph.Stem(words[w]))
+			}
+			start, end := offsets.OriginalRange(first.Start, last.End)
+			cands = append(cands, candidate{
+				stems:   stems,
+				surface: azcase.ToLower(norm[first.Start:last.End]),
+				start:   start,
+				end:     end,
+			})
+		}
+		run = run[:0]
+	}
+
+	next := 0 // next token index not yet visited
+	for w, tr := range tags {
+		// A line break between words ends the run.
+		for _, tok := range tokens[next:idx[w]] {
+			if strings.ContainsAny(tok.Text, "\r\n") {
+				flush()
+			}
+		}
+		next = idx[w] + 1
+
+		if !isPhraseWord(tokens[idx[w]], tr) {
+			flush()
+			continue
+		}
+		run = append(run, w)
+		if hasObliqueCase(words[w]) {
+			flush()
+		}
+	}
+	flush()
+	return cands
+}
+
+// isPhraseWord reports whether a tagged token can be part of a keyphrase.
+// Predicates with a copula (gözəldir) are excluded.
+func isPhraseWord(tok tokenizer.Token, tr pos.TagResult) bool {
+	if tok.Type != tokenizer.Word || strings.Count(tok.Text, "-") >= maxHyphenParts {
+		return false
+	}
+	switch tr.Tag {
+	case pos.Noun, pos.PropN, pos.Adj:
+	default:
+		return false
+	}
+	stem := azcase.ToLower(morph.Stem(tok.Text))
+	if utf8.RuneCountInString(stem) < minStemRunes || isStopword(stem) {
+		return false
+	}
+	return !slices.ContainsFunc(stemAnalyses(tok.Text), func(a morph.Analysis) bool {
+		return slices.ContainsFunc(a.Morphemes, func(m morph.Morpheme) bool { return m.Tag == morph.Copula })
+	})
+}
+
+// hasObliqueCase reports whether word carries a case suffix other than
+// the genitive in every analysis of its stem, which ends a noun phrase.
+// Ambiguous forms (siyasəti: accusative or possessive) do not.
+func hasObliqueCase(word string) bool {
+	analyses := stemAnalyses(word)
+	if len(analyses) == 0 {
+		return false
+	}
+	for _, a := range analyses {
+		if !slices.ContainsFunc(a.Morphemes, func(m morph.Morpheme) bool {
+			switch m.Tag {
+			case morph.CaseDat, morph.CaseAcc, morph.CaseLoc, morph.CaseAbl, morph.CaseIns:
+				return true
+			}
+			return false
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// stemAnalyses returns the analyses of word whose stem is the one chosen
+// by morph.Stem.
+func stemAnalyses(word string) []morph.Analysis {
+	stem := morph.Stem(word)
+	var out []morph.Analysis
+	for _, a := range morph.Analyze(word) {
+		if a.Stem == stem {
+			out = append(out, a)
+		}
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package keywords
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const phraseText = "Azərbaycanda neft hasilatı artdı. Xarici siyasət vacibdir. " +
+	"Ölkənin xarici siyasəti uğurludur.\nBakı şəhərində yeni metro stansiyası açılıb. " +
+	"Yeni metro stansiyası gözəldir."
+
+func TestExtractPhrases(t *testing.T) {
+	got := ExtractPhrases(phraseText, 0)
+	want := []struct {
+		text  string
+		count int
+	}{
+		{"yeni metro stansiyası", 2},
+		{"ölkənin xarici siyasəti", 1},
+		{"xarici siyasət", 1},
+		{"bakı şəhərində", 1},
+		{"neft hasilatı", 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d phrases %+v, want %d", len(got), got, len(want))
+	}
+	for i, w := range want {
+		p := got[i]
+		if p.Text != w.text || p.Count != w.count || len(p.Occurrences) != w.count {
+			t.Errorf("phrase[%d] = %q (count %d), want %q (count %d)", i, p.Text, p.Count, w.text, w.count)
+		}
+		for _, o := range p.Occurrences {
+			if !strings.EqualFold(phraseText[o.Start:o.End], p.Text) {
+				t.Errorf("%q: occurrence [%d:%d] = %q", p.Text, o.Start, o.End, phraseText[o.Start:o.End])
+			}
+		}
+		if i > 0 && got[i-1].Score < p.Score {
+			t.Errorf("phrase[%d] score %v > phrase[%d] score %v", i, p.Score, i-1, got[i-1].Score)
+		}
+	}
+}
+
+func TestExtractPhrasesBoundaries(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"stopword", "neft və qaz sənayesi", []string{"qaz sənayesi"}},
+		{"verb", "Neft hasilatı artdı", []string{"neft hasilatı"}},
+		{"punctuation", "neft, qaz sənayesi", []string{"qaz sənayesi"}},
+		{"line break", "neft\nqaz sənayesi", []string{"qaz sənayesi"}},
+		{"oblique case ends run", "Bakıda neft sənayesi", []string{"neft sənayesi"}},
+		{"too long", "böyük yeni neft qaz sənaye kompleksi", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, p := range ExtractPhrases(tt.text, 0) {
+				got = append(got, p.Text)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("ExtractPhrases(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractPhrasesOffsetsAfterNormalize(t *testing.T) {
+	// Diacritic restoration changes byte lengths; offsets refer to the input.
+	text := "Azerbaycan neft sektoru. Azərbaycan neft sektoru."
+	got := ExtractPhrases(text, 0)
+	if len(got) != 1 || got[0].Count != 2 {
+		t.Fatalf("got %+v, want one phrase with two occurrences", got)
+	}
+	for _, o := range got[0].Occurrences {
+		if !strings.HasSuffix(text[o.Start:o.End], "baycan neft sektoru") {
+			t.Errorf("occurrence [%d:%d] = %q", o.Start, o.End, text[o.Start:o.End])
+		}
+	}
+}
+
+func TestExtractPhrasesEdgeCases(t *testing.T) {
+	if got := ExtractPhrases("", 5); got != nil {
+		t.Errorf("empty: got %v, want nil", got)
+	}
+	if got := ExtractPhrases(strings.Repeat("a", maxInputBytes+1), 5); got != nil {
+		t.Errorf("oversized: got %d phrases, want nil", len(got))
+	}
+	if got := ExtractPhrases("Kitab.", 5); got != nil {
+		t.Errorf("single word: got %v, want nil", got)
+	}
+	if got := ExtractPhrases(phraseText, 2); len(got) != 2 {
+		t.Errorf("topN=2: got %d phrases, want 2", len(got))
+	}
+}
+
+func BenchmarkExtractPhrases(b *testing.B) {
+	for b.Loop() {
+		ExtractPhrases(phraseText, 0)
+	}
+}
+
+func ExampleExtractPhrases() {
+	for _, p := range ExtractPhrases("Neft hasilatı artdı. Neft hasilatı vacibdir. Xarici siyasət vacibdir.", 2) {
+		fmt.Printf("%s (count=%d)\n", p.Text, p.Count)
+	}
+	// Output:
+	// neft hasilatı (count=2)
+	// xarici siyasət (count=1)
+}
@@ -0,0 +1,129 @@
+package keywords
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+// simHashBits is the width of a SimHash fingerprint.
+const simHashBits = 64
+
+// SparseVector is a document vector mapping lowercase stems to TF-IDF
+// weights, as produced by the ExtractTFIDF pipeline.
+type SparseVector map[string]float64
+
+// Vector returns the TF-IDF weights of every stem of text, using the
+// embedded corpus-frequency IDF proxy. Compare vectors with Similarity.
+// Returns nil for empty text, text with no keyword stems, or text
+// exceeding maxInputBytes.
+func Vector(text string) SparseVector {
+	return vectorOf(scoreTFIDF(pipeline(text, nil)))
+}
+
+// Vector is like the package-level Vector but takes IDF from the corpus,
+// so that terms shared by every document of the domain carry little
+// weight. The text is not added to the corpus.
+func (c *Corpus) Vector(text string) SparseVector {
+	return vectorOf(c.scoreTFIDF(pipeline(text, nil)))
+}
+
+// vectorOf converts scored keywords into a SparseVector.
+func vectorOf(kws []Keyword) SparseVector {
+	if len(kws) == 0 {
+		return nil
+	}
+	v := make(SparseVector, len(kws))
+	for _, k := range kws {
+		v[k.Stem] = k.Score
+	}
+	return v
+}
+
+// Similarity returns the cosine similarity of a and b: 1.0 for texts with
+// proportional stem weights, 0.0 for texts with no stem in common or when
+// either vector is empty. Weights are non-negative, so the result is
+// always in [0, 1].
+func Similarity(a, b SparseVector) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var dot float64
+	for _, s := range a.stems() {
+		dot += a[s] * b[s]
+	}
+	na, nb := a.norm(), b.norm()
+	if dot == 0 || na == 0 || nb == 0 {
+		return 0
+	}
+	return min(dot/(na*nb), 1)
+}
+
+// norm returns the Euclidean length of v.
+func (v SparseVector) norm() float64 {
+	var sum float64
+	for _, s := range v.stems() {
+		sum += v[s] * v[s]
+	}
+	return math.Sqrt(sum)
+}
+
+// stems returns the stems of v in sorted order, so that floating-point
+// sums over v do not depend on map iteration order.
+func (v SparseVector) stems() []string {
+	stems := make([]string, 0, len(v))
+	for s := range v {
+		stems = append(stems, s)
+	}
+	slices.Sort(stems)
+	return stems
+}
+
+// SimHash returns a 64-bit SimHash fingerprint of text for near-duplicate
+// detection over large collections: texts with similar stem weights get
+// fingerprints that differ in few bits (see SimHashDistance). Each stem is
+// hashed with 64-bit FNV-1a and contributes its Vector weight to every bit
+// position. Word order and stopwords do not affect the fingerprint.
+// Returns 0 when Vector(text) is empty.
+func SimHash(text string) uint64 {
+	return Vector(text).SimHash()
+}
+
+// SimHash returns the SimHash fingerprint of v, as the package-level
+// SimHash does for a text. Use it with Corpus.Vector for domain weights.
+func (v SparseVector) SimHash() uint64 {
+	if len(v) == 0 {
+		return 0
+	}
+	var acc [simHashBits]float64
+	h := fnv.New64a()
+	for _, stem := range v.stems() {
+		w := v[stem]
+		h.Reset()
+		h.Write([]byte(stem))
+		sum := h.Sum64()
+		for i := range acc {
+			if sum&(1<<i) != 0 {
+				acc[i] += w
+			} else {
+				acc[i] -= w
+			}
+		}
+	}
+
+	var fp uint64
+	for i, a := range acc {
+		if a > 0 {
+			fp |= 1 << i
+		}
+	}
+	return fp
+}
+
+// SimHashDistance returns the number of differing bits between two SimHash
+// fingerprints, from 0 (identical stem profile) to 64. Distances of 3 or
+// less are the usual threshold for near-duplicate documents.
+func SimHashDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
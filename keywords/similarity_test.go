@@ -0,0 +1,123 @@
+package keywords
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+const (
+	simNews     = "Neft hasilatı artır, neft qiyməti bazarda sabitdir. Şirkət yeni yataq kəşf etdi."
+	simReworded = "Şirkət yeni yataq kəşf etdi. Neft qiyməti bazarda sabitdir, neft hasilatı artır."
+	simRelated  = "Neft ixracı Avropaya yönəldi, qaz ixracı da artdı."
+	simOther    = "Futbol komandası çempionatda qalib gəldi, azarkeşlər sevindi."
+)
+
+func TestVector(t *testing.T) {
+	v := Vector(simNews)
+	if len(v) == 0 {
+		t.Fatal("Vector returned no stems")
+	}
+	if v["neft"] <= v["yataq"] {
+		t.Errorf("weight of repeated neft %v not above yataq %v", v["neft"], v["yataq"])
+	}
+	for stem, w := range v {
+		if w <= 0 {
+			t.Errorf("weight of %q = %v, want positive", stem, w)
+		}
+	}
+	if got := Vector(""); got != nil {
+		t.Errorf("Vector(\"\") = %v, want nil", got)
+	}
+	if got := Vector(strings.Repeat("a", maxInputBytes+1)); got != nil {
+		t.Error("Vector(oversized) returned stems, want nil")
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	news := Vector(simNews)
+	tests := []struct {
+		name   string
+		b      SparseVector
+		lo, hi float64
+	}{
+		{"identical", news, 1, 1},
+		{"reordered sentences", Vector(simReworded), 1, 1},
+		{"shared topic", Vector(simRelated), 0.01, 0.5},
+		{"unrelated", Vector(simOther), 0, 0},
+		{"empty", nil, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Similarity(news, tt.b)
+			if got < tt.lo-1e-9 || got > tt.hi+1e-9 {
+				t.Errorf("Similarity = %v, want in [%v, %v]", got, tt.lo, tt.hi)
+			}
+			if rev := Similarity(tt.b, news); rev != got {
+				t.Errorf("Similarity not symmetric: %v vs %v", got, rev)
+			}
+		})
+	}
+}
+
+func TestCorpusVector(t *testing.T) {
+	c := trainedCorpus()
+	v := c.Vector("Neft və qaz ixracı artdı.")
+	// neft occurs in every corpus document and gets the minimum IDF.
+	if v["neft"] >= v["qaz"] {
+		t.Errorf("corpus weight of neft %v not below qaz %v", v["neft"], v["qaz"])
+	}
+	if got := c.Vector(""); got != nil {
+		t.Errorf("Corpus.Vector(\"\") = %v, want nil", got)
+	}
+}
+
+func TestSimHash(t *testing.T) {
+	news := SimHash(simNews)
+	if news == 0 {
+		t.Fatal("SimHash returned 0 for non-empty text")
+	}
+	if got := SimHash(simNews); got != news {
+		t.Errorf("SimHash not deterministic: %x vs %x", got, news)
+	}
+	if d := SimHashDistance(news, SimHash(simReworded)); d != 0 {
+		t.Errorf("distance to reordered text = %d, want 0", d)
+	}
+	nearDup := simNews + " Hasilat artır."
+	near := SimHashDistance(news, SimHash(nearDup))
+	far := SimHashDistance(news, SimHash(simOther))
+	if near >= far {
+		t.Errorf("near-duplicate distance %d not below unrelated distance %d", near, far)
+	}
+	if got := SimHash(""); got != 0 {
+		t.Errorf("SimHash(\"\") = %x, want 0", got)
+	}
+	if d := SimHashDistance(0, math.MaxUint64); d != 64 {
+		t.Errorf("SimHashDistance(0, max) = %d, want 64", d)
+	}
+}
+
+func BenchmarkSimilarity(b *testing.B) {
+	x, y := Vector(simNews), Vector(simRelated)
+	for b.Loop() {
+		Similarity(x, y)
+	}
+}
+
+func ExampleSimilarity() {
+	a := Vector("Neft hasilatı artır, neft qiyməti sabitdir.")
+	b := Vector("Neft qiyməti sabitdir, neft hasilatı artır.")
+	c := Vector("Futbol komandası çempionatda qalib gəldi.")
+	fmt.Printf("%.2f %.2f\n", Similarity(a, b), Similarity(a, c))
+	// Output:
+	// 1.00 0.00
+}
+
+func ExampleSimHashDistance() {
+	a := SimHash("Neft hasilatı artır, neft qiyməti sabitdir.")
+	b := SimHash("Neft qiyməti sabitdir, neft hasilatı artır.")
+	fmt.Println(SimHashDistance(a, b))
+	// Output:
+	// 0
+}
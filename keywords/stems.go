@@ -0,0 +1,68 @@
+package keywords
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// ExtractFromStems returns the top n keywords scored by TextRank over
+// stems a caller has already produced, e.g. with tokenizer.Words and
+// morph.Stems, so that a pipeline running several modules tokenizes and
+// stems the text only once.
+//
+// sentences, when non-nil, supplies the stems sentence by sentence in
+// place of stems, and co-occurrence windows do not cross sentence
+// boundaries; pass nil to score stems as one run. Stems are lowercased
+// and filtered like the stems of ExtractTextRank, but no normalization,
+// tokenization, or stemming is applied, so results match ExtractTextRank
+// only for stems produced the same way. WithPOSFilter has no effect, as
+// stems carry no part of speech.
+// Results are sorted by score descending, with lexicographic tie-breaking.
+// Returns nil when no stems remain or the stems exceed maxInputBytes in
+// total.
+func ExtractFromStems(stems []string, sentences [][]string, n int, opts ...Option) []Keyword {
+	if sentences == nil {
+		sentences = [][]string{stems}
+	}
+	total := 0
+	for _, sent := range sentences {
+		for _, s := range sent {
+			total += len(s)
+		}
+	}
+	if total > maxInputBytes {
+		return nil
+	}
+
+	o := newOptions(opts)
+	runs := make([][]string, 0, len(sentences))
+	var flat []string
+	for _, sent := range sentences {
+		start := len(flat)
+		for _, s := range sent {
+			low := azcase.ToLower(azcase.ComposeNFC(s))
+			if low == "" || o.isFiltered(low, utf8.RuneCountInString(low)) {
+				continue
+			}
+			flat = append(flat, low)
+		}
+		runs = append(runs, flat[start:len(flat):len(flat)])
+	}
+	if len(flat) == 0 {
+		return nil
+	}
+	if n <= 0 {
+		n = defaultTopN
+	}
+
+	nodes, edges := buildRunGraph(runs)
+	candidates := o.applyBoost(rankNodes(flat, nodes, edges))
+	slices.SortStableFunc(candidates, cmpKeyword)
+
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
+}
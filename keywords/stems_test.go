@@ -0,0 +1,107 @@
+package keywords
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/normalize"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const stemsSample = "Azərbaycan iqtisadiyyatı sürətlə inkişaf edir. Azərbaycan regionun ən böyük iqtisadiyyatına malikdir. Azərbaycan iqtisadiyyatı neft sektoruna əsaslanır."
+
+func TestExtractFromStemsMatchesTextRank(t *testing.T) {
+	t.Parallel()
+
+	stems := morph.Stems(tokenizer.Words(normalize.Normalize(stemsSample)))
+	for _, n := range []int{0, 3, 100} {
+		got := ExtractFromStems(stems, nil, n)
+		want := ExtractTextRank(stemsSample, n)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("n=%d: ExtractFromStems = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestExtractFromStemsSentences(t *testing.T) {
+	t.Parallel()
+
+	// Within one run, "neft" and "bank" share a window; split into two
+	// sentences, they do not.
+	stems := []string{"neft", "bank"}
+	joined := ExtractFromStems(stems, nil, 0)
+	split := ExtractFromStems(nil, [][]string{{"neft"}, {"bank"}}, 0)
+	if len(joined) != 2 || len(split) != 2 {
+		t.Fatalf("got %v and %v, want two keywords each", joined, split)
+	}
+	if joined[0].Score <= split[0].Score {
+		t.Errorf("connected score %v not above isolated score %v", joined[0].Score, split[0].Score)
+	}
+
+	var sentences [][]string
+	for _, sent := range tokenizer.Sentences(stemsSample) {
+		sentences = append(sentences, morph.Stems(tokenizer.Words(sent)))
+	}
+	got := ExtractFromStems(nil, sentences, 3)
+	if len(got) == 0 || got[0].Stem != "azərbaycan" && got[0].Stem != "iqtisadiyyat" {
+		t.Errorf("ExtractFromStems(sentences) = %v", got)
+	}
+	if got[0].Count != 3 {
+		t.Errorf("Count of %q = %d, want 3", got[0].Stem, got[0].Count)
+	}
+}
+
+func TestExtractFromStemsFiltering(t *testing.T) {
+	t.Parallel()
+
+	stems := []string{"Kitab", "və", "bu", "a", "KİTAB", "neft", "maddə", ""}
+	got := ExtractFromStems(stems, nil, 0, WithStopwords("maddə"), WithBoostTerms(map[string]float64{"neft": 0}))
+	if len(got) != 1 || got[0].Stem != "kitab" || got[0].Count != 2 {
+		t.Errorf("ExtractFromStems = %v, want only kitab (count 2)", got)
+	}
+}
+
+func TestExtractFromStemsEmpty(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		stems     []string
+		sentences [][]string
+	}{
+		{"nil", nil, nil},
+		{"stopwords only", []string{"və", "bu"}, nil},
+		{"empty sentences", nil, [][]string{{}, {}}},
+		{"oversized", []string{strings.Repeat("a", maxInputBytes+1)}, nil},
+	}
+	for _, tt := range tests {
+		if got := ExtractFromStems(tt.stems, tt.sentences, 5); got != nil {
+			t.Errorf("%s: ExtractFromStems = %v, want nil", tt.name, got)
+		}
+	}
+}
+
+func BenchmarkExtractFromStems(b *testing.B) {
+	stems := morph.Stems(tokenizer.Words(strings.Repeat(stemsSample+" ", 20)))
+	for b.Loop() {
+		ExtractFromStems(stems, nil, 10)
+	}
+}
+
+func ExampleExtractFromStems() {
+	// Stems computed once, e.g. by a pipeline that also needs them elsewhere.
+	var sentences [][]string
+	for _, s := range tokenizer.Sentences("Neft hasilatı artır. Neft ixracı da artır.") {
+		sentences = append(sentences, morph.Stems(tokenizer.Words(s)))
+	}
+	for _, kw := range ExtractFromStems(nil, sentences, 3) {
+		fmt.Println(kw.Stem, kw.Count)
+	}
+	// Output:
+	// art 2
+	// neft 2
+	// hasilat 1
+}
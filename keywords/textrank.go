@@ -7,6 +7,12 @@ import (
 
 func scoreTextRank(stems []string) []Keyword {
 	nodes, edges := buildGraph(stems)
+	return rankNodes(stems, nodes, edges)
+}
+
+// rankNodes runs PageRank over a built graph and pairs each node with
+// its score and frequency in stems.
+func rankNodes(stems, nodes []string, edges [][]edge) []Keyword {
 	scores := pagerank(edges)
 
 	freq := make(map[string]int, len(nodes))
@@ -28,11 +34,19 @@ type edge struct {
 }
 
 func buildGraph(stems []string) (nodes []string, edges [][]edge) {
+	return buildRunGraph([][]string{stems})
+}
+
+// buildRunGraph is buildGraph over several runs of stems, such as the
+// sentences of a text; co-occurrence windows do not cross runs.
+func buildRunGraph(runs [][]string) (nodes []string, edges [][]edge) {
 	index := make(map[string]int)
-	for _, s := range stems {
-		if _, ok := index[s]; !ok {
-			index[s] = len(nodes)
-			nodes = append(nodes, s)
+	for _, stems := range runs {
+		for _, s := range stems {
+			if _, ok := index[s]; !ok {
+				index[s] = len(nodes)
+				nodes = append(nodes, s)
+			}
 		}
 	}
 
@@ -42,14 +56,16 @@ func buildGraph(stems []string) (nodes []string, edges [][]edge) {
 		edgeMaps[i] = make(map[int]float64)
 	}
 
-	for i, s := range stems {
-		si := index[s]
-		end := min(i+textrankWindowSize, len(stems))
-		for j := i + 1; j < end; j++ {
-			sj := index[stems[j]]
-			if si != sj {
-				edgeMaps[si][sj]++
-				edgeMaps[sj][si]++
+	for _, stems := range runs {
+		for i, s := range stems {
+			si := index[s]
+			end := min(i+textrankWindowSize, len(stems))
+			for j := i + 1; j < end; j++ {
+				sj := index[stems[j]]
+				if si != sj {
+					edgeMaps[si][sj]++
+					edgeMaps[sj][si]++
+				}
 			}
 		}
 	}
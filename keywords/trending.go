@@ -0,0 +1,360 @@
+package keywords
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHalfLife   = 24 * time.Hour // Tracker half-life when a non-positive one is given
+	baselineHalfLives = 4              // baseline half-life as a multiple of the trend half-life
+	maxTrackedStems   = maxCandidates  // Tracker prunes beyond this many stems
+	minTrendGrowth    = 1.0            // growth at or below this is not rising
+)
+
+// TimestampedDoc is a document with its publication time.
+type TimestampedDoc struct {
+	Text string    `json:"text"`
+	Time time.Time `json:"time"`
+}
+
+// TrendingKeyword is a rising term with its time-decayed score.
+type TrendingKeyword struct {
+	Stem   string  `json:"stem"`
+	Score  float64 `json:"score"`  // decayed frequency at the evaluation time
+	Growth float64 `json:"growth"` // recent share / baseline share; > 1 means rising
+	Count  int     `json:"count"`  // raw occurrences across all documents in the window
+}
+
+// excess returns the recent score above what the baseline share predicts.
+func (k TrendingKeyword) excess() float64 {
+	return k.Score - k.Score/k.Growth
+}
+
+// decayed is an exponentially decaying accumulator, decayed lazily to the
+// time of the latest update.
+type decayed struct {
+	value float64
+	at    time.Time
+}
+
+// add decays the accumulator to t and adds v. Updates older than the
+// accumulator's time are decayed into it without moving it backwards.
+func (d *decayed) add(v float64, t time.Time, halfLife time.Duration) {
+	switch {
+	case d.at.IsZero():
+		d.value, d.at = v, t
+	case t.After(d.at):
+		d.value = d.value*decayFactor(t.Sub(d.at), halfLife) + v
+		d.at = t
+	default:
+		d.value += v * decayFactor(d.at.Sub(t), halfLife)
+	}
+}
+
+// valueAt returns the accumulator decayed to t (not below its own time).
+func (d decayed) valueAt(t time.Time, halfLife time.Duration) float64 {
+	if !t.After(d.at) {
+		return d.value
+	}
+	return d.value * decayFactor(t.Sub(d.at), halfLife)
+}
+
+// decayFactor returns 2^(-elapsed/halfLife).
+func decayFactor(elapsed, halfLife time.Duration) float64 {
+	return math.Exp2(-float64(elapsed) / float64(halfLife))
+}
+
+// trackedStem holds the incremental statistics for one stem.
+type trackedStem struct {
+	recent   decayed // decays with the trend half-life
+	baseline decayed // decays with baselineHalfLives × half-life
+	count    int
+}
+
+// remove subtracts n occurrences published at t, the inverse of the
+// updates in Tracker.Add.
+func (ts *trackedStem) remove(n int, t time.Time, halfLife time.Duration) {
+	ts.recent.add(-float64(n), t, halfLife)
+	ts.baseline.add(-float64(n), t, halfLife*baselineHalfLives)
+	ts.count -= n
+}
+
+// windowDoc is a document inside a Tracker's sliding window, kept so its
+// contribution can be subtracted once it expires.
+type windowDoc struct {
+	at    time.Time
+	tf    map[string]int
+	total int
+}
+
+// Tracker incrementally accumulates time-decayed term statistics over a
+// stream of documents. Each stem keeps two exponentially decayed scores:
+// a recent one with the configured half-life and a slower baseline.
+// A term is rising when its share of the recent mass exceeds its share of
+// the baseline mass.
+//
+// A Tracker created with [NewWindowTracker] also forgets documents
+// published more than the window before the evaluation time, so a term
+// that stopped appearing drops out entirely instead of fading slowly.
+//
+// Documents may be added out of order. A Tracker is safe for concurrent
+// use; memory is bounded by pruning the weakest stems once more than
+// 10000 are tracked.
+type Tracker struct {
+	mu       sync.Mutex
+	halfLife time.Duration
+	window   time.Duration // 0: no sliding window
+	docs     []windowDoc   // documents in the window, sorted by time
+	stems    map[string]*trackedStem
+	recent   decayed // total recent mass
+	baseline decayed // total baseline mass
+	latest   time.Time
+}
+
+// NewTracker returns a Tracker whose recent scores halve every halfLife.
+// A non-positive halfLife uses a default of 24 hours.
+func NewTracker(halfLife time.Duration) *Tracker {
+	if halfLife <= 0 {
+		halfLife = defaultHalfLife
+	}
+	return &Tracker{halfLife: halfLife, stems: make(map[string]*trackedStem)}
+}
+
+// NewWindowTracker returns a Tracker like [NewTracker] that only counts
+// documents published within window of the evaluation time. Expired
+// documents are dropped as newer ones arrive, so memory grows with the
+// number of documents in the window. A non-positive window disables it.
+func NewWindowTracker(halfLife, window time.Duration) *Tracker {
+	tr := NewTracker(halfLife)
+	tr.window = max(window, 0)
+	return tr
+}
+
+// Add records the keyword stems of text as published at t.
+// Empty or oversized input is ignored.
+func (tr *Tracker) Add(text string, t time.Time) {
+	stems := pipeline(text, nil)
+	if len(stems) == 0 {
+		return
+	}
+	tf := make(map[string]int, len(stems))
+	for _, s := range stems {
+		tf[s]++
+	}
+
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	if tr.window > 0 && t.Before(tr.latest.Add(-tr.window)) {
+		return
+	}
+	baseHL := tr.halfLife * baselineHalfLives
+	for stem, n := range tf {
+		ts := tr.stems[stem]
+		if ts == nil {
+			ts = &trackedStem{}
+			tr.stems[stem] = ts
+		}
+		ts.recent.add(float64(n), t, tr.halfLife)
+		ts.baseline.add(float64(n), t, baseHL)
+		ts.count += n
+	}
+	tr.recent.add(float64(len(stems)), t, tr.halfLife)
+	tr.baseline.add(float64(len(stems)), t, baseHL)
+	if t.After(tr.latest) {
+		tr.latest = t
+	}
+
+	if tr.window > 0 {
+		i, _ := slices.BinarySearchFunc(tr.docs, t, func(d windowDoc, t time.Time) int {
+			return d.at.Compare(t)
+		})
+		// Insert after documents with the same time to keep eviction FIFO.
+		for i < len(tr.docs) && tr.docs[i].at.Equal(t) {
+			i++
+		}
+		tr.docs = slices.Insert(tr.docs, i, windowDoc{at: t, tf: tf, total: len(stems)})
+		tr.evict(tr.latest.Add(-tr.window))
+	}
+
+	if len(tr.stems) > maxTrackedStems {
+		tr.prune()
+	}
+}
+
+// evict subtracts the documents published before cutoff and drops stems
+// left without occurrences. Caller must hold tr.mu.
+func (tr *Tracker) evict(cutoff time.Time) {
+	n := 0
+	for n < len(tr.docs) && tr.docs[n].at.Before(cutoff) {
+		d := tr.docs[n]
+		for stem, c := range d.tf {
+			ts := tr.stems[stem]
+			if ts == nil {
+				continue // pruned
+			}
+			ts.remove(c, d.at, tr.halfLife)
+			if ts.count <= 0 {
+				delete(tr.stems, stem)
+			}
+		}
+		tr.recent.add(-float64(d.total), d.at, tr.halfLife)
+		tr.baseline.add(-float64(d.total), d.at, tr.halfLife*baselineHalfLives)
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	tr.docs = slices.Delete(tr.docs, 0, n)
+	if len(tr.docs) == 0 {
+		// Avoid carrying rounding residue into the next burst.
+		tr.recent, tr.baseline = decayed{}, decayed{}
+	}
+}
+
+// prune drops the half of the tracked stems with the lowest baseline score.
+// Caller must hold tr.mu.
+func (tr *Tracker) prune() {
+	baseHL := tr.halfLife * baselineHalfLives
+	type scored struct {
+		stem  string
+		score float64
+	}
+	all := make([]scored, 0, len(tr.stems))
+	for stem, ts := range tr.stems {
+		all = append(all, scored{stem, ts.baseline.valueAt(tr.latest, baseHL)})
+	}
+	slices.SortFunc(all, func(a, b scored) int {
+		if a.score != b.score {
+			if a.score < b.score {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.stem, b.stem)
+	})
+	for _, s := range all[:len(all)/2] {
+		delete(tr.stems, s.stem)
+	}
+}
+
+// Trending returns up to k rising stems (Growth > 1) evaluated at t.
+// Results are sorted by excess score — Score minus the score the stem's
+// baseline share predicts — so that frequent risers outrank one-off terms;
+// ties break by Score, then stem. A zero t uses the time of
+// the latest document added. Non-positive k uses a default of 10.
+// With a sliding window, documents published more than the window before
+// t are left out; documents already evicted by newer ones stay out even
+// when t is earlier than the latest document.
+func (tr *Tracker) Trending(t time.Time, k int) []TrendingKeyword {
+	if k <= 0 {
+		k = defaultTopN
+	}
+
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	if t.IsZero() {
+		t = tr.latest
+	}
+	baseHL := tr.halfLife * baselineHalfLives
+
+	// Documents that expire by t without having been evicted yet are
+	// subtracted from copies, leaving the Tracker unchanged.
+	totalRecent, totalBaseline := tr.recent, tr.baseline
+	var expired map[string]trackedStem
+	if tr.window > 0 {
+		cutoff := t.Add(-tr.window)
+		for _, d := range tr.docs {
+			if !d.at.Before(cutoff) {
+				break
+			}
+			if expired == nil {
+				expired = make(map[string]trackedStem)
+			}
+			for stem, c := range d.tf {
+				ts, ok := expired[stem]
+				if !ok {
+					if tr.stems[stem] == nil {
+						continue
+					}
+					ts = *tr.stems[stem]
+				}
+				ts.remove(c, d.at, tr.halfLife)
+				expired[stem] = ts
+			}
+			totalRecent.add(-float64(d.total), d.at, tr.halfLife)
+			totalBaseline.add(-float64(d.total), d.at, baseHL)
+		}
+	}
+	recentTotal := totalRecent.valueAt(t, tr.halfLife)
+	baselineTotal := totalBaseline.valueAt(t, baseHL)
+	if recentTotal <= 0 || baselineTotal <= 0 {
+		return nil
+	}
+
+	var out []TrendingKeyword
+	for stem, tsp := range tr.stems {
+		ts := *tsp
+		if e, ok := expired[stem]; ok {
+			if e.count <= 0 {
+				continue
+			}
+			ts = e
+		}
+		score := ts.recent.valueAt(t, tr.halfLife)
+		growth := (score / recentTotal) / (ts.baseline.valueAt(t, baseHL) / baselineTotal)
+		if growth <= minTrendGrowth {
+			continue
+		}
+		out = append(out, TrendingKeyword{Stem: stem, Score: score, Growth: growth, Count: ts.count})
+	}
+
+	slices.SortFunc(out, func(a, b TrendingKeyword) int {
+		if ea, eb := a.excess(), b.excess(); ea != eb {
+			if ea > eb {
+				return -1
+			}
+			return 1
+		}
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Stem, b.Stem)
+	})
+	if len(out) > k {
+		out = out[:k]
+	}
+	return out
+}
+
+// Trending returns up to k rising stems across docs, evaluated at the time
+// of the newest document. Term scores decay exponentially with halfLife;
+// growth compares each term's share of recent mass against a slower
+// baseline. It is a one-shot wrapper over Tracker.
+// Returns nil when docs contain no keywords.
+func Trending(docs []TimestampedDoc, halfLife time.Duration, k int) []TrendingKeyword {
+	tr := NewTracker(halfLife)
+	for _, d := range docs {
+		tr.Add(d.Text, d.Time)
+	}
+	return tr.Trending(time.Time{}, k)
+}
+
+// TrendingWindow is like [Trending] but only counts documents published
+// within window of the newest document, as a [NewWindowTracker] would.
+// A non-positive window behaves like Trending.
+func TrendingWindow(docs []TimestampedDoc, halfLife, window time.Duration, k int) []TrendingKeyword {
+	tr := NewWindowTracker(halfLife, window)
+	for _, d := range docs {
+		tr.Add(d.Text, d.Time)
+	}
+	return tr.Trending(time.Time{}, k)
+}
@@ -0,0 +1,246 @@
+package keywords
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+var trendBase = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// trendDocs returns ten days of oil-market news followed by two
+// earthquake reports on the eleventh day.
+func trendDocs() []TimestampedDoc {
+	var docs []TimestampedDoc
+	for i := range 10 {
+		docs = append(docs, TimestampedDoc{
+			Text: "Neft qiyməti bazarda sabit qalır, iqtisadiyyat inkişaf edir",
+			Time: trendBase.Add(time.Duration(i) * 24 * time.Hour),
+		})
+	}
+	return append(docs,
+		TimestampedDoc{Text: "Güclü zəlzələ Şamaxıda hiss olundu, neft bazarı sakitdir", Time: trendBase.Add(240 * time.Hour)},
+		TimestampedDoc{Text: "Zəlzələ nəticəsində evlər zədələndi", Time: trendBase.Add(241 * time.Hour)},
+	)
+}
+
+func TestTrending(t *testing.T) {
+	got := Trending(trendDocs(), 24*time.Hour, 5)
+	if len(got) == 0 {
+		t.Fatal("Trending returned no keywords")
+	}
+	if got[0].Stem != "zəlzələ" || got[0].Count != 2 {
+		t.Errorf("top trending = %+v, want zəlzələ with count 2", got[0])
+	}
+	for _, k := range got {
+		if k.Growth <= 1 {
+			t.Errorf("%s: growth %v, want > 1", k.Stem, k.Growth)
+		}
+		if k.Stem == "neft" || k.Stem == "iqtisadiyyat" {
+			t.Errorf("steady term %q reported as trending", k.Stem)
+		}
+	}
+	if len(got) > 5 {
+		t.Errorf("got %d keywords, want at most 5", len(got))
+	}
+}
+
+func TestTrendingEmpty(t *testing.T) {
+	if got := Trending(nil, time.Hour, 5); got != nil {
+		t.Errorf("Trending(nil) = %v, want nil", got)
+	}
+	if got := Trending([]TimestampedDoc{{Text: "", Time: trendBase}}, time.Hour, 5); got != nil {
+		t.Errorf("Trending(empty doc) = %v, want nil", got)
+	}
+}
+
+func TestTrackerOrderIndependent(t *testing.T) {
+	docs := trendDocs()
+	forward := NewTracker(24 * time.Hour)
+	backward := NewTracker(24 * time.Hour)
+	for i := range docs {
+		forward.Add(docs[i].Text, docs[i].Time)
+		backward.Add(docs[len(docs)-1-i].Text, docs[len(docs)-1-i].Time)
+	}
+	a := forward.Trending(time.Time{}, 10)
+	b := backward.Trending(time.Time{}, 10)
+	if len(a) != len(b) {
+		t.Fatalf("forward %d results, backward %d", len(a), len(b))
+	}
+	for i := range a {
+		if a[i].Stem != b[i].Stem || math.Abs(a[i].Score-b[i].Score) > 1e-9 {
+			t.Errorf("[%d] forward %+v, backward %+v", i, a[i], b[i])
+		}
+	}
+}
+
+func TestTrackerDecay(t *testing.T) {
+	tr := NewTracker(time.Hour)
+	tr.Add("zəlzələ", trendBase)
+	tr.Add("daşqın", trendBase.Add(time.Hour))
+	// Evaluated two hours after the first document: zəlzələ has decayed by
+	// two half-lives, daşqın by one.
+	for _, k := range tr.Trending(trendBase.Add(2*time.Hour), 10) {
+		if k.Stem == "daşqın" && math.Abs(k.Score-0.5) > 1e-9 {
+			t.Errorf("daşqın score = %v, want 0.5", k.Score)
+		}
+	}
+}
+
+func TestTrackerDefaultHalfLife(t *testing.T) {
+	if tr := NewTracker(0); tr.halfLife != defaultHalfLife {
+		t.Errorf("halfLife = %v, want %v", tr.halfLife, defaultHalfLife)
+	}
+}
+
+func TestTrackerPrune(t *testing.T) {
+	tr := NewTracker(time.Hour)
+	for i := range maxTrackedStems + 1 {
+		tr.Add(fmt.Sprintf("söz%dx", i), trendBase.Add(time.Duration(i)*time.Second))
+	}
+	if n := len(tr.stems); n > maxTrackedStems {
+		t.Errorf("tracked %d stems, want at most %d", n, maxTrackedStems)
+	}
+}
+
+func TestTrackerConcurrent(t *testing.T) {
+	tr := NewTracker(time.Hour)
+	docs := trendDocs()
+	var wg sync.WaitGroup
+	for _, d := range docs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tr.Add(d.Text, d.Time)
+			tr.Trending(time.Time{}, 3)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTrendingWindow(t *testing.T) {
+	docs := trendDocs()
+	// A 12-hour window keeps only the two earthquake reports, so no stem
+	// has more than two occurrences.
+	got := TrendingWindow(docs, 24*time.Hour, 12*time.Hour, 10)
+	if i := slices.IndexFunc(got, func(k TrendingKeyword) bool { return k.Stem == "zəlzələ" }); i < 0 || got[i].Count != 2 {
+		t.Fatalf("TrendingWindow = %+v, want zəlzələ with count 2", got)
+	}
+	for _, k := range got {
+		if k.Count > 2 {
+			t.Errorf("%s: count %d includes documents outside the window", k.Stem, k.Count)
+		}
+	}
+
+	// Without a window, TrendingWindow matches Trending.
+	a := TrendingWindow(docs, 24*time.Hour, 0, 10)
+	b := Trending(docs, 24*time.Hour, 10)
+	if len(a) != len(b) {
+		t.Fatalf("window 0: %d results, Trending %d", len(a), len(b))
+	}
+	for i := range a {
+		if a[i].Stem != b[i].Stem || math.Abs(a[i].Score-b[i].Score) > 1e-9 {
+			t.Errorf("[%d] window 0 %+v, Trending %+v", i, a[i], b[i])
+		}
+	}
+}
+
+func TestWindowTrackerEvicts(t *testing.T) {
+	tr := NewWindowTracker(time.Hour, 2*time.Hour)
+	tr.Add("zəlzələ Şamaxı", trendBase)
+	tr.Add("daşqın Kür", trendBase.Add(time.Hour))
+	tr.Add("daşqın Sabirabad", trendBase.Add(3*time.Hour))
+	if _, ok := tr.stems["zəlzələ"]; ok {
+		t.Error("stem of expired document still tracked")
+	}
+	if n := len(tr.docs); n != 2 {
+		t.Errorf("window holds %d documents, want 2", n)
+	}
+	for _, k := range tr.Trending(time.Time{}, 10) {
+		if k.Stem == "daşqın" && k.Count != 2 {
+			t.Errorf("daşqın count = %d, want 2", k.Count)
+		}
+	}
+
+	// Documents older than the window are ignored on Add.
+	tr.Add("vulkan", trendBase)
+	if _, ok := tr.stems["vulkan"]; ok {
+		t.Error("expired document added")
+	}
+}
+
+func TestWindowTrackerEvaluationTime(t *testing.T) {
+	tr := NewWindowTracker(time.Hour, 2*time.Hour)
+	tr.Add("zəlzələ Şamaxı", trendBase)
+	tr.Add("daşqın Kür", trendBase.Add(time.Hour))
+
+	// At the latest document both are in the window; zəlzələ is older and
+	// does not rise.
+	stems := func(ks []TrendingKeyword) []string {
+		var out []string
+		for _, k := range ks {
+			out = append(out, k.Stem)
+		}
+		return out
+	}
+	if got := stems(tr.Trending(time.Time{}, 10)); !slices.Contains(got, "daşqın") {
+		t.Errorf("Trending(latest) = %v, want daşqın", got)
+	}
+	// Two and a half hours later zəlzələ has left the window, and the
+	// Tracker itself is unchanged by the evaluation.
+	later := trendBase.Add(150 * time.Minute)
+	for _, k := range tr.Trending(later, 10) {
+		if k.Stem == "zəlzələ" || k.Stem == "şamaxı" {
+			t.Errorf("expired stem %q reported at %v", k.Stem, later)
+		}
+	}
+	if _, ok := tr.stems["zəlzələ"]; !ok || len(tr.docs) != 2 {
+		t.Error("Trending modified the Tracker")
+	}
+	// Past both documents' window nothing is left.
+	if got := tr.Trending(trendBase.Add(4*time.Hour), 10); got != nil {
+		t.Errorf("Trending after window = %v, want nil", got)
+	}
+}
+
+func TestNewWindowTrackerNegative(t *testing.T) {
+	if tr := NewWindowTracker(time.Hour, -time.Hour); tr.window != 0 {
+		t.Errorf("window = %v, want 0", tr.window)
+	}
+}
+
+func BenchmarkTrending(b *testing.B) {
+	docs := trendDocs()
+	for b.Loop() {
+		Trending(docs, 24*time.Hour, 10)
+	}
+}
+
+func ExampleTrending() {
+	docs := []TimestampedDoc{
+		{Text: "Neft qiyməti sabit qalır", Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Text: "Neft bazarı sakitdir", Time: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{Text: "Güclü zəlzələ Şamaxıda, zəlzələ hiss olundu", Time: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	top := Trending(docs, 24*time.Hour, 1)
+	fmt.Println(top[0].Stem)
+	// Output:
+	// zəlzələ
+}
+
+func ExampleTrendingWindow() {
+	docs := []TimestampedDoc{
+		{Text: "Güclü zəlzələ Şamaxıda hiss olundu", Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Text: "Neft bazarı sakitdir", Time: time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{Text: "Kür çayında daşqın, daşqın təhlükəsi artır", Time: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)},
+	}
+	// The earthquake report is more than three days old and is left out.
+	for _, k := range TrendingWindow(docs, 24*time.Hour, 72*time.Hour, 1) {
+		fmt.Println(k.Stem, k.Count)
+	}
+	// Output:
+	// daşqın 2
+}
@@ -0,0 +1,136 @@
+// Package lm provides character- and word-level n-gram language models
+// for Azerbaijani text.
+//
+// A Model assigns a probability to text from n-gram counts collected by
+// Add. Character models read each word as a sequence of letters, which
+// makes them a plausibility signal for single words: ranking spelling
+// candidates, choosing a diacritic restoration, or telling Azerbaijani
+// words from foreign ones. Word models read each sentence as a sequence
+// of words and rank whole phrasings.
+//
+// Two scores are provided:
+//
+//   - Score returns the natural log probability of the text: higher is
+//     more likely. It falls as text gets longer, so compare it only
+//     between texts of the same length, such as candidates for one word.
+//   - Perplexity returns exp(-Score / n) for the n tokens predicted,
+//     the per-token surprise: lower is more likely, comparable across
+//     texts of different length.
+//
+// Probabilities use interpolated Witten-Bell smoothing: each order mixes
+// in the next lower one by how many distinct tokens followed the context
+// in training, down to a uniform distribution over the vocabulary plus
+// one unknown token, so unseen letters, words, and n-grams get a small
+// nonzero probability.
+//
+// The package-level Score and Perplexity use an embedded character
+// 4-gram model trained on the corpus word frequency list, each word
+// weighted by its count. Train a Model on your own corpus with NewModel
+// and Add, store it with Save, and restore it with LoadModel.
+//
+// Text is tokenized with tokenizer.WordTokens; only words are modeled,
+// lowercased with azcase.ToLower, so numbers, punctuation, and case do not
+// affect the scores. Word models split text into sentences with
+// tokenizer.SentenceTokens.
+//
+// All functions are safe for concurrent use by multiple goroutines.
+package lm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+)
+
+// maxInputBytes is the maximum input size. Longer input is not added to
+// a model and scores 0.
+const maxInputBytes = 1 << 20 // 1 MiB
+
+// defaultOrder is the order of the embedded character model.
+const defaultOrder = 4
+
+// Level is the unit a Model predicts.
+type Level int
+
+const (
+	Char Level = iota // letters of each word
+	Word              // words of each sentence
+)
+
+// levelNames maps Level values to their string names.
+var levelNames = [...]string{
+	Char: "Char",
+	Word: "Word",
+}
+
+// levelFromName maps string names back to Level values.
+var levelFromName = map[string]Level{
+	"Char": Char,
+	"Word": Word,
+}
+
+// String returns the name of the level.
+func (l Level) String() string {
+	if int(l) >= 0 && int(l) < len(levelNames) {
+		return levelNames[l]
+	}
+	return fmt.Sprintf("Level(%d)", int(l))
+}
+
+// MarshalJSON encodes the level as a JSON string (e.g. "Char").
+func (l Level) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Char") into a Level.
+func (l *Level) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := levelFromName[s]
+	if !ok {
+		return fmt.Errorf("lm: unknown level: %q", s)
+	}
+	*l = v
+	return nil
+}
+
+// defaultModel is the embedded character model, trained on first use from
+// the "word count" lines of the corpus frequency list.
+var defaultModel = sync.OnceValue(func() *Model {
+	m := NewModel(Char, defaultOrder)
+	for line := range bytes.Lines(data.SpellFreq) {
+		word, count, ok := strings.Cut(strings.TrimSpace(string(line)), " ")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(count, 10, 64)
+		if err != nil || n <= 0 {
+			continue
+		}
+		m.addSequence(charTokens(azcase.ToLower(word)), n)
+	}
+	return m
+})
+
+// Score returns the natural log probability of text under the embedded
+// character model: the sum over its words of the log probability of
+// their letters and the word end. Returns 0 for text with no words or
+// oversized input.
+func Score(text string) float64 {
+	return defaultModel().Score(text)
+}
+
+// Perplexity returns the per-letter perplexity of text under the
+// embedded character model: lower is more typical of Azerbaijani words.
+// Returns 0 for text with no words or oversized input.
+func Perplexity(text string) float64 {
+	return defaultModel().Perplexity(text)
+}
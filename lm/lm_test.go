@@ -0,0 +1,109 @@
+package lm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestScoreRanksWords(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		better, worse string
+	}{
+		{"kitab", "ketab"},
+		{"gözəl", "gozel"},
+		{"şəhər", "seher"},
+		{"gözəl", "qözəl"},
+		{"məktəb", "mktəb"},
+		{"Bakı", "zxqw"},
+	}
+	for _, tt := range tests {
+		if b, w := Score(tt.better), Score(tt.worse); b <= w {
+			t.Errorf("Score(%q) = %.2f, not above Score(%q) = %.2f", tt.better, b, tt.worse, w)
+		}
+		if b, w := Perplexity(tt.better), Perplexity(tt.worse); b >= w {
+			t.Errorf("Perplexity(%q) = %.2f, not below Perplexity(%q) = %.2f", tt.better, b, tt.worse, w)
+		}
+	}
+}
+
+func TestScoreIgnoresCaseAndNonWords(t *testing.T) {
+	t.Parallel()
+
+	if a, b := Score("Bakı"), Score("bakı"); a != b {
+		t.Errorf("Score(Bakı) = %v, Score(bakı) = %v", a, b)
+	}
+	if a, b := Score("kitab, 2026!"), Score("kitab"); a != b {
+		t.Errorf("Score with number and punctuation = %v, want %v", a, b)
+	}
+	// Scores of words add up.
+	if got, want := Score("kitab gözəl"), Score("kitab")+Score("gözəl"); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score(kitab gözəl) = %v, want %v", got, want)
+	}
+	for _, in := range []string{"", "123 !?", string(make([]byte, maxInputBytes+1))} {
+		if s, p := Score(in), Perplexity(in); s != 0 || p != 0 {
+			t.Errorf("Score/Perplexity(%.10q) = %v, %v; want 0, 0", in, s, p)
+		}
+	}
+}
+
+func TestLevelString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		l    Level
+		want string
+	}{
+		{Char, "Char"},
+		{Word, "Word"},
+		{Level(7), "Level(7)"},
+	}
+	for _, tt := range tests {
+		if got := tt.l.String(); got != tt.want {
+			t.Errorf("Level(%d).String() = %q, want %q", int(tt.l), got, tt.want)
+		}
+	}
+}
+
+func TestLevelJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, l := range []Level{Char, Word} {
+		data, err := json.Marshal(l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Level
+		if err := json.Unmarshal(data, &got); err != nil || got != l {
+			t.Errorf("round trip of %v = %v, %v", l, got, err)
+		}
+	}
+	var l Level
+	if err := json.Unmarshal([]byte(`"Sentence"`), &l); err == nil {
+		t.Error("Unmarshal(Sentence) succeeded")
+	}
+	if err := json.Unmarshal([]byte(`1`), &l); err == nil {
+		t.Error("Unmarshal(1) succeeded")
+	}
+}
+
+func BenchmarkScore(b *testing.B) {
+	defaultModel()
+	for b.Loop() {
+		Score("Azərbaycan Respublikasının paytaxtı Bakı şəhəridir.")
+	}
+}
+
+func ExampleScore() {
+	// Rank spelling candidates for one word: higher is more likely.
+	for _, w := range []string{"kitab", "ketab", "kitap"} {
+		fmt.Printf("%s %.1f\n", w, Score(w))
+	}
+	// Output:
+	// kitab -8.5
+	// ketab -21.0
+	// kitap -14.6
+}
@@ -0,0 +1,328 @@
+package lm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const (
+	modelFormatVersion = 1 // version of the serialized Model format
+	maxOrder           = 6 // longest n-gram a Model counts
+
+	bos = "<s>"  // padding before the first token of a sequence
+	eos = "</s>" // token predicted after the last one
+)
+
+// Model is an n-gram language model over letters (Char) or words (Word).
+//
+// Train it with Add, persist it with Save (JSON), restore it with
+// LoadModel, and score texts with Score and Perplexity. A Model is safe
+// for concurrent use; texts may be added while others are scored.
+type Model struct {
+	mu    sync.RWMutex
+	level Level
+	order int
+
+	// grams[k-1] counts the n-grams of k tokens, joined by a space;
+	// hists[k-1] holds the statistics of their (k-1)-token contexts,
+	// with the empty context of unigrams under "".
+	grams []map[string]int64
+	hists []map[string]histStats
+}
+
+// histStats are the training statistics of one context.
+type histStats struct {
+	total int64 // tokens seen after the context
+	types int64 // distinct tokens seen after the context
+}
+
+// modelJSON is the serialized form of a Model. Only the n-grams of the
+// full order are stored; the lower orders are their suffixes and are
+// recounted on load.
+type modelJSON struct {
+	Version int              `json:"version"`
+	Level   Level            `json:"level"`
+	Order   int              `json:"order"`
+	NGrams  map[string]int64 `json:"ngrams"` // tokens joined by a space -> count
+}
+
+// NewModel returns an empty model of the given level that conditions each
+// token on the order-1 tokens before it. order is clamped to 1..6, and a
+// level other than Word gives a Char model. Word models of order 3 and
+// Char models of order 4 or 5 are typical.
+func NewModel(level Level, order int) *Model {
+	if level != Word {
+		level = Char
+	}
+	order = max(1, min(order, maxOrder))
+	m := &Model{
+		level: level,
+		order: order,
+		grams: make([]map[string]int64, order),
+		hists: make([]map[string]histStats, order),
+	}
+	for k := range order {
+		m.grams[k] = make(map[string]int64)
+		m.hists[k] = make(map[string]histStats)
+	}
+	return m
+}
+
+// Level returns the unit the model predicts.
+func (m *Model) Level() Level {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.level
+}
+
+// Order returns the length of the longest n-gram the model counts.
+func (m *Model) Order() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.order
+}
+
+// Add trains the model on text: each word (Char) or sentence (Word),
+// padded at the start and ended with an end token, adds its n-grams of
+// every order up to Order. Empty or oversized input is ignored.
+func (m *Model) Add(text string) {
+	seqs := sequences(m.Level(), text)
+	if len(seqs) == 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, seq := range seqs {
+		m.addSequenceLocked(seq, 1)
+	}
+}
+
+// addSequence adds one token sequence weight times.
+func (m *Model) addSequence(seq []string, weight int64) {
+	if len(seq) == 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.addSequenceLocked(seq, weight)
+}
+
+// addSequenceLocked adds the n-grams of the padded sequence. Caller must
+// hold m.mu.
+func (m *Model) addSequenceLocked(seq []string, weight int64) {
+	padded := m.pad(seq)
+	for i := m.order - 1; i < len(padded); i++ {
+		m.addGram(padded[i-m.order+1:i+1], weight)
+	}
+}
+
+// addGram counts the full-order n-gram gram and its suffixes weight
+// times. Caller must hold m.mu.
+func (m *Model) addGram(gram []string, weight int64) {
+	for k := 1; k <= len(gram); k++ {
+		g := gram[len(gram)-k:]
+		key := strings.Join(g, " ")
+		hist := strings.Join(g[:k-1], " ")
+		s := m.hists[k-1][hist]
+		if m.grams[k-1][key] == 0 {
+			s.types++
+		}
+		s.total += weight
+		m.hists[k-1][hist] = s
+		m.grams[k-1][key] += weight
+	}
+}
+
+// Score returns the natural log probability of text: the sum of the log
+// probabilities of every token of its words (Char) or sentences (Word),
+// including the end token of each. Returns 0 for text with no words,
+// oversized input, or an empty model.
+func (m *Model) Score(text string) float64 {
+	lp, _ := m.logProb(text)
+	return lp
+}
+
+// Perplexity returns exp(-Score(text)/n), where n is the number of tokens
+// predicted, end tokens included. Returns 0 for text with no words,
+// oversized input, or an empty model.
+func (m *Model) Perplexity(text string) float64 {
+	lp, n := m.logProb(text)
+	if n == 0 {
+		return 0
+	}
+	return math.Exp(-lp / float64(n))
+}
+
+// logProb returns the log probability of text and the number of tokens
+// predicted.
+func (m *Model) logProb(text string) (float64, int) {
+	seqs := sequences(m.Level(), text)
+	if len(seqs) == 0 {
+		return 0, 0
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.hists[0][""].total == 0 {
+		return 0, 0
+	}
+	lp, n := 0.0, 0
+	for _, seq := range seqs {
+		padded := m.pad(seq)
+		for i := m.order - 1; i < len(padded); i++ {
+			lp += math.Log(m.prob(padded[i-m.order+1:i], padded[i]))
+			n++
+		}
+	}
+	return lp, n
+}
+
+// prob returns the Witten-Bell interpolated probability of token w after
+// the order-1 tokens of hist. The unigram distribution is interpolated
+// with a uniform one over the vocabulary plus one unknown token; each
+// longer context seen in training mixes in the estimate of the one below
+// it, weighted by the number of distinct tokens that followed it. Caller
+// must hold m.mu.
+func (m *Model) prob(hist []string, w string) float64 {
+	u := m.hists[0][""]
+	p := (float64(m.grams[0][w]) + float64(u.types)/float64(u.types+1)) / float64(u.total+u.types)
+	for k := 2; k <= m.order; k++ {
+		h := strings.Join(hist[len(hist)-(k-1):], " ")
+		s, ok := m.hists[k-1][h]
+		if !ok {
+			break // longer contexts end with this one and are unseen too
+		}
+		p = (float64(m.grams[k-1][h+" "+w]) + float64(s.types)*p) / float64(s.total+s.types)
+	}
+	return p
+}
+
+// pad returns seq with order-1 start tokens before it and the end token
+// after it.
+func (m *Model) pad(seq []string) []string {
+	padded := make([]string, 0, m.order+len(seq))
+	for range m.order - 1 {
+		padded = append(padded, bos)
+	}
+	padded = append(padded, seq...)
+	return append(padded, eos)
+}
+
+// sequences splits text into the token sequences the model predicts: the
+// letters of each word for Char, the words of each sentence for Word.
+// Words are lowercased. Returns nil for empty or oversized input.
+func sequences(level Level, text string) [][]string {
+	if text == "" || len(text) > maxInputBytes {
+		return nil
+	}
+	text = azcase.ComposeNFC(text)
+
+	var seqs [][]string
+	if level == Char {
+		for _, w := range words(text) {
+			seqs = append(seqs, charTokens(w))
+		}
+		return seqs
+	}
+	for _, sent := range tokenizer.SentenceTokens(text) {
+		if ws := words(sent.Text); len(ws) > 0 {
+			seqs = append(seqs, ws)
+		}
+	}
+	return seqs
+}
+
+// words returns the lowercase words of text.
+func words(text string) []string {
+	var out []string
+	for _, tok := range tokenizer.WordTokens(text) {
+		if tok.Type == tokenizer.Word {
+			out = append(out, azcase.ToLower(tok.Text))
+		}
+	}
+	return out
+}
+
+// charTokens returns the letters of word, one token per rune.
+func charTokens(word string) []string {
+	out := make([]string, 0, len(word))
+	for _, r := range word {
+		out = append(out, string(r))
+	}
+	return out
+}
+
+// MarshalJSON encodes the model as a versioned JSON document:
+// {"version":1,"level":"Char","order":4,"ngrams":{"<s> <s> <s> k":n,...}}.
+func (m *Model) MarshalJSON() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return json.Marshal(modelJSON{
+		Version: modelFormatVersion,
+		Level:   m.level,
+		Order:   m.order,
+		NGrams:  m.grams[m.order-1],
+	})
+}
+
+// UnmarshalJSON decodes a document produced by MarshalJSON, replacing the
+// model contents. Returns an error for unsupported versions, orders out
+// of range, or malformed n-grams.
+func (m *Model) UnmarshalJSON(data []byte) error {
+	var mj modelJSON
+	if err := json.Unmarshal(data, &mj); err != nil {
+		return fmt.Errorf("lm: decoding model: %w", err)
+	}
+	if mj.Version != modelFormatVersion {
+		return fmt.Errorf("lm: unsupported model version %d", mj.Version)
+	}
+	if mj.Order < 1 || mj.Order > maxOrder {
+		return fmt.Errorf("lm: model order %d out of range [1, %d]", mj.Order, maxOrder)
+	}
+
+	loaded := NewModel(mj.Level, mj.Order)
+	for key, n := range mj.NGrams {
+		gram := strings.Split(key, " ")
+		if len(gram) != mj.Order || n < 1 || slices.Contains(gram, "") {
+			return fmt.Errorf("lm: invalid %d-gram %q with count %d", mj.Order, key, n)
+		}
+		loaded.addGram(gram, n)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.level, m.order, m.grams, m.hists = loaded.level, loaded.order, loaded.grams, loaded.hists
+	return nil
+}
+
+// Save writes the model to w as JSON (see MarshalJSON).
+func (m *Model) Save(w io.Writer) error {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// LoadModel reads a model written by Save.
+func LoadModel(r io.Reader) (*Model, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("lm: reading model: %w", err)
+	}
+	m := NewModel(Char, 1)
+	if err := m.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,235 @@
+package lm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var wordCorpus = []string{
+	"Mən kitab oxuyuram. Sən kitab oxuyursan.",
+	"Mən məktub yazıram. O, kitab oxuyur.",
+	"Biz Bakıda yaşayırıq. Onlar Gəncədə yaşayırlar.",
+}
+
+func trainedWordModel() *Model {
+	m := NewModel(Word, 3)
+	for _, text := range wordCorpus {
+		m.Add(text)
+	}
+	return m
+}
+
+func TestNewModel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		level     Level
+		order     int
+		wantLevel Level
+		wantOrder int
+	}{
+		{Char, 4, Char, 4},
+		{Word, 3, Word, 3},
+		{Word, 0, Word, 1},
+		{Char, 99, Char, maxOrder},
+		{Level(5), 2, Char, 2},
+	}
+	for _, tt := range tests {
+		m := NewModel(tt.level, tt.order)
+		if m.Level() != tt.wantLevel || m.Order() != tt.wantOrder {
+			t.Errorf("NewModel(%v, %d) = %v order %d, want %v order %d",
+				tt.level, tt.order, m.Level(), m.Order(), tt.wantLevel, tt.wantOrder)
+		}
+	}
+}
+
+func TestModelWordScores(t *testing.T) {
+	t.Parallel()
+
+	m := trainedWordModel()
+	tests := []struct {
+		better, worse string
+	}{
+		{"Mən kitab oxuyuram.", "Kitab mən oxuyuram."},
+		{"Sən kitab oxuyursan.", "Sən məktub oxuyursan."},
+		{"Biz Bakıda yaşayırıq.", "Biz Bakıda oxuyuram."},
+		{"Mən məktub yazıram.", "Tamamilə yeni söz."},
+	}
+	for _, tt := range tests {
+		if b, w := m.Perplexity(tt.better), m.Perplexity(tt.worse); b >= w {
+			t.Errorf("Perplexity(%q) = %.2f, not below Perplexity(%q) = %.2f", tt.better, b, tt.worse, w)
+		}
+	}
+
+	// Sentences are scored independently, so their scores add up.
+	a, b := "Mən kitab oxuyuram.", "Biz Bakıda yaşayırıq."
+	if got, want := m.Score(a+" "+b), m.Score(a)+m.Score(b); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Score of two sentences = %v, want %v", got, want)
+	}
+}
+
+func TestModelProbabilitiesSumToOne(t *testing.T) {
+	t.Parallel()
+
+	m := trainedWordModel()
+	vocab := []string{"unknown-word"} // plus every seen word and the end token
+	for k := range m.grams[0] {
+		vocab = append(vocab, k)
+	}
+	// The unknown token stands for every unseen word; one of them is enough.
+	for _, hist := range [][]string{{bos, bos}, {bos, "mən"}, {"mən", "kitab"}, {"heç", "nə"}} {
+		sum := 0.0
+		for _, w := range vocab {
+			sum += m.prob(hist, w)
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("probabilities after %q sum to %v, want 1", hist, sum)
+		}
+	}
+}
+
+func TestModelChar(t *testing.T) {
+	t.Parallel()
+
+	m := NewModel(Char, 3)
+	m.Add("kitab kitablar kitabxana məktəb məktəblər")
+	if b, w := m.Score("kitabda"), m.Score("kətibda"); b <= w {
+		t.Errorf("Score(kitabda) = %.2f, not above Score(kətibda) = %.2f", b, w)
+	}
+	// Each word predicts its letters and its end.
+	lp := m.Score("kitab")
+	if got, want := m.Perplexity("kitab"), math.Exp(-lp/6); math.Abs(got-want) > 1e-9 {
+		t.Errorf("Perplexity(kitab) = %v, want %v", got, want)
+	}
+}
+
+func TestModelEmpty(t *testing.T) {
+	t.Parallel()
+
+	m := NewModel(Word, 2)
+	m.Add("")
+	m.Add("123, 456!")
+	if s, p := m.Score("Mən kitab oxuyuram."), m.Perplexity("Mən kitab oxuyuram."); s != 0 || p != 0 {
+		t.Errorf("empty model Score, Perplexity = %v, %v; want 0, 0", s, p)
+	}
+	m.Add("Mən kitab oxuyuram.")
+	if s := m.Score(""); s != 0 {
+		t.Errorf("Score(\"\") = %v, want 0", s)
+	}
+	if s := m.Score("Tamamilə yeni söz."); s >= 0 || math.IsInf(s, 0) {
+		t.Errorf("Score of unseen words = %v, want finite and negative", s)
+	}
+}
+
+func TestModelSaveLoad(t *testing.T) {
+	t.Parallel()
+
+	m := trainedWordModel()
+	var buf bytes.Buffer
+	if err := m.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadModel(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Level() != Word || loaded.Order() != 3 {
+		t.Errorf("loaded %v order %d, want Word order 3", loaded.Level(), loaded.Order())
+	}
+	for _, text := range append(wordCorpus, "Kitab mən oxuyuram.", "Tamamilə yeni söz.") {
+		if got, want := loaded.Score(text), m.Score(text); math.Abs(got-want) > 1e-9 {
+			t.Errorf("loaded Score(%q) = %v, want %v", text, got, want)
+		}
+	}
+	for k := range m.grams {
+		if len(loaded.grams[k]) != len(m.grams[k]) || len(loaded.hists[k]) != len(m.hists[k]) {
+			t.Errorf("order %d: loaded %d grams %d contexts, want %d and %d",
+				k+1, len(loaded.grams[k]), len(loaded.hists[k]), len(m.grams[k]), len(m.hists[k]))
+		}
+	}
+
+	// A loaded model keeps training.
+	loaded.Add("Mən kitab yazıram.")
+	if loaded.Score("Mən kitab yazıram.") <= m.Score("Mən kitab yazıram.") {
+		t.Error("training after load did not raise the score")
+	}
+}
+
+func TestLoadModelErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"not json", "{", "lm: decoding model"},
+		{"version", `{"version":2,"level":"Word","order":2}`, "unsupported model version 2"},
+		{"level", `{"version":1,"level":"Line","order":2}`, `unknown level: "Line"`},
+		{"order", `{"version":1,"level":"Word","order":7}`, "model order 7 out of range"},
+		{"gram length", `{"version":1,"level":"Word","order":2,"ngrams":{"a b c":1}}`, `invalid 2-gram "a b c"`},
+		{"empty token", `{"version":1,"level":"Word","order":2,"ngrams":{" a":1}}`, `invalid 2-gram " a"`},
+		{"count", `{"version":1,"level":"Word","order":2,"ngrams":{"a b":0}}`, "with count 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := LoadModel(strings.NewReader(tt.in))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadModel(%s) error = %v, want containing %q", tt.in, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := LoadModel(errReader{}); err == nil || !strings.Contains(err.Error(), "reading model") {
+		t.Errorf("LoadModel(failing reader) error = %v", err)
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("disk failure") }
+
+func TestModelConcurrent(t *testing.T) {
+	t.Parallel()
+
+	m := NewModel(Word, 2)
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			for j := range 50 {
+				m.Add(wordCorpus[(i+j)%len(wordCorpus)])
+				m.Perplexity("Mən kitab oxuyuram.")
+			}
+		})
+	}
+	wg.Wait()
+	if m.hists[0][""].total == 0 {
+		t.Error("no tokens counted")
+	}
+}
+
+func BenchmarkModelAdd(b *testing.B) {
+	text := strings.Join(wordCorpus, " ")
+	m := NewModel(Char, 4)
+	for b.Loop() {
+		m.Add(text)
+	}
+}
+
+func ExampleNewModel() {
+	m := NewModel(Word, 3)
+	m.Add("Mən kitab oxuyuram. Sən kitab oxuyursan. Mən məktub yazıram.")
+
+	for _, s := range []string{"Mən kitab oxuyuram.", "Kitab mən oxuyuram."} {
+		fmt.Printf("%s %.2f\n", s, m.Perplexity(s))
+	}
+	// Output:
+	// Mən kitab oxuyuram. 1.68
+	// Kitab mən oxuyuram. 12.07
+}
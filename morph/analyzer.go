@@ -0,0 +1,193 @@
+package morph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Analyzer performs morphological analysis against a dictionary and a
+// suffix table. The package-level functions use an Analyzer over the
+// embedded dictionary and the built-in suffix rules; NewAnalyzer builds
+// one extended with domain vocabulary or adjusted suffix rules.
+//
+// An Analyzer is immutable once created and safe for concurrent use by
+// multiple goroutines. The cache enabled by EnableCache serves only the
+// package-level functions.
+type Analyzer struct {
+	dict      map[string]byte  // stem -> POS byte, see posFromByte
+	rules     []suffixRule     // suffix table walked by the state machine
+	terminals []fsmState       // unique toState values of rules
+	minSuffix map[fsmState]int // shortest surface in runes producing each state
+
+	custom map[string]byte           // entries added by WithDictionary, merged into dict by NewAnalyzer
+	cache  *atomic.Pointer[lruCache] // the package cache for defaultAnalyzer, nil otherwise
+}
+
+// defaultAnalyzer backs the package-level functions.
+var defaultAnalyzer = newAnalyzer(dictMap, suffixRules, &analysisCache)
+
+// newAnalyzer returns an Analyzer over dict and rules, pre-computing the
+// rune forms of the suffix surfaces in place.
+func newAnalyzer(dict map[string]byte, rules []suffixRule, cache *atomic.Pointer[lruCache]) *Analyzer {
+	an := &Analyzer{dict: dict, rules: rules, cache: cache}
+	an.compile()
+	return an
+}
+
+// compile pre-computes the state machine tables derived from an.rules.
+func (an *Analyzer) compile() {
+	seen := make(map[fsmState]bool)
+	an.terminals = an.terminals[:0]
+	an.minSuffix = make(map[fsmState]int)
+	for i := range an.rules {
+		rule := &an.rules[i]
+		if !seen[rule.toState] {
+			seen[rule.toState] = true
+			an.terminals = append(an.terminals, rule.toState)
+		}
+
+		rule.surfaceRunes = make([][]rune, len(rule.surfaces))
+		for j, s := range rule.surfaces {
+			rule.surfaceRunes[j] = []rune(s)
+			l := len(rule.surfaceRunes[j])
+			if prev, ok := an.minSuffix[rule.toState]; !ok || l < prev {
+				an.minSuffix[rule.toState] = l
+			}
+		}
+	}
+}
+
+// Option configures an Analyzer created by NewAnalyzer.
+type Option func(*Analyzer) error
+
+// WithDictionary adds the entries listed in r to the embedded dictionary.
+// The format is that of the embedded dict.txt: one entry per line, a
+// part-of-speech byte (N noun, V verb, A adjective, D adverb, X other)
+// immediately followed by the stem, e.g. "Nfibrilyasiya" or "Vskanla".
+// Blank lines are skipped. Stems are lowercased; an entry for a stem
+// already in the dictionary replaces its part of speech.
+func WithDictionary(r io.Reader) Option {
+	return func(an *Analyzer) error {
+		if an.custom == nil {
+			an.custom = make(map[string]byte)
+		}
+		sc := bufio.NewScanner(r)
+		for n := 1; sc.Scan(); n++ {
+			line := strings.TrimSpace(sc.Text())
+			if line == "" {
+				continue
+			}
+			if len(line) < minLineLen {
+				return fmt.Errorf("morph: dictionary line %d: want <POS><stem>, got %q", n, line)
+			}
+			if _, ok := posFromByte[line[0]]; !ok {
+				return fmt.Errorf("morph: dictionary line %d: unknown part of speech %q", n, line[:1])
+			}
+			stem := azcase.ToLower(azcase.ComposeNFC(line[1:]))
+			if !utf8.ValidString(stem) || len(stem) > maxWordBytes || strings.ContainsAny(stem, " \t") {
+				return fmt.Errorf("morph: dictionary line %d: invalid stem %q", n, line[1:])
+			}
+			an.custom[stem] = line[0]
+		}
+		if err := sc.Err(); err != nil {
+			return fmt.Errorf("morph: reading dictionary: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithSuffixes adds surface allomorphs to the suffix tagged tag, such as
+// dialectal or colloquial variants. The new surfaces follow the
+// morphotactics and vowel harmony class of the suffix's first rule.
+// Returns an error from NewAnalyzer if no suffix rule carries tag or a
+// surface is empty.
+func WithSuffixes(tag MorphTag, surfaces ...string) Option {
+	return func(an *Analyzer) error {
+		i := slices.IndexFunc(an.rules, func(r suffixRule) bool { return r.tag == tag })
+		if i < 0 {
+			return fmt.Errorf("morph: no suffix rule for %v", tag)
+		}
+		rule := &an.rules[i]
+		added := slices.Clone(rule.surfaces)
+		for _, s := range surfaces {
+			s = azcase.ToLower(azcase.ComposeNFC(strings.TrimSpace(s)))
+			if s == "" {
+				return fmt.Errorf("morph: empty surface for %v", tag)
+			}
+			if !slices.Contains(added, s) {
+				added = append(added, s)
+			}
+		}
+		// Longest first, for greedy matching.
+		slices.SortStableFunc(added, func(a, b string) int {
+			return utf8.RuneCountInString(b) - utf8.RuneCountInString(a)
+		})
+		rule.surfaces = added
+		return nil
+	}
+}
+
+// WithoutSuffixes removes surface allomorphs from the suffix tagged tag,
+// so that the analyzer no longer strips them. With no surfaces, the suffix
+// is disabled entirely. Returns an error from NewAnalyzer if no suffix
+// rule carries tag.
+func WithoutSuffixes(tag MorphTag, surfaces ...string) Option {
+	return func(an *Analyzer) error {
+		drop := make([]string, len(surfaces))
+		for i, s := range surfaces {
+			drop[i] = azcase.ToLower(azcase.ComposeNFC(strings.TrimSpace(s)))
+		}
+		found := false
+		for i := range an.rules {
+			rule := &an.rules[i]
+			if rule.tag != tag {
+				continue
+			}
+			found = true
+			rule.surfaces = slices.DeleteFunc(slices.Clone(rule.surfaces), func(s string) bool {
+				return len(drop) == 0 || slices.Contains(drop, s)
+			})
+		}
+		if !found {
+			return fmt.Errorf("morph: no suffix rule for %v", tag)
+		}
+		return nil
+	}
+}
+
+// NewAnalyzer returns an Analyzer over the embedded dictionary and the
+// built-in suffix rules, configured by opts. Returns an error if an option
+// fails (e.g. a malformed dictionary line).
+func NewAnalyzer(opts ...Option) (*Analyzer, error) {
+	an := &Analyzer{rules: slices.Clone(suffixRules)}
+	for _, opt := range opts {
+		if err := opt(an); err != nil {
+			return nil, err
+		}
+	}
+	an.dict = dictMap
+	if len(an.custom) > 0 {
+		an.dict = maps.Clone(dictMap)
+		maps.Copy(an.dict, an.custom)
+	}
+	an.custom = nil
+	an.compile()
+	return an, nil
+}
+
+// cachePtr returns the cache serving an, or nil when caching is disabled
+// or an is not the default analyzer.
+func (an *Analyzer) cachePtr() *lruCache {
+	if an.cache == nil {
+		return nil
+	}
+	return an.cache.Load()
+}
@@ -0,0 +1,190 @@
+package morph
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const domainDict = "Nindossament\nNblokçeyn\n\nVskanla\n"
+
+func newDomainAnalyzer(t *testing.T) *Analyzer {
+	t.Helper()
+	an, err := NewAnalyzer(WithDictionary(strings.NewReader(domainDict)))
+	if err != nil {
+		t.Fatalf("NewAnalyzer: %v", err)
+	}
+	return an
+}
+
+func TestAnalyzerDictionary(t *testing.T) {
+	an := newDomainAnalyzer(t)
+
+	stems := []struct {
+		word        string
+		want        string
+		wantDefault string
+	}{
+		{"blokçeyn", "blokçeyn", "blokçey"},
+		{"blokçeyndə", "blokçeyn", "blokçey"},
+		{"Blokçeyndə", "Blokçeyn", "Blokçey"},
+		{"indossament", "indossament", "indossamen"},
+		{"kitablarımızdan", "kitab", "kitab"},
+	}
+	for _, tt := range stems {
+		if got := an.Stem(tt.word); got != tt.want {
+			t.Errorf("Analyzer.Stem(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+		if got := Stem(tt.word); got != tt.wantDefault {
+			t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.wantDefault)
+		}
+	}
+
+	if !an.IsKnownStem("blokçeyn") || IsKnownStem("blokçeyn") {
+		t.Error("custom stem should be known to the analyzer only")
+	}
+	if !an.IsKnownStem("kitab") {
+		t.Error("embedded dictionary missing from custom analyzer")
+	}
+
+	lemma, pos, err := an.Lemmatize("skanladım")
+	if err != nil || lemma.Form != "skanlamaq" || pos != Verb {
+		t.Errorf("Analyzer.Lemmatize(skanladım) = %v, %v, %v, want skanlamaq Verb", lemma, pos, err)
+	}
+	if _, _, err := Lemmatize("skanladım"); !errors.Is(err, ErrUnknownWord) {
+		t.Errorf("Lemmatize(skanladım) error = %v, want ErrUnknownWord", err)
+	}
+
+	if got := an.SuggestHarmony("blokçeynda"); !reflect.DeepEqual(got, []string{"blokçeyndə"}) {
+		t.Errorf("Analyzer.SuggestHarmony(blokçeynda) = %v, want [blokçeyndə]", got)
+	}
+}
+
+func TestAnalyzerDictionaryPOS(t *testing.T) {
+	// A custom entry replaces the part of speech of an embedded one.
+	an, err := NewAnalyzer(WithDictionary(strings.NewReader("Vkitab")))
+	if err != nil {
+		t.Fatalf("NewAnalyzer: %v", err)
+	}
+	if got := an.stemPOS("kitab"); got != 'V' {
+		t.Errorf("stemPOS(kitab) = %q, want 'V'", got)
+	}
+	if got := defaultAnalyzer.stemPOS("kitab"); got != 'N' {
+		t.Errorf("default stemPOS(kitab) = %q, want 'N'", got)
+	}
+}
+
+func TestAnalyzerDictionaryErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unknown part of speech", "Nkitab\nQqələm\n"},
+		{"missing stem", "N\n"},
+		{"space in stem", "Nqara qələm\n"},
+		{"invalid utf8", "N\xff\xfe\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := NewAnalyzer(WithDictionary(strings.NewReader(tt.input))); err == nil {
+				t.Errorf("NewAnalyzer(%q): expected error", tt.input)
+			}
+		})
+	}
+}
+
+func TestAnalyzerSuffixes(t *testing.T) {
+	// Colloquial instrumental -nan/-nən (kitabnan = kitabla).
+	an, err := NewAnalyzer(WithSuffixes(CaseIns, "nan", "NƏN"))
+	if err != nil {
+		t.Fatalf("NewAnalyzer: %v", err)
+	}
+	if got := an.Stem("kitabnan"); got != "kitab" {
+		t.Errorf("Analyzer.Stem(kitabnan) = %q, want kitab", got)
+	}
+	if got := an.Analyze("evnən")[0]; got.String() != "ev[CaseIns:nən]" {
+		t.Errorf("Analyzer.Analyze(evnən)[0] = %v, want ev[CaseIns:nən]", got)
+	}
+	if got := Stem("kitabnan"); got == "kitab" {
+		t.Error("suffix override leaked into the default analyzer")
+	}
+
+	an, err = NewAnalyzer(WithoutSuffixes(CaseLoc))
+	if err != nil {
+		t.Fatalf("NewAnalyzer: %v", err)
+	}
+	for _, a := range an.Analyze("kitablarda") {
+		if hasTag(a, CaseLoc) {
+			t.Errorf("disabled suffix still analyzed: %v", a)
+		}
+	}
+	if got := Stem("kitablarda"); got != "kitab" {
+		t.Errorf("Stem(kitablarda) = %q after WithoutSuffixes, want kitab", got)
+	}
+}
+
+func TestAnalyzerSuffixErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{"no rule for tag", WithSuffixes(Ordinal, "x")},
+		{"empty surface", WithSuffixes(Plural, " ")},
+		{"remove from unknown tag", WithoutSuffixes(MorphTag(999))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := NewAnalyzer(tt.opt); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestAnalyzerMatchesDefault(t *testing.T) {
+	an, err := NewAnalyzer()
+	if err != nil {
+		t.Fatalf("NewAnalyzer: %v", err)
+	}
+	for _, w := range corpusWords[:40] {
+		if got, want := an.Analyze(w), Analyze(w); !reflect.DeepEqual(got, want) {
+			t.Errorf("Analyze(%q) = %v, want %v", w, got, want)
+		}
+		if got, want := an.Stem(w), Stem(w); got != want {
+			t.Errorf("Stem(%q) = %q, want %q", w, got, want)
+		}
+	}
+	if got, want := an.Disambiguate(corpusWords[:12]), Disambiguate(corpusWords[:12]); !reflect.DeepEqual(got, want) {
+		t.Errorf("Disambiguate = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkAnalyzerStem(b *testing.B) {
+	an, err := NewAnalyzer(WithDictionary(strings.NewReader(domainDict)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	for b.Loop() {
+		an.Stem("blokçeynlərdə")
+	}
+}
+
+func ExampleNewAnalyzer() {
+	dict := strings.NewReader("Nblokçeyn\nVskanla\n")
+	an, err := NewAnalyzer(WithDictionary(dict), WithSuffixes(CaseIns, "nan", "nən"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(Stem("blokçeyndə"), an.Stem("blokçeyndə"))
+	fmt.Println(an.Stem("kitabnan"))
+	lemma, _, _ := an.Lemmatize("skanladım")
+	fmt.Println(lemma.Form)
+	// Output:
+	// blokçey blokçeyn
+	// kitab
+	// skanlamaq
+}
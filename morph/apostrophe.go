@@ -0,0 +1,52 @@
+package morph
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isApostrophe reports whether r is one of the marks written between a
+// proper noun and its suffixes: U+0027, U+2019, U+02BC.
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’' || r == 'ʼ'
+}
+
+// splitApostrophe splits a proper noun with suffixes written after an
+// apostrophe (Bakı'nın) into the noun, the apostrophe, and the suffixes.
+// ok is false unless the noun starts with an uppercase letter and both
+// parts are non-empty, so lowercase words with an old-orthography
+// apostrophe (mə'lumat) are left to the state machine.
+func splitApostrophe(word string) (noun, mark, suffixes string, ok bool) {
+	i := strings.IndexFunc(word, isApostrophe)
+	if i <= 0 {
+		return "", "", "", false
+	}
+	first, _ := utf8.DecodeRuneInString(word)
+	_, size := utf8.DecodeRuneInString(word[i:])
+	if !unicode.IsUpper(first) || i+size == len(word) {
+		return "", "", "", false
+	}
+	return word[:i], word[i : i+size], word[i+size:], true
+}
+
+// analyzeApostrophe analyzes the suffixes written after the apostrophe of
+// a proper noun as a suffix chain on the noun: Bakı'nın is
+// Bakı[CaseGen:'nın]. The first morpheme keeps the apostrophe, so that
+// Stem + suffixes == word. Vowel harmony is checked against the noun;
+// chains that start with a verbal suffix are dropped, as proper nouns
+// take only nominal suffixes, derivation, the copula, and the question
+// particle. Returns nil when no chain ends at the apostrophe.
+func (an *Analyzer) analyzeApostrophe(noun, mark, suffixes string) []Analysis {
+	var results []Analysis
+	for _, a := range an.analyze(noun + suffixes) {
+		if a.Stem != noun || len(a.Morphemes) == 0 || isVerbalTag(a.Morphemes[0].Tag) {
+			continue
+		}
+		ms := make([]Morpheme, len(a.Morphemes))
+		copy(ms, a.Morphemes)
+		ms[0].Surface = mark + ms[0].Surface
+		results = append(results, Analysis{Stem: noun, Morphemes: ms})
+	}
+	return results
+}
@@ -0,0 +1,103 @@
+package morph
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeApostrophe(t *testing.T) {
+	tests := []struct {
+		word string
+		want string // best analysis
+	}{
+		{"Bakı'nın", "Bakı[CaseGen:'nın]"},
+		{"Bakı'da", "Bakı[CaseLoc:'da]"},
+		{"Bakı’ya", "Bakı[CaseDat:’ya]"},
+		{"Bakıʼdan", "Bakı[CaseAbl:ʼdan]"},
+		{"Əliyev'in", "Əliyev[CaseGen:'in]"},
+		{"London'un", "London[CaseGen:'un]"},
+		{"Bakı'ların", "Bakı[Plural:'lar|CaseGen:ın]"},
+		{"Bakı'lı", "Bakı[DerivPoss:'lı]"},
+		{"Bakı'dır", "Bakı[Copula:'dır]"},
+		{"AzərEnerji'nin", "AzərEnerji[CaseGen:'nin]"},
+		{"BAKI'NIN", "BAKI[CaseGen:'NIN]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			results := Analyze(tt.word)
+			if len(results) == 0 || results[0].String() != tt.want {
+				t.Fatalf("Analyze(%q) = %v, want %s first", tt.word, results, tt.want)
+			}
+			for _, a := range results {
+				var sb strings.Builder
+				sb.WriteString(a.Stem)
+				for _, m := range a.Morphemes {
+					sb.WriteString(m.Surface)
+				}
+				if sb.String() != tt.word {
+					t.Errorf("%v does not rebuild %q", a, tt.word)
+				}
+				if isVerbalTag(a.Morphemes[0].Tag) {
+					t.Errorf("%v starts with a verbal suffix", a)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyzeApostropheUnparsed(t *testing.T) {
+	tests := []struct {
+		word string
+		want string // single analysis
+	}{
+		{"London'ün", "London'ün"},   // suffix against vowel harmony
+		{"Samir'gil", "Samir'gil"},   // not a suffix chain
+		{"Bakı'nınkı", "Bakı'nınkı"}, // relative -kı is not analyzed
+	}
+	for _, tt := range tests {
+		results := Analyze(tt.word)
+		if len(results) != 1 || results[0].String() != tt.want || results[0].Score != 1 {
+			t.Errorf("Analyze(%q) = %v, want only %s", tt.word, results, tt.want)
+		}
+	}
+
+	// Lowercase words with an apostrophe still go through the state machine.
+	if results := Analyze("mə'lumat"); len(results) < 2 {
+		t.Errorf("Analyze(mə'lumat) = %v, want state machine analyses", results)
+	}
+}
+
+func TestSplitApostrophe(t *testing.T) {
+	tests := []struct {
+		word, noun, mark, suffixes string
+		ok                         bool
+	}{
+		{"Bakı'nın", "Bakı", "'", "nın", true},
+		{"Bakı’nın", "Bakı", "’", "nın", true},
+		{"Bakı'nın'ın", "Bakı", "'", "nın'ın", true},
+		{"bakı'nın", "", "", "", false},
+		{"Bakı'", "", "", "", false},
+		{"'Bakı", "", "", "", false},
+		{"Bakı", "", "", "", false},
+	}
+	for _, tt := range tests {
+		noun, mark, suffixes, ok := splitApostrophe(tt.word)
+		if noun != tt.noun || mark != tt.mark || suffixes != tt.suffixes || ok != tt.ok {
+			t.Errorf("splitApostrophe(%q) = %q, %q, %q, %v; want %q, %q, %q, %v",
+				tt.word, noun, mark, suffixes, ok, tt.noun, tt.mark, tt.suffixes, tt.ok)
+		}
+	}
+}
+
+func BenchmarkAnalyzeApostrophe(b *testing.B) {
+	for b.Loop() {
+		Analyze("Azərbaycan'ın")
+	}
+}
+
+func ExampleAnalyze_properNoun() {
+	a := Analyze("Bakı'nın")[0]
+	fmt.Println(a.Stem, a.Morphemes[0].Tag)
+	// Output: Bakı CaseGen
+}
@@ -0,0 +1,155 @@
+package morph
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// analysisCache is the process-wide cache installed by EnableCache; nil
+// when caching is disabled.
+var analysisCache atomic.Pointer[lruCache]
+
+// CacheInfo reports usage counters of the analysis cache.
+type CacheInfo struct {
+	Size   int    `json:"size"`   // maximum number of words held
+	Len    int    `json:"len"`    // words currently held
+	Hits   uint64 `json:"hits"`   // Analyze and Stem calls served from the cache
+	Misses uint64 `json:"misses"` // Analyze and Stem calls that computed the result
+}
+
+// EnableCache memoizes Analyze and Stem results for up to size distinct
+// words in a process-wide LRU cache, for corpus jobs that see the same
+// high-frequency words many times. Each call replaces the cache with an
+// empty one; size <= 0 disables caching. Caching is disabled by default.
+//
+// Cached results are copied on return, so callers may modify them.
+func EnableCache(size int) {
+	if size <= 0 {
+		analysisCache.Store(nil)
+		return
+	}
+	analysisCache.Store(newLRUCache(size))
+}
+
+// CacheStats returns the counters of the analysis cache, or a zero
+// CacheInfo when caching is disabled.
+func CacheStats() CacheInfo {
+	c := analysisCache.Load()
+	if c == nil {
+		return CacheInfo{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheInfo{Size: c.size, Len: c.order.Len(), Hits: c.hits, Misses: c.misses}
+}
+
+// lruCache maps NFC words to their analyses and stem, evicting the least
+// recently used word once it holds size words.
+type lruCache struct {
+	mu     sync.Mutex
+	size   int
+	order  *list.List               // front = most recently used
+	items  map[string]*list.Element // word -> element holding *cacheEntry
+	hits   uint64
+	misses uint64
+}
+
+// cacheEntry holds the results computed so far for one word.
+type cacheEntry struct {
+	word     string
+	analyses []Analysis // nil until Analyze has run
+	stem     string
+	hasStem  bool
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{size: size, order: list.New(), items: make(map[string]*list.Element)}
+}
+
+// analyses returns a copy of the cached analyses of word.
+func (c *lruCache) analyses(word string) ([]Analysis, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.lookup(word)
+	if e == nil || e.analyses == nil {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	return cloneAnalyses(e.analyses), true
+}
+
+// stem returns the cached stem of word.
+func (c *lruCache) stem(word string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.lookup(word)
+	if e == nil || !e.hasStem {
+		c.misses++
+		return "", false
+	}
+	c.hits++
+	return e.stem, true
+}
+
+// setAnalyses stores a copy of the analyses of word.
+func (c *lruCache) setAnalyses(word string, analyses []Analysis) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entry(word).analyses = cloneAnalyses(analyses)
+}
+
+// setStem stores the stem of word.
+func (c *lruCache) setStem(word, stem string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.entry(word)
+	e.stem, e.hasStem = stem, true
+}
+
+// lookup returns the entry for word and marks it most recently used, or
+// nil. Callers hold c.mu.
+func (c *lruCache) lookup(word string) *cacheEntry {
+	el, ok := c.items[word]
+	if !ok {
+		return nil
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*cacheEntry)
+}
+
+// entry returns the entry for word, adding one and evicting the least
+// recently used word if needed. Callers hold c.mu.
+func (c *lruCache) entry(word string) *cacheEntry {
+	if e := c.lookup(word); e != nil {
+		return e
+	}
+	e := &cacheEntry{word: word}
+	c.items[word] = c.order.PushFront(e)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).word)
+	}
+	return e
+}
+
+// cloneAnalyses deep-copies analyses, sharing one morpheme backing array.
+func cloneAnalyses(analyses []Analysis) []Analysis {
+	n := 0
+	for _, a := range analyses {
+		n += len(a.Morphemes)
+	}
+	morphemes := make([]Morpheme, 0, n)
+	out := make([]Analysis, len(analyses))
+	for i, a := range analyses {
+		out[i].Stem, out[i].Score = a.Stem, a.Score
+		if a.Morphemes != nil {
+			start := len(morphemes)
+			morphemes = append(morphemes, a.Morphemes...)
+			out[i].Morphemes = morphemes[start:len(morphemes):len(morphemes)]
+		}
+	}
+	return out
+}
@@ -0,0 +1,163 @@
+package morph
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// corpusWords mimics a corpus job: a small vocabulary of inflected words
+// repeated many times.
+var corpusWords = strings.Fields(strings.Repeat(
+	"Azərbaycanın paytaxtı Bakı şəhəridir və şəhərdə gözəl binalar tikilir. "+
+		"Kitabxanalarımızdan götürdüyümüz kitabları oxuyuruq, məktəblərdə uşaqlar dərslərini yazırlar. "+
+		"Neft hasilatının artması ölkənin iqtisadiyyatına təsir göstərəcək. ", 50))
+
+func TestEnableCache(t *testing.T) {
+	t.Cleanup(func() { EnableCache(0) })
+
+	words := []string{"kitablarımızdan", "gəlmədi", "Bakıda", "2026-cı", "oğlum", "qızlar", "sosial-iqtisadi", "x"}
+	wantAnalyses := make([][]Analysis, len(words))
+	wantStems := make([]string, len(words))
+	for i, w := range words {
+		wantAnalyses[i] = Analyze(w)
+		wantStems[i] = Stem(w)
+	}
+
+	EnableCache(100)
+	for pass := range 2 {
+		for i, w := range words {
+			if got := Analyze(w); !reflect.DeepEqual(got, wantAnalyses[i]) {
+				t.Errorf("pass %d: Analyze(%q) = %v, want %v", pass, w, got, wantAnalyses[i])
+			}
+			if got := Stem(w); got != wantStems[i] {
+				t.Errorf("pass %d: Stem(%q) = %q, want %q", pass, w, got, wantStems[i])
+			}
+		}
+	}
+
+	st := CacheStats()
+	if st.Size != 100 || st.Hits == 0 || st.Misses == 0 {
+		t.Errorf("CacheStats() = %+v, want size 100 with hits and misses", st)
+	}
+}
+
+func TestCacheCopiesResults(t *testing.T) {
+	t.Cleanup(func() { EnableCache(0) })
+	EnableCache(10)
+
+	first := Analyze("kitablardan")
+	first[0].Stem = "changed"
+	first[0].Morphemes[0].Surface = "changed"
+
+	second := Analyze("kitablardan")
+	if second[0].Stem == "changed" || second[0].Morphemes[0].Surface == "changed" {
+		t.Errorf("cached analyses were modified through a returned slice: %v", second)
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	t.Cleanup(func() { EnableCache(0) })
+	EnableCache(2)
+
+	Stem("kitab")
+	Stem("qələm")
+	Stem("kitab") // kitab is now most recently used
+	Stem("dəftər")
+
+	if st := CacheStats(); st.Len != 2 {
+		t.Errorf("Len = %d, want 2", st.Len)
+	}
+	before := CacheStats().Misses
+	Stem("kitab")
+	if CacheStats().Misses != before {
+		t.Error("kitab was evicted, want qələm evicted")
+	}
+	Stem("qələm")
+	if CacheStats().Misses != before+1 {
+		t.Error("qələm was served from the cache, want it evicted")
+	}
+}
+
+func TestCacheDisable(t *testing.T) {
+	EnableCache(10)
+	Stem("kitab")
+	EnableCache(0)
+	if st := CacheStats(); st != (CacheInfo{}) {
+		t.Errorf("CacheStats() after disable = %+v, want zero", st)
+	}
+	if got := Stem("kitablar"); got != "kitab" {
+		t.Errorf("Stem(kitablar) = %q with cache disabled", got)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	t.Cleanup(func() { EnableCache(0) })
+	want := Stems(corpusWords[:60])
+	EnableCache(16)
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Go(func() {
+			for range 20 {
+				got := Stems(corpusWords[:60])
+				for i := range got {
+					if got[i] != want[i] {
+						t.Errorf("goroutine %d: Stem(%q) = %q, want %q", g, corpusWords[i], got[i], want[i])
+						return
+					}
+				}
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func BenchmarkStemsCorpus(b *testing.B) {
+	EnableCache(0)
+	for b.Loop() {
+		Stems(corpusWords)
+	}
+}
+
+func BenchmarkStemsCorpusCached(b *testing.B) {
+	b.Cleanup(func() { EnableCache(0) })
+	EnableCache(10000)
+	for b.Loop() {
+		Stems(corpusWords)
+	}
+}
+
+func BenchmarkAnalyzeCorpus(b *testing.B) {
+	EnableCache(0)
+	for b.Loop() {
+		for _, w := range corpusWords {
+			Analyze(w)
+		}
+	}
+}
+
+func BenchmarkAnalyzeCorpusCached(b *testing.B) {
+	b.Cleanup(func() { EnableCache(0) })
+	EnableCache(10000)
+	for b.Loop() {
+		for _, w := range corpusWords {
+			Analyze(w)
+		}
+	}
+}
+
+func ExampleEnableCache() {
+	EnableCache(10000)
+	defer EnableCache(0)
+
+	for range 3 {
+		Stem("kitablarımızdan")
+	}
+	st := CacheStats()
+	fmt.Println(st.Hits, st.Misses)
+	// Output:
+	// 2 1
+}
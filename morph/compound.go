@@ -0,0 +1,210 @@
+// Compound word segmentation for Azerbaijani.
+//
+// Closed compounds (qayınana, dəmiryolu, günəbaxan) are written as one
+// word, so an unknown compound is either left unanalyzed or over-stemmed
+// at a boundary that happens to look like a suffix. SplitCompound finds a
+// binary split into two dictionary stems, and Stem uses it as a fallback
+// for long words the dictionary does not cover.
+package morph
+
+import (
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+const (
+	// minCompoundPart is the minimum number of runes in each stem of a
+	// compound. Shorter stems (ev, su) match too many word fragments.
+	minCompoundPart = 3
+
+	// minCompoundRunes is the minimum length of a word for Stem to try
+	// compound segmentation.
+	minCompoundRunes = 7
+)
+
+// compoundSplit is a candidate segmentation of a word into left + right.
+type compoundSplit struct {
+	at        int    // rune index of the boundary
+	rightStem string // known stem of the right part, lowercase
+	linked    bool   // left part ends in a linking dative vowel (günə-)
+	bare      bool   // right part is a bare stem without suffixes
+}
+
+// better reports whether s is a more plausible segmentation than o:
+// bare right parts beat inflected ones, direct joins beat linked ones,
+// and balanced splits beat lopsided ones.
+func (s compoundSplit) better(o compoundSplit, n int) bool {
+	if s.bare != o.bare {
+		return s.bare
+	}
+	if s.linked != o.linked {
+		return !s.linked
+	}
+	return min(s.at, n-s.at) > min(o.at, n-o.at)
+}
+
+// SplitCompound segments a closed compound into its two parts, e.g.
+// "qayınana" → ["qayın", "ana"], "dəmiryolu" → ["dəmir", "yolu"] and
+// "günəbaxan" → ["günə", "baxan"].
+//
+// The left part must be a non-verb dictionary stem, optionally followed
+// by a linking dative -a/-ə that agrees in vowel harmony with it (günə-).
+// The right part must be a dictionary stem, optionally followed by a
+// suffix chain validated against the right stem alone; a verb stem only
+// closes a compound as a participle or verbal noun. Both stems need at
+// least three letters. Splits that read as inflection of the left part
+// (kitab+lar, ver+diyi) are rejected: the whole word must not parse with
+// the left part as its stem, and a boundary where vowel harmony carries
+// over is accepted only for a bare right part of a dictionary word
+// (qayın+ana). When several splits qualify, an uninflected right part, a
+// direct join, and a balanced split are preferred, in that order.
+//
+// Parts keep the case of the input; their concatenation is the NFC form of
+// word. Returns nil when no split qualifies, or when the input is empty or
+// exceeds maxWordBytes. Dictionary words are segmented too.
+func SplitCompound(word string) []string {
+	return defaultAnalyzer.SplitCompound(word)
+}
+
+// SplitCompound is like SplitCompound, using the analyzer's dictionary and suffix rules.
+func (an *Analyzer) SplitCompound(word string) []string {
+	if word == "" || len(word) > maxWordBytes {
+		return nil
+	}
+	word = azcase.ComposeNFC(word)
+	split, ok := an.splitCompound(word)
+	if !ok {
+		return nil
+	}
+	runes := []rune(word)
+	return []string{string(runes[:split.at]), string(runes[split.at:])}
+}
+
+// splitCompound returns the best segmentation of an NFC word.
+func (an *Analyzer) splitCompound(word string) (compoundSplit, bool) {
+	lower := []rune(azcase.ToLower(word))
+	n := len(lower)
+	if n < 2*minCompoundPart {
+		return compoundSplit{}, false
+	}
+
+	// Stems at which the whole word already parses as inflection. A stem
+	// that absorbed the buffer -y- (başlay-araq) also marks the stem
+	// without it, so that başla+yaraq is not taken for a compound.
+	inflected := make(map[string]bool)
+	for _, a := range an.analyze(word) {
+		if len(a.Morphemes) == 0 {
+			continue
+		}
+		stem := azcase.ToLower(a.Stem)
+		inflected[stem] = true
+		if r := []rune(stem); len(r) > 1 && r[len(r)-1] == 'y' && isVowel(r[len(r)-2]) {
+			inflected[string(r[:len(r)-1])] = true
+		}
+	}
+
+	known := an.isKnownStem(string(lower))
+	var best compoundSplit
+	found := false
+	for at := minCompoundPart; at <= n-minCompoundPart; at++ {
+		left := string(lower[:at])
+		linked, ok := an.compoundLeft(left)
+		if !ok || inflected[left] {
+			continue
+		}
+		right := string(lower[at:])
+		rightStem, bare, ok := an.compoundRight(right)
+		if !ok {
+			continue
+		}
+		// Most compounds break vowel harmony at the boundary (dəmir+yol,
+		// qara+çörək). A right part that continues the harmony of the left
+		// reads just as well as its suffix chain (ver+diyi, qasım+ova), so
+		// it is accepted only for a bare stem in a dictionary word
+		// (qayın+ana).
+		if harmonicBoundary(left, right) && (!bare || !known) {
+			continue
+		}
+		// A vowel after a consonant-final left part is where case and
+		// possessive suffixes attach (say+əsində, nizam+inin); there the
+		// right part must be a bare stem (neft+ayırma).
+		if !bare && !isVowel(lower[at-1]) && isVowel(lower[at]) {
+			continue
+		}
+		cand := compoundSplit{at: at, rightStem: rightStem, linked: linked, bare: bare}
+		if !found || cand.better(best, n) {
+			best, found = cand, true
+		}
+	}
+	return best, found
+}
+
+// compoundLeft reports whether left can open a compound, and whether it
+// does so through a linking dative vowel. Verb stems do not open
+// compounds; they are the inflected word's own root.
+func (an *Analyzer) compoundLeft(left string) (linked, ok bool) {
+	if an.isKnownStem(left) {
+		return false, an.stemPOS(left) != 'V'
+	}
+	runes := []rune(left)
+	last := runes[len(runes)-1]
+	if last != 'a' && last != 'ə' || len(runes) <= minCompoundPart {
+		return false, false
+	}
+	stem := string(runes[:len(runes)-1])
+	lv := lastVowel(stem)
+	if !an.isKnownStem(stem) || an.stemPOS(stem) == 'V' || lv == 0 || !matchesBackFront(lv, last) {
+		return false, false
+	}
+	return true, true
+}
+
+// compoundRight reports whether right can close a compound and returns
+// its known stem. Suffixes are validated against the right part alone. A
+// verb closes a compound only as a participle or verbal noun (günə+baxan).
+func (an *Analyzer) compoundRight(right string) (stem string, bare, ok bool) {
+	if an.isKnownStem(right) {
+		return right, true, an.stemPOS(right) != 'V'
+	}
+	for _, a := range an.analyze(right) {
+		s := azcase.ToLower(a.Stem)
+		if len(a.Morphemes) == 0 || len([]rune(s)) < minCompoundPart || !an.isKnownStem(s) {
+			continue
+		}
+		if an.stemPOS(s) == 'V' && !nominalizers[a.Morphemes[0].Tag] {
+			continue
+		}
+		return s, false, true
+	}
+	return "", false, false
+}
+
+// nominalizers lists the tags that turn a verb into a noun or adjective
+// that can close a compound.
+var nominalizers = map[MorphTag]bool{
+	Participle:    true,
+	ParticipleAdj: true,
+	Gerund:        true,
+	DerivAgent:    true,
+}
+
+// harmonicBoundary reports whether the first vowel of right agrees in
+// back/front harmony with the last vowel of left.
+func harmonicBoundary(left, right string) bool {
+	lv, fv := lastVowel(left), firstVowel(right)
+	return lv != 0 && fv != 0 && matchesBackFront(lv, fv)
+}
+
+// findCompoundStem stems an unknown long word as a compound: the left
+// part as written plus the stem of the right part. Returns "" if the
+// word does not segment.
+func (an *Analyzer) findCompoundStem(word string) string {
+	runes := []rune(word)
+	if len(runes) < minCompoundRunes {
+		return ""
+	}
+	split, ok := an.splitCompound(word)
+	if !ok {
+		return ""
+	}
+	return string(runes[:split.at]) + azcase.ApplyCase(string(runes[split.at:]), split.rightStem)
+}
@@ -0,0 +1,101 @@
+package morph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSplitCompound(t *testing.T) {
+	tests := []struct {
+		word string
+		want []string
+	}{
+		// -- Bare compounds --
+		{"qayınana", []string{"qayın", "ana"}},
+		{"qaraçörək", []string{"qara", "çörək"}},
+		{"dəmirqapı", []string{"dəmir", "qapı"}},
+
+		// -- Inflected right part --
+		{"dəmiryolu", []string{"dəmir", "yolu"}},
+		{"dəmiryolunda", []string{"dəmir", "yolunda"}},
+		{"ağacdələnlər", []string{"ağac", "dələnlər"}},
+
+		// -- Linking dative vowel --
+		{"günəbaxan", []string{"günə", "baxan"}},
+		{"günəbaxanlar", []string{"günə", "baxanlar"}},
+
+		// -- Case preservation --
+		{"Dəmiryolu", []string{"Dəmir", "yolu"}},
+		{"DƏMİRYOLU", []string{"DƏMİR", "YOLU"}},
+
+		// -- Inflection, not compounds --
+		{"kitablar", nil},
+		{"evlərdən", nil},
+		{"başlayaraq", nil},
+		{"verdiyi", nil},
+		{"edilməsi", nil},
+		{"sayəsində", nil},
+
+		// -- Edge cases --
+		{"kitab", nil},
+		{"", nil},
+		{strings.Repeat("a", maxWordBytes+1), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got := SplitCompound(tt.word)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SplitCompound(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+			if got != nil && strings.Join(got, "") != tt.word {
+				t.Errorf("parts %q do not rebuild %q", got, tt.word)
+			}
+		})
+	}
+}
+
+func TestStemCompoundFallback(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"dəmirqapılar", "dəmirqapı"},
+		{"Dəmirqapıdan", "Dəmirqapı"},
+		{"qaraçörəklər", "qaraçörək"},
+		{"qaraqapılarda", "qaraqapı"},
+		{"ağacdələnlər", "ağacdələn"},
+		{"fotoşəkil", "fotoşəkil"},
+		{"ümumtəhsil", "ümumtəhsil"},
+
+		// Known words and plain inflection are unaffected.
+		{"günəbaxanlar", "günəbaxan"},
+		{"kitablar", "kitab"},
+		{"başlayaraq", "başlay"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.want {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSplitCompound(b *testing.B) {
+	for b.Loop() {
+		SplitCompound("dəmiryolunda")
+	}
+}
+
+func ExampleSplitCompound() {
+	for _, w := range []string{"qayınana", "dəmiryolu", "günəbaxan", "kitablar"} {
+		fmt.Println(w, SplitCompound(w))
+	}
+	// Output:
+	// qayınana [qayın ana]
+	// dəmiryolu [dəmir yolu]
+	// günəbaxan [günə baxan]
+	// kitablar []
+}
@@ -0,0 +1,159 @@
+// Noun declension: the case paradigm generated from the suffix rules
+// (kitab → kitabın, kitaba, kitabı, kitabda, kitabdan, kitabla).
+package morph
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Cases holds the forms of a noun in the six cases and the instrumental.
+type Cases struct {
+	Nominative   string `json:"nominative"`   // adlıq: kitab
+	Genitive     string `json:"genitive"`     // yiyəlik: kitabın
+	Dative       string `json:"dative"`       // yönlük: kitaba
+	Accusative   string `json:"accusative"`   // təsirlik: kitabı
+	Locative     string `json:"locative"`     // yerlik: kitabda
+	Ablative     string `json:"ablative"`     // çıxışlıq: kitabdan
+	Instrumental string `json:"instrumental"` // -la/-lə: kitabla
+}
+
+// Declension is the case paradigm of a noun in both numbers.
+type Declension struct {
+	Singular Cases `json:"singular"`
+	Plural   Cases `json:"plural"`
+}
+
+// softened maps the final k and q of a stem to the y and ğ they become
+// before a vowel (çiçək → çiçəyi, otaq → otağı).
+var softened = map[rune]rune{'k': 'y', 'q': 'ğ', 'K': 'Y', 'Q': 'Ğ'}
+
+// yBuffered lists the vowel-final nouns whose genitive and accusative
+// take y where others take n (suyun, suyu).
+var yBuffered = map[string]bool{"su": true}
+
+// Decline returns the case paradigm of noun, singular and plural:
+// nominative, genitive, dative, accusative, locative, ablative, and
+// instrumental, e.g. "otaq" → otaq, otağın, otağa, otağı, otaqda,
+// otaqdan, otaqla; otaqlar, otaqların, otaqlara, otaqları, otaqlarda,
+// otaqlardan, otaqlarla.
+//
+// Suffixes follow vowel harmony with the last vowel of noun; after a
+// vowel the genitive and accusative take n (almanın, almanı), and the
+// dative and instrumental y (almaya, almayla); su takes y throughout
+// (suyun, suyu). Final k and q of a polysyllabic noun after a vowel
+// soften to y and ğ before a vowel (çiçəyin, qonağa), unless the embedded
+// corpus attests the unsoftened form instead, as for loanwords (fabriki).
+// The locative and ablative always take d (işdə, kitabdan), as the
+// standard orthography does.
+//
+// Noun is taken as given, in its nominative singular; the suffixes are
+// uppercase when noun is all uppercase. Returns the zero Declension when
+// noun is empty, exceeds maxWordBytes, or has no vowel.
+//
+// Known limitation: nouns that drop a vowel before a suffix (ağız →
+// ağzı, oğul → oğlu) are declined without the drop.
+func Decline(noun string) Declension {
+	if noun == "" || len(noun) > maxWordBytes {
+		return Declension{}
+	}
+	noun = azcase.ComposeNFC(noun)
+	lower := azcase.ToLower(noun)
+	if lastVowel(lower) == 0 {
+		return Declension{}
+	}
+	upper := azcase.IsAllUpper(noun) && utf8.RuneCountInString(noun) > 1
+
+	plural := "lar"
+	if !isBackVowel(lastVowel(lower)) {
+		plural = "lər"
+	}
+	if upper {
+		plural = azcase.ToUpper(plural)
+	}
+	return Declension{
+		Singular: declineNumber(noun, softenedStem(noun, lower), yBuffered[lastWord(lower)], upper),
+		Plural:   declineNumber(noun+plural, "", false, upper),
+	}
+}
+
+// declineNumber returns the case forms of stem. soft is the stem with
+// its final consonant softened, or "" when it does not soften; yBuffer
+// selects y over n in the genitive and accusative after a vowel.
+func declineNumber(stem, soft string, yBuffer, upper bool) Cases {
+	lower := azcase.ToLower(stem)
+	lv := lastVowel(lower)
+	a, i := "a", string(fourWayTarget(lv))
+	if !isBackVowel(lv) {
+		a = "ə"
+	}
+	vowelFinal := isVowel(lastRune(lower))
+	if soft == "" {
+		soft = stem
+	}
+	n := "n"
+	if yBuffer {
+		n = "y"
+	}
+
+	suffix := func(consonantForm, vowelForm string) string {
+		s := consonantForm
+		if vowelFinal {
+			s = vowelForm
+		}
+		if upper {
+			s = azcase.ToUpper(s)
+		}
+		return s
+	}
+	return Cases{
+		Nominative:   stem,
+		Genitive:     soft + suffix(i+"n", n+i+"n"),
+		Dative:       soft + suffix(a, "y"+a),
+		Accusative:   soft + suffix(i, n+i),
+		Locative:     stem + suffix("d"+a, "d"+a),
+		Ablative:     stem + suffix("d"+a+"n", "d"+a+"n"),
+		Instrumental: stem + suffix("l"+a, "yl"+a),
+	}
+}
+
+// softenedStem returns noun with its final k or q softened, or "" when
+// it keeps the consonant before a vowel: the last word of noun must have
+// two or more vowels and a vowel before the k or q, and the corpus must
+// not prefer the unsoftened accusative (fabriki over fabriyi).
+func softenedStem(noun, lower string) string {
+	r, size := utf8.DecodeLastRuneInString(noun)
+	to, ok := softened[r]
+	if !ok {
+		return ""
+	}
+	runes := []rune(lastWord(lower))
+	if len(runes) < 2 || !isVowel(runes[len(runes)-2]) {
+		return ""
+	}
+	var vowels int
+	for _, c := range runes {
+		if isVowel(c) {
+			vowels++
+		}
+	}
+	if vowels < 2 {
+		return ""
+	}
+
+	soft := noun[:len(noun)-size] + string(to)
+	acc := string(fourWayTarget(lastVowel(lower)))
+	freq := unigramFreq()
+	if freq[lower+acc] > freq[azcase.ToLower(soft)+acc] {
+		return ""
+	}
+	return soft
+}
+
+// lastWord returns the part of s after its last space or hyphen, the word
+// of a multi-word noun that takes the suffixes (içməli su → içməli suyun).
+func lastWord(s string) string {
+	return s[strings.LastIndexAny(s, " -")+1:]
+}
@@ -0,0 +1,175 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// forms lists the forms of c in case order.
+func (c Cases) forms() []string {
+	return []string{c.Nominative, c.Genitive, c.Dative, c.Accusative, c.Locative, c.Ablative, c.Instrumental}
+}
+
+func TestDecline(t *testing.T) {
+	tests := []struct {
+		noun     string
+		singular string
+		plural   string
+	}{
+		// -- Harmony --
+		{"kitab", "kitab kitabın kitaba kitabı kitabda kitabdan kitabla",
+			"kitablar kitabların kitablara kitabları kitablarda kitablardan kitablarla"},
+		{"ev", "ev evin evə evi evdə evdən evlə",
+			"evlər evlərin evlərə evləri evlərdə evlərdən evlərlə"},
+		{"dost", "dost dostun dosta dostu dostda dostdan dostla",
+			"dostlar dostların dostlara dostları dostlarda dostlardan dostlarla"},
+		{"göz", "göz gözün gözə gözü gözdə gözdən gözlə",
+			"gözlər gözlərin gözlərə gözləri gözlərdə gözlərdən gözlərlə"},
+
+		// -- Vowel-final: n and y buffers --
+		{"alma", "alma almanın almaya almanı almada almadan almayla",
+			"almalar almaların almalara almaları almalarda almalardan almalarla"},
+		{"ölkə", "ölkə ölkənin ölkəyə ölkəni ölkədə ölkədən ölkəylə",
+			"ölkələr ölkələrin ölkələrə ölkələri ölkələrdə ölkələrdən ölkələrlə"},
+		{"su", "su suyun suya suyu suda sudan suyla",
+			"sular suların sulara suları sularda sulardan sularla"},
+
+		// -- Consonant softening --
+		{"otaq", "otaq otağın otağa otağı otaqda otaqdan otaqla",
+			"otaqlar otaqların otaqlara otaqları otaqlarda otaqlardan otaqlarla"},
+		{"çiçək", "çiçək çiçəyin çiçəyə çiçəyi çiçəkdə çiçəkdən çiçəklə",
+			"çiçəklər çiçəklərin çiçəklərə çiçəkləri çiçəklərdə çiçəklərdən çiçəklərlə"},
+		{"yük", "yük yükün yükə yükü yükdə yükdən yüklə",
+			"yüklər yüklərin yüklərə yükləri yüklərdə yüklərdən yüklərlə"},
+		{"bank", "bank bankın banka bankı bankda bankdan bankla",
+			"banklar bankların banklara bankları banklarda banklardan banklarla"},
+		{"fabrik", "fabrik fabrikin fabrikə fabriki fabrikdə fabrikdən fabriklə",
+			"fabriklər fabriklərin fabriklərə fabrikləri fabriklərdə fabriklərdən fabriklərlə"},
+
+		// -- Case and multi-word nouns --
+		{"Bakı", "Bakı Bakının Bakıya Bakını Bakıda Bakıdan Bakıyla",
+			"Bakılar Bakıların Bakılara Bakıları Bakılarda Bakılardan Bakılarla"},
+		{"BANK", "BANK BANKIN BANKA BANKI BANKDA BANKDAN BANKLA",
+			"BANKLAR BANKLARIN BANKLARA BANKLARI BANKLARDA BANKLARDAN BANKLARLA"},
+		{"içməli su", "içməli su içməli suyun içməli suya içməli suyu içməli suda içməli sudan içməli suyla",
+			"içməli sular içməli suların içməli sulara içməli suları içməli sularda içməli sulardan içməli sularla"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.noun, func(t *testing.T) {
+			d := Decline(tt.noun)
+			if got := strings.Join(d.Singular.forms(), " "); got != tt.singular {
+				t.Errorf("Decline(%q).Singular = %s, want %s", tt.noun, got, tt.singular)
+			}
+			if got := strings.Join(d.Plural.forms(), " "); got != tt.plural {
+				t.Errorf("Decline(%q).Plural = %s, want %s", tt.noun, got, tt.plural)
+			}
+		})
+	}
+}
+
+func TestDeclineInvalid(t *testing.T) {
+	for _, noun := range []string{"", "BMT", "123", strings.Repeat("a", maxWordBytes+1)} {
+		if got := Decline(noun); got != (Declension{}) {
+			t.Errorf("Decline(%q) = %+v, want zero", noun, got)
+		}
+	}
+}
+
+// TestDeclineRoundTrip checks the generated paradigm against the analyzer:
+// every form must have an analysis with the noun as stem and exactly the
+// Plural and case morphemes Decline added.
+func TestDeclineRoundTrip(t *testing.T) {
+	cases := []MorphTag{CaseGen, CaseDat, CaseAcc, CaseLoc, CaseAbl, CaseIns}
+	nouns := []string{
+		"kitab", "alma", "otaq", "çiçək", "fabrik", "ev", "göz", "qapı", "ürək",
+		"bank", "yük", "uşaq", "məktəb", "dost", "gül", "nənə", "ayaq", "quzu",
+		"ölkə", "şəhər", "qonaq", "park", "kənd", "tələbə", "dəniz", "üzüm", "ordu",
+	}
+	for _, noun := range nouns {
+		d := Decline(noun)
+		for _, number := range []struct {
+			forms  []string
+			prefix []MorphTag
+		}{
+			{d.Singular.forms(), nil},
+			{d.Plural.forms(), []MorphTag{Plural}},
+		} {
+			for i, form := range number.forms {
+				want := number.prefix
+				if i > 0 {
+					want = append(slices.Clone(want), cases[i-1])
+				}
+				if !hasParse(Analyze(form), noun, want) {
+					t.Errorf("%s: Analyze(%q) = %v, want %s%v", noun, form, Analyze(form), noun, want)
+				}
+			}
+		}
+	}
+}
+
+// TestInstrumentalBuffer checks that the buffer y of -yla/-ylə is only
+// stripped after a vowel-final stem and never from a stem ending in y.
+func TestInstrumentalBuffer(t *testing.T) {
+	tests := []struct {
+		word, stem string
+		ok         bool // whether stem + CaseIns is a parse
+	}{
+		{"almayla", "alma", true},
+		{"babayla", "baba", true},
+		{"ölkəylə", "ölkə", true},
+		{"sayla", "say", true},
+		{"sayla", "sa", false},
+		{"söylə", "sö", false},
+		{"oyla", "o", false},
+	}
+	for _, tt := range tests {
+		if got := hasParse(Analyze(tt.word), tt.stem, []MorphTag{CaseIns}); got != tt.ok {
+			t.Errorf("Analyze(%q) has %s[CaseIns] = %v, want %v: %v", tt.word, tt.stem, got, tt.ok, Analyze(tt.word))
+		}
+	}
+	if got := Stem("sayla"); got != "say" {
+		t.Errorf("Stem(%q) = %q, want %q", "sayla", got, "say")
+	}
+}
+
+// hasParse reports whether analyses include stem with exactly tags.
+func hasParse(analyses []Analysis, stem string, tags []MorphTag) bool {
+	for _, a := range analyses {
+		if a.Stem != stem || len(a.Morphemes) != len(tags) {
+			continue
+		}
+		if slices.EqualFunc(a.Morphemes, tags, func(m Morpheme, t MorphTag) bool { return m.Tag == t }) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDeclensionJSON(t *testing.T) {
+	b, err := json.Marshal(Decline("ev").Singular)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"nominative":"ev","genitive":"evin","dative":"evə","accusative":"evi","locative":"evdə","ablative":"evdən","instrumental":"evlə"}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func BenchmarkDecline(b *testing.B) {
+	for b.Loop() {
+		Decline("çiçək")
+	}
+}
+
+func ExampleDecline() {
+	d := Decline("otaq")
+	fmt.Println(d.Singular.Genitive, d.Singular.Dative, d.Singular.Locative)
+	fmt.Println(d.Plural.Accusative)
+	// Output:
+	// otağın otağa otaqda
+	// otaqları
+}
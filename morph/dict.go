@@ -10,55 +10,58 @@ import (
 // one byte for the POS tag plus at least one character for the lemma.
 const minLineLen = 2
 
-// Parsed dictionary data, populated by init().
-var (
-	dictLemmas []string        // sorted lemmas, kept for test integrity checks
-	dictMap    map[string]byte // stem -> POS byte for O(1) lookups
-)
+// Parsed dictionary data: dictMap maps stem -> POS byte for O(1) lookups;
+// dictLemmas holds the sorted lemmas, kept for test integrity checks.
+var dictMap, dictLemmas = parseDict(data.MorphDict)
 
-func init() {
-	// Parse dictRaw: each line is <POS_byte><lemma>\n
-	// These lookups are for soft ranking in fsm.go walk() base case,
-	// not as hard filters — an unknown stem does not block analysis.
-	lines := bytes.Split(data.MorphDict, []byte("\n"))
-	dictMap = make(map[string]byte, len(lines))
-	dictLemmas = make([]string, 0, len(lines))
+// parseDict parses dict.txt: each line is <POS_byte><lemma>\n.
+// These lookups are for soft ranking in fsm.go walk() base case,
+// not as hard filters — an unknown stem does not block analysis.
+func parseDict(raw []byte) (map[string]byte, []string) {
+	lines := bytes.Split(raw, []byte("\n"))
+	m := make(map[string]byte, len(lines))
+	lemmas := make([]string, 0, len(lines))
 	for _, line := range lines {
 		if len(line) < minLineLen {
 			continue
 		}
 		lemma := string(line[1:])
-		if _, exists := dictMap[lemma]; !exists {
-			dictMap[lemma] = line[0]
+		if _, exists := m[lemma]; !exists {
+			m[lemma] = line[0]
 		}
-		dictLemmas = append(dictLemmas, lemma)
+		lemmas = append(lemmas, lemma)
 	}
+	return m, lemmas
 }
 
 // IsKnownStem reports whether s is a known dictionary stem.
 // Expects lowercase Azerbaijani Latin input.
 // Results may change as the dictionary grows.
 func IsKnownStem(s string) bool {
-	return isKnownStem(s)
+	return defaultAnalyzer.IsKnownStem(s)
+}
+
+// IsKnownStem reports whether s is a stem in the analyzer's dictionary,
+// including entries added by WithDictionary.
+func (an *Analyzer) IsKnownStem(s string) bool {
+	return an.isKnownStem(s)
 }
 
 // isKnownStem reports whether s is a known dictionary stem.
 // Expects lowercase Latin input.
-func isKnownStem(s string) bool {
+func (an *Analyzer) isKnownStem(s string) bool {
 	if s == "" {
 		return false
 	}
-	_, ok := dictMap[s]
+	_, ok := an.dict[s]
 	return ok
 }
 
 // stemPOS returns the POS byte for a known stem, or 0 if not found.
 // Expects lowercase Latin input.
-//
-//nolint:unused // called from tests; integrated into FSM in PR #2
-func stemPOS(s string) byte {
+func (an *Analyzer) stemPOS(s string) byte {
 	if s == "" {
 		return 0
 	}
-	return dictMap[s]
+	return an.dict[s]
 }
@@ -29,8 +29,8 @@ func TestIsKnownStem(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := isKnownStem(tt.input); got != tt.want {
-				t.Errorf("isKnownStem(%q) = %v, want %v", tt.input, got, tt.want)
+			if got := defaultAnalyzer.isKnownStem(tt.input); got != tt.want {
+				t.Errorf("defaultAnalyzer.isKnownStem(%q) = %v, want %v", tt.input, got, tt.want)
 			}
 		})
 	}
@@ -51,8 +51,8 @@ func TestStemPOS(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := stemPOS(tt.input); got != tt.want {
-				t.Errorf("stemPOS(%q) = %q, want %q", tt.input, got, tt.want)
+			if got := defaultAnalyzer.stemPOS(tt.input); got != tt.want {
+				t.Errorf("defaultAnalyzer.stemPOS(%q) = %q, want %q", tt.input, got, tt.want)
 			}
 		})
 	}
@@ -70,6 +70,6 @@ func TestDictIntegrity(t *testing.T) {
 
 func BenchmarkIsKnownStem(b *testing.B) {
 	for b.Loop() {
-		isKnownStem("kitab")
+		defaultAnalyzer.isKnownStem("kitab")
 	}
 }
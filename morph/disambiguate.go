@@ -0,0 +1,229 @@
+// Context-sensitive selection among the analyses of a word.
+package morph
+
+import (
+	"cmp"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// maxDisambiguateBytes is the maximum total size of the words passed to
+// Disambiguate. Larger inputs return nil.
+const maxDisambiguateBytes = 1 << 20 // 1 MiB
+
+// Context scores. A candidate replaces the analysis Stem would choose only
+// when it scores strictly higher.
+const (
+	scoreAfterNumeral = 2 // nominal after a cardinal (iki alma)
+	scoreAfterDet     = 2 // nominal after a demonstrative (bu alma)
+	scoreIzafet       = 2 // possessive after a genitive (müəllimin dərsi)
+	scoreQuestion     = 2 // question particle before "?" (gəlirmi?)
+	scorePredicate    = 1 // verbal or copular form ending a sentence
+)
+
+// cardinals lists the numeral words after which a noun is expected.
+var cardinals = map[string]bool{
+	"bir": true, "iki": true, "üç": true, "dörd": true, "beş": true,
+	"altı": true, "yeddi": true, "səkkiz": true, "doqquz": true, "on": true,
+	"iyirmi": true, "otuz": true, "qırx": true, "əlli": true, "altmış": true,
+	"yetmiş": true, "səksən": true, "doxsan": true, "yüz": true, "min": true,
+	"milyon": true, "milyard": true, "neçə": true,
+}
+
+// demonstratives lists the determiners after which a noun is expected.
+// "o" is left out: it is as often the pronoun "he, she" (o gəlir).
+var demonstratives = map[string]bool{
+	"bu": true, "həmin": true, "şu": true, "hər": true, "bütün": true,
+}
+
+// genitivePronouns maps the personal pronouns in the genitive, which
+// Analyze does not decompose, to the possessive suffix they agree with.
+var genitivePronouns = map[string]MorphTag{
+	"mənim": Poss1Sg, "sənin": Poss2Sg, "onun": Poss3Sg,
+	"bizim": Poss1Pl, "sizin": Poss2Pl, "onların": Poss3Sg,
+}
+
+// sentenceEnds lists the tokens that close a declarative sentence.
+var sentenceEnds = map[string]bool{
+	".": true, "!": true, "…": true, "...": true,
+}
+
+// Disambiguate selects one analysis for each word of a sentence or text,
+// using constraints from the neighboring words where Stem and Analyze
+// look at a word in isolation:
+//
+//   - after a cardinal numeral (iki, 5) or a demonstrative (bu, həmin),
+//     a nominal analysis is preferred: "iki alma" is the noun alma, not
+//     al+ma "do not take";
+//   - after a genitive noun or pronoun, an analysis with the agreeing
+//     possessive is preferred (izafət): "müəllimin dərsi" is dərs+Poss3Sg,
+//     not dərs+CaseAcc, and "mənim atım" is at+Poss1Sg;
+//   - before "?", an analysis with the question particle is preferred,
+//     verbal over nominal: "gəlirmi ?" is gəl+ir+mi, not gəlir "income"+mi;
+//   - at the end of a sentence (before ".", "!" or the last word), a
+//     verbal or copular analysis is preferred: "Onu alma ." is al+ma.
+//
+// Words are tokens as produced by tokenizer.Words; include punctuation
+// tokens (e.g. from tokenizer.WordTokens) to enable the sentence and
+// question constraints. Constraints are applied left to right, so each
+// word sees the analysis selected for the word before it. Only analyses
+// with a dictionary stem are promoted. When no constraint applies, the
+// analysis whose stem Stem returns is selected, or the first analysis if
+// Stem's choice is not among them.
+//
+// The result has one Analysis per word, each one of Analyze(word); words
+// that do not analyze (punctuation, empty strings) get a bare Analysis.
+// Returns nil for empty or oversized (>1 MiB total) input.
+func Disambiguate(words []string) []Analysis {
+	return defaultAnalyzer.Disambiguate(words)
+}
+
+// Disambiguate is like Disambiguate, using the analyzer's dictionary and suffix rules.
+func (an *Analyzer) Disambiguate(words []string) []Analysis {
+	if len(words) == 0 {
+		return nil
+	}
+	total := 0
+	for _, w := range words {
+		total += len(w)
+	}
+	if total > maxDisambiguateBytes {
+		return nil
+	}
+
+	out := make([]Analysis, len(words))
+	for i, w := range words {
+		cands := an.analyses(w)
+		if len(cands) == 0 {
+			out[i] = Analysis{Stem: w}
+			continue
+		}
+		best := an.defaultAnalysis(w, cands)
+		bestScore := an.contextScore(cands[best], words, out, i)
+		for j, a := range cands {
+			if s := an.contextScore(a, words, out, i); s > bestScore {
+				best, bestScore = j, s
+			}
+		}
+		out[i] = cands[best]
+	}
+	return out
+}
+
+// defaultAnalysis returns the index of the analysis Stem would choose for
+// word: the first with the same stem, or 0.
+func (an *Analyzer) defaultAnalysis(word string, cands []Analysis) int {
+	stem := an.stem(word)
+	for i, a := range cands {
+		if a.Stem == stem {
+			return i
+		}
+	}
+	return 0
+}
+
+// contextScore scores analysis a of words[i] against its neighbors;
+// prev holds the analyses already selected for words[:i].
+func (an *Analyzer) contextScore(a Analysis, words []string, prev []Analysis, i int) int {
+	if !an.isKnownStem(azcase.ToLower(a.Stem)) {
+		return 0
+	}
+	score := 0
+	nominal := !an.isVerbalAnalysis(a)
+
+	if i > 0 {
+		before := azcase.ToLower(words[i-1])
+		switch {
+		case isCardinal(before):
+			if nominal {
+				score += scoreAfterNumeral
+			}
+		case demonstratives[before]:
+			if nominal {
+				score += scoreAfterDet
+			}
+		case genitivePronouns[before] != 0 || lastTag(prev[i-1]) == CaseGen:
+			if hasPossessive(a, cmp.Or(genitivePronouns[before], Poss3Sg)) {
+				score += scoreIzafet
+			}
+		}
+	}
+
+	next := ""
+	if i+1 < len(words) {
+		next = words[i+1]
+	}
+	switch {
+	case next == "?":
+		if hasTag(a, Question) {
+			score += scoreQuestion
+			if !nominal {
+				score++
+			}
+		}
+	case next == "" || sentenceEnds[next]:
+		if !nominal || hasTag(a, Copula) {
+			score += scorePredicate
+		}
+	}
+	return score
+}
+
+// isVerbalAnalysis reports whether a ends as a verb form: a verb stem, or
+// a verbal suffix, not followed by a suffix that makes a noun or adjective
+// (participles, verbal nouns, derivational suffixes, case and plural).
+func (an *Analyzer) isVerbalAnalysis(a Analysis) bool {
+	verbal := an.stemPOS(azcase.ToLower(a.Stem)) == 'V'
+	for _, m := range a.Morphemes {
+		switch {
+		case m.Tag == DerivVerb:
+			verbal = true
+		case nominalizers[m.Tag] || m.Tag >= nounBase && m.Tag < copBase:
+			verbal = false
+		case isVerbalTag(m.Tag):
+			verbal = true
+		}
+	}
+	return verbal
+}
+
+// isCardinal reports whether a lowercase token is a cardinal numeral:
+// a number word or a run of digits.
+func isCardinal(w string) bool {
+	if cardinals[w] {
+		return true
+	}
+	if w == "" {
+		return false
+	}
+	for i := range len(w) {
+		if (w[i] < '0' || w[i] > '9') && w[i] != '.' && w[i] != ',' {
+			return false
+		}
+	}
+	return w[0] >= '0' && w[0] <= '9'
+}
+
+// hasTag reports whether a has a morpheme tagged t.
+func hasTag(a Analysis, t MorphTag) bool {
+	for _, m := range a.Morphemes {
+		if m.Tag == t {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPossessive reports whether a has the possessive suffix poss. The
+// third person accepts either number (onun kitabları, onların kitabı).
+func hasPossessive(a Analysis, poss MorphTag) bool {
+	return hasTag(a, poss) || poss == Poss3Sg && hasTag(a, Poss3Pl)
+}
+
+// lastTag returns the tag of the last morpheme of a, or 0.
+func lastTag(a Analysis) MorphTag {
+	if len(a.Morphemes) == 0 {
+		return 0
+	}
+	return a.Morphemes[len(a.Morphemes)-1].Tag
+}
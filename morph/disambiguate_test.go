@@ -0,0 +1,98 @@
+package morph
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDisambiguate(t *testing.T) {
+	tests := []struct {
+		name     string
+		sentence string
+		word     int    // index of the word under test
+		want     string // Analysis.String() of the selected analysis
+	}{
+		// -- Nominal after a numeral or demonstrative --
+		{"after numeral word", "iki alma aldım .", 1, "alma"},
+		{"after digits", "5 alma aldım .", 1, "alma"},
+		{"after demonstrative", "Bu alma .", 1, "alma"},
+
+		// -- Possessive after a genitive (izafət) --
+		{"genitive noun", "müəllimin dərsi maraqlıdır .", 1, "dərs[Poss3Sg:i]"},
+		{"genitive pronoun", "Mənim atım qaçdı .", 1, "at[Poss1Sg:ım]"},
+		{"genitive pronoun plural", "Bizim evimiz böyükdür .", 1, "ev[Poss1Pl:imiz]"},
+		{"person must agree", "Onun qalan pulu", 1, "qal[Participle:an]"},
+
+		// -- Question particle before "?" --
+		{"verbal question", "O gəlirmi ?", 1, "gəl[TensePresent:ir|Question:mi]"},
+
+		// -- Predicate at sentence end --
+		{"verb at end", "Onu alma .", 1, "al[Negation:ma]"},
+		{"verb at end without punctuation", "Onu alma", 1, "al[Negation:ma]"},
+		{"copula at end", "Hava gözəldir .", 1, "gözəl[Copula:dir]"},
+
+		// -- No constraint: the analysis Stem chooses --
+		{"default", "alma ağacı", 0, "alma"},
+		{"punctuation", "Onu alma .", 2, "."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words := strings.Fields(tt.sentence)
+			got := Disambiguate(words)
+			if len(got) != len(words) {
+				t.Fatalf("Disambiguate(%q) returned %d analyses, want %d", words, len(got), len(words))
+			}
+			if s := got[tt.word].String(); s != tt.want {
+				t.Errorf("Disambiguate(%q)[%d] = %s, want %s", words, tt.word, s, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisambiguateReturnsAnalyses(t *testing.T) {
+	words := strings.Fields("Uşaqlar məktəbə getdilər , kitablarını oxudular .")
+	for i, a := range Disambiguate(words) {
+		found := false
+		for _, c := range Analyze(words[i]) {
+			if c.String() == a.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%q: selected %s is not among Analyze results", words[i], a)
+		}
+	}
+}
+
+func TestDisambiguateEdgeCases(t *testing.T) {
+	if got := Disambiguate(nil); got != nil {
+		t.Errorf("Disambiguate(nil) = %v, want nil", got)
+	}
+	if got := Disambiguate([]string{strings.Repeat("a", maxDisambiguateBytes+1)}); got != nil {
+		t.Errorf("oversized input: got %d analyses, want nil", len(got))
+	}
+	got := Disambiguate([]string{"", "?"})
+	if len(got) != 2 || got[0].Stem != "" || got[1].Stem != "?" {
+		t.Errorf("Disambiguate(\"\", \"?\") = %v", got)
+	}
+}
+
+func BenchmarkDisambiguate(b *testing.B) {
+	words := strings.Fields("Müəllimin dərsi maraqlı idi , iki alma aldım . O gəlirmi ?")
+	for b.Loop() {
+		Disambiguate(words)
+	}
+}
+
+func ExampleDisambiguate() {
+	for _, s := range []string{"iki alma aldım .", "Onu alma .", "müəllimin dərsi"} {
+		a := Disambiguate(strings.Fields(s))
+		fmt.Println(s, "→", a[1])
+	}
+	// Output:
+	// iki alma aldım . → alma
+	// Onu alma . → al[Negation:ma]
+	// müəllimin dərsi → dərs[Poss3Sg:i]
+}
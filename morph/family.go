@@ -0,0 +1,66 @@
+// Derivational families: the words formed from a stem by the productive
+// derivational suffixes (dost → dostluq, dostlaş).
+package morph
+
+import "github.com/az-ai-labs/az-lang-nlp/azcase"
+
+// familySuffixes are the derivational suffixes Family tries, in output
+// order, with their allomorphs. The denominal verb is both -la (təmizlə)
+// and -laş (gözəlləş).
+var familySuffixes = []struct {
+	tag      MorphTag
+	surfaces []string
+	harmony  harmonyKind
+}{
+	{DerivAgent, []string{"çı", "çi", "çu", "çü"}, fourWay},
+	{DerivAbstract, []string{"lıq", "lik", "luq", "lük"}, fourWay},
+	{DerivPriv, []string{"sız", "siz", "suz", "süz"}, fourWay},
+	{DerivPoss, []string{"lı", "li", "lu", "lü"}, fourWay},
+	{DerivVerb, []string{"la", "lə"}, backFront},
+	{DerivVerb, []string{"laş", "ləş"}, backFront},
+}
+
+// Family returns the derivational family of stem: the agent noun (-çı),
+// abstract noun (-lıq), privative (-sız) and possessive (-lı) adjectives,
+// and denominal verbs (-la, -laş) formed from it that are dictionary
+// stems, e.g. "iş" → [işçi işsiz işlə]. Verbs are given as stems, without
+// the infinitive -maq/-mək. The suffixes follow vowel harmony with the
+// last vowel of stem.
+//
+// Family is meant for query expansion in search: the words share a root
+// but not always a meaning (göz → gözlük "glasses"). The stem itself is
+// not included. Input is lowercased; returns nil when stem is empty,
+// exceeds maxWordBytes, has no vowel, or has no derivation in the
+// dictionary.
+func Family(stem string) []string {
+	return defaultAnalyzer.Family(stem)
+}
+
+// Family is like Family, validating the derivations against the
+// analyzer's dictionary.
+func (an *Analyzer) Family(stem string) []string {
+	if stem == "" || len(stem) > maxWordBytes {
+		return nil
+	}
+	stem = azcase.ToLower(azcase.ComposeNFC(stem))
+	lv := lastVowel(stem)
+	if lv == 0 {
+		return nil
+	}
+
+	var family []string
+	for _, fs := range familySuffixes {
+		for _, s := range fs.surfaces {
+			fv := firstVowel(s)
+			if fs.harmony == fourWay && !matchesFourWay(lv, fv) ||
+				fs.harmony == backFront && !matchesBackFront(lv, fv) {
+				continue
+			}
+			if w := stem + s; an.isKnownStem(w) {
+				family = append(family, w)
+			}
+			break
+		}
+	}
+	return family
+}
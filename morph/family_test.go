@@ -0,0 +1,69 @@
+package morph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFamily(t *testing.T) {
+	tests := []struct {
+		stem string
+		want []string
+	}{
+		// -- Four-way harmony --
+		{"iş", []string{"işçi", "işsiz", "işlə"}},
+		{"balıq", []string{"balıqçı"}},
+		{"dost", []string{"dostluq", "dostlaş"}},
+		{"təmiz", []string{"təmizlik", "təmizlə"}},
+		{"duz", []string{"duzsuz", "duzlu", "duzla"}},
+		{"ürək", []string{"ürəkli"}},
+
+		// -- Vowel-final stems --
+		{"su", []string{"sulu", "sula"}},
+
+		// -- Case --
+		{"Ağıl", []string{"ağılsız", "ağıllı"}},
+
+		// -- No derivation in the dictionary --
+		{"kitab", nil},
+		{"BMT", nil},
+		{"", nil},
+		{strings.Repeat("a", maxWordBytes+1), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.stem, func(t *testing.T) {
+			if got := Family(tt.stem); !slices.Equal(got, tt.want) {
+				t.Errorf("Family(%q) = %q, want %q", tt.stem, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzerFamily(t *testing.T) {
+	an, err := NewAnalyzer(WithDictionary(strings.NewReader("Nkitabçı\nAkitabsız\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"kitabçı", "kitabsız"}
+	if got := an.Family("kitab"); !slices.Equal(got, want) {
+		t.Errorf("Family(%q) = %q, want %q", "kitab", got, want)
+	}
+}
+
+func BenchmarkFamily(b *testing.B) {
+	for b.Loop() {
+		Family("təmiz")
+	}
+}
+
+func ExampleFamily() {
+	for _, w := range []string{"iş", "dost", "gözəl"} {
+		fmt.Println(w, Family(w))
+	}
+	// Output:
+	// iş [işçi işsiz işlə]
+	// dost [dostluq dostlaş]
+	// gözəl [gözəllik gözəlləş]
+}
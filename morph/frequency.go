@@ -0,0 +1,98 @@
+package morph
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+)
+
+// runeWeight is the factor applied to an analysis score per rune the
+// analysis does not explain by a dictionary stem: each rune of stripped
+// suffix, or every rune of the word when the stem is unknown. Short stems
+// are far more frequent than long ones, so each stripped letter must be
+// paid for with a tenfold gain in stem frequency.
+const runeWeight = 0.1
+
+// unigramFreq returns the corpus word frequencies from the embedded spell
+// frequency list. It is loaded on first use so that callers of
+// SplitCompound or Syllabify alone do not pay for it.
+var unigramFreq = sync.OnceValue(func() map[string]int64 {
+	lines := bytes.Split(data.SpellFreq, []byte("\n"))
+	m := make(map[string]int64, len(lines))
+	for _, line := range lines {
+		sp := bytes.LastIndexByte(line, ' ')
+		if sp <= 0 {
+			continue
+		}
+		freq, err := strconv.ParseInt(string(line[sp+1:]), 10, 64)
+		if err != nil || freq < 0 {
+			continue
+		}
+		m[string(line[:sp])] = freq
+	}
+	return m
+})
+
+// score sets the Score of each analysis from the corpus frequency of its
+// stem. The raw score of a dictionary stem that admits its first suffix
+// is its frequency plus one, times runeWeight per rune of suffix; any
+// other analysis scores runeWeight per rune of the word. Scores are then
+// divided by their sum, so they add up to 1.0 across the analyses of a
+// word.
+func (an *Analyzer) score(results []Analysis) {
+	freq := unigramFreq()
+	var total float64
+	for i := range results {
+		a := &results[i]
+		raw, unexplained := 1.0, 0
+		if stem := azcase.ToLower(a.Stem); an.isKnownStem(stem) && an.admitsFirst(stem, a.Morphemes) {
+			raw += float64(freq[stem])
+		} else {
+			unexplained = utf8.RuneCountInString(a.Stem)
+		}
+		for _, m := range a.Morphemes {
+			unexplained += utf8.RuneCountInString(m.Surface)
+		}
+		a.Score = raw * math.Pow(runeWeight, float64(unexplained))
+		total += a.Score
+	}
+	for i := range results {
+		results[i].Score /= total
+	}
+}
+
+// admitsFirst reports whether the known stem can carry the first of ms:
+// a verbal suffix only attaches to a verb, and a derivational or voice
+// suffix is not stripped when it forms another dictionary stem (görüş is
+// a verb of its own, not gör + reciprocal).
+func (an *Analyzer) admitsFirst(stem string, ms []Morpheme) bool {
+	if len(ms) == 0 {
+		return true
+	}
+	t := ms[0].Tag
+	if isVerbalTag(t) && an.stemPOS(stem) != 'V' {
+		return false
+	}
+	if t >= derivBase && t < copBase || t >= vvoiceBase && t < vnegBase {
+		return !an.isKnownStem(stem + azcase.ToLower(ms[0].Surface))
+	}
+	return true
+}
+
+// bestKnown returns the index of the highest-scoring analysis with
+// morphemes and a dictionary stem, or -1. Ties keep the earlier analysis.
+func (an *Analyzer) bestKnown(results []Analysis) int {
+	best := -1
+	for i, a := range results {
+		if len(a.Morphemes) > 0 && an.isKnownStem(azcase.ToLower(a.Stem)) &&
+			(best < 0 || a.Score > results[best].Score) {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,116 @@
+package morph
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAnalyzeScores(t *testing.T) {
+	for _, w := range []string{"kitablarımızdan", "oğlunun", "ana", "gəlmədi", "xyzabc", "2026-cı", "Bakıda"} {
+		t.Run(w, func(t *testing.T) {
+			var sum float64
+			for _, a := range Analyze(w) {
+				if a.Score < 0 || a.Score > 1 {
+					t.Errorf("%v: Score = %v, want 0.0-1.0", a, a.Score)
+				}
+				sum += a.Score
+			}
+			if math.Abs(sum-1) > 1e-9 {
+				t.Errorf("scores of %q sum to %v, want 1.0", w, sum)
+			}
+		})
+	}
+}
+
+func TestAnalyzeScoreRanking(t *testing.T) {
+	tests := []struct {
+		word string
+		best string // analysis with the highest score
+	}{
+		// The frequent stem outweighs the extra stripped letters.
+		{"oğlunun", "oğlu[CaseGen:nun]"},
+		{"hallar", "hal[Plural:lar]"},
+		{"kitablarımızdan", "kitab[Plural:lar|Poss1Pl:ımız|CaseAbl:dan]"},
+		// A frequent whole word outweighs a split into a frequent stem.
+		{"ana", "ana"},
+		{"Bakı", "Bakı"},
+		// A verbal suffix on a noun stem earns no frequency (gəlmə "coming").
+		{"gəlmədi", "gəl[Negation:mə|TensePastDef:di]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			analyses := Analyze(tt.word)
+			for i := 1; i < len(analyses); i++ {
+				if analyses[i].Score > analyses[i-1].Score {
+					t.Errorf("Analyze(%q) not sorted by Score: %v before %v", tt.word, analyses[i-1], analyses[i])
+				}
+			}
+			if got := analyses[0].String(); got != tt.best {
+				t.Errorf("first analysis of %q = %s (%.3f), want %s", tt.word, got, analyses[0].Score, tt.best)
+			}
+		})
+	}
+}
+
+func TestStemFrequency(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		// A more frequent known stem beats a longer one.
+		{"oğlunun", "oğlu"},
+		{"sənədin", "sənəd"},
+		{"internetin", "internet"},
+		{"kameralar", "kamera"},
+		{"ananın", "ana"},
+		// Lexicalized derivations keep their own stem.
+		{"görüşdü", "görüş"},
+		{"barışdı", "barış"},
+		{"satılır", "satıl"},
+		// A verbal suffix needs a verb stem (not il + reciprocal).
+		{"ilişib", "iliş"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.want {
+				t.Errorf("Stem(%q) = %q, want %q\n  analyses: %v", tt.word, got, tt.want, Analyze(tt.word))
+			}
+		})
+	}
+}
+
+func TestAdmitsFirst(t *testing.T) {
+	an := defaultAnalyzer
+	tests := []struct {
+		stem string
+		ms   []Morpheme
+		want bool
+	}{
+		{"kitab", nil, true},
+		{"kitab", []Morpheme{{"lar", Plural}}, true},
+		{"gəl", []Morpheme{{"di", TensePastDef}}, true},
+		{"il", []Morpheme{{"iş", VoiceRecip}}, false},
+		{"gör", []Morpheme{{"üş", VoiceRecip}}, false},
+	}
+	for _, tt := range tests {
+		if got := an.admitsFirst(tt.stem, tt.ms); got != tt.want {
+			t.Errorf("admitsFirst(%q, %v) = %v, want %v", tt.stem, tt.ms, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkAnalyzeScored(b *testing.B) {
+	for b.Loop() {
+		Analyze("oğlunun")
+	}
+}
+
+func ExampleAnalysis_score() {
+	for _, a := range Analyze("ana") {
+		fmt.Printf("%s %.2f\n", a, a.Score)
+	}
+	// Output:
+	// ana 0.97
+	// an[CaseDat:a] 0.03
+}
@@ -14,75 +14,17 @@ const maxAnalyses = 128
 
 // walker holds the state for a single backtracking morphological analysis run.
 type walker struct {
-	origRunes  []rune     // original-cased word as runes
-	lowerRunes []rune     // lowercased word as runes
-	results    []Analysis // accumulated analyses
-}
-
-// terminalStates caches all unique toState values from suffixRules.
-// These represent states that can be the "outermost" (rightmost) suffix outcome.
-// Computed once at init time.
-var terminalStates []fsmState
-
-// minSuffixLen maps each toState to the shortest suffix (in runes) that
-// produces that state. Used for early termination in walk().
-var minSuffixLen map[fsmState]int
-
-func init() {
-	seen := make(map[fsmState]bool)
-	for i := range suffixRules {
-		seen[suffixRules[i].toState] = true
-	}
-	terminalStates = make([]fsmState, 0, len(seen))
-	for s := range seen {
-		terminalStates = append(terminalStates, s)
-	}
-
-	// Pre-compute rune forms for all suffix surfaces.
-	for i := range suffixRules {
-		rule := &suffixRules[i]
-		rule.surfaceRunes = make([][]rune, len(rule.surfaces))
-		for j, s := range rule.surfaces {
-			rule.surfaceRunes[j] = []rune(s)
-		}
-	}
-
-	// Compute minimum suffix length for each toState.
-	minSuffixLen = make(map[fsmState]int)
-	for i := range suffixRules {
-		rule := &suffixRules[i]
-		for _, sr := range rule.surfaceRunes {
-			l := len(sr)
-			if prev, ok := minSuffixLen[rule.toState]; !ok || l < prev {
-				minSuffixLen[rule.toState] = l
-			}
-		}
-	}
+	origRunes    []rune     // original-cased word as runes
+	lowerRunes   []rune     // lowercased word as runes
+	results      []Analysis // accumulated analyses
+	relaxHarmony bool       // accept suffixes that violate vowel harmony
+	an           *Analyzer  // dictionary and suffix table
 }
 
 // analyze performs morphological analysis on word, returning all valid parses
 // sorted by morpheme count descending (deepest analysis first), deduplicated.
-func analyze(word string) []Analysis {
-	low := azcase.ToLower(word)
-	origRunes := []rune(word)
-	lowerRunes := []rune(low)
-
-	w := &walker{
-		origRunes:  origRunes,
-		lowerRunes: lowerRunes,
-	}
-
-	// The suffix table uses left-to-right morphotactic semantics:
-	//   fromStates = valid predecessor states, toState = successor state.
-	// Since we strip right-to-left, we start from terminal states and
-	// work backward: match rule.toState == currentState, then recurse
-	// into each rule.fromStates entry. Base case: state == initial.
-	runeLen := len(lowerRunes)
-	for _, ts := range terminalStates {
-		w.walk(runeLen, ts, nil, 0)
-	}
-
-	w.results = dedup(w.results)
+func (an *Analyzer) analyze(word string) []Analysis {
+	w := an.walkAll(word, false)
 
 	// Sort analyses by plausibility. Known dictionary stems rank first.
 	// Among known stems, prefer longer stems (less stripping) and simpler
@@ -90,8 +32,8 @@ func analyze(word string) []Analysis {
 	// prefer shorter stems (deeper stripping found the real root), then
 	// simpler analyses (fewer morphemes) for same-length stems.
 	sort.Slice(w.results, func(i, j int) bool {
-		ki := isKnownStem(azcase.ToLower(w.results[i].Stem))
-		kj := isKnownStem(azcase.ToLower(w.results[j].Stem))
+		ki := an.isKnownStem(azcase.ToLower(w.results[i].Stem))
+		kj := an.isKnownStem(azcase.ToLower(w.results[j].Stem))
 		if ki != kj {
 			return ki
 		}
@@ -122,6 +64,31 @@ func analyze(word string) []Analysis {
 	return w.results
 }
 
+// walkAll runs the backtracking walker over word from every terminal state
+// and returns it with deduplicated results. When relaxHarmony is true,
+// suffixes are matched regardless of vowel harmony.
+func (an *Analyzer) walkAll(word string, relaxHarmony bool) *walker {
+	w := &walker{
+		origRunes:    []rune(word),
+		lowerRunes:   []rune(azcase.ToLower(word)),
+		relaxHarmony: relaxHarmony,
+		an:           an,
+	}
+
+	// The suffix table uses left-to-right morphotactic semantics:
+	//   fromStates = valid predecessor states, toState = successor state.
+	// Since we strip right-to-left, we start from terminal states and
+	// work backward: match rule.toState == currentState, then recurse
+	// into each rule.fromStates entry. Base case: state == initial.
+	runeLen := len(w.lowerRunes)
+	for _, ts := range an.terminals {
+		w.walk(runeLen, ts, nil, 0)
+	}
+
+	w.results = dedup(w.results)
+	return w
+}
+
 // walk recursively strips suffixes from the right, building morpheme chains.
 // pos is a rune index: runes [0..pos) are the remaining candidate stem.
 // state is the expected toState of the next suffix to strip (going right-to-left).
@@ -147,12 +114,12 @@ func (w *walker) walk(pos int, state fsmState, morphemes []Morpheme, depth int)
 	}
 
 	// Early termination: remaining runes too short for any suffix producing this state.
-	if minLen, ok := minSuffixLen[state]; ok && pos < minLen {
+	if minLen, ok := w.an.minSuffix[state]; ok && pos < minLen {
 		return
 	}
 
-	for ri := range suffixRules {
-		rule := &suffixRules[ri]
+	for ri := range w.an.rules {
+		rule := &w.an.rules[ri]
 		if rule.toState != state {
 			continue
 		}
@@ -174,12 +141,14 @@ func (w *walker) walk(pos int, state fsmState, morphemes []Morpheme, depth int)
 			stemLV := lastVowel(stemPart)
 			suffFV := firstVowel(surface)
 
-			switch rule.harmony {
-			case backFront:
+			switch {
+			case w.relaxHarmony:
+				// Harmony repair: accept any allomorph, fixed up later.
+			case rule.harmony == backFront:
 				if stemLV != 0 && suffFV != 0 && !matchesBackFront(stemLV, suffFV) {
 					continue
 				}
-			case fourWay:
+			case rule.harmony == fourWay:
 				if stemLV != 0 && suffFV != 0 && !matchesFourWay(stemLV, suffFV) {
 					continue
 				}
@@ -201,6 +170,15 @@ func (w *walker) walk(pos int, state fsmState, morphemes []Morpheme, depth int)
 				}
 			}
 
+			// Buffer y of the instrumental (-yla/-ylə): only after a vowel,
+			// and not when the y can close a known stem, so that stems
+			// ending in y keep it (sayla → say + la, not sa + yla).
+			if rule.tag == CaseIns && surfRunes[0] == 'y' {
+				if stemEnd == 0 || !isVowel(w.lowerRunes[stemEnd-1]) || w.an.isKnownStem(stemPart+"y") {
+					continue
+				}
+			}
+
 			// Build new morpheme list (prepend for left-to-right order).
 			origSurface := string(w.origRunes[stemEnd:pos])
 			newMorphemes := make([]Morpheme, len(morphemes)+1)
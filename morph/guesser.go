@@ -0,0 +1,168 @@
+package morph
+
+import (
+	"sync/atomic"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// guesserCounters is the process-wide counter set installed by
+// EnableGuesserStats; nil when counting is disabled.
+var guesserCounters atomic.Pointer[guesserCounts]
+
+// oovHook is the function installed by SetOOVHook; nil when unset.
+var oovHook atomic.Pointer[func(word string)]
+
+type guesserCounts struct {
+	words     atomic.Uint64
+	hits      atomic.Uint64
+	fallbacks atomic.Uint64
+}
+
+// GuesserInfo reports how the words passed to Analyze, AnalyzeDetailed,
+// Stem, and Stems were resolved. Digit-containing tokens count as words
+// but neither as dictionary hits nor as fallbacks.
+//
+// GuesserInfo marshals to JSON, so it can be published with expvar:
+//
+//	expvar.Publish("morph", expvar.Func(func() any { return morph.GuesserStats() }))
+type GuesserInfo struct {
+	Words          uint64 `json:"words"`           // words analyzed or stemmed
+	DictionaryHits uint64 `json:"dictionary_hits"` // words resolved to a dictionary stem
+	Fallbacks      uint64 `json:"fallbacks"`       // words resolved by the suffix rules alone
+}
+
+// FallbackRate returns the share of words resolved by the suffix rules
+// alone, or 0 when no word was counted.
+func (g GuesserInfo) FallbackRate() float64 {
+	if g.Words == 0 {
+		return 0
+	}
+	return float64(g.Fallbacks) / float64(g.Words)
+}
+
+// Detail is the result of AnalyzeDetailed: the analyses of a word and
+// whether the dictionary backs them.
+type Detail struct {
+	Analyses      []Analysis `json:"analyses"`       // Same as Analyze
+	DictionaryHit bool       `json:"dictionary_hit"` // The word or the stem of one of its analyses is a dictionary stem
+	FallbackUsed  bool       `json:"fallback_used"`  // No dictionary stem: the analyses come from the suffix rules alone
+}
+
+// EnableGuesserStats turns counting of dictionary hits and rule-only
+// fallbacks on or off. The counters are process-wide, shared by the
+// package-level functions and every Analyzer, and include calls made by
+// other packages of this module (spell, pos, keywords). Each call resets
+// them. Counting is disabled by default.
+func EnableGuesserStats(on bool) {
+	if !on {
+		guesserCounters.Store(nil)
+		return
+	}
+	guesserCounters.Store(&guesserCounts{})
+}
+
+// GuesserStats returns the counters enabled by EnableGuesserStats, or a
+// zero GuesserInfo when counting is disabled.
+func GuesserStats() GuesserInfo {
+	c := guesserCounters.Load()
+	if c == nil {
+		return GuesserInfo{}
+	}
+	return GuesserInfo{Words: c.words.Load(), DictionaryHits: c.hits.Load(), Fallbacks: c.fallbacks.Load()}
+}
+
+// SetOOVHook installs fn to be called with every word that Analyze,
+// AnalyzeDetailed, Stem, or Stems resolves by the suffix rules alone,
+// as passed by the caller. fn is called synchronously from the calling
+// goroutine and must be safe for concurrent use. A nil fn removes the
+// hook. Like the counters, the hook is process-wide.
+func SetOOVHook(fn func(word string)) {
+	if fn == nil {
+		oovHook.Store(nil)
+		return
+	}
+	oovHook.Store(&fn)
+}
+
+// AnalyzeDetailed is like Analyze but also reports whether the dictionary
+// backs the analyses. It returns a zero Detail for empty input.
+func AnalyzeDetailed(word string) Detail {
+	return defaultAnalyzer.AnalyzeDetailed(word)
+}
+
+// AnalyzeDetailed is like AnalyzeDetailed, using the analyzer's dictionary
+// and suffix rules.
+func (an *Analyzer) AnalyzeDetailed(word string) Detail {
+	if word == "" {
+		return Detail{}
+	}
+	d := Detail{Analyses: an.analyses(word)}
+	if len(word) <= maxWordBytes {
+		d.DictionaryHit, d.FallbackUsed = an.coverage(azcase.ComposeNFC(word), d.Analyses)
+	} else {
+		d.FallbackUsed = true
+	}
+	if observing() && len(word) <= maxWordBytes {
+		observe(word, d.DictionaryHit, d.FallbackUsed)
+	}
+	return d
+}
+
+// coverage reports whether the NFC word or the stem of one of its
+// analyses is a dictionary stem (hit), or whether neither is and the
+// analyses come from the suffix rules alone (fallback). Digit-containing
+// tokens are neither.
+func (an *Analyzer) coverage(word string, analyses []Analysis) (hit, fallback bool) {
+	if _, ok := analyzeNumeric(word); ok {
+		return false, false
+	}
+	if an.isKnownStem(azcase.ToLower(word)) {
+		return true, false
+	}
+	for _, a := range analyses {
+		if an.isKnownStem(azcase.ToLower(a.Stem)) {
+			return true, false
+		}
+	}
+	return false, true
+}
+
+// stemCoverage is coverage for Stem, which returned stem for the NFC word.
+// ok is false for a hyphenated word, whose parts observeStem counts separately.
+func (an *Analyzer) stemCoverage(word, stem string) (hit, fallback, ok bool) {
+	if _, numeric := analyzeNumeric(word); numeric {
+		return false, false, true
+	}
+	if isHyphenated(word) {
+		return false, false, false
+	}
+	if an.isKnownStem(azcase.ToLower(word)) || an.isKnownStem(azcase.ToLower(stem)) {
+		return true, false, true
+	}
+	return false, true, true
+}
+
+// observing reports whether counters or a hook are installed, so that
+// callers skip classifying words otherwise.
+func observing() bool {
+	return guesserCounters.Load() != nil || oovHook.Load() != nil
+}
+
+// observe counts word in GuesserStats and passes fallbacks to the hook.
+func observe(word string, hit, fallback bool) {
+	if c := guesserCounters.Load(); c != nil {
+		c.words.Add(1)
+		if hit {
+			c.hits.Add(1)
+		}
+		if fallback {
+			c.fallbacks.Add(1)
+		}
+	}
+	if fallback {
+		if fn := oovHook.Load(); fn != nil {
+			(*fn)(word)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestAnalyzeDetailed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		word         string
+		wantHit      bool
+		wantFallback bool
+	}{
+		{"kitablarımızdan", true, false},
+		{"kitab", true, false},
+		{"Bakıda", true, false},
+		{"gəlmədi", true, false},
+		{"zorbundlar", false, true},
+		{"qlimptəri", false, true},
+		{"2026-cı", false, false},
+		{"COVID-19", false, false},
+	}
+	for _, tt := range tests {
+		d := AnalyzeDetailed(tt.word)
+		if d.DictionaryHit != tt.wantHit || d.FallbackUsed != tt.wantFallback {
+			t.Errorf("AnalyzeDetailed(%q) = hit %v, fallback %v; want %v, %v",
+				tt.word, d.DictionaryHit, d.FallbackUsed, tt.wantHit, tt.wantFallback)
+		}
+		if want := Analyze(tt.word); !slices.EqualFunc(d.Analyses, want, func(a, b Analysis) bool { return a.String() == b.String() }) {
+			t.Errorf("AnalyzeDetailed(%q).Analyses = %v, want %v", tt.word, d.Analyses, want)
+		}
+	}
+	if d := AnalyzeDetailed(""); d.Analyses != nil || d.DictionaryHit || d.FallbackUsed {
+		t.Errorf("AnalyzeDetailed(\"\") = %+v, want zero", d)
+	}
+}
+
+func TestAnalyzerAnalyzeDetailed(t *testing.T) {
+	t.Parallel()
+
+	an, err := NewAnalyzer(WithDictionary(strings.NewReader("Nzorbund\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := an.AnalyzeDetailed("zorbundlar"); !d.DictionaryHit || d.FallbackUsed {
+		t.Errorf("AnalyzeDetailed(zorbundlar) with custom stem = %+v, want dictionary hit", d)
+	}
+}
+
+func TestGuesserStats(t *testing.T) {
+	t.Cleanup(func() { EnableGuesserStats(false) })
+
+	if got := GuesserStats(); got != (GuesserInfo{}) {
+		t.Errorf("GuesserStats() before enabling = %+v, want zero", got)
+	}
+	EnableGuesserStats(true)
+	Analyze("kitablarımızdan")
+	Analyze("zorbundlar")
+	Stem("qlimptəri")
+	Stem("sosial-iqtisadi") // two words
+	Stems([]string{"kitab", "2026-cı"})
+	AnalyzeDetailed("Bakıda")
+	Lemmatize("gəlmədi") // not counted
+
+	want := GuesserInfo{Words: 8, DictionaryHits: 5, Fallbacks: 2}
+	if got := GuesserStats(); got != want {
+		t.Errorf("GuesserStats() = %+v, want %+v", got, want)
+	}
+	if got, want := GuesserStats().FallbackRate(), 0.25; got != want {
+		t.Errorf("FallbackRate() = %v, want %v", got, want)
+	}
+
+	EnableGuesserStats(true)
+	if got := GuesserStats(); got != (GuesserInfo{}) {
+		t.Errorf("GuesserStats() after reset = %+v, want zero", got)
+	}
+	EnableGuesserStats(false)
+	Analyze("zorbundlar")
+	if got := GuesserStats(); got != (GuesserInfo{}) {
+		t.Errorf("GuesserStats() when disabled = %+v, want zero", got)
+	}
+}
+
+func TestGuesserStatsCached(t *testing.T) {
+	t.Cleanup(func() {
+		EnableGuesserStats(false)
+		EnableCache(0)
+	})
+	EnableCache(100)
+	EnableGuesserStats(true)
+
+	for range 3 {
+		Stem("qlorbzak-kitablar")
+		Stem("zorbundlar")
+	}
+
+	want := GuesserInfo{Words: 9, DictionaryHits: 3, Fallbacks: 6}
+	if got := GuesserStats(); got != want {
+		t.Errorf("GuesserStats() with cache = %+v, want %+v", got, want)
+	}
+}
+
+func TestGuesserStatsConcurrent(t *testing.T) {
+	t.Cleanup(func() { EnableGuesserStats(false) })
+	EnableGuesserStats(true)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 100 {
+				Stem("zorbundlar")
+			}
+		})
+	}
+	wg.Wait()
+	if got := GuesserStats(); got.Words != 800 || got.Fallbacks != 800 {
+		t.Errorf("GuesserStats() = %+v, want 800 fallbacks", got)
+	}
+}
+
+func TestSetOOVHook(t *testing.T) {
+	t.Cleanup(func() { SetOOVHook(nil) })
+
+	var mu sync.Mutex
+	var got []string
+	SetOOVHook(func(word string) {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, word)
+	})
+	for _, w := range []string{"kitablar", "Zorbundlar", "2026-cı", "qlimptəri"} {
+		Stem(w)
+	}
+	Analyze("zorbundu")
+
+	if want := []string{"Zorbundlar", "qlimptəri", "zorbundu"}; !slices.Equal(got, want) {
+		t.Errorf("hook saw %q, want %q", got, want)
+	}
+
+	SetOOVHook(nil)
+	Stem("zorbundlar")
+	if len(got) != 3 {
+		t.Errorf("hook called after removal: %q", got)
+	}
+}
+
+func TestGuesserInfoJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(GuesserInfo{Words: 3, DictionaryHits: 2, Fallbacks: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"words":3,"dictionary_hits":2,"fallbacks":1}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	if rate := (GuesserInfo{}).FallbackRate(); rate != 0 {
+		t.Errorf("FallbackRate() of zero GuesserInfo = %v, want 0", rate)
+	}
+}
+
+func BenchmarkStemGuesserStats(b *testing.B) {
+	EnableGuesserStats(true)
+	b.Cleanup(func() { EnableGuesserStats(false) })
+	for b.Loop() {
+		for _, w := range corpusWords[:50] {
+			Stem(w)
+		}
+	}
+}
+
+func ExampleAnalyzeDetailed() {
+	for _, w := range []string{"kitablarımızdan", "zorbundlar"} {
+		d := AnalyzeDetailed(w)
+		fmt.Println(w, d.DictionaryHit, d.FallbackUsed)
+	}
+	// Output:
+	// kitablarımızdan true false
+	// zorbundlar false true
+}
+
+func ExampleSetOOVHook() {
+	SetOOVHook(func(word string) { fmt.Println("OOV:", word) })
+	defer SetOOVHook(nil)
+
+	fmt.Println(Stem("kitablar"))
+	fmt.Println(Stem("zorbundlar"))
+	// Output:
+	// kitab
+	// OOV: zorbundlar
+	// zorbund
+}
@@ -0,0 +1,148 @@
+// Vowel harmony repair for Azerbaijani suffix chains.
+//
+// Learner text frequently attaches the wrong allomorph of a harmonizing
+// suffix (kitablər, evlarda). SuggestHarmony re-analyzes such words with
+// harmony checks disabled, and for every parse whose stem is a known
+// dictionary entry, regenerates the suffix chain left to right, choosing
+// the allomorph that agrees with the preceding vowel.
+package morph
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// maxHarmonySuggestions caps the number of repaired forms returned.
+const maxHarmonySuggestions = 3
+
+// SuggestHarmony proposes corrected spellings for a word whose suffix chain
+// violates vowel harmony, e.g. "kitablər" → ["kitablar"] and
+// "evlarda" → ["evlərdə"].
+// Returns nil when the word already has a harmonic analysis, when no
+// known dictionary stem is found, or when the input is empty or exceeds
+// maxWordBytes. Suggestions are ordered by plausibility (longest known
+// stem first) and carry the case pattern of the input.
+func SuggestHarmony(word string) []string {
+	return defaultAnalyzer.SuggestHarmony(word)
+}
+
+// SuggestHarmony is like SuggestHarmony, using the analyzer's dictionary and suffix rules.
+func (an *Analyzer) SuggestHarmony(word string) []string {
+	if word == "" || len(word) > maxWordBytes {
+		return nil
+	}
+	word = azcase.ComposeNFC(word)
+	lower := azcase.ToLower(word)
+	if an.isKnownStem(lower) || an.hasKnownParse(an.analyze(word)) {
+		return nil
+	}
+
+	candidates := an.walkAll(word, true).results
+	sort.SliceStable(candidates, func(i, j int) bool {
+		si, sj := len([]rune(candidates[i].Stem)), len([]rune(candidates[j].Stem))
+		if si != sj {
+			return si > sj
+		}
+		return len(candidates[i].Morphemes) < len(candidates[j].Morphemes)
+	})
+
+	lowerRunes := []rune(lower)
+	var out []string
+	seen := make(map[string]bool)
+	for _, a := range candidates {
+		if len(a.Morphemes) == 0 || !an.isKnownStem(azcase.ToLower(a.Stem)) {
+			continue
+		}
+		// Use the surface stem from the word itself so that k/q softening
+		// (ürəy- for ürək) is kept intact.
+		surfaceStem := string(lowerRunes[:len([]rune(a.Stem))])
+		repaired, ok := an.reharmonize(surfaceStem, a.Morphemes)
+		if !ok || repaired == lower || seen[repaired] {
+			continue
+		}
+		seen[repaired] = true
+		out = append(out, azcase.ApplyCase(word, repaired))
+		if len(out) == maxHarmonySuggestions {
+			break
+		}
+	}
+	return out
+}
+
+// hasKnownParse reports whether any analysis has a known dictionary stem
+// and at least one morpheme.
+func (an *Analyzer) hasKnownParse(results []Analysis) bool {
+	for _, a := range results {
+		if len(a.Morphemes) > 0 && an.isKnownStem(azcase.ToLower(a.Stem)) {
+			return true
+		}
+	}
+	return false
+}
+
+// reharmonize rebuilds stem + suffix chain, replacing each suffix surface
+// with the allomorph of the same rule that harmonizes with the form built
+// so far. Returns false if a morpheme cannot be matched to a rule.
+func (an *Analyzer) reharmonize(stem string, morphemes []Morpheme) (string, bool) {
+	var sb strings.Builder
+	sb.WriteString(stem)
+	for _, m := range morphemes {
+		surface := azcase.ToLower(m.Surface)
+		fixed, ok := an.harmonicAllomorph(sb.String(), surface, m.Tag)
+		if !ok {
+			return "", false
+		}
+		sb.WriteString(fixed)
+	}
+	return sb.String(), true
+}
+
+// harmonicAllomorph returns the allomorph of the rule that produced
+// surface (identified by tag and surface membership) which has the same
+// consonant skeleton as surface and agrees in harmony with prefix.
+func (an *Analyzer) harmonicAllomorph(prefix, surface string, tag MorphTag) (string, bool) {
+	lv := lastVowel(prefix)
+	skeleton := consonantSkeleton(surface)
+	for ri := range an.rules {
+		rule := &an.rules[ri]
+		if rule.tag != tag || !slices.Contains(rule.surfaces, surface) {
+			continue
+		}
+		if rule.harmony == noHarmony || lv == 0 {
+			return surface, true
+		}
+		for _, alt := range rule.surfaces {
+			if consonantSkeleton(alt) != skeleton {
+				continue
+			}
+			fv := firstVowel(alt)
+			if fv == 0 {
+				return surface, true
+			}
+			if rule.harmony == backFront && matchesBackFront(lv, fv) ||
+				rule.harmony == fourWay && matchesFourWay(lv, fv) {
+				return alt, true
+			}
+		}
+		return surface, true
+	}
+	return "", false
+}
+
+// consonantSkeleton replaces every vowel in s with 'V', so that allomorphs
+// differing only in harmony (lar/lər, dır/dür) share the same skeleton.
+func consonantSkeleton(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for _, r := range s {
+		if isVowel(r) {
+			sb.WriteByte('V')
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
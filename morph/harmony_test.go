@@ -0,0 +1,80 @@
+package morph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSuggestHarmony(t *testing.T) {
+	tests := []struct {
+		word string
+		want []string
+	}{
+		// -- Back/front violations --
+		{"kitablər", []string{"kitablar"}},
+		{"evlarda", []string{"evlərdə"}},
+		{"qızlər", []string{"qızlar"}},
+		{"məktəbdan", []string{"məktəbdən"}},
+		{"uşaqlərdə", []string{"uşaqlarda"}},
+
+		// -- Four-way violations --
+		{"gəldı", []string{"gəldi"}},
+
+		// -- k/q softening preserved --
+		{"ürəya", []string{"ürəyə"}},
+
+		// -- Case preservation --
+		{"Kitablər", []string{"Kitablar"}},
+
+		// -- Already harmonic --
+		{"kitablar", nil},
+		{"evlərdə", nil},
+		{"ürəyə", nil},
+		{"kitab", nil},
+
+		// -- Unknown stem --
+		{"xyzlər", nil},
+
+		// -- Edge cases --
+		{"", nil},
+		{strings.Repeat("a", maxWordBytes+1), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got := SuggestHarmony(tt.word)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SuggestHarmony(%q) = %v, want %v", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuggestHarmonyYieldsHarmonicForms(t *testing.T) {
+	for _, word := range []string{"kitablər", "evlarda", "gözlar", "sulər"} {
+		for _, s := range SuggestHarmony(word) {
+			if !defaultAnalyzer.hasKnownParse(Analyze(s)) {
+				t.Errorf("SuggestHarmony(%q) produced %q with no known-stem analysis", word, s)
+			}
+			if again := SuggestHarmony(s); again != nil {
+				t.Errorf("SuggestHarmony(%q) = %v, want nil for repaired form", s, again)
+			}
+		}
+	}
+}
+
+func BenchmarkSuggestHarmony(b *testing.B) {
+	for b.Loop() {
+		SuggestHarmony("kitablərımızdan")
+	}
+}
+
+func ExampleSuggestHarmony() {
+	fmt.Println(SuggestHarmony("kitablər"))
+	fmt.Println(SuggestHarmony("evlarda"))
+	// Output:
+	// [kitablar]
+	// [evlərdə]
+}
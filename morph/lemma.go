@@ -0,0 +1,299 @@
+package morph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// POS is the part-of-speech category of a dictionary lemma, as recorded
+// in data/dict.txt.
+type POS int
+
+const (
+	UnknownPOS POS = iota // Not in the dictionary
+	Noun                  // Nouns, proper names, pronouns, numerals, determiners
+	Verb                  // Verbs
+	Adjective             // Adjectives
+	Adverb                // Adverbs and closed-class words (conjunctions, postpositions, particles, interjections)
+	OtherPOS              // Any other dictionary category (set phrases)
+)
+
+// posNames maps POS values to their string names.
+var posNames = [...]string{
+	UnknownPOS: "Unknown",
+	Noun:       "Noun",
+	Verb:       "Verb",
+	Adjective:  "Adjective",
+	Adverb:     "Adverb",
+	OtherPOS:   "Other",
+}
+
+// posFromName maps string names back to POS values.
+var posFromName = map[string]POS{
+	"Unknown":   UnknownPOS,
+	"Noun":      Noun,
+	"Verb":      Verb,
+	"Adjective": Adjective,
+	"Adverb":    Adverb,
+	"Other":     OtherPOS,
+}
+
+// posFromByte maps dict.txt POS bytes to POS values.
+var posFromByte = map[byte]POS{
+	'N': Noun,
+	'V': Verb,
+	'A': Adjective,
+	'D': Adverb,
+	'X': OtherPOS,
+}
+
+// String returns the name of the part of speech.
+func (p POS) String() string {
+	if int(p) >= 0 && int(p) < len(posNames) {
+		return posNames[p]
+	}
+	return fmt.Sprintf("POS(%d)", int(p))
+}
+
+// MarshalJSON encodes the part of speech as a JSON string (e.g. "Verb").
+func (p POS) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Verb") into a POS.
+func (p *POS) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := posFromName[s]
+	if !ok {
+		return fmt.Errorf("unknown part of speech: %q", s)
+	}
+	*p = v
+	return nil
+}
+
+// ErrUnknownWord is returned by Lemmatize when no analysis of the word
+// resolves to a dictionary entry.
+var ErrUnknownWord = errors.New("morph: word not in dictionary")
+
+// Lemma is the dictionary form of a word.
+type Lemma struct {
+	Form string `json:"form"` // Dictionary form: the infinitive for verbs (gəlmək), the headword otherwise
+	Stem string `json:"stem"` // Dictionary stem the form is built on (gəl)
+	POS  POS    `json:"pos"`  // Part of speech of the lemma
+}
+
+// Infinitive suffixes, selected by back/front vowel harmony.
+const (
+	infinitiveBack  = "maq"
+	infinitiveFront = "mək"
+)
+
+// lemmaCandidate is a dictionary-backed reading of a word.
+type lemmaCandidate struct {
+	stem   string // dictionary stem, in the casing of the input
+	pos    POS
+	verbal bool // some reading has a suffix chain that only attaches to verbs
+}
+
+// Lemmatize returns the dictionary lemma of an inflected Azerbaijani word
+// and its part of speech.
+//
+// The lemma is resolved against the embedded dictionary. Contracted stems
+// are restored before lookup: dropped vowels (ağzı → ağız), softened final
+// consonants (ayağı → ayaq, çiçəyi → çiçək), and voiced verb-final t
+// (gedir → get). When the suffix chain is verbal (tense, mood, person,
+// voice, negation), the lemma is reported as a Verb even if the dictionary
+// lists the stem under another category (yazırdıq → yazmaq), and verbs are
+// returned in their infinitive form.
+//
+// Returns ErrUnknownWord when no reading of the word resolves to a
+// dictionary entry, including digit-containing tokens. Empty words and
+// words exceeding maxWordBytes are rejected with an error. The casing of
+// the first letter is preserved, as in Stem.
+func Lemmatize(word string) (Lemma, POS, error) {
+	return defaultAnalyzer.Lemmatize(word)
+}
+
+// Lemmatize is like Lemmatize, using the analyzer's dictionary and suffix rules.
+func (an *Analyzer) Lemmatize(word string) (Lemma, POS, error) {
+	if word == "" || len(word) > maxWordBytes {
+		return Lemma{}, UnknownPOS, fmt.Errorf("morph: invalid word length %d", len(word))
+	}
+	word = azcase.ComposeNFC(word)
+	if _, ok := analyzeNumeric(word); ok {
+		return Lemma{}, UnknownPOS, ErrUnknownWord
+	}
+
+	c, ok := an.bestLemma(word)
+	if !ok {
+		return Lemma{}, UnknownPOS, ErrUnknownWord
+	}
+	pos := c.pos
+	if c.verbal {
+		pos = Verb
+	}
+	form := c.stem
+	if pos == Verb {
+		form = infinitive(c.stem)
+	}
+	return Lemma{Form: form, Stem: c.stem, POS: pos}, pos, nil
+}
+
+// LemmatizeAll lemmatizes a slice of words.
+// Designed to be used with tokenizer.Words().
+// Words that cannot be lemmatized yield a Lemma with the word as Form and
+// Stem and POS UnknownPOS. Returns nil if the input is nil.
+func LemmatizeAll(words []string) []Lemma {
+	return defaultAnalyzer.LemmatizeAll(words)
+}
+
+// LemmatizeAll is like LemmatizeAll, using the analyzer's dictionary and suffix rules.
+func (an *Analyzer) LemmatizeAll(words []string) []Lemma {
+	if words == nil {
+		return nil
+	}
+	out := make([]Lemma, len(words))
+	for i, w := range words {
+		l, _, err := an.Lemmatize(w)
+		if err != nil {
+			l = Lemma{Form: w, Stem: w}
+		}
+		out[i] = l
+	}
+	return out
+}
+
+// bestLemma picks the dictionary-backed reading of word. The stem chosen
+// by Stem wins when it is a dictionary entry; otherwise the first reading
+// in candidate order is used.
+func (an *Analyzer) bestLemma(word string) (lemmaCandidate, bool) {
+	cands := an.lemmaCandidates(word)
+
+	lower := azcase.ToLower(word)
+	preferred := azcase.ToLower(an.stem(word))
+	for _, c := range cands {
+		if azcase.ToLower(c.stem) != preferred {
+			continue
+		}
+		// The dictionary lists some inflected verb forms (gedir, deyir)
+		// as verbs; prefer the verb root they decompose into.
+		if c.pos == Verb && preferred == lower {
+			for _, v := range cands {
+				if v.verbal && azcase.ToLower(v.stem) != lower {
+					return v, true
+				}
+			}
+		}
+		return c, true
+	}
+	if len(cands) > 0 {
+		return cands[0], true
+	}
+	return lemmaCandidate{}, false
+}
+
+// lemmaCandidates returns every dictionary-backed reading of word: the
+// infinitive reading (-maq/-mək), which the state machine does not produce
+// word-finally, followed by the readings in Analyze ranking order.
+func (an *Analyzer) lemmaCandidates(word string) []lemmaCandidate {
+	var out []lemmaCandidate
+	seen := make(map[string]int) // lowercase stem -> index in out
+	add := func(stem string, verbal bool) {
+		lower := azcase.ToLower(stem)
+		b, ok := an.dict[lower]
+		if !ok {
+			return
+		}
+		if i, dup := seen[lower]; dup {
+			out[i].verbal = out[i].verbal || verbal
+			return
+		}
+		seen[lower] = len(out)
+		out = append(out, lemmaCandidate{stem: matchFirstCase(word, lower), pos: posFromByte[b], verbal: verbal})
+	}
+
+	lower := azcase.ToLower(word)
+	for _, suf := range [...]string{infinitiveBack, infinitiveFront} {
+		if stem, ok := strings.CutSuffix(lower, suf); ok && infinitive(stem) == lower {
+			add(stem, true)
+		}
+	}
+
+	for _, a := range an.analyses(word) {
+		verbal := len(a.Morphemes) > 0 && isVerbalTag(a.Morphemes[0].Tag)
+		for _, s := range an.restoredStems(azcase.ToLower(a.Stem), len(a.Morphemes) > 0) {
+			add(s, verbal)
+		}
+	}
+
+	return out
+}
+
+// restoredStems returns stem followed by the dictionary forms it may have
+// been contracted from. Restorations are only attempted when a suffix was
+// stripped, and only for stems not already in the dictionary.
+func (an *Analyzer) restoredStems(stem string, inflected bool) []string {
+	out := []string{stem}
+	if !inflected || an.isKnownStem(stem) {
+		return out
+	}
+	if r := an.tryRestoreVowelDrop(stem); r != "" {
+		out = append(out, r)
+	}
+	// Verb-final t voices before a vowel: ged-ir → get, ed-ir → et.
+	if prefix, ok := strings.CutSuffix(stem, "d"); ok && prefix != "" {
+		out = append(out, prefix+"t")
+	}
+	// oxu- class verbs absorb the buffer y: oxuy-ur → oxu.
+	if prefix, ok := strings.CutSuffix(stem, "y"); ok && prefix != "" && isVowel(lastRune(prefix)) {
+		out = append(out, prefix)
+	}
+	return out
+}
+
+// isVerbalTag reports whether a morpheme tag only attaches to verb stems.
+func isVerbalTag(t MorphTag) bool {
+	return t >= vvoiceBase && t < questBase
+}
+
+// infinitive returns the infinitive of a verb stem (gəl → gəlmək,
+// yaz → yazmaq). Stems already ending in an infinitive suffix are
+// returned unchanged.
+func infinitive(stem string) string {
+	lower := azcase.ToLower(stem)
+	if strings.HasSuffix(lower, infinitiveBack) || strings.HasSuffix(lower, infinitiveFront) {
+		return stem
+	}
+	if isBackVowel(azcase.Lower(lastVowel(lower))) {
+		return stem + infinitiveBack
+	}
+	return stem + infinitiveFront
+}
+
+// lastRune returns the last rune of s, or 0 if s is empty.
+func lastRune(s string) rune {
+	r := []rune(s)
+	if len(r) == 0 {
+		return 0
+	}
+	return r[len(r)-1]
+}
+
+// matchFirstCase returns lower with its first rune uppercased when the
+// first rune of orig is uppercase.
+func matchFirstCase(orig, lower string) string {
+	o := []rune(orig)
+	l := []rune(lower)
+	if len(o) == 0 || len(l) == 0 || o[0] == azcase.Lower(o[0]) {
+		return lower
+	}
+	l[0] = azcase.Upper(l[0])
+	return string(l)
+}
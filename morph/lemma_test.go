@@ -0,0 +1,153 @@
+package morph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLemmatize(t *testing.T) {
+	tests := []struct {
+		word string
+		form string
+		stem string
+		pos  POS
+	}{
+		// Nouns and adjectives keep their headword.
+		{"kitablarımızdan", "kitab", "kitab", Noun},
+		{"evdə", "ev", "ev", Noun},
+		{"qızlar", "qız", "qız", Noun},
+		{"qırmızı", "qırmızı", "qırmızı", Adjective},
+		{"salam", "salam", "salam", Adverb},
+		// Whole-word dictionary entries are not split (alın "forehead").
+		{"alın", "alın", "alın", Noun},
+		{"qaymaq", "qaymaq", "qaymaq", Noun},
+		// k/q softening restored.
+		{"ayağı", "ayaq", "ayaq", Noun},
+		{"çiçəyi", "çiçək", "çiçək", Noun},
+		// Vowel drop restored.
+		{"ağzı", "ağız", "ağız", Noun},
+		{"alnımız", "alın", "alın", Noun},
+		// Verbs map to the infinitive.
+		{"gəldim", "gəlmək", "gəl", Verb},
+		{"getdi", "getmək", "get", Verb},
+		{"gəlmək", "gəlmək", "gəl", Verb},
+		{"dedi", "demək", "de", Verb},
+		// Voiced verb-final t restored.
+		{"gedir", "getmək", "get", Verb},
+		{"edir", "etmək", "et", Verb},
+		// oxu- class buffer y removed.
+		{"oxuyuram", "oxumaq", "oxu", Verb},
+		// Verbal suffix chain overrides the dictionary category (yaz "summer").
+		{"yazırdıq", "yazmaq", "yaz", Verb},
+		{"yazmaq", "yazmaq", "yaz", Verb},
+		// Casing of the first letter is preserved.
+		{"Kitablar", "Kitab", "Kitab", Noun},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			l, pos, err := Lemmatize(tt.word)
+			if err != nil {
+				t.Fatalf("Lemmatize(%q) error: %v", tt.word, err)
+			}
+			want := Lemma{Form: tt.form, Stem: tt.stem, POS: tt.pos}
+			if l != want || pos != tt.pos {
+				t.Errorf("Lemmatize(%q) = %+v, %v; want %+v, %v", tt.word, l, pos, want, tt.pos)
+			}
+		})
+	}
+}
+
+func TestLemmatizeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		word    string
+		unknown bool // error wraps ErrUnknownWord
+	}{
+		{"empty", "", false},
+		{"oversized", strings.Repeat("a", maxWordBytes+1), false},
+		{"unknown", "xyzqwe", true},
+		{"ordinal", "2026-cı", true},
+		{"digits", "A4", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, pos, err := Lemmatize(tt.word)
+			if err == nil {
+				t.Fatalf("Lemmatize(%q) = %+v, want error", tt.word, l)
+			}
+			if errors.Is(err, ErrUnknownWord) != tt.unknown {
+				t.Errorf("Lemmatize(%q) error = %v, ErrUnknownWord = %v", tt.word, err, tt.unknown)
+			}
+			if l != (Lemma{}) || pos != UnknownPOS {
+				t.Errorf("Lemmatize(%q) = %+v, %v; want zero values", tt.word, l, pos)
+			}
+		})
+	}
+}
+
+func TestLemmatizeAll(t *testing.T) {
+	if got := LemmatizeAll(nil); got != nil {
+		t.Errorf("LemmatizeAll(nil) = %v, want nil", got)
+	}
+	got := LemmatizeAll([]string{"kitablar", "gəldim", "xyzqwe"})
+	want := []Lemma{
+		{Form: "kitab", Stem: "kitab", POS: Noun},
+		{Form: "gəlmək", Stem: "gəl", POS: Verb},
+		{Form: "xyzqwe", Stem: "xyzqwe", POS: UnknownPOS},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("LemmatizeAll = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("LemmatizeAll[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPOSJSON(t *testing.T) {
+	for p := UnknownPOS; p <= OtherPOS; p++ {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", p, err)
+		}
+		var back POS
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if back != p {
+			t.Errorf("round trip %v = %v", p, back)
+		}
+	}
+	var p POS
+	if err := json.Unmarshal([]byte(`"Pronoun"`), &p); err == nil {
+		t.Error("Unmarshal(\"Pronoun\") = nil error, want error")
+	}
+	if got := POS(99).String(); got != "POS(99)" {
+		t.Errorf("POS(99).String() = %q", got)
+	}
+}
+
+func BenchmarkLemmatize(b *testing.B) {
+	for b.Loop() {
+		_, _, _ = Lemmatize("kitablarımızdan")
+	}
+}
+
+func ExampleLemmatize() {
+	for _, w := range []string{"gəldim", "ayağı", "kitablarımızdan"} {
+		l, pos, err := Lemmatize(w)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(l.Form, pos)
+	}
+	// Output:
+	// gəlmək Verb
+	// ayaq Noun
+	// kitab Noun
+}
@@ -9,16 +9,80 @@
 //   - Convenience: Stem returns just the base form string, and Stems
 //     is a batch wrapper for use with tokenizer.Words().
 //
+// Each Analysis carries a Score: the relative confidence in it among the
+// word's analyses, from the corpus frequency of its stem in the embedded
+// unigram counts and the number of letters it strips. Stem picks the
+// highest-scoring parse among those with a known stem, so frequent
+// stems win over longer ones (oğlunun → oğlu, not oğlun). A stem earns
+// its frequency only if it takes the first suffix: a verbal suffix needs
+// a verb stem, and a derivational or voice suffix that forms another
+// dictionary stem (görüş, barış) is not stripped.
+//
+// Lemmatize resolves a word to its dictionary lemma and part of speech
+// from the embedded dictionary, restoring dropped vowels and softened
+// consonants and returning verbs in the infinitive; LemmatizeAll is its
+// batch form.
+//
+// Disambiguate selects one analysis per word of a sentence from the
+// neighboring words (a noun after a numeral, a possessive after a
+// genitive, a verb before "?" or at the end of a sentence).
+//
+// SplitCompound segments closed compounds (dəmiryolu → dəmir + yolu) into
+// two dictionary stems; Stem uses it for unknown long words that no suffix
+// analysis maps to a known stem.
+//
+// Syllabify splits a word into syllables by the V, VC, CV, CVC patterns
+// of Azerbaijani, and Hyphenate inserts a separator at the syllable
+// boundaries where a line may break.
+//
+// Decline generates the case paradigm of a noun, singular and plural,
+// from the same vowel harmony and consonant softening rules the analyzer
+// strips (otaq → otağın, otağa, otaqda), for language-learning tools and
+// round-trip tests of the analyzer.
+//
+// Family lists the dictionary words derived from a stem by the agent,
+// abstract, privative, possessive, and denominal verb suffixes (iş →
+// işçi, işsiz, işlə), for query expansion.
+//
+// EnableCache turns on a process-wide LRU cache of Analyze and Stem
+// results for corpus-scale workloads; CacheStats reports its hit rate.
+//
+// AnalyzeDetailed reports whether a word's analyses reach a dictionary
+// stem or come from the suffix rules alone (the guesser fallback).
+// EnableGuesserStats counts both outcomes process-wide for GuesserStats,
+// and SetOOVHook passes every fallback word to a callback, for finding
+// the out-of-vocabulary words of a corpus.
+//
+// The package-level functions use the embedded dictionary and built-in
+// suffix rules. NewAnalyzer returns an Analyzer with the same methods over
+// a dictionary extended with domain vocabulary (WithDictionary) and with
+// suffix allomorphs added or removed (WithSuffixes, WithoutSuffixes).
+//
 // The analyzer uses a table-driven morphotactic state machine with
 // backtracking. It validates vowel harmony, consonant assimilation,
 // and suffix ordering constraints without requiring a dictionary.
 //
 // All functions are safe for concurrent use by multiple goroutines.
 //
+// A capitalized word with suffixes after an apostrophe (Bakı'nın,
+// Əliyev’in) is analyzed as a proper noun: the noun is the stem and the
+// suffixes after the apostrophe form the morpheme chain, checked for
+// vowel harmony against the noun (Bakı[CaseGen:'nın]), so callers can read
+// the case of a name. The first morpheme keeps the apostrophe. A suffix
+// part that is not a nominal chain leaves the word as its own stem.
+//
+// Tokens containing digits are not run through the state machine. A
+// digit-leading token with an ordinal suffix (2026-cı, 5ci, 3-üncü) is
+// analyzed as the digits plus an Ordinal morpheme; every other
+// digit-containing token (2026-da, COVID-19, A4) passes through unchanged.
+//
 // Known limitations:
 //
 //   - Dictionary lookup is soft (ranking only). Unknown stems fall back
 //     to rule-based analysis which may over-stem.
+//   - Frequencies are of word forms, not lemmas, and each stem has one
+//     part of speech: homographs such as al ("take", "red") and frequent
+//     function words (ya, də) may pull the score toward a wrong split.
 //   - Vowel drop restoration requires the stem to be in the dictionary.
 //   - oxu- class verbs absorb buffer -y- into the stem (oxuy-).
 //   - Morpheme tagging may prefer deeper parses over correct ones
@@ -29,8 +93,10 @@
 package morph
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/az-ai-labs/az-lang-nlp/azcase"
@@ -47,6 +113,7 @@ const (
 	vpartBase  = 340
 	vpersBase  = 350
 	questBase  = 400
+	numBase    = 450
 )
 
 // MorphTag classifies morphemes by grammatical category.
@@ -68,7 +135,7 @@ const (
 	CaseAcc                                 // -i/-i/-u/-u, -ni/-ni/-nu/-nu
 	CaseLoc                                 // -da/-de, -ta/-te
 	CaseAbl                                 // -dan/-den, -tan/-ten
-	CaseIns                                 // -la/-le
+	CaseIns                                 // -la/-le, -yla/-yle
 )
 
 const (
@@ -127,6 +194,10 @@ const (
 	Question MorphTag = questBase // -mi/-mi, -mu/-mu (question particle)
 )
 
+const (
+	Ordinal MorphTag = numBase // -cı/-ci/-cu/-cü, -ıncı/-inci/-uncu/-üncü after digits (2026-cı)
+)
+
 // morphTagNames maps MorphTag values to their string names.
 var morphTagNames = map[MorphTag]string{
 	Plural:  "Plural",
@@ -181,6 +252,8 @@ var morphTagNames = map[MorphTag]string{
 	Pers3:   "Pers3",
 
 	Question: "Question",
+
+	Ordinal: "Ordinal",
 }
 
 // morphTagFromName maps string names back to MorphTag values.
@@ -237,6 +310,8 @@ var morphTagFromName = map[string]MorphTag{
 	"Pers3":   Pers3,
 
 	"Question": Question,
+
+	"Ordinal": Ordinal,
 }
 
 // productiveTags lists morpheme tags that indicate a genuine productive
@@ -303,6 +378,7 @@ type Morpheme struct {
 type Analysis struct {
 	Stem      string     `json:"stem"`      // The base form
 	Morphemes []Morpheme `json:"morphemes"` // Ordered list of suffixes
+	Score     float64    `json:"score"`     // Relative confidence among the word's analyses, 0.0-1.0
 }
 
 // String returns a debug representation, e.g. kitab[Plural:lar|Poss1Pl:imiz|CaseAbl:dan].
@@ -333,11 +409,11 @@ const maxWordBytes = 256
 // indicates a deeper (more correct) decomposition than a longer stem
 // that absorbed part of the suffix (e.g. gəlmə+di vs gəl+mə+di).
 // Returns the shortest such stem, or "" if none found.
-func findDeepVerbStem(results []Analysis) string {
+func (an *Analyzer) findDeepVerbStem(results []Analysis) string {
 	var best string
 	bestLen := maxWordBytes
 	for _, a := range results {
-		if len(a.Morphemes) == 0 || !isKnownStem(azcase.ToLower(a.Stem)) {
+		if len(a.Morphemes) == 0 || !an.isKnownStem(azcase.ToLower(a.Stem)) {
 			continue
 		}
 		tag := a.Morphemes[0].Tag
@@ -357,10 +433,10 @@ func findDeepVerbStem(results []Analysis) string {
 // Only attempts restoration on stems not already in the dictionary, so that
 // plurals like qızlar→qız are not incorrectly restored (qızl→qızıl).
 // Preserves original casing of the first character.
-func findVowelDropStem(results []Analysis) string {
+func (an *Analyzer) findVowelDropStem(results []Analysis) string {
 	for _, a := range results {
-		if len(a.Morphemes) > 0 && !isKnownStem(azcase.ToLower(a.Stem)) {
-			if restored := tryRestoreVowelDrop(azcase.ToLower(a.Stem)); restored != "" {
+		if len(a.Morphemes) > 0 && !an.isKnownStem(azcase.ToLower(a.Stem)) {
+			if restored := an.tryRestoreVowelDrop(azcase.ToLower(a.Stem)); restored != "" {
 				rOrig := []rune(a.Stem)
 				rRest := []rune(restored)
 				if len(rOrig) > 0 && len(rRest) > 0 && rOrig[0] != azcase.Lower(rOrig[0]) {
@@ -379,14 +455,14 @@ func findVowelDropStem(results []Analysis) string {
 // This allows stemming of words like gələcək→gəl (TenseFuture) and
 // gözlük→göz (DerivAbstract) even when the whole word is in the dictionary.
 // Returns the shorter stem, or "" if no productive decomposition exists.
-func findProductiveStem(results []Analysis, word string) string {
+func (an *Analyzer) findProductiveStem(results []Analysis, word string) string {
 	wordLower := azcase.ToLower(word)
 	for _, a := range results {
 		if len(a.Morphemes) == 0 {
 			continue
 		}
 		stemLower := azcase.ToLower(a.Stem)
-		if stemLower == wordLower || !isKnownStem(stemLower) {
+		if stemLower == wordLower || !an.isKnownStem(stemLower) {
 			continue
 		}
 		// Require at least 2-rune surface to avoid false positives from
@@ -403,62 +479,117 @@ func findProductiveStem(results []Analysis, word string) string {
 // Handles hyphens by stemming each part separately and rejoining.
 // Handles apostrophes by returning the part before the first apostrophe.
 func Stem(word string) string {
+	return defaultAnalyzer.Stem(word)
+}
+
+// Stem is like Stem, using the analyzer's dictionary and suffix rules.
+func (an *Analyzer) Stem(word string) string {
+	s := an.stem(word)
+	if observing() && word != "" && len(word) <= maxWordBytes {
+		an.observeStem(word, azcase.ComposeNFC(word), s)
+	}
+	return s
+}
+
+// observeStem counts word, whose NFC form Stem reduced to stem, in
+// GuesserStats. It runs outside the cache, so repeated words are counted
+// every time; each part of a hyphenated word is counted on its own.
+func (an *Analyzer) observeStem(word, nfc, stem string) {
+	if hit, fallback, ok := an.stemCoverage(nfc, stem); ok {
+		observe(word, hit, fallback)
+		return
+	}
+	parts, stems := strings.Split(nfc, "-"), strings.Split(stem, "-")
+	for i, p := range parts {
+		if p != "" && i < len(stems) {
+			an.observeStem(p, p, stems[i])
+		}
+	}
+}
+
+// stem implements Stem through the cache without counting the word in
+// GuesserStats, for callers inside the package.
+func (an *Analyzer) stem(word string) string {
 	if word == "" || len(word) > maxWordBytes {
 		return word
 	}
 	word = azcase.ComposeNFC(word)
 
-	// Handle hyphens: split, stem each part, rejoin
-	if idx := strings.Index(word, "-"); idx > 0 && idx < len(word)-1 {
+	if c := an.cachePtr(); c != nil {
+		if s, ok := c.stem(word); ok {
+			return s
+		}
+		s := an.stemWord(word)
+		c.setStem(word, s)
+		return s
+	}
+	return an.stemWord(word)
+}
+
+// stemWord implements Stem for a non-empty NFC word.
+func (an *Analyzer) stemWord(word string) string {
+	// Digit-containing tokens bypass the FSM (see analyzeNumeric).
+	if a, ok := analyzeNumeric(word); ok {
+		return a.Stem
+	}
+
+	// Handle hyphens: split, stem each part, rejoin. Stem counts each part
+	// in GuesserStats on its own.
+	if isHyphenated(word) {
 		parts := strings.Split(word, "-")
 		for i, p := range parts {
-			parts[i] = Stem(p)
+			parts[i] = an.stem(p)
 		}
 		return strings.Join(parts, "-")
 	}
 
 	// Handle apostrophes: split at first apostrophe, return pre-apostrophe part
-	for i, r := range word {
-		if r == '\'' || r == '\u2019' || r == '\u02BC' {
-			if i > 0 {
-				return word[:i]
-			}
-			return word // apostrophe at start, return unchanged
+	if i := strings.IndexFunc(word, isApostrophe); i >= 0 {
+		if i > 0 {
+			return word[:i]
 		}
+		return word // apostrophe at start, return unchanged
 	}
 
-	results := Analyze(word)
+	results := an.analyzeWord(word)
 
-	// Four-pass dictionary-aware stem selection.
-	wordKnown := isKnownStem(azcase.ToLower(word))
-	// Pass 1: prefer analysis with morphemes AND known dictionary stem,
-	// but skip when the whole word is also known (avoids stripping real
-	// stems like ana->an where both are dictionary entries).
+	// Four-pass dictionary-aware stem selection, with a compound fallback.
+	wordKnown := an.isKnownStem(azcase.ToLower(word))
+	// Pass 1: prefer an analysis with morphemes AND a known dictionary
+	// stem, the most frequent one when several compete. Skip it when the
+	// whole word is also known, to avoid stripping real stems like
+	// ana->an where both are dictionary entries.
 	if !wordKnown {
 		// First, check for deep verb root: when a derived verbal noun
 		// (gəlmə, yazma) is in the dictionary but the real verb root
 		// (gəl, yaz) should be preferred. Negation, MoodOblig and MoodCond
 		// are close-to-root suffixes that indicate a deeper decomposition.
-		if deep := findDeepVerbStem(results); deep != "" {
+		if deep := an.findDeepVerbStem(results); deep != "" {
 			return deep
 		}
-		for _, a := range results {
-			if len(a.Morphemes) > 0 && isKnownStem(azcase.ToLower(a.Stem)) {
-				return a.Stem
-			}
+		// Among the remaining known stems, take the most frequent parse.
+		if best := an.bestKnown(results); best >= 0 {
+			return results[best].Stem
 		}
 	}
 	// Pass 2: vowel drop restoration (oğl→oğul, aln→alın).
 	if !wordKnown {
-		if restored := findVowelDropStem(results); restored != "" {
+		if restored := an.findVowelDropStem(results); restored != "" {
 			return restored
 		}
 	}
+	// Pass 2b: compound segmentation for unknown long words
+	// (dəmirqapılar→dəmirqapı, qaraçörəklər→qaraçörək).
+	if !wordKnown {
+		if compound := an.findCompoundStem(word); compound != "" {
+			return compound
+		}
+	}
 	// Pass 3: if the whole word is a known dictionary stem, prefer keeping
 	// it unless a productive decomposition (verbal/derivational suffix with
 	// a known shorter stem) exists.
 	if wordKnown {
-		if prod := findProductiveStem(results, word); prod != "" {
+		if prod := an.findProductiveStem(results, word); prod != "" {
 			return prod
 		}
 		return word
@@ -473,10 +604,27 @@ func Stem(word string) string {
 }
 
 // Analyze performs morphological analysis on an Azerbaijani word.
-// Returns all possible analyses (stems with suffix chains).
+// Returns all possible analyses (stems with suffix chains), most likely
+// first: by Score, each one's frequency-based confidence, descending.
 // Returns nil for empty input.
 // Returns a single-element slice with the original word as stem if analysis fails.
 func Analyze(word string) []Analysis {
+	return defaultAnalyzer.Analyze(word)
+}
+
+// Analyze is like Analyze, using the analyzer's dictionary and suffix rules.
+func (an *Analyzer) Analyze(word string) []Analysis {
+	a := an.analyses(word)
+	if observing() && word != "" && len(word) <= maxWordBytes {
+		hit, fallback := an.coverage(azcase.ComposeNFC(word), a)
+		observe(word, hit, fallback)
+	}
+	return a
+}
+
+// analyses implements Analyze through the cache without counting the
+// word in GuesserStats, for callers inside the package.
+func (an *Analyzer) analyses(word string) []Analysis {
 	if word == "" {
 		return nil
 	}
@@ -485,27 +633,78 @@ func Analyze(word string) []Analysis {
 	}
 	word = azcase.ComposeNFC(word)
 
-	results := analyze(word)
+	if c := an.cachePtr(); c != nil {
+		if a, ok := c.analyses(word); ok {
+			return a
+		}
+		a := an.rankedAnalyses(word)
+		c.setAnalyses(word, a)
+		return a
+	}
+	return an.rankedAnalyses(word)
+}
+
+// rankedAnalyses returns the analyses of a non-empty NFC word by Score,
+// highest first; ties keep the walker's order (known stems first).
+func (an *Analyzer) rankedAnalyses(word string) []Analysis {
+	a := an.analyzeWord(word)
+	slices.SortStableFunc(a, func(x, y Analysis) int {
+		return cmp.Compare(y.Score, x.Score)
+	})
+	return a
+}
+
+// analyzeWord implements Analyze for a non-empty NFC word.
+func (an *Analyzer) analyzeWord(word string) []Analysis {
+	if a, ok := analyzeNumeric(word); ok {
+		a.Score = 1
+		return []Analysis{a}
+	}
+
+	// Proper noun with suffixes after an apostrophe (Bakı'nın).
+	if noun, mark, suffixes, ok := splitApostrophe(word); ok {
+		results := an.analyzeApostrophe(noun, mark, suffixes)
+		if len(results) == 0 {
+			return []Analysis{{Stem: word, Score: 1}}
+		}
+		an.score(results)
+		return results
+	}
+
+	results := an.analyze(word)
 	// Always include bare-stem interpretation.
 	if isValidStem(azcase.ToLower(word)) {
 		results = append(results, Analysis{Stem: word})
 	}
 	if len(results) == 0 {
-		return []Analysis{{Stem: word}}
+		return []Analysis{{Stem: word, Score: 1}}
 	}
+	an.score(results)
 	return results
 }
 
+// isHyphenated reports whether word has a hyphen between two parts, which
+// Stem stems separately.
+func isHyphenated(word string) bool {
+	idx := strings.Index(word, "-")
+	return idx > 0 && idx < len(word)-1
+}
+
 // Stems extracts stems from a slice of words.
 // Designed to be used with tokenizer.Words().
 // Returns nil if the input is nil.
 func Stems(words []string) []string {
+	return defaultAnalyzer.Stems(words)
+}
+
+// Stems is like Stems, using the analyzer's dictionary and suffix rules.
+func (an *Analyzer) Stems(words []string) []string {
 	if words == nil {
 		return nil
 	}
 	out := make([]string, len(words))
 	for i, w := range words {
-		out[i] = Stem(w)
+		out[i] = an.Stem(w)
 	}
 	return out
 }
@@ -0,0 +1,51 @@
+package morph
+
+import (
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// ordinalSuffixes lists the ordinal suffix forms written after digits.
+// Vowel harmony with the spoken numeral (altı → 6-cı, üç → 3-cü) is not
+// enforced: digit suffixes are frequently written with the wrong variant,
+// and the number itself carries no vowels to check against.
+var ordinalSuffixes = map[string]bool{
+	"cı": true, "ci": true, "cu": true, "cü": true,
+	"ncı": true, "nci": true, "ncu": true, "ncü": true,
+	"ıncı": true, "inci": true, "uncu": true, "üncü": true,
+}
+
+// analyzeNumeric applies the pass-through policy for tokens containing
+// ASCII digits. It reports false for digit-free words, which go through
+// the regular state machine.
+//
+// A digit-leading token whose remainder (after an optional hyphen) is an
+// ordinal suffix yields the digit run as stem and the remainder, hyphen
+// included, as an Ordinal morpheme, so that Stem + suffix == word.
+// Any other digit-containing token is returned unchanged as a bare stem.
+func analyzeNumeric(word string) (Analysis, bool) {
+	digits := 0
+	for digits < len(word) && word[digits] >= '0' && word[digits] <= '9' {
+		digits++
+	}
+	if digits == 0 {
+		if strings.ContainsAny(word, "0123456789") {
+			return Analysis{Stem: word}, true
+		}
+		return Analysis{}, false
+	}
+
+	rest := word[digits:]
+	suffix := rest
+	if len(suffix) > 0 && suffix[0] == '-' {
+		suffix = suffix[1:]
+	}
+	if ordinalSuffixes[azcase.ToLower(suffix)] {
+		return Analysis{
+			Stem:      word[:digits],
+			Morphemes: []Morpheme{{Surface: rest, Tag: Ordinal}},
+		}, true
+	}
+	return Analysis{Stem: word}, true
+}
@@ -0,0 +1,74 @@
+package morph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNumericTokens(t *testing.T) {
+	tests := []struct {
+		word    string
+		stem    string
+		surface string // Ordinal morpheme surface; "" for pass-through
+	}{
+		{"2026-cı", "2026", "-cı"},
+		{"5-ci", "5", "-ci"},
+		{"3-üncü", "3", "-üncü"},
+		{"1-inci", "1", "-inci"},
+		{"9-uncu", "9", "-uncu"},
+		{"20-nci", "20", "-nci"},
+		{"5ci", "5", "ci"},
+		{"5-Cİ", "5", "-Cİ"},
+		{"2026", "2026", ""},
+		{"2026-da", "2026-da", ""},
+		{"10-a", "10-a", ""},
+		{"5-", "5-", ""},
+		{"5-ci-ci", "5-ci-ci", ""},
+		{"COVID-19", "COVID-19", ""},
+		{"A4", "A4", ""},
+		{"3,5", "3,5", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.stem {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.stem)
+			}
+			results := Analyze(tt.word)
+			if len(results) != 1 {
+				t.Fatalf("Analyze(%q) = %v, want exactly one analysis", tt.word, results)
+			}
+			a := results[0]
+			if a.Stem != tt.stem {
+				t.Errorf("Analyze(%q).Stem = %q, want %q", tt.word, a.Stem, tt.stem)
+			}
+			if tt.surface == "" {
+				if len(a.Morphemes) != 0 {
+					t.Errorf("Analyze(%q) = %v, want no morphemes", tt.word, a)
+				}
+				return
+			}
+			if len(a.Morphemes) != 1 || a.Morphemes[0].Tag != Ordinal || a.Morphemes[0].Surface != tt.surface {
+				t.Errorf("Analyze(%q) = %v, want Ordinal:%s", tt.word, a, tt.surface)
+			}
+			verifyInvariants(t, tt.word, a)
+		})
+	}
+}
+
+func TestNumericStems(t *testing.T) {
+	got := Stems([]string{"2026-cı", "ildə", "5-ci", "sinif"})
+	want := []string{"2026", "il", "5", "sinif"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Stems[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func ExampleAnalyze_ordinal() {
+	fmt.Println(Analyze("2026-cı")[0])
+	fmt.Println(Stem("2026-da"))
+	// Output:
+	// 2026[Ordinal:-cı]
+	// 2026-da
+}
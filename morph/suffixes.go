@@ -180,9 +180,9 @@ var suffixRules = []suffixRule{
 		harmony:    backFront,
 	},
 
-	// Case Instrumental: -la / -l\u0259 (back/front alternation)
+	// Case Instrumental: -yla / -yl\u0259 (after vowel), -la / -l\u0259 (after consonant)
 	{
-		surfaces:   []string{"la", "l\u0259"},
+		surfaces:   []string{"yla", "yl\u0259", "la", "l\u0259"},
 		tag:        CaseIns,
 		fromStates: []fsmState{initial, nounAfterPoss, nounAfterPlural, nounAfterDeriv},
 		toState:    nounAfterCase,
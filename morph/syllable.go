@@ -0,0 +1,115 @@
+// Syllabification and hyphenation for Azerbaijani.
+//
+// Every Azerbaijani syllable has exactly one vowel and follows the V, VC,
+// CV, or CVC pattern (o, ev, ki, tab), with longer codas only in a few
+// words (dost, türk). Native words have no onset clusters, so of the
+// consonants between two vowels only the last starts the next syllable:
+// ki-tab, mək-təb, dost-luq, mü-əl-lim.
+package morph
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// minHyphenRunes is the minimum number of letters Hyphenate leaves on
+// either side of a break: orthography forbids leaving a single letter at
+// the end of a line or carrying one over (a-ta).
+const minHyphenRunes = 2
+
+// Syllabify splits word into syllables, e.g. "müəllim" → ["mü", "əl",
+// "lim"] and "Azərbaycan" → ["A", "zər", "bay", "can"].
+//
+// Each syllable holds one vowel. Consonants before the first vowel belong
+// to the first syllable and consonants after the last vowel to the last,
+// so loanwords with clusters keep them at the edges (stol, prob-lem). A
+// hyphenated word is split at the hyphen, which stays at the end of the
+// preceding syllable ("sosial-iqtisadi" → [so, si, al-, iq, ti, sa, di]);
+// other non-letters count as consonants. A part without vowels (BMT, 19)
+// is one syllable.
+//
+// Syllables keep the case of the input; their concatenation is the NFC
+// form of word. Returns nil when the input is empty or exceeds
+// maxWordBytes.
+func Syllabify(word string) []string {
+	if word == "" || len(word) > maxWordBytes {
+		return nil
+	}
+	word = azcase.ComposeNFC(word)
+
+	var syllables []string
+	for part := range strings.SplitAfterSeq(word, "-") {
+		if part != "" {
+			syllables = appendSyllables(syllables, []rune(part))
+		}
+	}
+	return syllables
+}
+
+// appendSyllables appends the syllables of a word part without internal
+// hyphens to dst.
+func appendSyllables(dst []string, runes []rune) []string {
+	start := 0
+	prevVowel := -1
+	for i, r := range runes {
+		if !isVowel(r) {
+			continue
+		}
+		if prevVowel >= 0 {
+			// Of the consonants between two vowels, the last one opens
+			// the next syllable.
+			boundary := i
+			if i-prevVowel > 1 {
+				boundary = i - 1
+			}
+			dst = append(dst, string(runes[start:boundary]))
+			start = boundary
+		}
+		prevVowel = i
+	}
+	return append(dst, string(runes[start:]))
+}
+
+// Hyphenate returns word with sep inserted at every syllable boundary where
+// a line may break, e.g. Hyphenate("müəllim", "-") is "mü-əl-lim". Use the
+// soft hyphen "\u00AD" as sep to mark break opportunities for typesetting.
+//
+// No break leaves fewer than two letters on either side ("ata" stays
+// whole), and no sep is added at an existing hyphen, which is already a
+// break point. Returns word unchanged when it is empty or exceeds
+// maxWordBytes; otherwise the result is in NFC form.
+func Hyphenate(word, sep string) string {
+	syllables := Syllabify(word)
+	if syllables == nil {
+		return word
+	}
+
+	total := 0
+	for _, s := range syllables {
+		total += countLetters(s)
+	}
+	var b strings.Builder
+	left := 0
+	for i, s := range syllables {
+		if i > 0 && left >= minHyphenRunes && total-left >= minHyphenRunes &&
+			!strings.HasSuffix(syllables[i-1], "-") {
+			b.WriteString(sep)
+		}
+		b.WriteString(s)
+		left += countLetters(s)
+	}
+	return b.String()
+}
+
+// countLetters returns the number of letters and digits in s.
+func countLetters(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			n++
+		}
+	}
+	return n
+}
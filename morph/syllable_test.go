@@ -0,0 +1,116 @@
+package morph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSyllabify(t *testing.T) {
+	tests := []struct {
+		word string
+		want []string
+	}{
+		// -- V, VC, CV, CVC --
+		{"o", []string{"o"}},
+		{"ev", []string{"ev"}},
+		{"ata", []string{"a", "ta"}},
+		{"kitab", []string{"ki", "tab"}},
+		{"kitablar", []string{"ki", "tab", "lar"}},
+		{"məktəb", []string{"mək", "təb"}},
+		{"Azərbaycan", []string{"A", "zər", "bay", "can"}},
+
+		// -- Adjacent vowels --
+		{"müəllim", []string{"mü", "əl", "lim"}},
+		{"şair", []string{"şa", "ir"}},
+
+		// -- Consonant clusters --
+		{"dostluq", []string{"dost", "luq"}},
+		{"türklər", []string{"türk", "lər"}},
+		{"problem", []string{"prob", "lem"}},
+		{"stol", []string{"stol"}},
+
+		// -- Case, hyphens, apostrophes, no vowels --
+		{"İSTİQLAL", []string{"İS", "TİQ", "LAL"}},
+		{"sosial-iqtisadi", []string{"so", "si", "al-", "iq", "ti", "sa", "di"}},
+		{"Bakı'nın", []string{"Ba", "kı'", "nın"}},
+		{"BMT", []string{"BMT"}},
+		{"COVID-19", []string{"CO", "VID-", "19"}},
+		{"-", []string{"-"}},
+
+		// -- Edge cases --
+		{"", nil},
+		{strings.Repeat("a", maxWordBytes+1), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			t.Parallel()
+			got := Syllabify(tt.word)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Syllabify(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+			if got != nil && strings.Join(got, "") != tt.word {
+				t.Errorf("Syllabify(%q) does not reconstruct the word: %q", tt.word, got)
+			}
+		})
+	}
+}
+
+func TestSyllabifyNFC(t *testing.T) {
+	// "gözəl" with a decomposed ö (o + U+0308).
+	if got, want := Syllabify("go\u0308zəl"), []string{"gö", "zəl"}; !slices.Equal(got, want) {
+		t.Errorf("Syllabify(decomposed) = %q, want %q", got, want)
+	}
+}
+
+func TestHyphenate(t *testing.T) {
+	tests := []struct {
+		word string
+		sep  string
+		want string
+	}{
+		{"müəllim", "-", "mü-əl-lim"},
+		{"Azərbaycan", "-", "Azər-bay-can"},
+		{"kitablar", "\u00AD", "ki\u00ADtab\u00ADlar"},
+		{"ata", "-", "ata"},
+		{"şair", "-", "şa-ir"},
+		{"ailə", "-", "ai-lə"},
+		{"sosial-iqtisadi", "-", "so-si-al-iq-ti-sa-di"},
+		{"ev", "-", "ev"},
+		{"BMT", "-", "BMT"},
+		{"", "-", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			t.Parallel()
+			if got := Hyphenate(tt.word, tt.sep); got != tt.want {
+				t.Errorf("Hyphenate(%q, %q) = %q, want %q", tt.word, tt.sep, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSyllabify(b *testing.B) {
+	for b.Loop() {
+		Syllabify("Azərbaycanlılaşdırılmış")
+	}
+}
+
+func ExampleSyllabify() {
+	for _, w := range []string{"müəllim", "Azərbaycan", "dostluq"} {
+		fmt.Println(w, Syllabify(w))
+	}
+	// Output:
+	// müəllim [mü əl lim]
+	// Azərbaycan [A zər bay can]
+	// dostluq [dost luq]
+}
+
+func ExampleHyphenate() {
+	fmt.Println(Hyphenate("Azərbaycan", "-"))
+	fmt.Println(Hyphenate("ata", "-"))
+	// Output:
+	// Azər-bay-can
+	// ata
+}
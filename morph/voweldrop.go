@@ -20,7 +20,7 @@ const minRestoreLen = 3
 // the dictionary. Returns the restored form or "" if restoration fails.
 //
 // Examples: oğlu → oğul, burnu → burun, ağzı → ağız
-func tryRestoreVowelDrop(stem string) string {
+func (an *Analyzer) tryRestoreVowelDrop(stem string) string {
 	runes := []rune(stem)
 	if len(runes) < minRestoreLen {
 		return ""
@@ -51,7 +51,7 @@ func tryRestoreVowelDrop(stem string) string {
 	var matches []string
 	for _, v := range azVowels {
 		candidate := prefix + string(v) + string(runes[insertPos:])
-		if isKnownStem(candidate) {
+		if an.isKnownStem(candidate) {
 			matches = append(matches, candidate)
 		}
 	}
@@ -45,9 +45,9 @@ func TestTryRestoreVowelDrop(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.stem, func(t *testing.T) {
-			got := tryRestoreVowelDrop(tt.stem)
+			got := defaultAnalyzer.tryRestoreVowelDrop(tt.stem)
 			if got != tt.want {
-				t.Errorf("tryRestoreVowelDrop(%q) = %q, want %q", tt.stem, got, tt.want)
+				t.Errorf("defaultAnalyzer.tryRestoreVowelDrop(%q) = %q, want %q", tt.stem, got, tt.want)
 			}
 		})
 	}
@@ -57,7 +57,7 @@ func BenchmarkTryRestoreVowelDrop(b *testing.B) {
 	stems := []string{"oğl", "burn", "ağz", "aln", "beyn", "kitab", "ev", "str"}
 	for b.Loop() {
 		for _, s := range stems {
-			tryRestoreVowelDrop(s)
+			defaultAnalyzer.tryRestoreVowelDrop(s)
 		}
 	}
 }
@@ -0,0 +1,322 @@
+// Azerbaijani postal addresses: "AZ1000, Bakı şəhəri, Nəsimi rayonu,
+// Nizami küçəsi, ev 25, mənzil 14".
+package ner
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PostalAddress holds the parts of an Azerbaijani postal address. Parts not
+// written in the text are empty.
+type PostalAddress struct {
+	PostalCode string `json:"postal_code,omitempty"` // "AZ1000", without inner space
+	City       string `json:"city,omitempty"`        // "Bakı" for "Bakı şəhəri", "Bakı ş.", or "Bakı"
+	District   string `json:"district,omitempty"`    // rayon: "Nəsimi" for "Nəsimi rayonu" or "Nəsimi r."
+	Settlement string `json:"settlement,omitempty"`  // qəsəbə: "Bakıxanov" for "Bakıxanov qəsəbəsi"
+	Street     string `json:"street,omitempty"`      // Name and kind: "Nizami küçəsi", "Neftçilər prospekti" for "Neftçilər pr."
+	House      string `json:"house,omitempty"`       // ev or bina: "25", "12A", "25/14" as written
+	Apartment  string `json:"apartment,omitempty"`   // mənzil: "14"
+}
+
+// String returns the address in canonical order with full keywords, e.g.
+// "AZ1000, Bakı şəhəri, Nəsimi rayonu, Nizami küçəsi, ev 25, mənzil 14".
+func (a PostalAddress) String() string {
+	parts := make([]string, 0, int(partApartment)+1)
+	add := func(value, format string) {
+		if value != "" {
+			parts = append(parts, fmt.Sprintf(format, value))
+		}
+	}
+	add(a.PostalCode, "%s")
+	add(a.City, "%s şəhəri")
+	add(a.District, "%s rayonu")
+	add(a.Settlement, "%s qəsəbəsi")
+	add(a.Street, "%s")
+	add(a.House, "ev %s")
+	add(a.Apartment, "mənzil %s")
+	return strings.Join(parts, ", ")
+}
+
+// ParseAddress parses the parts of a single address, such as a delivery
+// address field. Unlike Recognize, it accepts an address of one part
+// ("Nizami küçəsi 25" has two: street and house). Returns an error for
+// empty or oversized input, or when no part is found.
+func ParseAddress(s string) (PostalAddress, error) {
+	if s == "" {
+		return PostalAddress{}, fmt.Errorf("ner: empty input")
+	}
+	if len(s) > maxInputBytes {
+		return PostalAddress{}, fmt.Errorf("ner: input exceeds %d bytes", maxInputBytes)
+	}
+	parts := addressParts(s, false)
+	if len(parts) == 0 {
+		return PostalAddress{}, fmt.Errorf("ner: no address found")
+	}
+	return buildAddress(parts), nil
+}
+
+// addressPart identifies a part of an address.
+type addressPart int
+
+const (
+	partPostal addressPart = iota
+	partCity
+	partDistrict
+	partSettlement
+	partStreet
+	partHouse
+	partApartment
+)
+
+// addressMatch is one part of an address found in the text.
+type addressMatch struct {
+	part       addressPart
+	start, end int
+	value      string
+}
+
+// Patterns of address parts. Names are one or two capitalized words or
+// numbers ("Nizami", "Üzeyir Hacıbəyov", "H.Əliyev", "28 May") before
+// the keyword; the keyword is a possessive form, optionally inflected
+// ("küçəsi", "küçəsində"), or an abbreviation ("küç.", "pr.").
+const (
+	reAddrName = `((?:\p{Lu}[\p{L}.'’-]*|\d+(?:-\p{L}+)?)(?:\s+(?:\p{Lu}[\p{L}.'’-]*|\d+(?:-\p{L}+)?))?)`
+	reAddrHead = `(?:^|[^\p{L}\p{N}])` + reAddrName + `\s+`
+	reHouseNum = `(\d+(?:/\d+|\p{L})?)`
+)
+
+var (
+	reAddrStreet     = regexp.MustCompile(reAddrHead + `(küçəsi\p{L}*|küç\.|prospekti\p{L}*|pr-ti|pr\.|döngəsi\p{L}*|dön\.|dalanı\p{L}*|şossesi\p{L}*|şos\.|bulvarı\p{L}*|meydanı\p{L}*)`)
+	reAddrDistrict   = regexp.MustCompile(reAddrHead + `(rayonu\p{L}*|r-nu|r-n|r\.)`)
+	reAddrCity       = regexp.MustCompile(reAddrHead + `(şəhəri\p{L}*|şəh\.|ş\.)`)
+	reAddrSettlement = regexp.MustCompile(reAddrHead + `(qəsəbəsi\p{L}*|qəs\.)`)
+	reAddrHouse      = regexp.MustCompile(`(?:^|[^\p{L}\p{N}])((?i:ev|bina)[\s:.№]*` + reHouseNum + `)`)
+	reAddrApartment  = regexp.MustCompile(`(?:^|[^\p{L}\p{N}])((?:(?i:mənzil)|mən\.|m\.)[\s:№]*(\d+\p{L}?))`)
+	reAddrPostal     = regexp.MustCompile(`(?:^|[^\p{L}\p{N}])(AZ\s?\d{4})`)
+
+	// reHouseAfterStreet matches a house number written right after the
+	// street: "Nizami küçəsi 25", "Nizami küç., 25/14".
+	reHouseAfterStreet = regexp.MustCompile(`^,?\s*(?:№\s?)?` + reHouseNum)
+
+	// reAddressGap matches what may separate two parts of one address.
+	reAddressGap = regexp.MustCompile(`^[\s,;]*$`)
+
+	// reAddressLabel matches a keyword introducing an address: "Ünvan:".
+	reAddressLabel = regexp.MustCompile(`(?i)ünvan\p{L}*\s*:\s*$`)
+)
+
+// streetKinds maps the start of a street keyword to its full form.
+var streetKinds = []struct{ prefix, kind string }{
+	{"küç", "küçəsi"},
+	{"pr", "prospekti"},
+	{"dön", "döngəsi"},
+	{"dalan", "dalanı"},
+	{"şos", "şossesi"},
+	{"bulvar", "bulvarı"},
+	{"meydan", "meydanı"},
+}
+
+// cities lists the cities recognized without "şəhəri".
+var cities = []string{
+	"Bakı", "Gəncə", "Sumqayıt", "Mingəçevir", "Naxçıvan", "Lənkəran", "Şəki",
+	"Şirvan", "Xankəndi", "Yevlax", "Naftalan", "Şuşa", "Quba", "Qəbələ", "Xaçmaz",
+}
+
+// reAddrBareCity matches a city of cities written alone.
+var reAddrBareCity = regexp.MustCompile(`(?:^|[^\p{L}\p{N}])(` + strings.Join(cities, "|") + `)`)
+
+// addressParts returns the address parts found in s, sorted by start and
+// without overlaps. In running text (inText), a two-word name starting a
+// sentence loses its first word, which is taken as an ordinary word.
+func addressParts(s string, inText bool) []addressMatch {
+	var parts []addressMatch
+	named := func(re *regexp.Regexp, part addressPart) {
+		for _, m := range re.FindAllStringSubmatchIndex(s, -1) {
+			if !wordEnd(s, m[5]) {
+				continue
+			}
+			name := s[m[2]:m[3]]
+			start := m[2]
+			if k := strings.IndexFunc(name, unicode.IsSpace); k > 0 {
+				first, rest := name[:k], strings.TrimLeftFunc(name[k:], unicode.IsSpace)
+				switch {
+				case part == partStreet && slices.Contains(cities, first):
+					// A city written before the street without a comma:
+					// "Bakı Nizami küçəsi".
+					parts = append(parts, addressMatch{partCity, start, start + len(first), first})
+					start, name = m[3]-len(rest), rest
+				case inText && sentenceStart(s, start) && capitalized(first):
+					// "Bağlama Gəncə şəhəri": the first word is
+					// capitalized as the start of the sentence.
+					start, name = m[3]-len(rest), rest
+				}
+			}
+			if part == partStreet {
+				name += " " + streetKind(s[m[4]:m[5]])
+			}
+			parts = append(parts, addressMatch{part, start, m[5], name})
+			if part == partStreet {
+				parts = appendHouseAfter(parts, s, m[5])
+			}
+		}
+	}
+	named(reAddrStreet, partStreet)
+	named(reAddrDistrict, partDistrict)
+	named(reAddrCity, partCity)
+	named(reAddrSettlement, partSettlement)
+
+	// Group 1 of these patterns is the part, the last group its value.
+	numbered := func(re *regexp.Regexp, part addressPart) {
+		for _, m := range re.FindAllStringSubmatchIndex(s, -1) {
+			if !wordEnd(s, m[3]) {
+				continue
+			}
+			value := s[m[len(m)-2]:m[len(m)-1]]
+			if part == partPostal {
+				value = strings.ReplaceAll(value, " ", "")
+			}
+			parts = append(parts, addressMatch{part, m[2], m[3], value})
+		}
+	}
+	numbered(reAddrHouse, partHouse)
+	numbered(reAddrApartment, partApartment)
+	numbered(reAddrPostal, partPostal)
+	numbered(reAddrBareCity, partCity)
+
+	slices.SortStableFunc(parts, func(a, b addressMatch) int {
+		if c := cmp.Compare(a.start, b.start); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.end, a.end)
+	})
+	out := parts[:0]
+	maxEnd := 0
+	for _, p := range parts {
+		if p.start >= maxEnd {
+			out = append(out, p)
+			maxEnd = p.end
+		}
+	}
+	return out
+}
+
+// appendHouseAfter appends the house number written right after the
+// street ending at end, if any.
+func appendHouseAfter(parts []addressMatch, s string, end int) []addressMatch {
+	m := reHouseAfterStreet.FindStringSubmatchIndex(s[end:])
+	if m == nil || !wordEnd(s, end+m[3]) {
+		return parts
+	}
+	return append(parts, addressMatch{partHouse, end + m[2], end + m[3], s[end+m[2] : end+m[3]]})
+}
+
+// maxAbbrevLetters is the length of the longest abbreviated keyword
+// ("küç.", "şəh."), whose dot does not end a sentence.
+const maxAbbrevLetters = 3
+
+// sentenceStart reports whether the word at s[i] starts the text or a
+// sentence: it follows "!", "?", or a dot after a word longer than an
+// abbreviation.
+func sentenceStart(s string, i int) bool {
+	before := strings.TrimRightFunc(s[:i], unicode.IsSpace)
+	if before == "" {
+		return true
+	}
+	r, size := utf8.DecodeLastRuneInString(before)
+	switch r {
+	case '!', '?':
+		return true
+	case '.':
+		word := before[:len(before)-size]
+		letters := strings.TrimRightFunc(word, unicode.IsLetter)
+		return utf8.RuneCountInString(word[len(letters):]) > maxAbbrevLetters
+	}
+	return false
+}
+
+// capitalized reports whether a name word is a capitalized word, rather
+// than a number or an initial ("28", "H.Əliyev").
+func capitalized(word string) bool {
+	r, size := utf8.DecodeRuneInString(word)
+	return unicode.IsUpper(r) && !strings.HasPrefix(word[size:], ".")
+}
+
+// wordEnd reports whether s[end:] does not continue the word or number
+// ending at end.
+func wordEnd(s string, end int) bool {
+	r, _ := utf8.DecodeRuneInString(s[end:])
+	return end == len(s) || !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// streetKind returns the full form of a street keyword ("pr." →
+// "prospekti").
+func streetKind(keyword string) string {
+	for _, k := range streetKinds {
+		if strings.HasPrefix(keyword, k.prefix) {
+			return k.kind
+		}
+	}
+	return keyword
+}
+
+// buildAddress fills an Address from parts, keeping the first of each.
+func buildAddress(parts []addressMatch) PostalAddress {
+	var a PostalAddress
+	for _, p := range parts {
+		field := [...]*string{
+			partPostal:     &a.PostalCode,
+			partCity:       &a.City,
+			partDistrict:   &a.District,
+			partSettlement: &a.Settlement,
+			partStreet:     &a.Street,
+			partHouse:      &a.House,
+			partApartment:  &a.Apartment,
+		}[p.part]
+		if *field == "" {
+			*field = p.value
+		}
+	}
+	return a
+}
+
+// appendAddress appends addresses: runs of address parts separated only
+// by commas and spaces, with at least two parts, one of them a street or
+// a postal code. A city and a district alone name a place, not an
+// address.
+func appendAddress(all []Entity, s string) []Entity {
+	parts := addressParts(s, true)
+	for i := 0; i < len(parts); {
+		j := i + 1
+		for j < len(parts) && reAddressGap.MatchString(s[parts[j-1].end:parts[j].start]) {
+			j++
+		}
+		run := parts[i:j]
+		i = j
+
+		if len(run) < 2 || !slices.ContainsFunc(run, func(p addressMatch) bool {
+			return p.part == partStreet || p.part == partPostal
+		}) {
+			continue
+		}
+		a := buildAddress(run)
+		start, end := run[0].start, run[len(run)-1].end
+		e := Entity{
+			Text: s[start:end], Start: start, End: end, Type: Address, Address: &a,
+			Confidence: confidenceStreet, Source: sourceAddress,
+		}
+		switch {
+		case reAddressLabel.MatchString(s[max(0, start-maxLabelBytes):start]):
+			e.Labeled, e.Confidence = true, confidenceLabeled
+		case a.House != "" || a.PostalCode != "":
+			e.Confidence = confidencePattern
+		}
+		all = append(all, e)
+	}
+	return all
+}
@@ -0,0 +1,182 @@
+package ner
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestRecognizeAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		text string
+		want PostalAddress
+	}{
+		{
+			"full", "AZ1000, Bakı şəhəri, Nəsimi rayonu, Nizami küçəsi, ev 25, mənzil 14",
+			"AZ1000, Bakı şəhəri, Nəsimi rayonu, Nizami küçəsi, ev 25, mənzil 14",
+			PostalAddress{PostalCode: "AZ1000", City: "Bakı", District: "Nəsimi", Street: "Nizami küçəsi", House: "25", Apartment: "14"},
+		},
+		{
+			"abbreviated", "Ünvan: Bakı ş., Yasamal r-nu, Şərifzadə küç. 12, m. 34",
+			"Bakı ş., Yasamal r-nu, Şərifzadə küç. 12, m. 34",
+			PostalAddress{City: "Bakı", District: "Yasamal", Street: "Şərifzadə küçəsi", House: "12", Apartment: "34"},
+		},
+		{
+			"in a sentence", "Bağlama Gəncə şəhəri, Atatürk prospekti 5, mənzil 3 ünvanına göndərildi.",
+			"Gəncə şəhəri, Atatürk prospekti 5, mənzil 3",
+			PostalAddress{City: "Gəncə", Street: "Atatürk prospekti", House: "5", Apartment: "3"},
+		},
+		{
+			"city before street", "Bakı Nizami küçəsi 25/14",
+			"Bakı Nizami küçəsi 25/14",
+			PostalAddress{City: "Bakı", Street: "Nizami küçəsi", House: "25/14"},
+		},
+		{
+			"settlement", "Bakıxanov qəsəbəsi, Sülh küçəsi 7",
+			"Bakıxanov qəsəbəsi, Sülh küçəsi 7",
+			PostalAddress{Settlement: "Bakıxanov", Street: "Sülh küçəsi", House: "7"},
+		},
+		{
+			"numeric street name", "28 May küçəsi 5, mənzil 3",
+			"28 May küçəsi 5, mənzil 3",
+			PostalAddress{Street: "28 May küçəsi", House: "5", Apartment: "3"},
+		},
+		{
+			"initial and prospekt", "H.Əliyev pr. 10, Xətai r.",
+			"H.Əliyev pr. 10, Xətai r.",
+			PostalAddress{District: "Xətai", Street: "H.Əliyev prospekti", House: "10"},
+		},
+		{
+			"postal code with space", "AZ 1010, Səbail rayonu",
+			"AZ 1010, Səbail rayonu",
+			PostalAddress{PostalCode: "AZ1010", District: "Səbail"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterType(Recognize(tt.in), Address)
+			if len(got) != 1 {
+				t.Fatalf("Recognize(%q) = %v, want one address", tt.in, got)
+			}
+			e := got[0]
+			if e.Text != tt.text || tt.in[e.Start:e.End] != e.Text {
+				t.Errorf("Text = %q [%d:%d], want %q", e.Text, e.Start, e.End, tt.text)
+			}
+			if e.Address == nil || *e.Address != tt.want {
+				t.Errorf("Address = %+v, want %+v", e.Address, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecognizeAddressNegative(t *testing.T) {
+	for _, in := range []string{
+		"Bakı şəhəri, Nəsimi rayonu",           // a place, not an address
+		"Üzeyir Hacıbəyov küçəsində yerləşir.", // a street alone
+		"Ev 5 otaqlıdır.",                      // a house number alone
+		"AZ1000",                               // a postal code alone
+		"Bakıda, Nizami küçəsindən uzaqda",     // the city and street are not adjacent parts
+		"IBAN AZ21NABZ00000000137010001944",    // postal-code look-alike inside an IBAN
+	} {
+		if got := filterType(Recognize(in), Address); len(got) != 0 {
+			t.Errorf("Recognize(%q) = %v, want no address", in, got)
+		}
+	}
+}
+
+func TestRecognizeAddressWithDate(t *testing.T) {
+	// "28 May" is also a date; the longer address wins.
+	got := Recognize("28 May küçəsi 5")
+	if len(got) != 1 || got[0].Type != Address {
+		t.Errorf("Recognize = %v, want one address", got)
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	a, err := ParseAddress("Nizami küç., 25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (PostalAddress{Street: "Nizami küçəsi", House: "25"}); a != want {
+		t.Errorf("ParseAddress = %+v, want %+v", a, want)
+	}
+
+	a, err = ParseAddress("Üzeyir Hacıbəyov küçəsi")
+	if err != nil || a.Street != "Üzeyir Hacıbəyov küçəsi" {
+		t.Errorf("ParseAddress = %+v, %v; want the street alone", a, err)
+	}
+
+	for _, in := range []string{"", "salam dünya"} {
+		if _, err := ParseAddress(in); err == nil {
+			t.Errorf("ParseAddress(%q): want error", in)
+		}
+	}
+}
+
+func TestPostalAddressString(t *testing.T) {
+	a := PostalAddress{PostalCode: "AZ1000", City: "Bakı", District: "Nəsimi", Street: "Nizami küçəsi", House: "25", Apartment: "14"}
+	want := "AZ1000, Bakı şəhəri, Nəsimi rayonu, Nizami küçəsi, ev 25, mənzil 14"
+	if got := a.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got := (PostalAddress{}).String(); got != "" {
+		t.Errorf("zero String() = %q, want empty", got)
+	}
+}
+
+func TestAddressJSON(t *testing.T) {
+	s := "Nizami küçəsi 25, mənzil 14"
+	data, err := MarshalEntities(s, Recognize(s), FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Entities) != 1 || doc.Entities[0].Normalized != "Nizami küçəsi, ev 25, mənzil 14" {
+		t.Fatalf("entities = %+v", doc.Entities)
+	}
+	back, err := UnmarshalEntities(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back[0].Address == nil || back[0].Address.Apartment != "14" {
+		t.Errorf("round trip Address = %+v", back[0].Address)
+	}
+}
+
+// filterType returns the entities of type typ.
+func filterType(entities []Entity, typ EntityType) []Entity {
+	var out []Entity
+	for _, e := range entities {
+		if e.Type == typ {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func BenchmarkRecognizeAddress(b *testing.B) {
+	const s = "Sifariş AZ1000, Bakı şəhəri, Nəsimi rayonu, Nizami küçəsi, ev 25, mənzil 14 ünvanına, 050 123 45 67 nömrəsinə zəng edin."
+	for b.Loop() {
+		Recognize(s)
+	}
+}
+
+func ExampleParseAddress() {
+	a, _ := ParseAddress("AZ1000, Bakı ş., Nəsimi r., Nizami küç. 25, m. 14")
+	fmt.Println(a.City, a.District, a.Street, a.House, a.Apartment)
+	fmt.Println(a)
+	// Output:
+	// Bakı Nəsimi Nizami küçəsi 25 14
+	// AZ1000, Bakı şəhəri, Nəsimi rayonu, Nizami küçəsi, ev 25, mənzil 14
+}
+
+func ExampleAddresses() {
+	fmt.Println(Addresses("Çatdırılma: Yasamal r-nu, Şərifzadə küç. 12, m. 34. Zəng edin."))
+	// Output:
+	// [Yasamal r-nu, Şərifzadə küç. 12, m. 34]
+}
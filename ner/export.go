@@ -0,0 +1,219 @@
+package ner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// SchemaVersion is the version of the JSON export schema written by
+// MarshalEntities. It is bumped only on incompatible changes; new optional
+// fields may be added without a bump.
+const SchemaVersion = 1
+
+// Format selects the serialization produced by MarshalEntities.
+type Format int
+
+const (
+	FormatJSON  Format = iota // Versioned JSON document
+	FormatCoNLL               // CoNLL-style "token<TAB>BIO-tag" lines, blank line between sentences
+)
+
+// phoneNationalDigits is the number of digits after the +994 country code.
+const phoneNationalDigits = 9
+
+// ExportedEntity is the JSON representation of an entity in the export schema.
+type ExportedEntity struct {
+	Type       EntityType `json:"type"`       // Entity type name (e.g. "Phone")
+	Text       string     `json:"text"`       // Matched text as it appears in the source
+	Start      int        `json:"start"`      // Byte offset (inclusive)
+	End        int        `json:"end"`        // Byte offset (exclusive)
+	Normalized string     `json:"normalized"` // Canonical value (e.g. "+994501234567")
+	Confidence float64    `json:"confidence"` // 0.0-1.0
+	Labeled    bool       `json:"labeled"`    // Preceded by a keyword
+
+	Value    float64   `json:"value,omitempty"`    // Money and Percent: numeric value
+	Currency string    `json:"currency,omitempty"` // Money: ISO 4217 code
+	Time     time.Time `json:"time,omitzero"`      // Date: resolved date or date-time
+	Source   string    `json:"source,omitempty"`   // Rule that matched (e.g. "regex:phone_intl")
+
+	Address *PostalAddress `json:"address,omitempty"` // Address: parts of the address
+}
+
+// Document is the top-level JSON export envelope.
+type Document struct {
+	SchemaVersion int              `json:"schema_version"`
+	Entities      []ExportedEntity `json:"entities"`
+}
+
+// MarshalEntities serializes entities recognized in text.
+//
+// FormatJSON produces a Document with SchemaVersion, normalized values,
+// and confidence scores. FormatCoNLL tokenizes text with the tokenizer
+// package and emits one non-space token per line with its BIO tag
+// (B-Phone, I-Phone, O); sentences are separated by a blank line.
+//
+// text must be the string the entities were recognized in; it is only
+// read for FormatCoNLL.
+func MarshalEntities(text string, entities []Entity, format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return marshalJSON(entities)
+	case FormatCoNLL:
+		return marshalCoNLL(text, entities)
+	default:
+		return nil, fmt.Errorf("ner: unknown export format %d", int(format))
+	}
+}
+
+// UnmarshalEntities decodes a FormatJSON document produced by
+// MarshalEntities. Returns an error for unsupported schema versions.
+func UnmarshalEntities(data []byte) ([]Entity, error) {
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("ner: decoding entities: %w", err)
+	}
+	if doc.SchemaVersion < 1 || doc.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("ner: unsupported schema version %d", doc.SchemaVersion)
+	}
+	out := make([]Entity, len(doc.Entities))
+	for i, e := range doc.Entities {
+		out[i] = Entity{
+			Text: e.Text, Start: e.Start, End: e.End, Type: e.Type, Labeled: e.Labeled,
+			Value: e.Value, Currency: e.Currency, Time: e.Time, Address: e.Address,
+			Confidence: e.Confidence, Source: e.Source,
+		}
+	}
+	return out, nil
+}
+
+func marshalJSON(entities []Entity) ([]byte, error) {
+	doc := Document{
+		SchemaVersion: SchemaVersion,
+		Entities:      make([]ExportedEntity, len(entities)),
+	}
+	for i, e := range entities {
+		doc.Entities[i] = ExportedEntity{
+			Type:       e.Type,
+			Text:       e.Text,
+			Start:      e.Start,
+			End:        e.End,
+			Normalized: normalizedValue(e),
+			Confidence: confidence(e),
+			Labeled:    e.Labeled,
+			Source:     e.Source,
+			Value:      e.Value,
+			Currency:   e.Currency,
+			Time:       e.Time,
+			Address:    e.Address,
+		}
+	}
+	return json.Marshal(doc)
+}
+
+func marshalCoNLL(text string, entities []Entity) ([]byte, error) {
+	var buf bytes.Buffer
+	ei := 0
+	for si, sent := range tokenizer.SentenceTokens(text) {
+		if si > 0 {
+			buf.WriteByte('\n')
+		}
+		for _, tok := range tokenizer.WordTokens(sent.Text) {
+			if tok.Type == tokenizer.Space {
+				continue
+			}
+			start := sent.Start + tok.Start
+			end := sent.Start + tok.End
+			// Entities are sorted by Start; skip those ending before this token.
+			for ei < len(entities) && entities[ei].End <= start {
+				ei++
+			}
+			tag := "O"
+			if ei < len(entities) && entities[ei].Start < end {
+				prefix := "I-"
+				if start <= entities[ei].Start {
+					prefix = "B-"
+				}
+				tag = prefix + entities[ei].Type.String()
+			}
+			buf.WriteString(tok.Text)
+			buf.WriteByte('\t')
+			buf.WriteString(tag)
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// normalizedValue returns the canonical form of an entity value:
+// phones as +994XXXXXXXXX, card numbers as bare digits, emails
+// lowercased, identifiers uppercased without whitespace, URLs unchanged,
+// money as "1500 AZN", percentages as "3.5%", dates as 2006-01-02
+// (RFC 3339 when a time of day is set), and addresses as
+// PostalAddress.String writes them.
+func normalizedValue(e Entity) string {
+	switch e.Type {
+	case Money:
+		return strconv.FormatFloat(e.Value, 'f', -1, 64) + " " + e.Currency
+	case Percent:
+		return strconv.FormatFloat(e.Value, 'f', -1, 64) + "%"
+	case Date:
+		if h, m, sec := e.Time.Clock(); h == 0 && m == 0 && sec == 0 {
+			return e.Time.Format(time.DateOnly)
+		}
+		return e.Time.Format(time.RFC3339)
+	case Address:
+		if e.Address != nil {
+			return e.Address.String()
+		}
+		return e.Text
+	case Card:
+		return digitsOnly(e.Text)
+	case Phone:
+		digits := digitsOnly(e.Text)
+		if len(digits) >= phoneNationalDigits {
+			return "+994" + digits[len(digits)-phoneNationalDigits:]
+		}
+		return digits
+	case Email:
+		return strings.ToLower(e.Text)
+	case URL:
+		return e.Text
+	default:
+		return strings.ToUpper(strings.Join(strings.Fields(e.Text), ""))
+	}
+}
+
+// digitsOnly returns the ASCII digits of s.
+func digitsOnly(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// confidence returns the entity's Confidence, or for an entity built by
+// hand without one, the score Recognize would assign its kind of match.
+func confidence(e Entity) float64 {
+	switch {
+	case e.Confidence > 0:
+		return e.Confidence
+	case e.Labeled:
+		return confidenceLabeled
+	case e.Type == IBAN || e.Type == Card:
+		return confidenceValidated
+	case e.Type == FIN:
+		return confidenceBare
+	case e.Type == VOEN:
+		return confidenceBareVOEN
+	default:
+		return confidencePattern
+	}
+}
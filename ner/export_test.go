@@ -0,0 +1,182 @@
+package ner
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshalEntitiesJSON(t *testing.T) {
+	s := "Əlaqə: +994 50 123 45 67. FIN: 5ARPXK2, email INFO@gov.az, kart 4169-7388-1234-5670"
+	data, err := MarshalEntities(s, Recognize(s), FormatJSON)
+	if err != nil {
+		t.Fatalf("MarshalEntities: %v", err)
+	}
+
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if doc.SchemaVersion != SchemaVersion {
+		t.Errorf("SchemaVersion = %d, want %d", doc.SchemaVersion, SchemaVersion)
+	}
+
+	want := []struct {
+		typ        EntityType
+		normalized string
+		confidence float64
+	}{
+		{Phone, "+994501234567", confidencePattern},
+		{FIN, "5ARPXK2", confidenceLabeled},
+		{Email, "info@gov.az", confidencePattern},
+		{Card, "4169738812345670", confidenceValidated},
+	}
+	if len(doc.Entities) != len(want) {
+		t.Fatalf("got %d entities, want %d: %s", len(doc.Entities), len(want), data)
+	}
+	for i, w := range want {
+		e := doc.Entities[i]
+		if e.Type != w.typ || e.Normalized != w.normalized || e.Confidence != w.confidence {
+			t.Errorf("[%d] = %+v, want type=%s normalized=%q confidence=%v", i, e, w.typ, w.normalized, w.confidence)
+		}
+		if s[e.Start:e.End] != e.Text {
+			t.Errorf("[%d] offset invariant broken: %q != %q", i, s[e.Start:e.End], e.Text)
+		}
+	}
+}
+
+func TestUnmarshalEntitiesRoundTrip(t *testing.T) {
+	s := "tel 0501234567, FIN 5ARPXK2, https://gov.az"
+	entities := Recognize(s)
+	data, err := MarshalEntities(s, entities, FormatJSON)
+	if err != nil {
+		t.Fatalf("MarshalEntities: %v", err)
+	}
+	got, err := UnmarshalEntities(data)
+	if err != nil {
+		t.Fatalf("UnmarshalEntities: %v", err)
+	}
+	compareEntities(t, entities, got)
+	for i := range got {
+		if got[i].Confidence != entities[i].Confidence || got[i].Source != entities[i].Source {
+			t.Errorf("[%d] = %v/%q, want %v/%q", i, got[i].Confidence, got[i].Source, entities[i].Confidence, entities[i].Source)
+		}
+	}
+}
+
+func TestUnmarshalEntitiesErrors(t *testing.T) {
+	for _, in := range []string{
+		`not json`,
+		`{"schema_version":0,"entities":[]}`,
+		`{"schema_version":99,"entities":[]}`,
+		`{"schema_version":1,"entities":[{"type":"Bogus"}]}`,
+	} {
+		if _, err := UnmarshalEntities([]byte(in)); err == nil {
+			t.Errorf("UnmarshalEntities(%s) error = nil, want error", in)
+		}
+	}
+}
+
+func TestMarshalEntitiesValues(t *testing.T) {
+	s := "5 mart 2026 tarixinə 1 500 manat, 3,5% faizlə"
+	entities := RecognizeAt(s, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	data, err := MarshalEntities(s, entities, FormatJSON)
+	if err != nil {
+		t.Fatalf("MarshalEntities: %v", err)
+	}
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	want := []string{"2026-03-05", "1500 AZN", "3.5%"}
+	if len(doc.Entities) != len(want) {
+		t.Fatalf("got %d entities, want %d: %s", len(doc.Entities), len(want), data)
+	}
+	for i, w := range want {
+		if doc.Entities[i].Normalized != w {
+			t.Errorf("[%d] normalized = %q, want %q", i, doc.Entities[i].Normalized, w)
+		}
+	}
+
+	got, err := UnmarshalEntities(data)
+	if err != nil {
+		t.Fatalf("UnmarshalEntities: %v", err)
+	}
+	for i := range got {
+		if got[i].Value != entities[i].Value || got[i].Currency != entities[i].Currency || !got[i].Time.Equal(entities[i].Time) {
+			t.Errorf("[%d] round trip = %+v, want %+v", i, got[i], entities[i])
+		}
+	}
+}
+
+func TestMarshalEntitiesCoNLL(t *testing.T) {
+	s := "Zəng: +994 50 123 45 67. Yaz info@gov.az ünvanına."
+	data, err := MarshalEntities(s, Recognize(s), FormatCoNLL)
+	if err != nil {
+		t.Fatalf("MarshalEntities: %v", err)
+	}
+	want := strings.Join([]string{
+		"Zəng\tO",
+		":\tO",
+		"+\tB-Phone",
+		"994\tI-Phone",
+		"50\tI-Phone",
+		"123\tI-Phone",
+		"45\tI-Phone",
+		"67\tI-Phone",
+		".\tO",
+		"",
+		"Yaz\tO",
+		"info@gov.az\tB-Email",
+		"ünvanına\tO",
+		".\tO",
+	}, "\n") + "\n"
+	if string(data) != want {
+		t.Errorf("CoNLL output:\n%s\nwant:\n%s", data, want)
+	}
+}
+
+func TestMarshalEntitiesCoNLLAdjacent(t *testing.T) {
+	// Two entities of the same type must each start with B-.
+	s := "0501234567 0551234567"
+	data, err := MarshalEntities(s, Recognize(s), FormatCoNLL)
+	if err != nil {
+		t.Fatalf("MarshalEntities: %v", err)
+	}
+	if got := strings.Count(string(data), "B-Phone"); got != 2 {
+		t.Errorf("B-Phone count = %d, want 2:\n%s", got, data)
+	}
+}
+
+func TestMarshalEntitiesEmpty(t *testing.T) {
+	data, err := MarshalEntities("", nil, FormatJSON)
+	if err != nil {
+		t.Fatalf("MarshalEntities: %v", err)
+	}
+	if string(data) != `{"schema_version":1,"entities":[]}` {
+		t.Errorf("got %s", data)
+	}
+	data, err = MarshalEntities("", nil, FormatCoNLL)
+	if err != nil || len(data) != 0 {
+		t.Errorf("CoNLL empty = %q, %v; want empty, nil", data, err)
+	}
+}
+
+func TestMarshalEntitiesUnknownFormat(t *testing.T) {
+	if _, err := MarshalEntities("x", nil, Format(99)); err == nil {
+		t.Error("want error for unknown format, got nil")
+	}
+}
+
+func ExampleMarshalEntities() {
+	s := "FIN: 5ARPXK2"
+	data, _ := MarshalEntities(s, Recognize(s), FormatCoNLL)
+	fmt.Print(string(data))
+	// Output:
+	// FIN	O
+	// :	O
+	// 5	B-FIN
+	// ARPXK2	I-FIN
+}
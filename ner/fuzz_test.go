@@ -14,6 +14,7 @@ func FuzzRecognize(f *testing.F) {
 	f.Add("\xff\xfe")
 	f.Add("FIN FIN FIN FIN FIN")
 	f.Add("+994 50 123 45 67 və 050 123 45 67")
+	f.Add("AZ1000, Bakı ş., Nəsimi r., Nizami küç. 25, m. 14")
 
 	f.Fuzz(func(t *testing.T, s string) {
 		entities := Recognize(s)
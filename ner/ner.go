@@ -1,9 +1,26 @@
 // Package ner extracts named entities from Azerbaijani text using rule-based
 // pattern matching.
 //
-// The package recognizes seven entity types: FIN (personal ID), VOEN (tax ID),
-// Phone, Email, IBAN, LicensePlate, and URL. Each entity is returned with byte
-// offsets satisfying the invariant s[e.Start:e.End] == e.Text.
+// The package recognizes twelve entity types: FIN (personal ID), VOEN (tax ID),
+// Phone, Email, IBAN, LicensePlate, URL, Card (bank card number), Money,
+// Percent, Date, and Address. Each entity is returned with byte offsets satisfying
+// the invariant s[e.Start:e.End] == e.Text.
+//
+// Money ("1500 manat", "25 AZN", "$300") and Percent ("15 faiz", "3,5%")
+// entities carry their numeric value in Entity.Value, and Money its ISO
+// 4217 code in Entity.Currency. Date entities are the date and date-time
+// expressions found by datetime.Extract, with the resolved time in
+// Entity.Time; RecognizeAt resolves relative dates ("sabah") against a
+// given reference time.
+//
+// Address entities are postal addresses: runs of parts such as "AZ1000",
+// "Bakı şəhəri", "Nəsimi rayonu", "Nizami küçəsi", "ev 25", and "mənzil
+// 14", with at least two parts including a street or postal code.
+// Entity.Address holds the parts; ParseAddress parses a single address
+// field.
+//
+// IBANs must pass the ISO 13616 mod-97 checksum and card numbers the Luhn
+// checksum; look-alike digit strings that fail are not reported.
 //
 // Two API layers are provided:
 //
@@ -14,12 +31,34 @@
 // (e.g. "FIN:" or "VOEN:"), the Entity.Labeled field is set to true, indicating
 // higher confidence. Standalone matches have Labeled=false.
 //
+// Every entity carries a Confidence between 0.0 and 1.0 and the Source
+// that produced it: a regex ("regex:phone_intl"), a checksum validator
+// ("validator:mod97" for IBANs, "validator:luhn" for cards), a gazetteer
+// ("gazetteer:currency" for amounts with a currency word), or "datetime"
+// for dates. Checksum-validated matches score highest (0.98), then
+// labeled FIN/VOEN (0.95), other patterns (0.9), bare FIN (0.6), and bare
+// 10-digit VOEN candidates (0.3). Recognize drops entities below 0.5, so
+// bare VOENs are only reported by a Recognizer created with a lower
+// WithMinConfidence; a higher one filters out the weaker matches.
+//
+// Where patterns overlap (a phone number inside a URL, a labeled VOEN that
+// is also a valid local phone number), Recognize keeps the leftmost,
+// longest match. A Recognizer created by NewRecognizer applies another
+// OverlapPolicy: Priority resolves overlaps by a configurable ranking of
+// entity types, and AllMatches returns every match. Ties are broken by a
+// fixed type order, so results are deterministic under every policy.
+//
+// MarshalEntities exports entities as a versioned JSON document (with
+// normalized values and confidence scores) or as CoNLL BIO-tagged tokens
+// for annotation tools; UnmarshalEntities reads the JSON form back.
+//
 // All functions are safe for concurrent use by multiple goroutines.
 package ner
 
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // EntityType classifies a recognized entity.
@@ -30,9 +69,14 @@ const (
 	VOEN                           // Tax identification number (10 digits)
 	Phone                          // Phone number (+994... or 0XX...)
 	Email                          // Email address
-	IBAN                           // International bank account number (AZ prefix, 28 chars)
+	IBAN                           // International bank account number (AZ prefix, 28 chars, mod-97 checked)
 	LicensePlate                   // Azerbaijani vehicle license plate (XX-YY-ZZZ)
 	URL                            // HTTP or HTTPS URL
+	Card                           // Bank card number (16 digits, Luhn checked)
+	Money                          // Amount of money with a currency ("1500 manat", "$300")
+	Percent                        // Percentage ("15 faiz", "3,5%")
+	Date                           // Date or date-time expression (via datetime.Extract)
+	Address                        // Postal address ("Nizami küçəsi 25, mənzil 14"), parsed into Entity.Address
 )
 
 // entityTypeNames maps EntityType values to their string names.
@@ -44,6 +88,11 @@ var entityTypeNames = [...]string{
 	IBAN:         "IBAN",
 	LicensePlate: "LicensePlate",
 	URL:          "URL",
+	Card:         "Card",
+	Money:        "Money",
+	Percent:      "Percent",
+	Date:         "Date",
+	Address:      "Address",
 }
 
 // entityTypeFromName maps string names back to EntityType values.
@@ -55,6 +104,11 @@ var entityTypeFromName = map[string]EntityType{
 	"IBAN":         IBAN,
 	"LicensePlate": LicensePlate,
 	"URL":          URL,
+	"Card":         Card,
+	"Money":        Money,
+	"Percent":      Percent,
+	"Date":         Date,
+	"Address":      Address,
 }
 
 // String returns the name of the entity type.
@@ -95,6 +149,15 @@ type Entity struct {
 	End     int        `json:"end"`     // Byte offset in the original string (exclusive)
 	Type    EntityType `json:"type"`    // Classification of the entity
 	Labeled bool       `json:"labeled"` // True if preceded by a keyword (e.g. "FIN:", "VOEN:")
+
+	Value    float64   `json:"value,omitempty"`    // Money and Percent: numeric value (1500 for "1 500 manat")
+	Currency string    `json:"currency,omitempty"` // Money: ISO 4217 code (e.g. "AZN")
+	Time     time.Time `json:"time,omitzero"`      // Date: resolved date or date-time
+
+	Address *PostalAddress `json:"address,omitempty"` // Address: parts of the address
+
+	Confidence float64 `json:"confidence"` // How likely the match is a true entity, 0.0-1.0
+	Source     string  `json:"source"`     // Rule that matched: "regex:voen_labeled", "validator:luhn", ...
 }
 
 // String returns a debug representation, e.g. Phone("0501234567")[5:15].
@@ -111,13 +174,23 @@ func (e Entity) String() string {
 const maxInputBytes = 1 << 20 // 1 MiB
 
 // Recognize extracts all named entities from the input string.
-// Returns entities sorted by Start offset. When entities overlap,
-// the longer (more specific) match wins; if equal length, labeled wins.
+// Returns entities sorted by Start offset. Overlaps are resolved by the
+// LeftmostLongest policy: the earliest-starting entity wins; at the same
+// start the longer (more specific) match, then a labeled one. Use a
+// Recognizer for another policy. Relative dates are resolved against the
+// current time, so for text with a relative date ("sabah", "3 gün sonra")
+// Recognize is not a pure function of s: the Date entities change with the
+// day, or with the hour for durations. Use RecognizeAt with a fixed
+// reference time for reproducible or cacheable output.
 func Recognize(s string) []Entity {
-	if s == "" || len(s) > maxInputBytes {
-		return nil
-	}
-	return recognize(s)
+	return RecognizeAt(s, time.Time{})
+}
+
+// RecognizeAt is like Recognize but resolves relative dates ("bu gün",
+// "3 gün sonra") against ref, as datetime.Extract does. When ref is the
+// zero value, the current time is used.
+func RecognizeAt(s string, ref time.Time) []Entity {
+	return defaultRecognizer.RecognizeAt(s, ref)
 }
 
 // Phones returns all phone number texts found in s.
@@ -155,6 +228,31 @@ func URLs(s string) []string {
 	return filterTexts(Recognize(s), URL)
 }
 
+// Cards returns all bank card number texts found in s.
+func Cards(s string) []string {
+	return filterTexts(Recognize(s), Card)
+}
+
+// MoneyAmounts returns all amount-of-money texts found in s.
+func MoneyAmounts(s string) []string {
+	return filterTexts(Recognize(s), Money)
+}
+
+// Percents returns all percentage texts found in s.
+func Percents(s string) []string {
+	return filterTexts(Recognize(s), Percent)
+}
+
+// Dates returns all date and date-time texts found in s.
+func Dates(s string) []string {
+	return filterTexts(Recognize(s), Date)
+}
+
+// Addresses returns all postal address texts found in s.
+func Addresses(s string) []string {
+	return filterTexts(Recognize(s), Address)
+}
+
 // filterTexts returns the Text field of entities matching the given type.
 func filterTexts(entities []Entity, typ EntityType) []string {
 	var out []string
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestRecognizePhones(t *testing.T) {
@@ -189,9 +190,24 @@ func TestRecognizeIBAN(t *testing.T) {
 		},
 		{
 			name: "IBAN in text",
-			in:   "Köçürmə AZ77AIIB38060019441234567890 hesabına",
+			in:   "Köçürmə AZ12AIIB38060019441234567890 hesabına",
 			// "Köçürmə" has ö(2)+ç(2)+ü(2)+ə(2) → prefix = 12 bytes
-			want: []Entity{{Text: "AZ77AIIB38060019441234567890", Start: 12, End: 40, Type: IBAN}},
+			want: []Entity{{Text: "AZ12AIIB38060019441234567890", Start: 12, End: 40, Type: IBAN}},
+		},
+		{
+			name: "printed form with spaces",
+			in:   "IBAN: AZ21 NABZ 0000 0000 1370 1000 1944.",
+			want: []Entity{{Text: "AZ21 NABZ 0000 0000 1370 1000 1944", Start: 6, End: 40, Type: IBAN}},
+		},
+		{
+			name: "bad checksum",
+			in:   "Hesab: AZ77AIIB38060019441234567890",
+			want: nil,
+		},
+		{
+			name: "mixed grouping",
+			in:   "AZ21 NABZ00000000137010001944",
+			want: nil,
 		},
 	}
 
@@ -230,6 +246,87 @@ func TestRecognizeLicensePlate(t *testing.T) {
 	}
 }
 
+func TestRecognizeCard(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []Entity
+	}{
+		{
+			name: "compact",
+			in:   "Kart: 4169738812345670",
+			want: []Entity{{Text: "4169738812345670", Start: 6, End: 22, Type: Card}},
+		},
+		{
+			name: "grouped with spaces",
+			in:   "kart 4169 7388 1234 5670 ilə",
+			want: []Entity{{Text: "4169 7388 1234 5670", Start: 5, End: 24, Type: Card}},
+		},
+		{
+			name: "grouped with hyphens",
+			in:   "4169-7388-1234-5670",
+			want: []Entity{{Text: "4169-7388-1234-5670", Start: 0, End: 19, Type: Card}},
+		},
+		{
+			name: "Luhn failure",
+			in:   "Kart: 4169738812345678",
+			want: nil,
+		},
+		{
+			name: "mixed separators",
+			in:   "4169 7388-1234 5670",
+			want: nil,
+		},
+		{
+			name: "longer digit run",
+			in:   "41697388123456701",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Recognize(tt.in)
+			compareEntities(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidIBAN(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"AZ21NABZ00000000137010001944", true},
+		{"AZ12AIIB38060019441234567890", true},
+		{"AZ22NABZ00000000137010001944", false},
+		{"AZ21NABZ0000000013701000194x", false},
+		{"AZ2", false},
+	}
+	for _, tt := range tests {
+		if got := validIBAN(tt.in); got != tt.want {
+			t.Errorf("validIBAN(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestValidLuhn(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"4169738812345670", true},
+		{"5555555555554444", true},
+		{"4169738812345671", false},
+		{"0000000000000000", true},
+	}
+	for _, tt := range tests {
+		if got := validLuhn(tt.in); got != tt.want {
+			t.Errorf("validLuhn(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
 func TestRecognizeURL(t *testing.T) {
 	tests := []struct {
 		name string
@@ -261,6 +358,108 @@ func TestRecognizeURL(t *testing.T) {
 	}
 }
 
+func TestRecognizeMoney(t *testing.T) {
+	tests := []struct {
+		in       string
+		text     string
+		value    float64
+		currency string
+	}{
+		{"Qiymət 1500 manat idi", "1500 manat", 1500, "AZN"},
+		{"1 500 manata aldım", "1 500 manata", 1500, "AZN"},
+		{"Ödəniş 25 AZN", "25 AZN", 25, "AZN"},
+		{"cəmi 10₼", "10₼", 10, "AZN"},
+		{"bilet $300", "$300", 300, "USD"},
+		{"€1.250,50 ödənildi", "€1.250,50", 1250.5, "EUR"},
+		{"AZN 25 köçürün", "AZN 25", 25, "AZN"},
+		{"2,5 milyon deyil, 2 500 000 Dollar", "2 500 000 Dollar", 2500000, "USD"},
+		{"100 rubl", "100 rubl", 100, "RUB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := Recognize(tt.in)
+			if len(got) != 1 || got[0].Type != Money {
+				t.Fatalf("Recognize(%q) = %v, want one Money", tt.in, got)
+			}
+			e := got[0]
+			if e.Text != tt.text || e.Value != tt.value || e.Currency != tt.currency {
+				t.Errorf("got %q %v %s, want %q %v %s", e.Text, e.Value, e.Currency, tt.text, tt.value, tt.currency)
+			}
+			if tt.in[e.Start:e.End] != e.Text {
+				t.Errorf("invariant broken: s[%d:%d]=%q != Text=%q", e.Start, e.End, tt.in[e.Start:e.End], e.Text)
+			}
+		})
+	}
+}
+
+func TestRecognizePercent(t *testing.T) {
+	tests := []struct {
+		in    string
+		text  string
+		value float64
+	}{
+		{"endirim 15 faiz", "15 faiz", 15},
+		{"inflyasiya 3,5% oldu", "3,5%", 3.5},
+		{"artım 12 % təşkil etdi", "12 %", 12},
+		{"20 faizə qədər", "20 faizə", 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := Recognize(tt.in)
+			if len(got) != 1 || got[0].Type != Percent || got[0].Text != tt.text || got[0].Value != tt.value {
+				t.Errorf("Recognize(%q) = %v, want Percent(%q) value %v", tt.in, got, tt.text, tt.value)
+			}
+		})
+	}
+}
+
+func TestRecognizeDate(t *testing.T) {
+	ref := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	in := "Müqavilə 5 mart 2026 tarixində imzalandı, ödəniş sabah olacaq."
+	got := RecognizeAt(in, ref)
+	want := []struct {
+		text string
+		time time.Time
+	}{
+		{"5 mart 2026", time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"sabah", time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RecognizeAt = %v, want %d dates", got, len(want))
+	}
+	for i, w := range want {
+		if got[i].Type != Date || got[i].Text != w.text || !got[i].Time.Equal(w.time) {
+			t.Errorf("[%d] = %v %v, want Date(%q) %v", i, got[i], got[i].Time, w.text, w.time)
+		}
+	}
+	// Times of day and durations are not dates.
+	if got := RecognizeAt("Görüş saat 15:00-da, 2 saat çəkəcək", ref); got != nil {
+		t.Errorf("time and duration: got %v, want nil", got)
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in   string
+		want float64
+	}{
+		{"1500", 1500},
+		{"1 500", 1500},
+		{"1.500", 1500},
+		{"1,500", 1500},
+		{"3,5", 3.5},
+		{"3.25", 3.25},
+		{"1.250,50", 1250.5},
+		{"1,250.50", 1250.5},
+		{"2 500 000", 2500000},
+	}
+	for _, tt := range tests {
+		if got, ok := parseAmount(tt.in); !ok || got != tt.want {
+			t.Errorf("parseAmount(%q) = %v, %v, want %v", tt.in, got, ok, tt.want)
+		}
+	}
+}
+
 func TestRecognizeMixed(t *testing.T) {
 	in := "FIN: 5ARPXK2, tel +994501234567, email info@gov.az"
 	got := Recognize(in)
@@ -294,7 +493,7 @@ func TestRecognizeNoEntities(t *testing.T) {
 }
 
 func TestConvenienceFunctions(t *testing.T) {
-	in := "FIN: 5ARPXK2, tel +994501234567, VOEN: 1234567890, email info@gov.az, IBAN AZ21NABZ00000000137010001944, plate 10-AA-123, url https://gov.az"
+	in := "FIN: 5ARPXK2, tel +994501234567, VOEN: 1234567890, email info@gov.az, IBAN AZ21NABZ00000000137010001944, plate 10-AA-123, url https://gov.az, kart 4169 7388 1234 5670"
 
 	assertStrings(t, "Phones", Phones(in), []string{"+994501234567"})
 	assertStrings(t, "Emails", Emails(in), []string{"info@gov.az"})
@@ -303,6 +502,12 @@ func TestConvenienceFunctions(t *testing.T) {
 	assertStrings(t, "IBANs", IBANs(in), []string{"AZ21NABZ00000000137010001944"})
 	assertStrings(t, "LicensePlates", LicensePlates(in), []string{"10-AA-123"})
 	assertStrings(t, "URLs", URLs(in), []string{"https://gov.az"})
+	assertStrings(t, "Cards", Cards(in), []string{"4169 7388 1234 5670"})
+
+	in = "Qiymət 1500 manat, endirim 15 faiz, son tarix 5 mart 2026"
+	assertStrings(t, "MoneyAmounts", MoneyAmounts(in), []string{"1500 manat"})
+	assertStrings(t, "Percents", Percents(in), []string{"15 faiz"})
+	assertStrings(t, "Dates", Dates(in), []string{"5 mart 2026"})
 }
 
 func TestEntityTypeJSON(t *testing.T) {
@@ -396,7 +601,7 @@ func TestEntityTypeUnmarshalNonString(t *testing.T) {
 }
 
 func TestEntityTypeMapsComplete(t *testing.T) {
-	for i := EntityType(0); i <= URL; i++ {
+	for i := EntityType(0); i <= Address; i++ {
 		name := i.String()
 		if strings.HasPrefix(name, "EntityType(") {
 			t.Errorf("EntityType %d has no name in entityTypeNames", i)
@@ -506,12 +711,27 @@ func ExampleLicensePlates() {
 	// [90-BZ-456]
 }
 
+func ExampleCards() {
+	fmt.Println(Cards("Kart: 4169 7388 1234 5670, köhnə kart: 4169 7388 1234 5678"))
+	// Output:
+	// [4169 7388 1234 5670]
+}
+
 func ExampleURLs() {
 	fmt.Println(URLs("Sayt: https://gov.az/services"))
 	// Output:
 	// [https://gov.az/services]
 }
 
+func ExampleMoneyAmounts() {
+	for _, e := range Recognize("Bilet 25 AZN, endirim 10 faiz") {
+		fmt.Println(e.Type, e.Text, e.Value, e.Currency)
+	}
+	// Output:
+	// Money 25 AZN 25 AZN
+	// Percent 10 faiz 10
+}
+
 func ExampleEntityType_String() {
 	fmt.Println(FIN)
 	fmt.Println(Phone)
@@ -1,10 +1,12 @@
 package ner
 
 import (
-	"cmp"
 	"regexp"
-	"slices"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/datetime"
 )
 
 // Compiled regexes for each entity type.
@@ -22,8 +24,12 @@ var (
 	// URL: http or https prefixed, restricted to RFC 3986 characters
 	reURL = regexp.MustCompile(`https?://[A-Za-z0-9\-._~:/?#\[\]@!$&'()*+,;=%]+`)
 
-	// IBAN: AZ + 2 digits + 4 uppercase letters + 20 alphanumeric chars = 28 total
-	reIBAN = regexp.MustCompile(`\bAZ\d{2}[A-Z]{4}[A-Z0-9]{20}\b`)
+	// IBAN: AZ + 2 digits + 4 uppercase letters + 20 alphanumeric chars = 28 total,
+	// either compact or in the printed form with a space every 4 chars
+	reIBAN = regexp.MustCompile(`\bAZ\d{2}(?:[A-Z]{4}[A-Z0-9]{20}|(?: [A-Z]{4})(?: [A-Z0-9]{4}){5})\b`)
+
+	// Card: 16 digits, compact or in groups of 4 separated by spaces or hyphens
+	reCard = regexp.MustCompile(`\b\d{4}(?:[ \-]?\d{4}){3}\b`)
 
 	// LicensePlate: XX-YY-ZZZ format
 	reLicensePlate = regexp.MustCompile(`\b\d{2}-[A-Z]{2}-\d{3}\b`)
@@ -34,18 +40,79 @@ var (
 	reFINBare = regexp.MustCompile(`\b[A-HJ-NP-Z0-9]{7}\b`)
 
 	// VOEN labeled: preceded by keyword "VOEN" or "VÖEN" with optional colon/space.
-	// Bare VOEN is not matched — 10 bare digits are too ambiguous.
 	reVOENLabeled = regexp.MustCompile(`(?i)\bV[ÖO]EN[:\s]\s?(\d{10})\b`)
+	// VOEN bare: 10 digits. Too ambiguous to report by default; see
+	// confidenceBareVOEN.
+	reVOENBare = regexp.MustCompile(`\b\d{10}\b`)
+
+	// Money: amount followed by an ISO code, a currency sign, or a
+	// (possibly inflected) currency word: "25 AZN", "10₼", "1500 manata"
+	reMoneyAfter = regexp.MustCompile(`\b(` + reAmount + `)\s?(?:(AZN|USD|EUR|RUB|TRY|GBP)\b|([₼$€₽₺£])|((?i:manat|dollar|avro|euro|rubl|lirə|funt))\p{L}*)`)
+	// Money: currency sign or ISO code followed by an amount: "$300", "AZN 25"
+	reMoneyBefore = regexp.MustCompile(`(?:([₼$€₽₺£])\s?|\b(AZN|USD|EUR|RUB|TRY|GBP) )(` + reAmount + `)\b`)
+
+	// Percent: amount followed by a percent sign or "faiz": "3,5%", "15 faizə"
+	rePercent = regexp.MustCompile(`\b(` + reAmount + `)(?:\s?%|\s(?i:faiz)\p{L}*)`)
+)
+
+// reAmount matches a number with optional thousands groups (space, dot, or
+// comma) and decimal part: "1500", "1 500", "1.500,50", "3,5".
+const reAmount = `\d{1,3}(?:[ .,]\d{3})+(?:[.,]\d+)?|\d+(?:[.,]\d+)?`
+
+// currencyCodes maps currency signs and words to ISO 4217 codes.
+var currencyCodes = map[string]string{
+	"₼": "AZN", "manat": "AZN",
+	"$": "USD", "dollar": "USD",
+	"€": "EUR", "avro": "EUR", "euro": "EUR",
+	"₽": "RUB", "rubl": "RUB",
+	"₺": "TRY", "lirə": "TRY",
+	"£": "GBP", "funt": "GBP",
+}
+
+// Confidence levels assigned to entities.
+const (
+	confidenceValidated = 0.98 // checksum-validated IBAN and card numbers
+	confidenceLabeled   = 0.95 // keyword-prefixed FIN/VOEN
+	confidencePattern   = 0.9  // structurally distinctive patterns (phone, email, URL, ...)
+	confidenceStreet    = 0.7  // address without a house number or postal code
+	confidenceBare      = 0.6  // bare FIN: 7 alphanumeric chars may be any code
+	confidenceBareVOEN  = 0.3  // bare VOEN: 10 digits may be any number
+)
+
+// Sources recorded in Entity.Source: the regex, checksum validator, or
+// gazetteer that produced the match.
+const (
+	sourcePhoneIntl   = "regex:phone_intl"
+	sourcePhoneLocal  = "regex:phone_local"
+	sourceEmail       = "regex:email"
+	sourceURL         = "regex:url"
+	sourceIBAN        = "validator:mod97"
+	sourceCard        = "validator:luhn"
+	sourcePlate       = "regex:license_plate"
+	sourceFINLabeled  = "regex:fin_labeled"
+	sourceFINBare     = "regex:fin_bare"
+	sourceVOENLabeled = "regex:voen_labeled"
+	sourceVOENBare    = "regex:voen_bare"
+	sourceMoney       = "regex:money"
+	sourceCurrency    = "gazetteer:currency" // money with a currency word ("1500 manata")
+	sourcePercent     = "regex:percent"
+	sourceDate        = "datetime"
+	sourceAddress     = "regex:address"
 )
 
 // maxEmailLen is the maximum length of an email address per RFC 5321.
 const maxEmailLen = 254
 
+// maxLabelBytes is how far before an address its "Ünvan:" label is
+// looked for.
+const maxLabelBytes = 32
+
 // maxEntities is the maximum number of entities returned per call.
 const maxEntities = 10000
 
-// recognize is the internal implementation of Recognize.
-func recognize(s string) []Entity {
+// collect returns every entity matched in s, overlapping ones included,
+// for a Recognizer to resolve.
+func collect(s string, ref time.Time) []Entity {
 	// Pre-allocate with a heuristic: ~1 entity per 200 bytes.
 	const minCap = 8
 	all := make([]Entity, 0, len(s)/200+minCap)
@@ -54,37 +121,41 @@ func recognize(s string) []Entity {
 	all = appendURL(all, s)
 	all = appendEmail(all, s)
 	all = appendIBAN(all, s)
+	all = appendCard(all, s)
 	all = appendLicensePlate(all, s)
 	all = appendPhone(all, s)
+	all = appendMoney(all, s)
+	all = appendPercent(all, s)
+	all = appendDate(all, s, ref)
+	all = appendAddress(all, s)
 
 	// Ambiguous patterns last (FIN/VOEN labeled, then bare)
 	all = appendFIN(all, s)
 	all = appendVOEN(all, s)
 
-	if len(all) == 0 {
-		return nil
-	}
-
-	// resolveOverlaps returns entities already sorted by Start offset.
-	return resolveOverlaps(all)
+	return all
 }
 
 // appendPhone appends phone numbers in both international and local formats.
 func appendPhone(all []Entity, s string) []Entity {
 	for _, m := range rePhoneIntl.FindAllStringIndex(s, -1) {
 		all = append(all, Entity{
-			Text:  s[m[0]:m[1]],
-			Start: m[0],
-			End:   m[1],
-			Type:  Phone,
+			Text:       s[m[0]:m[1]],
+			Start:      m[0],
+			End:        m[1],
+			Type:       Phone,
+			Confidence: confidencePattern,
+			Source:     sourcePhoneIntl,
 		})
 	}
 	for _, m := range rePhoneLocal.FindAllStringIndex(s, -1) {
 		all = append(all, Entity{
-			Text:  s[m[0]:m[1]],
-			Start: m[0],
-			End:   m[1],
-			Type:  Phone,
+			Text:       s[m[0]:m[1]],
+			Start:      m[0],
+			End:        m[1],
+			Type:       Phone,
+			Confidence: confidencePattern,
+			Source:     sourcePhoneLocal,
 		})
 	}
 	return all
@@ -97,10 +168,12 @@ func appendEmail(all []Entity, s string) []Entity {
 			continue
 		}
 		all = append(all, Entity{
-			Text:  s[m[0]:m[1]],
-			Start: m[0],
-			End:   m[1],
-			Type:  Email,
+			Text:       s[m[0]:m[1]],
+			Start:      m[0],
+			End:        m[1],
+			Type:       Email,
+			Confidence: confidencePattern,
+			Source:     sourceEmail,
 		})
 	}
 	return all
@@ -114,23 +187,51 @@ func appendURL(all []Entity, s string) []Entity {
 		text = strings.TrimRight(text, ".,;:!?)]}>")
 		end := m[0] + len(text)
 		all = append(all, Entity{
-			Text:  text,
-			Start: m[0],
-			End:   end,
-			Type:  URL,
+			Text:       text,
+			Start:      m[0],
+			End:        end,
+			Type:       URL,
+			Confidence: confidencePattern,
+			Source:     sourceURL,
 		})
 	}
 	return all
 }
 
-// appendIBAN appends Azerbaijani IBAN numbers.
+// appendIBAN appends Azerbaijani IBAN numbers with a valid checksum.
 func appendIBAN(all []Entity, s string) []Entity {
 	for _, m := range reIBAN.FindAllStringIndex(s, -1) {
+		if !validIBAN(strings.ReplaceAll(s[m[0]:m[1]], " ", "")) {
+			continue
+		}
+		all = append(all, Entity{
+			Text:       s[m[0]:m[1]],
+			Start:      m[0],
+			End:        m[1],
+			Type:       IBAN,
+			Confidence: confidenceValidated,
+			Source:     sourceIBAN,
+		})
+	}
+	return all
+}
+
+// appendCard appends bank card numbers that use one separator style
+// throughout and pass the Luhn checksum.
+func appendCard(all []Entity, s string) []Entity {
+	for _, m := range reCard.FindAllStringIndex(s, -1) {
+		text := s[m[0]:m[1]]
+		digits, ok := cardDigits(text)
+		if !ok || !validLuhn(digits) {
+			continue
+		}
 		all = append(all, Entity{
-			Text:  s[m[0]:m[1]],
-			Start: m[0],
-			End:   m[1],
-			Type:  IBAN,
+			Text:       text,
+			Start:      m[0],
+			End:        m[1],
+			Type:       Card,
+			Confidence: confidenceValidated,
+			Source:     sourceCard,
 		})
 	}
 	return all
@@ -140,10 +241,12 @@ func appendIBAN(all []Entity, s string) []Entity {
 func appendLicensePlate(all []Entity, s string) []Entity {
 	for _, m := range reLicensePlate.FindAllStringIndex(s, -1) {
 		all = append(all, Entity{
-			Text:  s[m[0]:m[1]],
-			Start: m[0],
-			End:   m[1],
-			Type:  LicensePlate,
+			Text:       s[m[0]:m[1]],
+			Start:      m[0],
+			End:        m[1],
+			Type:       LicensePlate,
+			Confidence: confidencePattern,
+			Source:     sourcePlate,
 		})
 	}
 	return all
@@ -160,11 +263,13 @@ func appendFIN(all []Entity, s string) []Entity {
 		// sub[2]:sub[3] is the capture group (the 7-char code)
 		labeled[sub[2]] = struct{}{}
 		all = append(all, Entity{
-			Text:    s[sub[2]:sub[3]],
-			Start:   sub[2],
-			End:     sub[3],
-			Type:    FIN,
-			Labeled: true,
+			Text:       s[sub[2]:sub[3]],
+			Start:      sub[2],
+			End:        sub[3],
+			Type:       FIN,
+			Labeled:    true,
+			Confidence: confidenceLabeled,
+			Source:     sourceFINLabeled,
 		})
 	}
 
@@ -178,10 +283,12 @@ func appendFIN(all []Entity, s string) []Entity {
 		text := s[m[0]:m[1]]
 		if isMixedAlphanumeric(text) {
 			all = append(all, Entity{
-				Text:  text,
-				Start: m[0],
-				End:   m[1],
-				Type:  FIN,
+				Text:       text,
+				Start:      m[0],
+				End:        m[1],
+				Type:       FIN,
+				Confidence: confidenceBare,
+				Source:     sourceFINBare,
 			})
 		}
 	}
@@ -189,21 +296,199 @@ func appendFIN(all []Entity, s string) []Entity {
 	return all
 }
 
-// appendVOEN appends VOEN codes. Only labeled matches (preceded by "VOEN"/"VÖEN")
-// are recognized — bare 10-digit sequences are too ambiguous.
+// appendVOEN appends VOEN codes. Labeled matches (preceded by "VOEN"/"VÖEN")
+// take priority; bare 10-digit matches at the same position are skipped.
+// Bare matches carry confidenceBareVOEN, below the default minimum
+// confidence, so only a Recognizer created WithMinConfidence reports them.
 func appendVOEN(all []Entity, s string) []Entity {
+	labeled := make(map[int]struct{})
 	for _, sub := range reVOENLabeled.FindAllStringSubmatchIndex(s, -1) {
+		labeled[sub[2]] = struct{}{}
 		all = append(all, Entity{
-			Text:    s[sub[2]:sub[3]],
-			Start:   sub[2],
-			End:     sub[3],
-			Type:    VOEN,
-			Labeled: true,
+			Text:       s[sub[2]:sub[3]],
+			Start:      sub[2],
+			End:        sub[3],
+			Type:       VOEN,
+			Labeled:    true,
+			Confidence: confidenceLabeled,
+			Source:     sourceVOENLabeled,
+		})
+	}
+	for _, m := range reVOENBare.FindAllStringIndex(s, -1) {
+		if _, ok := labeled[m[0]]; ok {
+			continue
+		}
+		all = append(all, Entity{
+			Text:       s[m[0]:m[1]],
+			Start:      m[0],
+			End:        m[1],
+			Type:       VOEN,
+			Confidence: confidenceBareVOEN,
+			Source:     sourceVOENBare,
 		})
 	}
 	return all
 }
 
+// ibanModulus is the ISO 7064 MOD 97-10 modulus used by IBAN check digits.
+const ibanModulus = 97
+
+// validIBAN reports whether a compact IBAN passes the mod-97 checksum:
+// with the first four characters moved to the end and letters replaced
+// by 10..35, the number must leave remainder 1.
+func validIBAN(iban string) bool {
+	const checkLen = 4 // country code + check digits
+	if len(iban) <= checkLen {
+		return false
+	}
+	rearranged := iban[checkLen:] + iban[:checkLen]
+	rem := 0
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		switch {
+		case c >= '0' && c <= '9':
+			rem = (rem*10 + int(c-'0')) % ibanModulus
+		case c >= 'A' && c <= 'Z':
+			rem = (rem*100 + int(c-'A') + 10) % ibanModulus
+		default:
+			return false
+		}
+	}
+	return rem == 1
+}
+
+// cardDigits returns the 16 digits of a card number match. ok is false
+// when the groups are separated inconsistently ("1234 5678-9012 3456",
+// "12345678 9012 3456").
+func cardDigits(text string) (string, bool) {
+	const compactLen = 16
+	if len(text) == compactLen {
+		return text, true
+	}
+	sep := text[4]
+	digits := make([]byte, 0, compactLen)
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c >= '0' && c <= '9' {
+			digits = append(digits, c)
+			continue
+		}
+		if c != sep || (i+1)%5 != 0 {
+			return "", false
+		}
+	}
+	return string(digits), len(digits) == compactLen
+}
+
+// validLuhn reports whether a digit string passes the Luhn checksum.
+func validLuhn(digits string) bool {
+	sum := 0
+	for i := range len(digits) {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// appendMoney appends amounts of money with a currency before or after
+// the number.
+func appendMoney(all []Entity, s string) []Entity {
+	for _, m := range reMoneyAfter.FindAllStringSubmatchIndex(s, -1) {
+		value, ok := parseAmount(s[m[2]:m[3]])
+		if !ok {
+			continue
+		}
+		code, source := "", sourceMoney
+		switch {
+		case m[4] >= 0:
+			code = s[m[4]:m[5]]
+		case m[6] >= 0:
+			code = currencyCodes[s[m[6]:m[7]]]
+		default:
+			code, source = currencyCodes[strings.ToLower(s[m[8]:m[9]])], sourceCurrency
+		}
+		all = append(all, Entity{
+			Text: s[m[0]:m[1]], Start: m[0], End: m[1], Type: Money, Value: value, Currency: code,
+			Confidence: confidencePattern, Source: source,
+		})
+	}
+	for _, m := range reMoneyBefore.FindAllStringSubmatchIndex(s, -1) {
+		value, ok := parseAmount(s[m[6]:m[7]])
+		if !ok {
+			continue
+		}
+		code := currencyCodes[s[max(m[2], 0):max(m[3], 0)]]
+		if m[4] >= 0 {
+			code = s[m[4]:m[5]]
+		}
+		all = append(all, Entity{
+			Text: s[m[0]:m[1]], Start: m[0], End: m[1], Type: Money, Value: value, Currency: code,
+			Confidence: confidencePattern, Source: sourceMoney,
+		})
+	}
+	return all
+}
+
+// appendPercent appends percentages written with "%" or "faiz".
+func appendPercent(all []Entity, s string) []Entity {
+	for _, m := range rePercent.FindAllStringSubmatchIndex(s, -1) {
+		value, ok := parseAmount(s[m[2]:m[3]])
+		if !ok {
+			continue
+		}
+		all = append(all, Entity{
+			Text: s[m[0]:m[1]], Start: m[0], End: m[1], Type: Percent, Value: value,
+			Confidence: confidencePattern, Source: sourcePercent,
+		})
+	}
+	return all
+}
+
+// appendDate appends the date and date-time expressions found by
+// datetime.Extract, resolved against ref. Times and durations are skipped.
+func appendDate(all []Entity, s string, ref time.Time) []Entity {
+	for _, r := range datetime.Extract(s, ref) {
+		if r.Type != datetime.TypeDate && r.Type != datetime.TypeDateTime {
+			continue
+		}
+		all = append(all, Entity{
+			Text: r.Text, Start: r.Start, End: r.End, Type: Date, Time: r.Time,
+			Confidence: confidencePattern, Source: sourceDate,
+		})
+	}
+	return all
+}
+
+// parseAmount converts a number matched by reAmount to a float64. The
+// last separator is decimal when the other separator also occurs or when
+// it is not followed by exactly three digits ("3,5", "1.500,50");
+// otherwise all separators group thousands ("1.500", "1,500").
+func parseAmount(text string) (float64, bool) {
+	text = strings.ReplaceAll(text, " ", "")
+	intPart, frac := text, ""
+	if i := strings.LastIndexAny(text, ".,"); i >= 0 {
+		other := "."
+		if text[i] == '.' {
+			other = ","
+		}
+		if len(text)-i-1 != 3 || strings.Contains(text[:i], other) {
+			intPart, frac = text[:i], text[i+1:]
+		}
+	}
+	num := strings.NewReplacer(".", "", ",", "").Replace(intPart)
+	if frac != "" {
+		num += "." + frac
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	return v, err == nil
+}
+
 // isMixedAlphanumeric returns true if s contains at least one ASCII letter
 // and at least one ASCII digit. Used to filter bare FIN candidates.
 func isMixedAlphanumeric(s string) bool {
@@ -221,49 +506,3 @@ func isMixedAlphanumeric(s string) bool {
 	}
 	return false
 }
-
-// resolveOverlaps removes overlapping entities. When two entities overlap:
-//   - The longer (more specific) match wins.
-//   - If equal length, labeled wins over unlabeled.
-//   - If still tied, the first one encountered wins.
-//
-// Returns entities sorted by Start offset.
-func resolveOverlaps(entities []Entity) []Entity {
-	if len(entities) <= 1 {
-		return entities
-	}
-
-	// Sort by start offset, then by length descending, then labeled first.
-	slices.SortFunc(entities, func(a, b Entity) int {
-		if c := cmp.Compare(a.Start, b.Start); c != 0 {
-			return c
-		}
-		la := a.End - a.Start
-		lb := b.End - b.Start
-		if c := cmp.Compare(lb, la); c != 0 {
-			return c
-		}
-		if a.Labeled != b.Labeled {
-			if a.Labeled {
-				return -1
-			}
-			return 1
-		}
-		return 0
-	})
-
-	result := make([]Entity, 0, len(entities))
-	maxEnd := 0
-
-	for _, e := range entities {
-		if e.Start >= maxEnd {
-			result = append(result, e)
-			if len(result) >= maxEntities {
-				break
-			}
-			maxEnd = e.End
-		}
-	}
-
-	return result
-}
@@ -0,0 +1,285 @@
+package ner
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// OverlapPolicy selects how a Recognizer resolves entities whose spans
+// overlap, such as a digit run matching both Phone and VOEN.
+type OverlapPolicy int
+
+const (
+	// LeftmostLongest keeps the earliest-starting entity, dropping the
+	// entities that overlap it. Among entities starting at the same offset
+	// the longest wins, then a labeled one, then the higher-priority type.
+	// This is the policy of Recognize.
+	LeftmostLongest OverlapPolicy = iota
+
+	// Priority keeps the entity of the highest-priority type, dropping
+	// the entities that overlap it, regardless of where they start. Among
+	// entities of the same type a labeled one wins, then the longest, then
+	// the earliest-starting.
+	Priority
+
+	// AllMatches keeps every entity, including overlapping ones, sorted by
+	// start, longest first, then by type priority. Entities with the same
+	// span and type are reported once, labeled if either is.
+	AllMatches
+)
+
+// overlapPolicyNames maps OverlapPolicy values to their string names.
+var overlapPolicyNames = [...]string{
+	LeftmostLongest: "LeftmostLongest",
+	Priority:        "Priority",
+	AllMatches:      "AllMatches",
+}
+
+// String returns the name of the overlap policy.
+func (p OverlapPolicy) String() string {
+	if int(p) >= 0 && int(p) < len(overlapPolicyNames) {
+		return overlapPolicyNames[p]
+	}
+	return fmt.Sprintf("OverlapPolicy(%d)", int(p))
+}
+
+// defaultPriority lists the entity types from highest to lowest priority:
+// structurally distinctive patterns first, the ambiguous FIN and VOEN
+// codes last.
+var defaultPriority = []EntityType{URL, Email, IBAN, Card, LicensePlate, Phone, Money, Percent, Address, Date, FIN, VOEN}
+
+// Recognizer extracts entities like Recognize with a configurable overlap
+// resolution policy. The zero value is not valid; use NewRecognizer.
+//
+// A Recognizer is immutable once created and safe for concurrent use by
+// multiple goroutines.
+type Recognizer struct {
+	policy  OverlapPolicy
+	rank    [len(entityTypeNames)]int // priority rank by type, 0 highest
+	minConf float64                   // entities below this confidence are dropped
+}
+
+// defaultMinConfidence is the minimum confidence of Recognize: it keeps
+// bare FINs (0.6) and drops bare VOEN candidates (0.3).
+const defaultMinConfidence = 0.5
+
+// defaultRecognizer backs Recognize and RecognizeAt.
+var defaultRecognizer = &Recognizer{policy: LeftmostLongest, rank: rankOf(defaultPriority), minConf: defaultMinConfidence}
+
+// rankOf returns the rank of each type in order; order must list every
+// type once.
+func rankOf(order []EntityType) [len(entityTypeNames)]int {
+	var rank [len(entityTypeNames)]int
+	for i, t := range order {
+		rank[t] = i
+	}
+	return rank
+}
+
+// Option configures a Recognizer created by NewRecognizer.
+type Option func(*Recognizer) error
+
+// WithOverlapPolicy sets how overlapping entities are resolved. The
+// default is LeftmostLongest. Returns an error from NewRecognizer for an
+// unknown policy.
+func WithOverlapPolicy(p OverlapPolicy) Option {
+	return func(r *Recognizer) error {
+		if p < LeftmostLongest || p > AllMatches {
+			return fmt.Errorf("ner: unknown overlap policy: %v", p)
+		}
+		r.policy = p
+		return nil
+	}
+}
+
+// WithPriority sets the type priority used by the Priority policy and to
+// break ties, highest first, e.g. VOEN before Phone to report a tax ID
+// rather than a phone number. Types not listed follow in the default
+// order: URL, Email, IBAN, Card, LicensePlate, Phone, Money, Percent,
+// Address, Date, FIN, VOEN. Returns an error from NewRecognizer for an unknown or
+// repeated type.
+func WithPriority(types ...EntityType) Option {
+	return func(r *Recognizer) error {
+		order := make([]EntityType, 0, len(defaultPriority))
+		for _, t := range types {
+			if t < 0 || int(t) >= len(entityTypeNames) {
+				return fmt.Errorf("ner: unknown entity type: %v", t)
+			}
+			if slices.Contains(order, t) {
+				return fmt.Errorf("ner: entity type %v listed twice", t)
+			}
+			order = append(order, t)
+		}
+		for _, t := range defaultPriority {
+			if !slices.Contains(order, t) {
+				order = append(order, t)
+			}
+		}
+		r.rank = rankOf(order)
+		return nil
+	}
+}
+
+// WithMinConfidence drops entities whose Confidence is below c before
+// overlaps are resolved, so a weak match never hides a stronger one. The
+// default is 0.5; 0 reports every candidate, including bare 10-digit VOEN
+// numbers. Returns an error from NewRecognizer unless 0 <= c <= 1.
+func WithMinConfidence(c float64) Option {
+	return func(r *Recognizer) error {
+		if !(c >= 0 && c <= 1) {
+			return fmt.Errorf("ner: minimum confidence out of range [0, 1]: %v", c)
+		}
+		r.minConf = c
+		return nil
+	}
+}
+
+// NewRecognizer returns a Recognizer configured by opts. Without options
+// it behaves like Recognize.
+func NewRecognizer(opts ...Option) (*Recognizer, error) {
+	r := &Recognizer{policy: LeftmostLongest, rank: rankOf(defaultPriority), minConf: defaultMinConfidence}
+	for _, opt := range opts {
+		if err := opt(r); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Recognize is like the package-level Recognize, resolving overlaps by the
+// recognizer's policy.
+func (r *Recognizer) Recognize(s string) []Entity {
+	return r.RecognizeAt(s, time.Time{})
+}
+
+// RecognizeAt is like the package-level RecognizeAt, resolving overlaps by
+// the recognizer's policy.
+func (r *Recognizer) RecognizeAt(s string, ref time.Time) []Entity {
+	if s == "" || len(s) > maxInputBytes {
+		return nil
+	}
+	all := slices.DeleteFunc(collect(s, ref), func(e Entity) bool {
+		return e.Confidence < r.minConf
+	})
+	if len(all) == 0 {
+		return nil
+	}
+	switch r.policy {
+	case Priority:
+		return r.resolveByPriority(all)
+	case AllMatches:
+		return r.allMatches(all)
+	}
+	return r.resolveOverlaps(all)
+}
+
+// compareSpan orders entities by start, then longest first, labeled
+// first, and higher priority first.
+func (r *Recognizer) compareSpan(a, b Entity) int {
+	if c := cmp.Compare(a.Start, b.Start); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(b.End, a.End); c != 0 {
+		return c
+	}
+	if c := labeledFirst(a, b); c != 0 {
+		return c
+	}
+	return cmp.Compare(r.rank[a.Type], r.rank[b.Type])
+}
+
+// labeledFirst orders a labeled entity before an unlabeled one.
+func labeledFirst(a, b Entity) int {
+	switch {
+	case a.Labeled == b.Labeled:
+		return 0
+	case a.Labeled:
+		return -1
+	}
+	return 1
+}
+
+// resolveOverlaps implements LeftmostLongest.
+//
+// Returns entities sorted by Start offset.
+func (r *Recognizer) resolveOverlaps(entities []Entity) []Entity {
+	slices.SortStableFunc(entities, r.compareSpan)
+
+	result := make([]Entity, 0, len(entities))
+	maxEnd := 0
+	for _, e := range entities {
+		if e.Start >= maxEnd {
+			result = append(result, e)
+			if len(result) >= maxEntities {
+				break
+			}
+			maxEnd = e.End
+		}
+	}
+	return result
+}
+
+// resolveByPriority implements Priority: entities are considered from the
+// highest-priority type down, and each is kept unless it overlaps one
+// already kept.
+//
+// Returns entities sorted by Start offset.
+func (r *Recognizer) resolveByPriority(entities []Entity) []Entity {
+	slices.SortStableFunc(entities, func(a, b Entity) int {
+		if c := cmp.Compare(r.rank[a.Type], r.rank[b.Type]); c != 0 {
+			return c
+		}
+		if c := labeledFirst(a, b); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.End-b.Start, a.End-a.Start); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	// kept is sorted by Start; kept spans do not overlap, so their ends
+	// are sorted too.
+	kept := make([]Entity, 0, len(entities))
+	for _, e := range entities {
+		i, _ := slices.BinarySearchFunc(kept, e.Start, func(k Entity, start int) int {
+			return cmp.Compare(k.Start, start)
+		})
+		if i > 0 && kept[i-1].End > e.Start || i < len(kept) && kept[i].Start < e.End {
+			continue
+		}
+		kept = slices.Insert(kept, i, e)
+		if len(kept) >= maxEntities {
+			break
+		}
+	}
+	return kept
+}
+
+// allMatches implements AllMatches.
+//
+// Returns entities sorted by Start offset, longest first, then by type
+// priority.
+func (r *Recognizer) allMatches(entities []Entity) []Entity {
+	slices.SortStableFunc(entities, func(a, b Entity) int {
+		if c := cmp.Compare(a.Start, b.Start); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.End, a.End); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(r.rank[a.Type], r.rank[b.Type]); c != 0 {
+			return c
+		}
+		return labeledFirst(a, b)
+	})
+	entities = slices.CompactFunc(entities, func(a, b Entity) bool {
+		return a.Start == b.Start && a.End == b.End && a.Type == b.Type
+	})
+	if len(entities) > maxEntities {
+		entities = entities[:maxEntities]
+	}
+	return entities
+}
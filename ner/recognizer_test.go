@@ -0,0 +1,259 @@
+package ner
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// entityStrings returns the debug forms of entities for comparison.
+func entityStrings(entities []Entity) []string {
+	out := make([]string, len(entities))
+	for i, e := range entities {
+		out[i] = e.String()
+	}
+	return out
+}
+
+func TestRecognizerPolicies(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		in   string
+		want []string
+	}{
+		{
+			name: "default matches Recognize",
+			in:   "VÖEN: 0501234567",
+			want: []string{`VOEN("0501234567")[7:17,labeled]`},
+		},
+		{
+			name: "leftmost longest keeps the earlier date",
+			opts: []Option{WithOverlapPolicy(LeftmostLongest)},
+			in:   "5 mart 2026 manat",
+			want: []string{`Date("5 mart 2026")[0:11]`},
+		},
+		{
+			name: "priority prefers money over date",
+			opts: []Option{WithOverlapPolicy(Priority)},
+			in:   "5 mart 2026 manat",
+			want: []string{`Money("2026 manat")[7:17]`},
+		},
+		{
+			name: "priority prefers phone over labeled VOEN by default",
+			opts: []Option{WithOverlapPolicy(Priority)},
+			in:   "VÖEN: 0501234567",
+			want: []string{`Phone("0501234567")[7:17]`},
+		},
+		{
+			name: "custom priority",
+			opts: []Option{WithOverlapPolicy(Priority), WithPriority(VOEN)},
+			in:   "VÖEN: 0501234567",
+			want: []string{`VOEN("0501234567")[7:17,labeled]`},
+		},
+		{
+			name: "custom priority breaks leftmost ties",
+			opts: []Option{WithPriority(Phone)},
+			in:   "0501234567",
+			want: []string{`Phone("0501234567")[0:10]`},
+		},
+		{
+			name: "all matches",
+			opts: []Option{WithOverlapPolicy(AllMatches)},
+			in:   "Sayt: https://example.az/0501234567, VÖEN: 0501234567",
+			want: []string{
+				`URL("https://example.az/0501234567")[6:35]`,
+				`Phone("0501234567")[25:35]`,
+				`Phone("0501234567")[44:54]`,
+				`VOEN("0501234567")[44:54,labeled]`,
+			},
+		},
+		{
+			name: "non-overlapping entities are kept by every policy",
+			opts: []Option{WithOverlapPolicy(Priority)},
+			in:   "Qiymət 1500 manat, 15 faiz endirim",
+			want: []string{`Money("1500 manat")[8:18]`, `Percent("15 faiz")[20:27]`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := NewRecognizer(tt.opts...)
+			if err != nil {
+				t.Fatalf("NewRecognizer: %v", err)
+			}
+			got := entityStrings(r.Recognize(tt.in))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Recognize(%q)\n got  %v\n want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecognizerMatchesRecognize(t *testing.T) {
+	r, err := NewRecognizer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := "Əlaqə: +994501234567, info@example.az, FIN: 5ABCD12, VOEN 1234567890, 25 AZN, 5 mart 2026"
+	if got, want := entityStrings(r.Recognize(in)), entityStrings(Recognize(in)); !slices.Equal(got, want) {
+		t.Errorf("NewRecognizer().Recognize = %v, want %v", got, want)
+	}
+}
+
+func TestRecognizerDeterministic(t *testing.T) {
+	in := strings.Repeat("VÖEN: 0501234567, https://example.az/0551234567 5 mart 2026 manat. ", 50)
+	for _, p := range []OverlapPolicy{LeftmostLongest, Priority, AllMatches} {
+		r, err := NewRecognizer(WithOverlapPolicy(p))
+		if err != nil {
+			t.Fatal(err)
+		}
+		first := entityStrings(r.Recognize(in))
+		for range 5 {
+			if got := entityStrings(r.Recognize(in)); !slices.Equal(got, first) {
+				t.Fatalf("%v: results differ between calls", p)
+			}
+		}
+	}
+}
+
+func TestNewRecognizerErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{"unknown policy", WithOverlapPolicy(OverlapPolicy(9))},
+		{"unknown type", WithPriority(EntityType(99))},
+		{"repeated type", WithPriority(Phone, VOEN, Phone)},
+		{"negative confidence", WithMinConfidence(-0.1)},
+		{"confidence above one", WithMinConfidence(1.5)},
+		{"NaN confidence", WithMinConfidence(math.NaN())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRecognizer(tt.opt); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestRecognizeConfidence(t *testing.T) {
+	tests := []struct {
+		in         string
+		typ        EntityType
+		confidence float64
+		source     string
+	}{
+		{"VÖEN: 1234567890", VOEN, confidenceLabeled, "regex:voen_labeled"},
+		{"FIN: 5ARPXK2", FIN, confidenceLabeled, "regex:fin_labeled"},
+		{"kod 5ARPXK2", FIN, confidenceBare, "regex:fin_bare"},
+		{"+994501234567", Phone, confidencePattern, "regex:phone_intl"},
+		{"050 123 45 67", Phone, confidencePattern, "regex:phone_local"},
+		{"AZ21NABZ00000000137010001944", IBAN, confidenceValidated, "validator:mod97"},
+		{"4169 7388 1234 5670", Card, confidenceValidated, "validator:luhn"},
+		{"25 AZN", Money, confidencePattern, "regex:money"},
+		{"1500 manata", Money, confidencePattern, "gazetteer:currency"},
+		{"15 faiz", Percent, confidencePattern, "regex:percent"},
+		{"5 mart 2026", Date, confidencePattern, "datetime"},
+		{"Ünvan: Nizami küçəsi 25", Address, confidenceLabeled, "regex:address"},
+		{"Nizami küçəsi 25", Address, confidencePattern, "regex:address"},
+		{"Nizami küçəsi, Nəsimi rayonu", Address, confidenceStreet, "regex:address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := Recognize(tt.in)
+			if len(got) != 1 {
+				t.Fatalf("Recognize(%q) = %v, want one entity", tt.in, got)
+			}
+			e := got[0]
+			if e.Type != tt.typ || e.Confidence != tt.confidence || e.Source != tt.source {
+				t.Errorf("Recognize(%q) = %v %v %q, want %v %v %q", tt.in, e.Type, e.Confidence, e.Source, tt.typ, tt.confidence, tt.source)
+			}
+		})
+	}
+}
+
+func TestWithMinConfidence(t *testing.T) {
+	in := "VÖEN: 1234567890, hesab 9876543210, kod 5ARPXK2"
+	tests := []struct {
+		min  float64
+		want []string
+	}{
+		{0, []string{`VOEN("1234567890")[7:17,labeled]`, `VOEN("9876543210")[25:35]`, `FIN("5ARPXK2")[41:48]`}},
+		{defaultMinConfidence, []string{`VOEN("1234567890")[7:17,labeled]`, `FIN("5ARPXK2")[41:48]`}},
+		{confidenceLabeled, []string{`VOEN("1234567890")[7:17,labeled]`}},
+		{1, []string{}},
+	}
+	for _, tt := range tests {
+		r, err := NewRecognizer(WithMinConfidence(tt.min))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := entityStrings(r.Recognize(in)); !slices.Equal(got, tt.want) {
+			t.Errorf("min %v: got %v, want %v", tt.min, got, tt.want)
+		}
+	}
+}
+
+func TestWithMinConfidenceBareVOENYieldsToPhone(t *testing.T) {
+	r, err := NewRecognizer(WithMinConfidence(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := entityStrings(r.Recognize("zəng: 0501234567"))
+	if want := []string{`Phone("0501234567")[7:17]`}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestOverlapPolicyString(t *testing.T) {
+	for p, want := range map[OverlapPolicy]string{
+		LeftmostLongest:   "LeftmostLongest",
+		Priority:          "Priority",
+		AllMatches:        "AllMatches",
+		OverlapPolicy(42): "OverlapPolicy(42)",
+	} {
+		if got := p.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func BenchmarkRecognizerPriority(b *testing.B) {
+	r, err := NewRecognizer(WithOverlapPolicy(Priority))
+	if err != nil {
+		b.Fatal(err)
+	}
+	in := strings.Repeat("VÖEN: 0501234567, https://example.az/0551234567 5 mart 2026 manat. ", 100)
+	b.SetBytes(int64(len(in)))
+	for b.Loop() {
+		r.Recognize(in)
+	}
+}
+
+func ExampleWithMinConfidence() {
+	r, _ := NewRecognizer(WithMinConfidence(0))
+	for _, e := range r.Recognize("VÖEN: 1234567890, hesab 9876543210") {
+		fmt.Println(e, e.Confidence, e.Source)
+	}
+	// Output:
+	// VOEN("1234567890")[7:17,labeled] 0.95 regex:voen_labeled
+	// VOEN("9876543210")[25:35] 0.3 regex:voen_bare
+}
+
+func ExampleNewRecognizer() {
+	r, _ := NewRecognizer(WithOverlapPolicy(AllMatches))
+	for _, e := range r.Recognize("VÖEN: 0501234567") {
+		fmt.Println(e)
+	}
+
+	r, _ = NewRecognizer(WithOverlapPolicy(Priority))
+	fmt.Println(r.Recognize("VÖEN: 0501234567"))
+	// Output:
+	// Phone("0501234567")[7:17]
+	// VOEN("0501234567")[7:17,labeled]
+	// [Phone("0501234567")[7:17]]
+}
@@ -0,0 +1,47 @@
+package normalize
+
+import (
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// AlignedToken is a token of normalized text together with the span of
+// the original text it was produced from.
+type AlignedToken struct {
+	tokenizer.Token     // Text, Start and End refer to the normalized text
+	OrigStart       int `json:"orig_start"` // Byte offset in the original text (inclusive)
+	OrigEnd         int `json:"orig_end"`   // Byte offset in the original text (exclusive)
+}
+
+// Align maps tokens of the normalized text back to the original text
+// with OriginalRange. A token covering part of a replaced span is widened
+// to the whole source of the replacement, so a highlight drawn over
+// original[OrigStart:OrigEnd] never cuts a character in half.
+// Returns nil for nil tokens.
+func (m OffsetMap) Align(tokens []tokenizer.Token) []AlignedToken {
+	if tokens == nil {
+		return nil
+	}
+	out := make([]AlignedToken, len(tokens))
+	for i, tok := range tokens {
+		start, end := m.OriginalRange(tok.Start, tok.End)
+		out[i] = AlignedToken{Token: tok, OrigStart: start, OrigEnd: end}
+	}
+	return out
+}
+
+// WordTokens normalizes s and splits the result with
+// tokenizer.WordTokens, returning every token with its span in s:
+// "Gozel   seher" yields the token "Gözəl" at [0:7] of the normalized
+// text and [0:5] of s.
+// Returns nil for empty input.
+func (n *Normalizer) WordTokens(s string) []AlignedToken {
+	out, m := n.NormalizeWithMap(s)
+	return m.Align(tokenizer.WordTokens(out))
+}
+
+// SentenceTokens is like WordTokens but splits the normalized text with
+// tokenizer.SentenceTokens.
+func (n *Normalizer) SentenceTokens(s string) []AlignedToken {
+	out, m := n.NormalizeWithMap(s)
+	return m.Align(tokenizer.SentenceTokens(out))
+}
@@ -0,0 +1,100 @@
+package normalize
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+func TestNormalizerWordTokens(t *testing.T) {
+	t.Parallel()
+
+	n := New(WithHomoglyphs(true), WithPunctuation(true), WithWhitespace(true))
+	in := "«Gozel»   dovlet —  Bаkı göz."
+	want := []struct{ norm, orig string }{
+		{`"`, "«"},
+		{"Gözəl", "Gozel"},
+		{`"`, "»"},
+		{" ", "   "},
+		{"dövlət", "dovlet"},
+		{" ", " "},
+		{"-", "—"},
+		{" ", "  "},
+		{"Bakı", "Bаkı"},
+		{" ", " "},
+		{"göz", "göz"},
+		{".", "."},
+	}
+	got := n.WordTokens(in)
+	if len(got) != len(want) {
+		t.Fatalf("WordTokens(%q) returned %d tokens, want %d: %+v", in, len(got), len(want), got)
+	}
+	prevEnd := 0
+	for i, tok := range got {
+		if tok.Text != want[i].norm {
+			t.Errorf("[%d] Text = %q, want %q", i, tok.Text, want[i].norm)
+		}
+		if orig := in[tok.OrigStart:tok.OrigEnd]; orig != want[i].orig {
+			t.Errorf("[%d] %q: original = %q, want %q", i, tok.Text, orig, want[i].orig)
+		}
+		// Original spans tile the input like the tokens tile the output.
+		if tok.OrigStart != prevEnd {
+			t.Errorf("[%d] %q: OrigStart = %d, want %d", i, tok.Text, tok.OrigStart, prevEnd)
+		}
+		prevEnd = tok.OrigEnd
+	}
+	if prevEnd != len(in) {
+		t.Errorf("last OrigEnd = %d, want %d", prevEnd, len(in))
+	}
+}
+
+func TestNormalizerSentenceTokens(t *testing.T) {
+	t.Parallel()
+
+	in := "Gozel   dovlet. Bakı boyuk."
+	got := New(WithWhitespace(true)).SentenceTokens(in)
+	want := []struct{ norm, orig string }{
+		{"Gözəl dövlət.", "Gozel   dovlet."},
+		{" Bakı böyük.", " Bakı boyuk."},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("SentenceTokens(%q) = %+v, want %d sentences", in, got, len(want))
+	}
+	for i, tok := range got {
+		if tok.Text != want[i].norm || in[tok.OrigStart:tok.OrigEnd] != want[i].orig {
+			t.Errorf("[%d] = %q from %q, want %q from %q",
+				i, tok.Text, in[tok.OrigStart:tok.OrigEnd], want[i].norm, want[i].orig)
+		}
+	}
+}
+
+func TestOffsetMapAlign(t *testing.T) {
+	t.Parallel()
+
+	var m OffsetMap
+	if got := m.Align(nil); got != nil {
+		t.Errorf("Align(nil) = %v, want nil", got)
+	}
+	tokens := tokenizer.WordTokens("kitab ev")
+	for _, tok := range m.Align(tokens) {
+		if tok.OrigStart != tok.Start || tok.OrigEnd != tok.End {
+			t.Errorf("identity map: %+v", tok)
+		}
+	}
+	if got := New().WordTokens(""); got != nil {
+		t.Errorf("WordTokens(\"\") = %v, want nil", got)
+	}
+}
+
+func ExampleNormalizer_WordTokens() {
+	in := "Gozel   seher"
+	for _, tok := range New(WithWhitespace(true)).WordTokens(in) {
+		fmt.Printf("%q [%d:%d] ← %q [%d:%d]\n", tok.Text, tok.Start, tok.End,
+			in[tok.OrigStart:tok.OrigEnd], tok.OrigStart, tok.OrigEnd)
+	}
+	// Output:
+	// "Gözəl" [0:7] ← "Gozel" [0:5]
+	// " " [7:8] ← "   " [5:8]
+	// "seher" [8:13] ← "seher" [8:13]
+}
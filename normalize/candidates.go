@@ -0,0 +1,135 @@
+package normalize
+
+import (
+	"bytes"
+	"cmp"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// Candidate is a possible diacritic restoration of a word.
+type Candidate struct {
+	Word      string  `json:"word"`      // restored word, in the case of the input
+	Frequency int64   `json:"frequency"` // corpus frequency of the lowercase form, 0 if unseen
+	Score     float64 `json:"score"`     // share of the smoothed frequency among all candidates, 0.0-1.0
+}
+
+// Restoration lists the candidates for one word of a text.
+type Restoration struct {
+	Word       string      `json:"word"`       // word as it appears in the text
+	Start      int         `json:"start"`      // byte offset in the text (inclusive)
+	End        int         `json:"end"`        // byte offset in the text (exclusive)
+	Candidates []Candidate `json:"candidates"` // ranked by descending Score
+}
+
+// wordFreq returns the corpus word frequencies from the embedded spell
+// frequency list. It is loaded on first use so that callers of Normalize
+// alone do not pay for it.
+var wordFreq = sync.OnceValue(func() map[string]int64 {
+	lines := bytes.Split(data.SpellFreq, []byte("\n"))
+	m := make(map[string]int64, len(lines))
+	for _, line := range lines {
+		sp := bytes.LastIndexByte(line, ' ')
+		if sp <= 0 {
+			continue
+		}
+		freq, err := strconv.ParseInt(string(line[sp+1:]), 10, 64)
+		if err != nil || freq < 0 {
+			continue
+		}
+		m[string(line[:sp])] = freq
+	}
+	return m
+})
+
+// WordCandidates returns the readings of an ASCII-typed word with and
+// without each possible diacritic ("sac" → saç, sac), ranked by how often
+// they occur in the embedded corpus frequency list. A reading is a
+// candidate when it occurs in the corpus or is a dictionary stem; the
+// word as typed is included when it qualifies.
+//
+// Score is each candidate's frequency plus one divided by the sum over all
+// candidates, so scores add up to 1.0 and a low top score marks a
+// restoration that downstream consumers may want to defer. Unlike
+// NormalizeWord, which only rewrites unambiguous stems, this also covers
+// inflected forms ("seherde" → şəhərdə).
+//
+// Returns nil for words that already contain diacritics, have no
+// substitutable letters or more than 10 of them, or have no candidate.
+// Hyphenated and apostrophe words are not split.
+func WordCandidates(word string) []Candidate {
+	if word == "" {
+		return nil
+	}
+	word = azcase.ComposeNFC(word)
+	if len(word) > maxWordBytes || containsDiacritics(word) {
+		return nil
+	}
+
+	runes := toLowerRunes(word)
+	var positions []int
+	for i, r := range runes {
+		if hasDiacriticAlt(r) {
+			positions = append(positions, i)
+		}
+	}
+	if len(positions) == 0 || len(positions) > maxSubstitutablePositions {
+		return nil
+	}
+
+	freqs := wordFreq()
+	var cands []Candidate
+	var total float64
+	candidate := make([]rune, len(runes))
+	for mask := range 1 << len(positions) {
+		copy(candidate, runes)
+		for bit, pos := range positions {
+			if mask&(1<<bit) != 0 {
+				candidate[pos] = asciiToDiacritic[runes[pos]]
+			}
+		}
+		lower := string(candidate)
+		freq, seen := freqs[lower]
+		if !seen && !morph.IsKnownStem(lower) {
+			continue
+		}
+		cands = append(cands, Candidate{Word: restoreCase(word, candidate), Frequency: freq})
+		total += float64(freq + 1)
+	}
+
+	for i := range cands {
+		cands[i].Score = float64(cands[i].Frequency+1) / total
+	}
+	slices.SortStableFunc(cands, func(a, b Candidate) int {
+		return cmp.Compare(b.Frequency, a.Frequency)
+	})
+	return cands
+}
+
+// Restorations returns the words of s whose best WordCandidates reading
+// adds diacritics, with all their candidates. Words already in their most
+// frequent form ("Bu", not "Bü") are omitted. Offsets refer to s. Returns
+// nil for empty or oversized (>1 MiB) input.
+func Restorations(s string) []Restoration {
+	if s == "" || len(s) > maxInputBytes {
+		return nil
+	}
+	var out []Restoration
+	for _, tok := range tokenizer.WordTokens(s) {
+		if tok.Type != tokenizer.Word {
+			continue
+		}
+		cands := WordCandidates(tok.Text)
+		if len(cands) == 0 || cands[0].Word == azcase.ComposeNFC(tok.Text) {
+			continue
+		}
+		out = append(out, Restoration{Word: tok.Text, Start: tok.Start, End: tok.End, Candidates: cands})
+	}
+	return out
+}
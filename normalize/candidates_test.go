@@ -0,0 +1,120 @@
+package normalize
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestWordCandidates(t *testing.T) {
+	tests := []struct {
+		word string
+		want []string // ranked candidate words
+	}{
+		{"sac", []string{"saç", "sac"}},
+		{"seher", []string{"şəhər", "səhər"}},
+		{"Seher", []string{"Şəhər", "Səhər"}},
+		{"seherde", []string{"şəhərdə"}}, // inflected form, not a stem
+		{"gozel", []string{"gözəl"}},
+		{"ac", []string{"aç", "ac"}},
+		{"gözəl", nil}, // already has diacritics
+		{"kitab", nil}, // nothing to substitute
+		{"", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got := WordCandidates(tt.word)
+			var words []string
+			var sum float64
+			for i, c := range got {
+				words = append(words, c.Word)
+				sum += c.Score
+				if i > 0 && c.Score > got[i-1].Score {
+					t.Errorf("candidates not ranked: %+v", got)
+				}
+			}
+			if fmt.Sprint(words) != fmt.Sprint(tt.want) {
+				t.Errorf("WordCandidates(%q) = %v, want %v", tt.word, words, tt.want)
+			}
+			if len(got) > 0 && math.Abs(sum-1) > 1e-9 {
+				t.Errorf("scores sum to %v, want 1", sum)
+			}
+		})
+	}
+}
+
+func TestWordCandidatesAgreesWithNormalizeWord(t *testing.T) {
+	// A word NormalizeWord restores has that restoration as a candidate.
+	for _, w := range []string{"gozel", "Azerbaycan", "olke", "cox"} {
+		restored := NormalizeWord(w)
+		found := false
+		for _, c := range WordCandidates(w) {
+			found = found || c.Word == restored
+		}
+		if !found {
+			t.Errorf("WordCandidates(%q) lacks NormalizeWord result %q", w, restored)
+		}
+	}
+}
+
+func TestRestorations(t *testing.T) {
+	s := "Bu gun seher cox gozel idi"
+	got := Restorations(s)
+	want := []struct {
+		word, best string
+	}{
+		{"gun", "gün"},
+		{"seher", "şəhər"},
+		{"cox", "çox"},
+		{"gozel", "gözəl"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d restorations %+v, want %d", len(got), got, len(want))
+	}
+	for i, w := range want {
+		r := got[i]
+		if r.Word != w.word || r.Candidates[0].Word != w.best {
+			t.Errorf("[%d] = %q → %q, want %q → %q", i, r.Word, r.Candidates[0].Word, w.word, w.best)
+		}
+		if s[r.Start:r.End] != r.Word {
+			t.Errorf("[%d] s[%d:%d] = %q, want %q", i, r.Start, r.End, s[r.Start:r.End], r.Word)
+		}
+	}
+
+	if got := Restorations(""); got != nil {
+		t.Errorf("empty: got %v, want nil", got)
+	}
+	if got := Restorations(strings.Repeat("a", maxInputBytes+1)); got != nil {
+		t.Errorf("oversized: got %d restorations, want nil", len(got))
+	}
+}
+
+func BenchmarkWordCandidates(b *testing.B) {
+	for b.Loop() {
+		WordCandidates("seherde")
+	}
+}
+
+func ExampleWordCandidates() {
+	for _, c := range WordCandidates("sac") {
+		fmt.Printf("%s %.2f\n", c.Word, c.Score)
+	}
+	// Output:
+	// saç 0.95
+	// sac 0.05
+}
+
+func ExampleRestorations() {
+	for _, r := range Restorations("seher gozel idi") {
+		best := r.Candidates[0]
+		if best.Score < 0.99 {
+			fmt.Printf("%s: defer (%s %.2f)\n", r.Word, best.Word, best.Score)
+			continue
+		}
+		fmt.Printf("%s → %s\n", r.Word, best.Word)
+	}
+	// Output:
+	// seher: defer (şəhər 0.96)
+	// gozel → gözəl
+}
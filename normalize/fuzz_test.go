@@ -65,3 +65,52 @@ func FuzzNormalizeWord(f *testing.F) {
 		}
 	})
 }
+
+func FuzzWordCandidates(f *testing.F) {
+	f.Add("sac")
+	f.Add("Seher")
+	f.Add("gözəl")
+	f.Add("\xff")
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		var sum float64
+		for _, c := range WordCandidates(s) {
+			if c.Score <= 0 || c.Score > 1 {
+				t.Errorf("WordCandidates(%q): score %v out of range", s, c.Score)
+			}
+			sum += c.Score
+		}
+		if sum != 0 && (sum < 0.999 || sum > 1.001) {
+			t.Errorf("WordCandidates(%q): scores sum to %v", s, sum)
+		}
+	})
+}
+
+func FuzzNormalizerNumbers(f *testing.F) {
+	f.Add("3.5 kg")
+	f.Add("1 000 000 manat")
+	f.Add("1,234,567.89")
+	f.Add("21.03.2026 14:30")
+	f.Add("+994 50 123 45 67")
+	f.Add("80 kv.m, 90 km/h")
+	f.Add("iki yüz əlli")
+	f.Add("15% və 2026-cı")
+	f.Add("\xff1.5")
+	f.Add("")
+
+	n := New(WithNumbers(true), WithUnits(true))
+	f.Fuzz(func(t *testing.T, s string) {
+		out, m := n.NormalizeWithMap(s)
+
+		// Idempotency.
+		if second := n.Normalize(out); second != out {
+			t.Errorf("not idempotent:\ninput:  %q\nfirst:  %q\nsecond: %q", s, out, second)
+		}
+
+		// The whole output maps back to the whole input.
+		if start, end := m.OriginalRange(0, len(out)); len(s) <= maxInputBytes && (start != 0 || end != len(s)) {
+			t.Errorf("OriginalRange(0, %d) = (%d, %d) for input of length %d", len(out), start, end, len(s))
+		}
+	})
+}
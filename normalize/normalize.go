@@ -10,6 +10,22 @@
 //   - Normalize processes full text: tokenizes, restores each word, reassembles.
 //   - NormalizeWord processes a single word.
 //
+// For more control, New builds a Normalizer from options that toggle NFC
+// composition, diacritic restoration, homoglyph fixing, quote and dash
+// unification, and whitespace collapsing. Its NormalizeWithMap method also
+// returns an OffsetMap from normalized byte offsets back to the original text.
+// Its WordTokens and SentenceTokens methods tokenize the normalized text
+// and return each token with its span in the original (see OffsetMap.Align).
+//
+// WithNumbers, WithUnits, and WithNumberForm canonicalize numerals: a
+// decimal comma and dot-separated thousands ("1 250 000.5" → "1.250.000,5"),
+// Azerbaijani unit spellings ("5 kg" → "5 kq", "80 m2" → "80 m²"), and
+// optionally digits or words throughout via numtext ("iki yüz əlli" ↔ "250").
+//
+// WordCandidates and Restorations report every possible restoration of a
+// word with a score from embedded corpus word frequencies, so callers can
+// defer low-confidence rewrites such as "sac" (saç or sac).
+//
 // Input must be Azerbaijani Latin in NFC form.
 //
 // All functions are safe for concurrent use by multiple goroutines.
@@ -20,6 +36,9 @@
 //   - Approximately 49 ambiguous ASCII forms are never restored (by design).
 //   - The i/ı distinction is not resolved (both are valid after Turkic lowering).
 //   - Full Unicode NFC normalization is not performed; input must already be NFC.
+//   - WithNumbers cannot tell a decimal from a section number ("bənd 3.5"
+//     becomes "3,5"), and reads a single ".ddd" group as thousands (3.141
+//     is 3141), as the tokenizer and numtext do.
 //   - Worst-case CPU cost is O(2^N) per word where N is the number of substitutable
 //     positions (capped at 10). Callers processing untrusted input should apply
 //     timeouts or rate limiting.
@@ -0,0 +1,429 @@
+package normalize
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/numtext"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const (
+	// minGroupedDigits is the shortest integer part grouped into thousands.
+	// Four-digit numbers stay ungrouped so years read naturally (2026, not
+	// 2.026).
+	minGroupedDigits = 5
+
+	// maxNumberDigits is the longest integer or fractional part rewritten;
+	// longer digit runs are identifiers and are left unchanged.
+	maxNumberDigits = 18
+
+	// maxNumberWords is the longest run of number words DigitForm contracts.
+	maxNumberWords = 12
+)
+
+// NumberForm selects whether a Normalizer rewrites numbers between digits
+// and words.
+type NumberForm int
+
+const (
+	// KeepForm leaves numbers in the form they are written.
+	KeepForm NumberForm = iota
+
+	// DigitForm contracts cardinal number words into digits:
+	// "iki yüz əlli" → "250".
+	DigitForm
+
+	// WordForm expands numbers written in digits into words with
+	// numtext.Verbalize: "3,5" → "üç tam onda beş".
+	WordForm
+)
+
+// WithNumbers toggles canonicalization of numerals to the Azerbaijani
+// convention: a comma as the decimal separator and dots between thousands
+// groups of numbers with five or more integer digits ("3.5" → "3,5",
+// "1 000 000" → "1.000.000", "1,234.5" → "1.234,5", "12345" → "12.345").
+// Disabled by default.
+func WithNumbers(on bool) Option {
+	return func(n *Normalizer) { n.numbers = on }
+}
+
+// WithUnits toggles normalization of unit abbreviations written after a
+// number to their Azerbaijani spelling ("5 kg" → "5 kq", "20 cm" → "20 sm",
+// "80 m2" and "80 kv.m" → "80 m²", "90 km/h" → "90 km/saat"). The spacing
+// between number and unit is kept. Disabled by default.
+func WithUnits(on bool) Option {
+	return func(n *Normalizer) { n.units = on }
+}
+
+// WithNumberForm sets whether numbers are contracted to digits, expanded
+// to words, or kept as written (KeepForm, the default). Numbers converted
+// to digits are written as WithNumbers formats them.
+func WithNumberForm(form NumberForm) Option {
+	return func(n *Normalizer) { n.form = form }
+}
+
+// unitSpellings maps unit abbreviations to their Azerbaijani spelling.
+// Canonical spellings map to themselves so that "5kq" is recognized as a
+// number and a unit rather than a code.
+var unitSpellings = map[string]string{
+	"kq": "kq", "kg": "kq", "Kg": "kq", "KG": "kq", "Kq": "kq", "KQ": "kq",
+	"q": "q", "g": "q", "gr": "q", "qr": "q",
+	"mq": "mq", "mg": "mq",
+	"km": "km", "Km": "km", "KM": "km",
+	"m": "m", "sm": "sm", "cm": "sm", "mm": "mm",
+	"m²": "m²", "m2": "m²", "m^2": "m²", "kv.m": "m²", "kv. m": "m²",
+	"km²": "km²", "km2": "km²", "km^2": "km²", "kv.km": "km²",
+	"sm²": "sm²", "sm2": "sm²", "cm2": "sm²", "cm²": "sm²",
+	"m³": "m³", "m3": "m³", "m^3": "m³", "kub.m": "m³",
+	"sm³": "sm³", "sm3": "sm³", "cm3": "sm³", "cm³": "sm³",
+	"l": "l", "lt": "l", "ml": "ml", "mL": "ml",
+	"km/saat": "km/saat", "km/h": "km/saat",
+	"°C": "°C", "ºC": "°C", "˚C": "°C", "° C": "°C",
+	"Vt": "Vt", "kVt": "kVt", "kW": "kVt", "KW": "kVt", "kw": "kVt", "MVt": "MVt", "MW": "MVt",
+}
+
+// unitVariants lists the keys of unitSpellings, longest first.
+var unitVariants = func() []string {
+	keys := make([]string, 0, len(unitSpellings))
+	for k := range unitSpellings {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		if d := len(b) - len(a); d != 0 {
+			return d
+		}
+		return strings.Compare(a, b)
+	})
+	return keys
+}()
+
+// ambiguousNumberWords are number words that are more often something
+// else ("bir" is also the indefinite article, "yüz" also means face);
+// DigitForm contracts them only as part of a longer number.
+var ambiguousNumberWords = map[string]bool{
+	"bir": true, "on": true, "altı": true, "yüz": true, "min": true,
+}
+
+// transformNumbers applies WithNumbers, WithUnits, and WordForm to every
+// number written in digits and records an edit for each rewritten span.
+func (n *Normalizer) transformNumbers(s string) (string, []edit) {
+	var (
+		b     strings.Builder
+		edits []edit
+		last  int
+	)
+	replace := func(start, end int, out string) {
+		if out == s[start:end] {
+			return
+		}
+		if len(edits) == 0 {
+			b.Grow(len(s))
+		}
+		b.WriteString(s[last:start])
+		edits = append(edits, edit{origStart: start, origEnd: end, newStart: b.Len(), newEnd: b.Len() + len(out)})
+		b.WriteString(out)
+		last = end
+	}
+
+	for i := 0; i < len(s); {
+		if !isDigitByte(s[i]) {
+			i++
+			continue
+		}
+		groups, seps, end := scanNumberRun(s, i)
+		if !numberStart(s, i) || !numberEnd(s, end) {
+			i = end
+			continue
+		}
+		unitStart, unitEnd, unit, hasUnit := matchUnit(s, end)
+		if !hasUnit {
+			if r, _ := utf8.DecodeRuneInString(s[end:]); unicode.IsLetter(r) {
+				// Letters joined to the digits: a code (5G, 4x4).
+				i = end
+				continue
+			}
+		}
+
+		canon, ok := canonicalNumber(groups, seps)
+		numEnd := end
+		switch {
+		case ok && n.form == WordForm:
+			if end < len(s) && s[end] == '%' {
+				numEnd++
+			} else if suffix, e := hyphenLetters(s, end); suffix {
+				numEnd = e
+			}
+			spoken := numtext.Verbalize(canon + s[end:numEnd])
+			if hasUnit && unitStart == end {
+				spoken += " "
+			}
+			replace(i, numEnd, spoken)
+		case ok && n.numbers:
+			replace(i, end, canon)
+		}
+		if hasUnit && n.units {
+			replace(unitStart, unitEnd, unit)
+		}
+		i = max(numEnd, unitEnd)
+	}
+	if len(edits) == 0 {
+		return s, nil
+	}
+	b.WriteString(s[last:])
+	return b.String(), edits
+}
+
+// scanNumberRun returns the digit groups starting at s[i] and the
+// separators between them: a dot or comma before any digit, or a space
+// before a group of exactly three digits ("1 000 000").
+func scanNumberRun(s string, i int) (groups, seps []string, end int) {
+	g, end := digitsAt(s, i)
+	groups = append(groups, g)
+	for end < len(s) {
+		sep := numberSeparator(s[end:])
+		if sep == "" {
+			break
+		}
+		g, e := digitsAt(s, end+len(sep))
+		if g == "" || sep != "." && sep != "," && len(g) != 3 {
+			break
+		}
+		groups, seps, end = append(groups, g), append(seps, sep), e
+	}
+	return groups, seps, end
+}
+
+// numberSeparator returns the separator at the start of s, or "": a dot,
+// comma, space, no-break space, or narrow no-break space.
+func numberSeparator(s string) string {
+	for _, sep := range []string{".", ",", " ", "\u00a0", "\u202f"} {
+		if strings.HasPrefix(s, sep) {
+			return sep
+		}
+	}
+	return ""
+}
+
+// canonicalNumber returns the canonical spelling of a run of digit
+// groups, or false when the run is not a single number: a date
+// (21.03.2026), a version (1.2.3), or a list (1,2,3).
+//
+// A comma between two groups is a decimal separator. A dot is one too,
+// except before a single group of exactly three digits, which is a
+// thousands group as the tokenizer and numtext read it (10.000). Longer
+// runs are thousands groups joined by one separator, optionally followed
+// by the other of comma and dot as the decimal separator ("1,234.5").
+func canonicalNumber(groups, seps []string) (string, bool) {
+	var intPart, frac string
+	switch {
+	case len(groups) == 1:
+		intPart = groups[0]
+	case len(groups) == 2 && (seps[0] == "," ||
+		seps[0] == "." && (len(groups[1]) != 3 || len(groups[0]) > 3 || groups[0][0] == '0')):
+		intPart, frac = groups[0], groups[1]
+	default:
+		k := len(groups)
+		t := seps[0]
+		if d := seps[k-2]; d != t {
+			if d != "." && d != "," {
+				return "", false
+			}
+			frac = groups[k-1]
+			k--
+		}
+		if t == "," && k < 3 && frac == "" || len(groups[0]) > 3 {
+			return "", false
+		}
+		for j := 1; j < k; j++ {
+			if seps[j-1] != t || len(groups[j]) != 3 {
+				return "", false
+			}
+		}
+		intPart = strings.Join(groups[:k], "")
+	}
+	if len(intPart) > maxNumberDigits || len(frac) > maxNumberDigits ||
+		len(intPart) > 1 && intPart[0] == '0' {
+		return "", false
+	}
+	if frac != "" {
+		return groupThousands(intPart) + "," + frac, true
+	}
+	return groupThousands(intPart), true
+}
+
+// groupThousands inserts a dot between the thousands groups of an integer
+// of at least minGroupedDigits digits.
+func groupThousands(d string) string {
+	if len(d) < minGroupedDigits {
+		return d
+	}
+	var b strings.Builder
+	b.Grow(len(d) + len(d)/3)
+	head := len(d) % 3
+	if head == 0 {
+		head = 3
+	}
+	b.WriteString(d[:head])
+	for j := head; j < len(d); j += 3 {
+		b.WriteByte('.')
+		b.WriteString(d[j : j+3])
+	}
+	return b.String()
+}
+
+// matchUnit finds a unit abbreviation after a number ending at s[i],
+// directly or after one space, and returns its span and spelling.
+func matchUnit(s string, i int) (start, end int, unit string, ok bool) {
+	start = i
+	if sep := numberSeparator(s[i:]); sep != "" && sep != "." && sep != "," {
+		start += len(sep)
+	}
+	for _, v := range unitVariants {
+		if strings.HasPrefix(s[start:], v) && !letterOrDigitAt(s, start+len(v)) {
+			return start, start + len(v), unitSpellings[v], true
+		}
+	}
+	return 0, 0, "", false
+}
+
+// numberStart reports whether a number may start at s[i]: not inside a
+// word, code, or phone number (A4, COVID-19, +994 50 123 45 67), and not
+// after a date or time separator (21/03, 14:30).
+func numberStart(s string, i int) bool {
+	prev, n := utf8.DecodeLastRuneInString(s[:i])
+	if n == 0 {
+		return true
+	}
+	before, _ := utf8.DecodeLastRuneInString(s[:i-n])
+	switch {
+	case prev == '+' || prev == '_':
+		return false
+	case prev == '-':
+		return !unicode.IsLetter(before)
+	case strings.ContainsRune(".,/:", prev):
+		return !unicode.IsLetter(before) && !unicode.IsDigit(before)
+	case unicode.IsSpace(prev):
+		return !unicode.IsDigit(before)
+	}
+	return !unicode.IsLetter(prev) && !unicode.IsDigit(prev)
+}
+
+// numberEnd reports whether a number may end at s[i]: not before a date
+// or time separator, or a space, followed by another digit.
+func numberEnd(s string, i int) bool {
+	if i >= len(s) {
+		return true
+	}
+	sep := numberSeparator(s[i:])
+	if sep == "" && (s[i] == '/' || s[i] == ':') {
+		sep = s[i : i+1]
+	}
+	return sep == "" || i+len(sep) >= len(s) || !isDigitByte(s[i+len(sep)])
+}
+
+// hyphenLetters reports whether a hyphenated suffix ("-da", "-ci")
+// starts at s[i], and returns its end.
+func hyphenLetters(s string, i int) (bool, int) {
+	if i >= len(s) || s[i] != '-' {
+		return false, i
+	}
+	j := i + 1
+	for j < len(s) {
+		r, n := utf8.DecodeRuneInString(s[j:])
+		if !unicode.IsLetter(r) {
+			break
+		}
+		j += n
+	}
+	return j > i+1, j
+}
+
+// letterOrDigitAt reports whether a letter or digit starts at s[i].
+func letterOrDigitAt(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// digitsAt returns the ASCII digits starting at s[i] and their end.
+func digitsAt(s string, i int) (string, int) {
+	j := i
+	for j < len(s) && isDigitByte(s[j]) {
+		j++
+	}
+	return s[i:j], j
+}
+
+// isDigitByte reports whether c is an ASCII digit.
+func isDigitByte(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// contractNumberWords replaces runs of cardinal number words with digits
+// (see DigitForm) and records an edit for each. A run is contracted only
+// when it is the spelling numtext.Convert gives its value, so "iki bir"
+// is not read as 3.
+func contractNumberWords(s string) (string, []edit) {
+	var (
+		b     strings.Builder
+		edits []edit
+		last  int
+	)
+	tokens := tokenizer.WordTokens(s)
+	for k := 0; k < len(tokens); k++ {
+		words := numberWordRun(tokens, k)
+		for len(words) > 0 {
+			text := strings.Join(words, " ")
+			if val, err := numtext.Parse(text); err == nil && numtext.Convert(val) == text &&
+				(len(words) > 1 || !ambiguousNumberWords[text]) {
+				break
+			}
+			words = words[:len(words)-1]
+		}
+		if len(words) == 0 {
+			continue
+		}
+
+		val, _ := numtext.Parse(strings.Join(words, " "))
+		digits := groupThousands(strconv.FormatInt(max(val, -val), 10))
+		if val < 0 {
+			digits = "-" + digits
+		}
+		end := k + 2*(len(words)-1)
+		start := tokens[k].Start
+		b.WriteString(s[last:start])
+		edits = append(edits, edit{origStart: start, origEnd: tokens[end].End, newStart: b.Len(), newEnd: b.Len() + len(digits)})
+		b.WriteString(digits)
+		last = tokens[end].End
+		k = end
+	}
+	if len(edits) == 0 {
+		return s, nil
+	}
+	b.WriteString(s[last:])
+	return b.String(), edits
+}
+
+// numberWordRun returns the lowercased number words starting at
+// tokens[k], each separated from the next by a space within a line.
+func numberWordRun(tokens []tokenizer.Token, k int) []string {
+	var words []string
+	for j := k; j < len(tokens) && len(words) < maxNumberWords; j += 2 {
+		if tokens[j].Type != tokenizer.Word {
+			break
+		}
+		w := azcase.ToLower(tokens[j].Text)
+		if _, err := numtext.Parse(w); err != nil && (j > k || w != "mənfi") {
+			break
+		}
+		words = append(words, w)
+		if j+1 >= len(tokens) || tokens[j+1].Type != tokenizer.Space || strings.ContainsAny(tokens[j+1].Text, "\n\r") {
+			break
+		}
+	}
+	return words
+}
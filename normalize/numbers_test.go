@@ -0,0 +1,261 @@
+package normalize
+
+import (
+	"fmt"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// WithNumbers
+// ---------------------------------------------------------------------------
+
+func TestNormalizerNumbers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// -- Decimal separator --
+		{"3.5 faiz", "3,5 faiz"},
+		{"3,5 faiz", "3,5 faiz"},
+		{"3.14", "3,14"},
+		{"0.500", "0,500"},
+		{"-2.5 dərəcə", "-2,5 dərəcə"},
+
+		// -- Thousands grouping --
+		{"12345 nəfər", "12.345 nəfər"},
+		{"1 000 000 manat", "1.000.000 manat"},
+		{"1\u00a0000\u00a0000 manat", "1.000.000 manat"},
+		{"1,000,000", "1.000.000"},
+		{"10.000", "10.000"},
+		{"1.500", "1500"},
+		{"1 500", "1500"},
+		{"1,234,567.89", "1.234.567,89"},
+		{"12345.6", "12.345,6"},
+		{"10000-20000", "10.000-20.000"},
+		{"10000-dən çox", "10.000-dən çox"},
+		{"2026-cı il", "2026-cı il"},
+
+		// -- Not a single number --
+		{"21.03.2026", "21.03.2026"},
+		{"1.2.3", "1.2.3"},
+		{"1,2,3", "1,2,3"},
+		{"14:30", "14:30"},
+		{"12345/67890", "12345/67890"},
+		{"+994 50 123 45 67", "+994 50 123 45 67"},
+		{"+994501234567", "+994501234567"},
+		{"050 123 45 67", "050 123 45 67"},
+		{"COVID-19", "COVID-19"},
+		{"5G şəbəkə", "5G şəbəkə"},
+		{"v1.2", "v1.2"},
+		{"00123", "00123"},
+		{"1234567890123456789", "1234567890123456789"},
+	}
+
+	n := New(WithDiacritics(false), WithNumbers(true))
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			if got := n.Normalize(tt.input); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// WithUnits
+// ---------------------------------------------------------------------------
+
+func TestNormalizerUnits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"5 kg un", "5 kq un"},
+		{"5kg", "5kq"},
+		{"5 KQ", "5 kq"},
+		{"20 cm", "20 sm"},
+		{"80 m2 mənzil", "80 m² mənzil"},
+		{"80 kv.m mənzil", "80 m² mənzil"},
+		{"80 kv. m", "80 m²"},
+		{"3 m3", "3 m³"},
+		{"90 km/h", "90 km/saat"},
+		{"25 ºC", "25 °C"},
+		{"5 kW", "5 kVt"},
+		{"2 lt su", "2 l su"},
+		{"5 kg-dan çox", "5 kq-dan çox"},
+		{"3.5 kg", "3.5 kq"},
+
+		// -- Not units --
+		{"5 mart", "5 mart"},
+		{"5 g", "5 q"},
+		{"kg", "kg"},
+		{"ən azı kg", "ən azı kg"},
+		{"5 mL", "5 ml"},
+		{"5 kq", "5 kq"},
+	}
+
+	n := New(WithDiacritics(false), WithUnits(true))
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			if got := n.Normalize(tt.input); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// WithNumberForm
+// ---------------------------------------------------------------------------
+
+func TestNormalizerDigitForm(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"iki yüz əlli manat", "250 manat"},
+		{"on beş gün", "15 gün"},
+		{"Beş alma", "5 alma"},
+		{"min doqquz yüz doxsan", "1990"},
+		{"on min nəfər", "10.000 nəfər"},
+		{"mənfi beş dərəcə", "-5 dərəcə"},
+		{"üç, dörd", "3, 4"},
+		{"iki\nüç", "2\n3"},
+
+		// -- Not canonical number text --
+		{"iki bir", "2 bir"},
+		{"bir kitab", "bir kitab"},
+		{"yüz", "yüz"},
+		{"min", "min"},
+		{"bir min", "bir min"},
+		{"beşinci", "beşinci"},
+		{"ikidə", "ikidə"},
+	}
+
+	n := New(WithNumberForm(DigitForm))
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			if got := n.Normalize(tt.input); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizerWordForm(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"3.5 kg", "üç tam onda beş kq"},
+		{"1 000 000 manat", "bir milyon manat"},
+		{"1,234.5", "min iki yüz otuz dörd tam onda beş"},
+		{"15% artım", "on beş faiz artım"},
+		{"2026-cı ildə", "iki min iyirmi altıncı ildə"},
+		{"10000-dən çox", "on mindən çox"},
+		{"5kg", "beş kq"},
+
+		// -- Left unchanged --
+		{"21.03.2026", "21.03.2026"},
+		{"14:30", "14:30"},
+		{"+994 50 123 45 67", "+994 50 123 45 67"},
+		{"COVID-19", "COVID-19"},
+	}
+
+	n := New(WithDiacritics(false), WithUnits(true), WithNumberForm(WordForm))
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			if got := n.Normalize(tt.input); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizerNumbersOffsets(t *testing.T) {
+	t.Parallel()
+
+	n := New(WithNumbers(true), WithUnits(true), WithNumberForm(DigitForm))
+	in := "on min nəfər 3.5 kg aldı, 12345 m2"
+	out, m := n.NormalizeWithMap(in)
+	if want := "10.000 nəfər 3,5 kq aldı, 12.345 m²"; out != want {
+		t.Fatalf("NormalizeWithMap(%q) = %q, want %q", in, out, want)
+	}
+
+	spans := []struct{ norm, orig string }{
+		{"10.000", "on min"},
+		{"nəfər", "nəfər"},
+		{"3,5", "3.5"},
+		{"kq", "kg"},
+		{"12.345", "12345"},
+		{"m²", "m2"},
+	}
+	for _, sp := range spans {
+		start := indexOf(out, sp.norm)
+		os, oe := m.OriginalRange(start, start+len(sp.norm))
+		if got := in[os:oe]; got != sp.orig {
+			t.Errorf("OriginalRange(%q) = in[%d:%d] = %q, want %q", sp.norm, os, oe, got, sp.orig)
+		}
+	}
+}
+
+func TestNormalizerNumbersIdempotent(t *testing.T) {
+	t.Parallel()
+
+	n := New(WithNumbers(true), WithUnits(true))
+	inputs := []string{
+		"1,234,567.89 kg",
+		"1.500 və 12345",
+		"3.5 m2, 21.03.2026",
+		"10 000,5",
+	}
+	for _, in := range inputs {
+		first := n.Normalize(in)
+		if second := n.Normalize(first); second != first {
+			t.Errorf("not idempotent: %q → %q → %q", in, first, second)
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Benchmarks
+// ---------------------------------------------------------------------------
+
+func BenchmarkNormalizerNumbers(b *testing.B) {
+	n := New(WithNumbers(true), WithUnits(true), WithNumberForm(DigitForm))
+	s := "Anbara iki yüz əlli kisə, hər biri 50 kg, cəmi 12500 kg un və 1 000 000 manat dəyərində 3.5 m3 yağ gəldi."
+	for b.Loop() {
+		n.Normalize(s)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Examples
+// ---------------------------------------------------------------------------
+
+func ExampleWithNumbers() {
+	n := New(WithNumbers(true), WithUnits(true))
+	fmt.Println(n.Normalize("Qiymət 1 250 000 manat, sahə 80 kv.m, çəki 3.5 kg"))
+	// Output:
+	// Qiymət 1.250.000 manat, sahə 80 m², çəki 3,5 kq
+}
+
+func ExampleWithNumberForm() {
+	fmt.Println(New(WithNumberForm(DigitForm)).Normalize("iki yüz əlli manat"))
+	fmt.Println(New(WithNumberForm(WordForm)).Normalize("15% artım"))
+	// Output:
+	// 250 manat
+	// on beş faiz artım
+}
@@ -0,0 +1,336 @@
+package normalize
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// Normalizer applies a configurable set of text normalizations.
+// Create one with New; the zero value applies no transformation.
+// A Normalizer is immutable and safe for concurrent use.
+type Normalizer struct {
+	nfc         bool
+	diacritics  bool
+	homoglyphs  bool
+	punctuation bool
+	whitespace  bool
+	numbers     bool
+	units       bool
+	form        NumberForm
+}
+
+// Option toggles a normalization step of a Normalizer.
+type Option func(*Normalizer)
+
+// WithNFC toggles composition of decomposed Azerbaijani letters
+// (o + U+0308 → ö, see azcase.ComposeNFC). Enabled by default.
+func WithNFC(on bool) Option {
+	return func(n *Normalizer) { n.nfc = on }
+}
+
+// WithDiacritics toggles dictionary-based diacritic restoration
+// (gozel → gözəl), as done by Normalize. Enabled by default.
+func WithDiacritics(on bool) Option {
+	return func(n *Normalizer) { n.diacritics = on }
+}
+
+// WithHomoglyphs toggles replacement of look-alike letters from the wrong
+// script inside a word (Cyrillic а in "Bаkı" → Latin a). The target script
+// is the one most letters of the word are written in; words with a tie
+// are left unchanged. Disabled by default.
+func WithHomoglyphs(on bool) Option {
+	return func(n *Normalizer) { n.homoglyphs = on }
+}
+
+// WithPunctuation toggles unification of typographic quotes to ASCII '"',
+// apostrophe variants (’ ʼ) to "'", and dashes (– — ‒ ― −) to '-'.
+// Disabled by default.
+func WithPunctuation(on bool) Option {
+	return func(n *Normalizer) { n.punctuation = on }
+}
+
+// WithWhitespace toggles collapsing of whitespace runs: a run containing a
+// blank line becomes "\n\n", a run containing one line break becomes "\n",
+// and any other run becomes a single space. Disabled by default.
+func WithWhitespace(on bool) Option {
+	return func(n *Normalizer) { n.whitespace = on }
+}
+
+// New returns a Normalizer configured by opts. Without options it behaves
+// like Normalize: NFC composition and diacritic restoration only.
+func New(opts ...Option) *Normalizer {
+	n := &Normalizer{nfc: true, diacritics: true}
+	for _, opt := range opts {
+		opt(n)
+	}
+	return n
+}
+
+// Normalize applies the configured normalizations to s.
+// Returns the input unchanged for empty or oversized (>1 MiB) input.
+func (n *Normalizer) Normalize(s string) string {
+	out, _ := n.NormalizeWithMap(s)
+	return out
+}
+
+// NormalizeWithMap applies the configured normalizations to s and returns
+// an OffsetMap from byte offsets in the result back to byte offsets in s.
+// Returns the input unchanged with an identity map for empty or oversized
+// (>1 MiB) input.
+func (n *Normalizer) NormalizeWithMap(s string) (string, OffsetMap) {
+	if s == "" || len(s) > maxInputBytes {
+		return s, OffsetMap{}
+	}
+
+	var m OffsetMap
+	if n.nfc {
+		var edits []edit
+		s, edits = composeWithEdits(s)
+		m.push(edits)
+	}
+	if n.diacritics || n.homoglyphs || n.punctuation || n.whitespace {
+		var edits []edit
+		s, edits = n.transformTokens(s)
+		m.push(edits)
+	}
+	if n.form == DigitForm {
+		var edits []edit
+		s, edits = contractNumberWords(s)
+		m.push(edits)
+	}
+	if n.numbers || n.units || n.form == WordForm {
+		var edits []edit
+		s, edits = n.transformNumbers(s)
+		m.push(edits)
+	}
+	return s, m
+}
+
+// transformTokens applies the token-level steps and records an edit for
+// every token whose text changed.
+func (n *Normalizer) transformTokens(s string) (string, []edit) {
+	var (
+		b     strings.Builder
+		edits []edit
+	)
+	b.Grow(len(s))
+	for _, tok := range tokenizer.WordTokens(s) {
+		out := tok.Text
+		switch tok.Type {
+		case tokenizer.Word:
+			if n.homoglyphs {
+				out = fixHomoglyphs(out)
+			}
+			if n.diacritics {
+				out = restoreWordToken(out)
+			}
+			if n.punctuation {
+				out = apostropheReplacer.Replace(out)
+			}
+		case tokenizer.Space:
+			if n.whitespace {
+				out = collapseSpace(out)
+			}
+		case tokenizer.Punctuation, tokenizer.Symbol:
+			if n.punctuation {
+				out = punctReplacer.Replace(out)
+			}
+		}
+		if out != tok.Text {
+			edits = append(edits, edit{
+				origStart: tok.Start,
+				origEnd:   tok.End,
+				newStart:  b.Len(),
+				newEnd:    b.Len() + len(out),
+			})
+		}
+		b.WriteString(out)
+	}
+	return b.String(), edits
+}
+
+// nfdPairs maps an ASCII base letter and a combining mark to the
+// precomposed Azerbaijani letter, mirroring azcase.ComposeNFC.
+var nfdPairs = map[string]string{
+	"o\u0308": "ö", "u\u0308": "ü", "c\u0327": "ç", "s\u0327": "ş", "g\u0306": "ğ",
+	"O\u0308": "Ö", "U\u0308": "Ü", "C\u0327": "Ç", "S\u0327": "Ş", "G\u0306": "Ğ",
+	"I\u0307": "İ",
+}
+
+// nfdPairLen is the byte length of an NFD pair: an ASCII letter followed
+// by a two-byte combining mark.
+const nfdPairLen = 3
+
+// composeWithEdits composes decomposed Azerbaijani letters like
+// azcase.ComposeNFC, recording one edit per composed pair.
+func composeWithEdits(s string) (string, []edit) {
+	if azcase.ComposeNFC(s) == s {
+		return s, nil
+	}
+
+	var (
+		b     strings.Builder
+		edits []edit
+	)
+	b.Grow(len(s))
+	last := 0
+	for i := 0; i+nfdPairLen <= len(s); i++ {
+		c, ok := nfdPairs[s[i:i+nfdPairLen]]
+		if !ok {
+			continue
+		}
+		b.WriteString(s[last:i])
+		edits = append(edits, edit{origStart: i, origEnd: i + nfdPairLen, newStart: b.Len(), newEnd: b.Len() + len(c)})
+		b.WriteString(c)
+		last = i + nfdPairLen
+		i = last - 1
+	}
+	b.WriteString(s[last:])
+	return b.String(), edits
+}
+
+// ── Offset mapping ────────────────────────────────────────────────────
+
+// edit records that s[origStart:origEnd] was replaced by
+// out[newStart:newEnd].
+type edit struct {
+	origStart, origEnd int
+	newStart, newEnd   int
+}
+
+// OffsetMap maps byte offsets in normalized text back to the text it was
+// produced from. The zero value is the identity map.
+type OffsetMap struct {
+	stages [][]edit // edit lists in application order, each sorted by newStart
+}
+
+// push appends a stage; empty stages are dropped.
+func (m *OffsetMap) push(edits []edit) {
+	if len(edits) > 0 {
+		m.stages = append(m.stages, edits)
+	}
+}
+
+// Original maps byte offset pos in the normalized text to the matching
+// offset in the original text. Offsets inside a replaced span map to the
+// start of the original span; the offset at the end of a replacement maps
+// to the end of the original span.
+func (m OffsetMap) Original(pos int) int {
+	for i := len(m.stages) - 1; i >= 0; i-- {
+		pos = mapBack(pos, m.stages[i])
+	}
+	return pos
+}
+
+// OriginalRange maps the normalized span [start, end) to the original
+// span that produced it, widened to whole replaced spans so that a span
+// covering part of a replacement covers all of its source.
+func (m OffsetMap) OriginalRange(start, end int) (int, int) {
+	for i := len(m.stages) - 1; i >= 0; i-- {
+		start = mapBack(start, m.stages[i])
+		end = mapBackEnd(end, m.stages[i])
+	}
+	return start, end
+}
+
+// mapBack maps pos through one stage; positions inside a replacement map
+// to its original start.
+func mapBack(pos int, edits []edit) int {
+	// Last edit starting at or before pos.
+	i := sort.Search(len(edits), func(i int) bool { return edits[i].newStart > pos }) - 1
+	if i < 0 {
+		return pos
+	}
+	e := edits[i]
+	if pos >= e.newEnd {
+		return pos - e.newEnd + e.origEnd
+	}
+	return e.origStart
+}
+
+// mapBackEnd is like mapBack but maps positions strictly inside a
+// replacement to its original end.
+func mapBackEnd(pos int, edits []edit) int {
+	i := sort.Search(len(edits), func(i int) bool { return edits[i].newStart >= pos }) - 1
+	if i < 0 {
+		return pos
+	}
+	e := edits[i]
+	if pos >= e.newEnd {
+		return pos - e.newEnd + e.origEnd
+	}
+	return e.origEnd
+}
+
+// ── Token transforms ──────────────────────────────────────────────────
+
+// punctReplacer unifies typographic quotes and dashes.
+var punctReplacer = strings.NewReplacer(
+	"“", `"`, "”", `"`, "„", `"`, "«", `"`, "»", `"`, // “ ” „ « »
+	"‘", "'", "’", "'", "‚", "'", "‹", "'", "›", "'", // ‘ ’ ‚ ‹ ›
+	"–", "-", "—", "-", "‒", "-", "―", "-", "−", "-", // – — ‒ ― −
+)
+
+// apostropheReplacer unifies apostrophe variants inside words.
+var apostropheReplacer = strings.NewReplacer("’", "'", "ʼ", "'")
+
+// collapseSpace collapses a whitespace run (see WithWhitespace).
+func collapseSpace(s string) string {
+	switch n := strings.Count(s, "\n"); {
+	case n >= 2:
+		return "\n\n"
+	case n == 1:
+		return "\n"
+	case strings.Contains(s, "\r"):
+		return "\n"
+	default:
+		return " "
+	}
+}
+
+// cyrToLat and latToCyr map homoglyph letters between scripts.
+var (
+	cyrToLat = map[rune]rune{
+		'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'х': 'x', 'у': 'y',
+		'А': 'A', 'Е': 'E', 'О': 'O', 'Р': 'P', 'С': 'C', 'Х': 'X', 'У': 'Y',
+		'і': 'i', 'І': 'I', 'ј': 'j', 'Ј': 'J',
+	}
+	latToCyr = map[rune]rune{
+		'a': 'а', 'e': 'е', 'o': 'о', 'p': 'р', 'c': 'с', 'x': 'х', 'y': 'у',
+		'A': 'А', 'E': 'Е', 'O': 'О', 'P': 'Р', 'C': 'С', 'X': 'Х', 'Y': 'У',
+		'i': 'і', 'I': 'І', 'j': 'ј', 'J': 'Ј',
+	}
+)
+
+// fixHomoglyphs replaces homoglyphs from the minority script of word with
+// their equivalents in the majority script.
+func fixHomoglyphs(word string) string {
+	var latin, cyrillic int
+	for _, r := range word {
+		switch {
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		}
+	}
+	var table map[rune]rune
+	switch {
+	case latin > cyrillic && cyrillic > 0:
+		table = cyrToLat
+	case cyrillic > latin && latin > 0:
+		table = latToCyr
+	default:
+		return word
+	}
+	return strings.Map(func(r rune) rune {
+		if to, ok := table[r]; ok {
+			return to
+		}
+		return r
+	}, word)
+}
@@ -0,0 +1,205 @@
+package normalize
+
+import (
+	"fmt"
+	"testing"
+)
+
+// ---------------------------------------------------------------------------
+// Normalizer — option toggles
+// ---------------------------------------------------------------------------
+
+func TestNormalizerDefaultMatchesNormalize(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		"",
+		"Azerbaycan gozel olkedir.",
+		"Bakı şəhəri, 2024-cü il.",
+		"Gözel söz",
+		"Bakı'da yaşayıram — dovlet isinde",
+		"seher  \n\n  server",
+	}
+	n := New()
+	for _, in := range inputs {
+		if got, want := n.Normalize(in), Normalize(in); got != want {
+			t.Errorf("New().Normalize(%q) = %q, Normalize = %q", in, got, want)
+		}
+	}
+}
+
+func TestNormalizerOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		opts  []Option
+		input string
+		want  string
+	}{
+		{"defaults restore diacritics", nil, "gozel soz", "gözəl söz"},
+		{"diacritics off", []Option{WithDiacritics(false)}, "gozel soz", "gozel soz"},
+		{"nfc only", []Option{WithDiacritics(false)}, "gözel", "gözel"},
+		{"nfc off", []Option{WithNFC(false), WithDiacritics(false)}, "gözel", "gözel"},
+
+		{"homoglyph cyrillic in latin", []Option{WithHomoglyphs(true)}, "Bаkı", "Bakı"},
+		{"homoglyph latin in cyrillic", []Option{WithHomoglyphs(true)}, "мaса", "маса"},
+		{"homoglyph tie unchanged", []Option{WithHomoglyphs(true), WithDiacritics(false)}, "aа", "aа"},
+		{"homoglyph then diacritics", []Option{WithHomoglyphs(true)}, "gоzel", "gözəl"},
+		{"homoglyphs off", nil, "Bаkı", "Bаkı"},
+
+		{"guillemets", []Option{WithPunctuation(true)}, "«Bakı»", `"Bakı"`},
+		{"curly quotes", []Option{WithPunctuation(true)}, "“Bakı” ‘ev’", `"Bakı" 'ev'`},
+		{"dashes", []Option{WithPunctuation(true)}, "Bakı — Gəncə – Şəki", "Bakı - Gəncə - Şəki"},
+		{"apostrophe in word", []Option{WithPunctuation(true)}, "Bakı’da", "Bakı'da"},
+		{"punctuation off", nil, "«Bakı» — ev", "«Bakı» — ev"},
+
+		{"collapse spaces", []Option{WithWhitespace(true)}, "Bakı   \t gözəl", "Bakı gözəl"},
+		{"collapse line break", []Option{WithWhitespace(true)}, "Bakı \n  gözəl", "Bakı\ngözəl"},
+		{"collapse paragraph", []Option{WithWhitespace(true)}, "Bakı\n \n\n gözəl", "Bakı\n\ngözəl"},
+		{"whitespace off", nil, "Bakı   gözəl", "Bakı   gözəl"},
+
+		{"all on", []Option{WithHomoglyphs(true), WithPunctuation(true), WithWhitespace(true)},
+			"«Bаkı»  —  gozel", `"Bakı" - gözəl`},
+		{"zero config", []Option{WithNFC(false), WithDiacritics(false)}, "gozel  «ev»", "gozel  «ev»"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := New(tt.opts...).Normalize(tt.input)
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizerZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var n Normalizer
+	in := "gozel̈  «ev»"
+	if got := n.Normalize(in); got != in {
+		t.Errorf("zero Normalizer changed %q to %q", in, got)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// OffsetMap
+// ---------------------------------------------------------------------------
+
+func TestNormalizeWithMap(t *testing.T) {
+	t.Parallel()
+
+	n := New(WithHomoglyphs(true), WithPunctuation(true), WithWhitespace(true))
+	in := "«Gozel»   dovlet —  Bаkı go\u0308z"
+	out, m := n.NormalizeWithMap(in)
+	if want := `"Gözəl" dövlət - Bakı göz`; out != want {
+		t.Fatalf("NormalizeWithMap(%q) = %q, want %q", in, out, want)
+	}
+
+	// Every word of the output maps back to the word it came from.
+	words := []struct{ norm, orig string }{
+		{"Gözəl", "Gozel"},
+		{"dövlət", "dovlet"},
+		{"Bakı", "Bаkı"},
+		{"göz", "go\u0308z"},
+	}
+	for _, w := range words {
+		start := indexOf(out, w.norm)
+		os, oe := m.OriginalRange(start, start+len(w.norm))
+		if got := in[os:oe]; got != w.orig {
+			t.Errorf("OriginalRange(%q) = in[%d:%d] = %q, want %q", w.norm, os, oe, got, w.orig)
+		}
+	}
+
+	// Boundaries map to boundaries.
+	if got := m.Original(0); got != 0 {
+		t.Errorf("Original(0) = %d, want 0", got)
+	}
+	if got := m.Original(len(out)); got != len(in) {
+		t.Errorf("Original(len(out)) = %d, want %d", got, len(in))
+	}
+}
+
+func TestOffsetMapMonotonic(t *testing.T) {
+	t.Parallel()
+
+	n := New(WithHomoglyphs(true), WithPunctuation(true), WithWhitespace(true))
+	inputs := []string{
+		"Azerbaycan gozel olkedir.",
+		"üç  «şeher»\n\n\n — dovlet",
+		"Bakı’da   “Bаkı”",
+	}
+	for _, in := range inputs {
+		out, m := n.NormalizeWithMap(in)
+		prev := 0
+		for pos := 0; pos <= len(out); pos++ {
+			got := m.Original(pos)
+			if got < prev || got > len(in) {
+				t.Fatalf("%q: Original(%d) = %d, previous %d, len %d", in, pos, got, prev, len(in))
+			}
+			prev = got
+		}
+	}
+}
+
+func TestOffsetMapIdentity(t *testing.T) {
+	t.Parallel()
+
+	var m OffsetMap
+	for _, pos := range []int{0, 5, 100} {
+		if got := m.Original(pos); got != pos {
+			t.Errorf("zero OffsetMap Original(%d) = %d", pos, got)
+		}
+	}
+
+	_, m = New().NormalizeWithMap("kitab ev")
+	if s, e := m.OriginalRange(2, 6); s != 2 || e != 6 {
+		t.Errorf("unchanged text OriginalRange(2, 6) = (%d, %d)", s, e)
+	}
+}
+
+func indexOf(s, sub string) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if s[i:i+len(sub)] == sub {
+			return i
+		}
+	}
+	return -1
+}
+
+// ---------------------------------------------------------------------------
+// Benchmarks
+// ---------------------------------------------------------------------------
+
+func BenchmarkNormalizerAll(b *testing.B) {
+	n := New(WithHomoglyphs(true), WithPunctuation(true), WithWhitespace(true))
+	s := "«Azerbaycan»  gozel olkedir — Bаkı   seheri cox boyukdur."
+	for b.Loop() {
+		n.NormalizeWithMap(s)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Examples
+// ---------------------------------------------------------------------------
+
+func ExampleNew() {
+	n := New(WithPunctuation(true), WithWhitespace(true))
+	fmt.Println(n.Normalize("«Azerbaycan»   gozel — olkedir"))
+	// Output:
+	// "Azərbaycan" gözəl - olkedir
+}
+
+func ExampleNormalizer_NormalizeWithMap() {
+	in := "Gozel   seher"
+	out, m := New(WithWhitespace(true)).NormalizeWithMap(in)
+	start, end := m.OriginalRange(len("Gözəl "), len(out))
+	fmt.Println(out)
+	fmt.Println(in[start:end])
+	// Output:
+	// Gözəl seher
+	// seher
+}
@@ -0,0 +1,333 @@
+// Fraction and currency conversion, and parsing of decimal, fraction,
+// and currency text.
+package numtext
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+const (
+	wordHalf     = "yarım"
+	halfValue    = 0.5
+	minorPerUnit = 100 // minor units (qəpik, sent) per major unit
+)
+
+// currency holds the Azerbaijani names of a currency's units.
+type currency struct {
+	major string // major unit (manat)
+	minor string // minor unit (qəpik)
+}
+
+// currencies lists the supported currencies by ISO 4217 code.
+var currencies = map[string]currency{
+	"AZN": {major: "manat", minor: "qəpik"},
+	"USD": {major: "dollar", minor: "sent"},
+	"EUR": {major: "avro", minor: "sent"},
+	"RUB": {major: "rubl", minor: "qəpik"},
+	"TRY": {major: "lirə", minor: "quruş"},
+	"GBP": {major: "funt", minor: "pens"},
+}
+
+// majorUnits and minorUnits map unit words back to currency codes. Minor
+// unit words shared by several currencies map to the first listed here.
+var (
+	majorUnits = map[string]string{
+		"manat": "AZN", "dollar": "USD", "avro": "EUR", "rubl": "RUB", "lirə": "TRY", "funt": "GBP",
+	}
+	minorUnits = map[string]string{
+		"qəpik": "AZN", "sent": "USD", "quruş": "TRY", "pens": "GBP",
+	}
+)
+
+// convertFraction converts num/den to text: the denominator in the
+// locative case followed by the numerator ("dörddə üç").
+func convertFraction(num, den int64) string {
+	if den <= 0 || num > maxAbs || num < -maxAbs || den > maxAbs {
+		return ""
+	}
+	negative := num < 0
+	if negative {
+		num = -num
+	}
+	denText := convert(den)
+	text := denText + locativeSuffix(denText) + " " + convert(num)
+	if negative {
+		return wordNegative + " " + text
+	}
+	return text
+}
+
+// convertCurrency converts an amount of money to text, rounding to the
+// nearest minor unit.
+func convertCurrency(amount float64, code string) string {
+	cur, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok || math.IsNaN(amount) || math.Abs(amount) > float64(maxAbs/minorPerUnit) {
+		return ""
+	}
+	minorTotal := int64(math.Round(amount * minorPerUnit))
+	negative := minorTotal < 0
+	if negative {
+		minorTotal = -minorTotal
+	}
+	major, minor := minorTotal/minorPerUnit, minorTotal%minorPerUnit
+
+	var b strings.Builder
+	b.Grow(growFloat)
+	if negative {
+		b.WriteString(wordNegative)
+		b.WriteByte(' ')
+	}
+	if major > 0 || minor == 0 {
+		b.WriteString(convert(major))
+		b.WriteByte(' ')
+		b.WriteString(cur.major)
+	}
+	if minor > 0 {
+		if major > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(convert(minor))
+		b.WriteByte(' ')
+		b.WriteString(cur.minor)
+	}
+	return b.String()
+}
+
+// parseFloat parses cardinal, decimal ("üç tam yüzdə on dörd",
+// "üç vergül bir dörd"), fraction ("dörddə üç"), and half ("iki yarım")
+// text.
+func parseFloat(s string) (float64, error) {
+	tokens := strings.Fields(azcase.ToLower(strings.TrimSpace(s)))
+	if len(tokens) == 0 {
+		return 0, fmt.Errorf("numtext: empty input")
+	}
+
+	sign := 1.0
+	if tokens[0] == wordNegative {
+		sign = -1
+		tokens = tokens[1:]
+		if len(tokens) == 0 {
+			return 0, fmt.Errorf("numtext: empty input after %q", wordNegative)
+		}
+	}
+
+	v, err := parseUnsignedFloat(tokens)
+	if err != nil {
+		return 0, err
+	}
+	return sign * v, nil
+}
+
+// parseUnsignedFloat parses lowercase tokens without a sign.
+func parseUnsignedFloat(tokens []string) (float64, error) {
+	// "iki yarım" = 2.5; a lone "yarım" = 0.5.
+	if tokens[len(tokens)-1] == wordHalf {
+		whole, err := parseWhole(tokens[:len(tokens)-1])
+		if err != nil {
+			return 0, err
+		}
+		return float64(whole) + halfValue, nil
+	}
+
+	// Digit mode: "<whole> vergül d d d".
+	for i, tok := range tokens {
+		if tok != wordComma {
+			continue
+		}
+		whole, err := parseWhole(tokens[:i])
+		if err != nil {
+			return 0, err
+		}
+		digits, err := parseDigits(tokens[i+1:])
+		if err != nil {
+			return 0, err
+		}
+		return strconv.ParseFloat(strconv.FormatInt(whole, 10)+"."+digits, 64)
+	}
+
+	// Math mode "<whole> tam <fraction>" or a bare fraction.
+	var whole int64
+	rest := tokens
+	for i, tok := range tokens {
+		if tok != wordExact {
+			continue
+		}
+		w, err := parseWhole(tokens[:i])
+		if err != nil {
+			return 0, err
+		}
+		whole, rest = w, tokens[i+1:]
+		if len(rest) == 0 {
+			return 0, fmt.Errorf("numtext: missing fraction after %q", wordExact)
+		}
+		break
+	}
+
+	num, den, ok, err := parseFraction(rest)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		if len(rest) != len(tokens) {
+			return 0, fmt.Errorf("numtext: missing denominator after %q", wordExact)
+		}
+		n, err := parse(strings.Join(tokens, " "))
+		return float64(n), err
+	}
+	if whole != 0 || len(rest) != len(tokens) {
+		return decimalValue(whole, num, den)
+	}
+	return float64(num) / float64(den), nil
+}
+
+// parseWhole parses the integer part before "tam", "vergül" or "yarım";
+// an empty part is zero.
+func parseWhole(tokens []string) (int64, error) {
+	if len(tokens) == 0 {
+		return 0, nil
+	}
+	return parse(strings.Join(tokens, " "))
+}
+
+// parseDigits parses digit words ("bir dörd") into a digit string ("14").
+func parseDigits(tokens []string) (string, error) {
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("numtext: missing digits after %q", wordComma)
+	}
+	var b strings.Builder
+	for _, tok := range tokens {
+		d := digitValue(tok)
+		if d < 0 {
+			return "", fmt.Errorf("numtext: %q is not a digit", tok)
+		}
+		b.WriteByte(byte('0' + d))
+	}
+	return b.String(), nil
+}
+
+// digitValue returns the value of a single-digit word, or -1.
+func digitValue(word string) int {
+	for d, w := range ones {
+		if w == word {
+			return d
+		}
+	}
+	return -1
+}
+
+// parseFraction parses "<denominator>-da/də <numerator>". ok is false when
+// no token carries a locative denominator suffix.
+func parseFraction(tokens []string) (num, den int64, ok bool, err error) {
+	for i, tok := range tokens {
+		stem, found := strings.CutSuffix(tok, "da")
+		if !found {
+			stem, found = strings.CutSuffix(tok, "də")
+		}
+		if !found || stem == "" {
+			continue
+		}
+		if _, known := wordValues[stem]; !known {
+			continue
+		}
+		denWords := append(append([]string{}, tokens[:i]...), stem)
+		if den, err = parse(strings.Join(denWords, " ")); err != nil {
+			return 0, 0, false, err
+		}
+		if den == 0 {
+			return 0, 0, false, fmt.Errorf("numtext: zero denominator")
+		}
+		if i+1 == len(tokens) {
+			return 0, 0, false, fmt.Errorf("numtext: missing numerator after %q", tok)
+		}
+		if num, err = parse(strings.Join(tokens[i+1:], " ")); err != nil {
+			return 0, 0, false, err
+		}
+		return num, den, true, nil
+	}
+	return 0, 0, false, nil
+}
+
+// decimalValue returns whole + num/den, exactly rounded when den is a
+// power of ten.
+func decimalValue(whole, num, den int64) (float64, error) {
+	for exp, p := range powersOf10 {
+		if p != den || exp == 0 {
+			continue
+		}
+		frac := strconv.FormatInt(num, 10)
+		if len(frac) > exp {
+			break
+		}
+		frac = strings.Repeat("0", exp-len(frac)) + frac
+		return strconv.ParseFloat(strconv.FormatInt(whole, 10)+"."+frac, 64)
+	}
+	return float64(whole) + float64(num)/float64(den), nil
+}
+
+// parseCurrency parses "<n> manat <m> qəpik" style amounts.
+func parseCurrency(s string) (float64, string, error) {
+	tokens := strings.Fields(azcase.ToLower(strings.TrimSpace(s)))
+	if len(tokens) == 0 {
+		return 0, "", fmt.Errorf("numtext: empty input")
+	}
+
+	sign := 1.0
+	if tokens[0] == wordNegative {
+		sign = -1
+		tokens = tokens[1:]
+	}
+
+	var (
+		code         string
+		major, minor int64
+		start        int
+		seenUnit     bool
+		seenMinor    bool
+	)
+	for i, tok := range tokens {
+		c, isMajor := majorUnits[tok]
+		mc, isMinor := minorUnits[tok]
+		if !isMajor && !isMinor {
+			continue
+		}
+		if i == start {
+			return 0, "", fmt.Errorf("numtext: missing amount before %q", tok)
+		}
+		n, err := parse(strings.Join(tokens[start:i], " "))
+		if err != nil {
+			return 0, "", err
+		}
+		switch {
+		case isMajor && !seenUnit:
+			code, major = c, n
+		case isMinor && !seenMinor && (code == "" || currencies[code].minor == tok):
+			if code == "" {
+				code = mc
+			}
+			if n >= minorPerUnit {
+				return 0, "", fmt.Errorf("numtext: %d %s exceeds one %s", n, tok, currencies[code].major)
+			}
+			minor, seenMinor = n, true
+		default:
+			return 0, "", fmt.Errorf("numtext: unexpected unit %q", tok)
+		}
+		seenUnit = true
+		start = i + 1
+	}
+	if !seenUnit {
+		return 0, "", fmt.Errorf("numtext: no currency unit in %q", s)
+	}
+	if start != len(tokens) {
+		return 0, "", fmt.Errorf("numtext: unexpected words after amount: %q", strings.Join(tokens[start:], " "))
+	}
+	v, err := decimalValue(major, minor, minorPerUnit)
+	if err != nil {
+		return 0, "", err
+	}
+	return sign * v, code, nil
+}
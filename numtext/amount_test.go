@@ -0,0 +1,240 @@
+package numtext
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestConvertFraction(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		num, den int64
+		want     string
+	}{
+		{"three quarters", 3, 4, "dörddə üç"},
+		{"half", 1, 2, "ikidə bir"},
+		{"back vowel denominator", 5, 6, "altıda beş"},
+		{"tenth", 1, 10, "onda bir"},
+		{"hundredths", 7, 100, "yüzdə yeddi"},
+		{"improper", 5, 2, "ikidə beş"},
+		{"zero numerator", 0, 3, "üçdə sıfır"},
+		{"negative", -2, 3, "mənfi üçdə iki"},
+		{"zero denominator", 1, 0, ""},
+		{"negative denominator", 1, -2, ""},
+		{"out of range", 1, maxAbs + 1, ""},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ConvertFraction(tt.num, tt.den)
+			if got != tt.want {
+				t.Errorf("ConvertFraction(%d, %d) = %q, want %q", tt.num, tt.den, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertCurrency(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		amount float64
+		code   string
+		want   string
+	}{
+		{"whole manat", 1250, "AZN", "min iki yüz əlli manat"},
+		{"manat and qəpik", 12.5, "AZN", "on iki manat əlli qəpik"},
+		{"qəpik only", 0.05, "AZN", "beş qəpik"},
+		{"zero", 0, "AZN", "sıfır manat"},
+		{"dollar", 99.99, "USD", "doxsan doqquz dollar doxsan doqquz sent"},
+		{"euro", 1, "EUR", "bir avro"},
+		{"ruble", 2.01, "RUB", "iki rubl bir qəpik"},
+		{"lira", 3.07, "TRY", "üç lirə yeddi quruş"},
+		{"pound", 10, "GBP", "on funt"},
+		{"lowercase code", 5, "azn", "beş manat"},
+		{"negative", -3.5, "AZN", "mənfi üç manat əlli qəpik"},
+		{"rounds to minor unit", 0.1 + 0.2, "AZN", "otuz qəpik"},
+		{"rounds up to major unit", 1.999, "AZN", "iki manat"},
+		{"unknown code", 5, "XYZ", ""},
+		{"NaN", math.NaN(), "AZN", ""},
+		{"infinity", math.Inf(1), "AZN", ""},
+		{"too large", 1e17, "AZN", ""},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ConvertCurrency(tt.amount, tt.code)
+			if got != tt.want {
+				t.Errorf("ConvertCurrency(%v, %q) = %q, want %q", tt.amount, tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{"math mode", "üç tam yüzdə on dörd", 3.14, false},
+		{"digit mode", "üç vergül bir dörd", 3.14, false},
+		{"leading zero digits", "sıfır vergül sıfır beş", 0.05, false},
+		{"fraction", "dörddə üç", 0.75, false},
+		{"improper fraction", "ikidə beş", 2.5, false},
+		{"whole and fraction", "iki tam dörddə üç", 2.75, false},
+		{"composed denominator", "üç tam on mində bir", 3.0001, false},
+		{"half", "iki yarım", 2.5, false},
+		{"lone half", "yarım", 0.5, false},
+		{"negative", "mənfi iki tam onda beş", -2.5, false},
+		{"cardinal", "yüz iyirmi üç", 123, false},
+		{"case insensitive", "Üç Tam Onda Bir", 3.1, false},
+		{"empty", "", 0, true},
+		{"only negative", "mənfi", 0, true},
+		{"missing fraction", "üç tam", 0, true},
+		{"missing denominator", "üç tam beş", 0, true},
+		{"missing numerator", "dörddə", 0, true},
+		{"non-digit after comma", "üç vergül on", 0, true},
+		{"missing digits", "üç vergül", 0, true},
+		{"zero denominator", "sıfırda bir", 0, true},
+		{"unknown word", "üç tam yüzdə salam", 0, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseFloat(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFloat(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseFloat(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		want     float64
+		wantCode string
+		wantErr  bool
+	}{
+		{"manat", "min iki yüz əlli manat", 1250, "AZN", false},
+		{"manat and qəpik", "on iki manat əlli qəpik", 12.5, "AZN", false},
+		{"qəpik only", "əlli qəpik", 0.5, "AZN", false},
+		{"euro and cent", "beş avro on sent", 5.1, "EUR", false},
+		{"cent only", "beş sent", 0.05, "USD", false},
+		{"ruble and kopeck", "iki rubl bir qəpik", 2.01, "RUB", false},
+		{"negative", "mənfi üç manat", -3, "AZN", false},
+		{"empty", "", 0, "", true},
+		{"no unit", "beş", 0, "", true},
+		{"missing amount", "manat", 0, "", true},
+		{"minor overflow", "yüz sent", 0, "", true},
+		{"duplicate minor", "beş manat on qəpik beş qəpik", 0, "", true},
+		{"mismatched minor", "beş manat on sent", 0, "", true},
+		{"trailing words", "beş manat on", 0, "", true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, code, err := ParseCurrency(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCurrency(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && (got != tt.want || code != tt.wantCode) {
+				t.Errorf("ParseCurrency(%q) = %v, %q, want %v, %q", tt.input, got, code, tt.want, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAmountRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"3.14", "0.5", "12.007", "-2.25"} {
+		for _, mode := range []Mode{MathMode, DigitMode} {
+			text := ConvertFloat(s, mode)
+			got, err := ParseFloat(text)
+			if err != nil {
+				t.Errorf("ParseFloat(%q): %v", text, err)
+				continue
+			}
+			if fmt.Sprint(got) != fmt.Sprint(mustFloat(s)) {
+				t.Errorf("ParseFloat(ConvertFloat(%q)) = %v (text %q)", s, got, text)
+			}
+		}
+	}
+
+	for _, amount := range []float64{0.01, 1, 12.5, 1250, 99.99} {
+		for code := range currencies {
+			text := ConvertCurrency(amount, code)
+			got, gotCode, err := ParseCurrency(text)
+			if err != nil {
+				t.Errorf("ParseCurrency(%q): %v", text, err)
+				continue
+			}
+			// Shared minor unit words resolve to the first currency.
+			if got != amount || (gotCode != code && amount >= 1) {
+				t.Errorf("ParseCurrency(%q) = %v, %q, want %v, %q", text, got, gotCode, amount, code)
+			}
+		}
+	}
+}
+
+func mustFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
+
+func ExampleConvertFraction() {
+	fmt.Println(ConvertFraction(3, 4))
+	// Output: dörddə üç
+}
+
+func ExampleConvertCurrency() {
+	fmt.Println(ConvertCurrency(1250, "AZN"))
+	fmt.Println(ConvertCurrency(12.5, "USD"))
+	// Output:
+	// min iki yüz əlli manat
+	// on iki dollar əlli sent
+}
+
+func ExampleParseFloat() {
+	v, _ := ParseFloat("üç tam yüzdə on dörd")
+	fmt.Println(v)
+	// Output: 3.14
+}
+
+func ExampleParseCurrency() {
+	v, code, _ := ParseCurrency("on iki manat əlli qəpik")
+	fmt.Println(v, code)
+	// Output: 12.5 AZN
+}
+
+func BenchmarkConvertCurrency(b *testing.B) {
+	for b.Loop() {
+		ConvertCurrency(1250.75, "AZN")
+	}
+}
+
+func BenchmarkParseFloat(b *testing.B) {
+	for b.Loop() {
+		ParseFloat("üç tam yüzdə on dörd")
+	}
+}
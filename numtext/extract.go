@@ -0,0 +1,236 @@
+// Extraction of spelled-out numbers from running text.
+package numtext
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+const maxExtractBytes = 1 << 20 // 1 MiB
+
+// Span is a spelled-out number found by Extract.
+type Span struct {
+	Text    string `json:"text"`              // The matched substring
+	Start   int    `json:"start"`             // Byte offset in the original string (inclusive)
+	End     int    `json:"end"`               // Byte offset in the original string (exclusive)
+	Value   int64  `json:"value"`             // The number, negative after "mənfi"
+	Ordinal bool   `json:"ordinal,omitempty"` // The last word is an ordinal ("üçüncü")
+}
+
+// extractEndings are the case and possessive endings the last word of a
+// number may carry in running text, longest first.
+var extractEndings = []string{
+	"yadək", "yədək", "adək", "ədək",
+	"nın", "nin", "nun", "nün", "dan", "dən", "tan", "tən",
+	"ın", "in", "un", "ün", "nı", "ni", "nu", "nü", "sı", "si", "su", "sü",
+	"da", "də", "ta", "tə", "ya", "yə",
+	"a", "ə", "ı", "i", "u", "ü",
+}
+
+// ambiguousWords are the words that read as a number only inside a
+// longer number: the article bir, pronoun forms of o (ona, onu, onun,
+// onda, ondan), and birdən (suddenly) and mini.
+var ambiguousWords = map[string]bool{
+	"bir": true, "ona": true, "onu": true, "onun": true, "onda": true,
+	"ondan": true, "birdən": true, "mini": true,
+}
+
+// ordinalWords maps the ordinal of each number word to the word
+// ("üçüncü" → "üç").
+var ordinalWords = func() map[string]string {
+	m := make(map[string]string, len(wordValues))
+	for w := range wordValues {
+		last, _ := utf8.DecodeLastRuneInString(w)
+		if isVowel(last) {
+			m[w+ordinalShortSuffix(lastVowel(w))] = w
+		} else {
+			m[w+ordinalFullSuffix(lastVowel(w))] = w
+		}
+	}
+	return m
+}()
+
+// Extract returns the spelled-out numbers in text with their byte offsets
+// and values, in order: "tədbirdə yüz iyirmi üç nəfər iştirak etdi" gives
+// one Span, "yüz iyirmi üç" with Value 123.
+//
+// A number is a run of number words separated by spaces, in the order of
+// a cardinal: hundreds, tens, and ones within each group, and magnitude
+// words (min, milyon, ...) in decreasing order, so "iki üç gün" is two
+// numbers and "min iki yüz" one. It may start with "mənfi", and its last
+// word may be an ordinal ("yüz iyirmi üçüncü") or carry a case or
+// possessive ending ("beşdən", "ikisi"), which the span includes. Numbers
+// written in digits are not matched, and neither are a lone "bir" (the
+// article "a") and the pronoun forms of o that spell a number (ona, onu,
+// onun, onda, ondan).
+//
+// Returns nil for empty or oversized (>1 MiB) input, or when text holds
+// no number.
+func Extract(text string) []Span {
+	if text == "" || len(text) > maxExtractBytes {
+		return nil
+	}
+	words := letterWords(text)
+
+	var spans []Span
+	for i := 0; i < len(words); {
+		s, n, ok := readSpan(text, words, i)
+		if n == 0 {
+			i++
+			continue
+		}
+		if ok {
+			spans = append(spans, s)
+		}
+		i += n
+	}
+	return spans
+}
+
+// word is a run of letters in the text passed to Extract.
+type word struct {
+	lower      string
+	start, end int
+}
+
+// letterWords returns the runs of letters in text, lowercased.
+func letterWords(text string) []word {
+	var words []word
+	for i := 0; i < len(text); {
+		r, n := utf8.DecodeRuneInString(text[i:])
+		if !unicode.IsLetter(r) {
+			i += n
+			continue
+		}
+		w, end := letterRun(text, i)
+		words = append(words, word{lower: azcase.ToLower(w), start: i, end: end})
+		i = end
+	}
+	return words
+}
+
+// readSpan reads the number starting at words[i]. It returns the span, the
+// number of words it covers, and whether the words form a number that is
+// reported; n is 0 when words[i] does not start a number.
+func readSpan(text string, words []word, i int) (Span, int, bool) {
+	j, sign := i, int64(1)
+	if words[j].lower == wordNegative && j+1 < len(words) && spaced(text, words[j], words[j+1]) {
+		j, sign = j+1, -1
+	}
+
+	var (
+		c       compound
+		bases   []string
+		ordinal bool
+		k       = j
+	)
+	for ; k < len(words); k++ {
+		if k > j && !spaced(text, words[k-1], words[k]) {
+			break
+		}
+		base, ord, final, ok := numberWord(words[k].lower)
+		if !ok || !c.accept(wordValues[base]) {
+			break
+		}
+		bases = append(bases, base)
+		if final {
+			ordinal = ord
+			k++
+			break
+		}
+	}
+	if len(bases) == 0 {
+		return Span{}, 0, false
+	}
+	n := k - i
+	if n == 1 && ambiguousWords[words[i].lower] {
+		return Span{}, n, false
+	}
+
+	v, err := parse(strings.Join(bases, " "))
+	if err != nil {
+		return Span{}, n, false
+	}
+	start, end := words[i].start, words[k-1].end
+	return Span{Text: text[start:end], Start: start, End: end, Value: sign * v, Ordinal: ordinal}, n, true
+}
+
+// spaced reports whether only whitespace separates words a and b.
+func spaced(text string, a, b word) bool {
+	return a.end < b.start && strings.TrimSpace(text[a.end:b.start]) == ""
+}
+
+// numberWord returns the number word w stands for: w itself, the word of
+// an ordinal, or w without a case or possessive ending. final reports
+// that w ends the number, being an ordinal or carrying an ending.
+func numberWord(w string) (base string, ordinal, final, ok bool) {
+	if _, ok := wordValues[w]; ok {
+		return w, false, false, true
+	}
+	if base, ok := ordinalWords[w]; ok {
+		return base, true, true, true
+	}
+	for _, e := range extractEndings {
+		stem, ok := strings.CutSuffix(w, e)
+		if !ok {
+			continue
+		}
+		if _, ok := wordValues[stem]; ok {
+			return stem, false, true, true
+		}
+		if base, ok := ordinalWords[stem]; ok {
+			return base, true, true, true
+		}
+	}
+	return "", false, false, false
+}
+
+// compound checks that number words follow the order of a cardinal:
+// hundreds, tens, and ones within each three-digit group, and magnitude
+// words in decreasing order. sıfır stands alone.
+type compound struct {
+	hundreds, tens, ones bool
+	magnitude            int64 // last magnitude word, 0 before the first
+	n                    int   // words accepted
+	closed               bool  // after sıfır
+}
+
+// accept reports whether a word of value v may follow the words accepted
+// so far, and records it.
+func (c *compound) accept(v int64) bool {
+	switch {
+	case c.closed:
+		return false
+	case v == 0:
+		if c.n > 0 {
+			return false
+		}
+		c.closed = true
+	case v < 10: //nolint:mnd // ones
+		if c.ones {
+			return false
+		}
+		c.ones = true
+	case v < hundred:
+		if c.tens || c.ones {
+			return false
+		}
+		c.tens = true
+	case v == hundred:
+		if c.hundreds || c.tens {
+			return false
+		}
+		c.hundreds, c.ones = true, false
+	default:
+		if c.magnitude != 0 && v >= c.magnitude {
+			return false
+		}
+		c.magnitude = v
+		c.hundreds, c.tens, c.ones = false, false, false
+	}
+	c.n++
+	return true
+}
@@ -0,0 +1,128 @@
+package numtext
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		input string
+		want  []Span
+	}{
+		{"in sentence", "Tədbirdə yüz iyirmi üç nəfər iştirak etdi.", []Span{
+			{Text: "yüz iyirmi üç", Start: 11, End: 27, Value: 123},
+		}},
+		{"magnitudes", "Büdcə iki milyon üç yüz min manatdır", []Span{
+			{Text: "iki milyon üç yüz min", Start: 8, End: 32, Value: 2_300_000},
+		}},
+		{"capitalized", "Min doqquz yüz doxsan birinci ildə", []Span{
+			{Text: "Min doqquz yüz doxsan birinci", Start: 0, End: 30, Value: 1991, Ordinal: true},
+		}},
+		{"negative", "Temperatur mənfi beş dərəcəyə düşdü.", []Span{
+			{Text: "mənfi beş", Start: 11, End: 22, Value: -5},
+		}},
+		{"case endings", "Qırx beşdən əlliyədək", []Span{
+			{Text: "Qırx beşdən", Start: 0, End: 14, Value: 45},
+			{Text: "əlliyədək", Start: 15, End: 27, Value: 50},
+		}},
+		{"possessive", "İkisi gəldi, üçüncüsü gəlmədi.", []Span{
+			{Text: "İkisi", Start: 0, End: 6, Value: 2},
+			{Text: "üçüncüsü", Start: 15, End: 28, Value: 3, Ordinal: true},
+		}},
+		{"out of order", "iki üç gün", []Span{
+			{Text: "iki", Start: 0, End: 3, Value: 2},
+			{Text: "üç", Start: 4, End: 8, Value: 3},
+		}},
+		{"punctuation", "on, on bir", []Span{
+			{Text: "on", Start: 0, End: 2, Value: 10},
+			{Text: "on bir", Start: 4, End: 10, Value: 11},
+		}},
+		{"dash", "üç-dörd nəfər", []Span{
+			{Text: "üç", Start: 0, End: 4, Value: 3},
+			{Text: "dörd", Start: 5, End: 10, Value: 4},
+		}},
+		{"zero", "sıfır bir", []Span{
+			{Text: "sıfır", Start: 0, End: 7, Value: 0},
+		}},
+		{"article and pronouns", "Bir kitabı ona verdim, ondan sonra onun dostu birdən gəldi.", nil},
+		{"bir in number", "Bir kitab otuz bir manatdır", []Span{
+			{Text: "otuz bir", Start: 10, End: 18, Value: 31},
+		}},
+		{"digits", "Tədbirdə 123 nəfər", nil},
+		{"empty", "", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := Extract(tc.input)
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("Extract(%q) = %+v, want %+v", tc.input, got, tc.want)
+			}
+			for _, s := range got {
+				if tc.input[s.Start:s.End] != s.Text {
+					t.Errorf("Extract(%q): offsets [%d:%d] do not match %q", tc.input, s.Start, s.End, s.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int64{2, 10, 21, 100, 123, 999, 1_000, 1_001, 2_026, 45_000, 1_000_000, 1_234_567_890, -17} {
+		text := "Cəmi " + Convert(n) + " nəfər"
+		got := Extract(text)
+		if len(got) != 1 || got[0].Value != n {
+			t.Errorf("Extract(%q) = %+v, want one span with Value %d", text, got, n)
+		}
+		ord := ConvertOrdinal(n)
+		if got := Extract(ord); len(got) != 1 || got[0].Value != n || !got[0].Ordinal {
+			t.Errorf("Extract(%q) = %+v, want one ordinal span with Value %d", ord, got, n)
+		}
+	}
+}
+
+func TestExtractOversized(t *testing.T) {
+	t.Parallel()
+
+	s := strings.Repeat("beş ", maxExtractBytes/4+1)
+	if got := Extract(s); got != nil {
+		t.Errorf("Extract of oversized input = %d spans, want nil", len(got))
+	}
+}
+
+func TestSpanJSON(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(Extract("beşinci"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"text":"beşinci","start":0,"end":8,"value":5,"ordinal":true}]`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func ExampleExtract() {
+	for _, s := range Extract("Tədbirdə yüz iyirmi üç nəfər iştirak etdi, onlardan otuzu tələbədir.") {
+		fmt.Printf("%q [%d:%d] %d\n", s.Text, s.Start, s.End, s.Value)
+	}
+	// Output:
+	// "yüz iyirmi üç" [11:27] 123
+	// "otuzu" [60:65] 30
+}
+
+func BenchmarkExtract(b *testing.B) {
+	s := "Tədbirdə yüz iyirmi üç nəfər iştirak etdi, onlardan otuz beşi tələbə idi. Büdcə iki milyon üç yüz min manat təşkil edir."
+	for b.Loop() {
+		Extract(s)
+	}
+}
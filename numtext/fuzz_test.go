@@ -87,3 +87,40 @@ func FuzzConvertFloat(f *testing.F) {
 		_ = ConvertFloat(s, DigitMode)
 	})
 }
+
+// FuzzParseRange verifies that ParseRange never panics and returns ordered
+// bounds.
+func FuzzParseRange(f *testing.F) {
+	f.Add("üç-dörd nəfər")
+	f.Add("yüzə yaxın")
+	f.Add("onlarla insan")
+	f.Add("beşdən onadək")
+	f.Add("100-ə-dək")
+	f.Add("\xff-\xfe")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		r, err := ParseRange(s)
+		if err == nil && (r.Low > r.High || r.Low < 0 || r.High > maxAbs) {
+			t.Errorf("ParseRange(%q) = %+v, want 0 <= Low <= High <= 10^18", s, r)
+		}
+	})
+}
+
+// FuzzExtract verifies that Extract never panics and that every span's
+// offsets match its text.
+func FuzzExtract(f *testing.F) {
+	f.Add("")
+	f.Add("Tədbirdə yüz iyirmi üç nəfər iştirak etdi.")
+	f.Add("mənfi beş dərəcə")
+	f.Add("Min doqquz yüz doxsan birinci ildə")
+	f.Add("kvintilyon kvintilyon")
+	f.Add("\xff\xfe beş")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, sp := range Extract(s) {
+			if sp.Start < 0 || sp.End > len(s) || sp.Start >= sp.End || s[sp.Start:sp.End] != sp.Text {
+				t.Fatalf("Extract(%q): bad span %+v", s, sp)
+			}
+		}
+	})
+}
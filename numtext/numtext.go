@@ -5,7 +5,22 @@
 //   - Convert turns an integer into cardinal Azerbaijani text.
 //   - ConvertOrdinal produces ordinal forms with vowel-harmony suffixes.
 //   - ConvertFloat converts decimal number strings to text.
+//   - ConvertFraction reads a fraction: "dörddə üç" (3/4).
+//   - ConvertCurrency reads an amount of money: "on iki manat əlli qəpik".
 //   - Parse turns Azerbaijani number text back into an integer.
+//   - ParseFloat parses decimal, fraction, and half ("iki yarım") text.
+//   - ParseCurrency parses an amount of money and its currency code.
+//   - ParseRange reads ranges ("üç-dörd nəfər") and approximations
+//     ("yüzə yaxın", "onlarla") as a low and high bound.
+//   - FormatRoman and ParseRoman convert to and from Roman numerals.
+//   - Extract finds spelled-out numbers in running text with their byte
+//     offsets and values, like datetime.Extract does for dates.
+//   - Verbalize rewrites the numbers, dates, times, and phone numbers in
+//     running text into their spoken form, for text-to-speech.
+//
+// Verbalize also reads Roman numerals as ordinals where Azerbaijani text
+// uses them: centuries ("XIX əsr" → "on doqquzuncu əsr") and regnal
+// numbers ("II Şah Abbas" → "ikinci Şah Abbas").
 //
 // ConvertFloat supports two reading modes: mathematical ("üç tam yüzdə on dörd")
 // and digit-by-digit ("üç vergül bir dörd"), controlled by the Mode parameter.
@@ -16,7 +31,16 @@
 //
 //   - Integer range is limited to ±10^18 (kvintilyon).
 //   - Decimal conversion supports up to 18 fractional digits.
-//   - Parse handles cardinal text only; ordinal input returns an error.
+//   - Parse and ParseFloat handle cardinal text only; ordinal input returns
+//     an error.
+//   - ParseCurrency maps minor units shared by several currencies to the
+//     first: "qəpik" alone is AZN and "sent" alone is USD.
+//   - ParseRange reads closed ranges only: open bounds such as "yüzdən
+//     çox" (more than a hundred) return an error.
+//   - Extract reads number words that are also other words as numbers:
+//     altı ("six", "underside of"), qırx ("forty", "shear").
+//   - Verbalize reads dotted numbers with a single ".ddd" group as thousands
+//     (10.000 is ten thousand), following the comma decimal separator.
 //   - Composed denominator words for decimals beyond 3 digits (D>3) are
 //     non-standard in Azerbaijani and provided as a best-effort extension.
 package numtext
@@ -76,3 +100,85 @@ func Parse(s string) (int64, error) {
 	}
 	return parse(s)
 }
+
+// ConvertFraction returns the Azerbaijani text for the fraction num/den:
+// the denominator in the locative case followed by the numerator
+// ("dörddə üç" for 3/4, "ikidə bir" for 1/2). A negative numerator is
+// prefixed with "mənfi".
+// Returns an empty string when den <= 0 or either value exceeds 10^18.
+func ConvertFraction(num, den int64) string {
+	return convertFraction(num, den)
+}
+
+// ConvertCurrency returns the Azerbaijani text for an amount of money in
+// the currency with the given ISO 4217 code, rounded to the nearest minor
+// unit: ConvertCurrency(1250, "AZN") is "min iki yüz əlli manat" and
+// ConvertCurrency(12.5, "USD") is "on iki dollar əlli sent". Amounts
+// below one major unit name only the minor unit ("əlli qəpik").
+//
+// Supported codes (case-insensitive): AZN, USD, EUR, RUB, TRY, GBP.
+// Returns an empty string for unknown codes, NaN, or amounts exceeding
+// 10^16.
+func ConvertCurrency(amount float64, code string) string {
+	return convertCurrency(amount, code)
+}
+
+// ParseFloat converts Azerbaijani number text to a float64. In addition to
+// the cardinal forms accepted by Parse, it accepts decimals in both
+// reading modes ("üç tam yüzdə on dörd", "üç vergül bir dörd"), fractions
+// ("dörddə üç"), and halves ("iki yarım"). Input is whitespace-normalized
+// and case-insensitive.
+//
+// Returns an error for empty, unparseable, or out-of-range input.
+func ParseFloat(s string) (float64, error) {
+	return parseFloat(s)
+}
+
+// ParseCurrency converts an Azerbaijani amount of money to its value and
+// ISO 4217 currency code: "on iki manat əlli qəpik" is (12.5, "AZN").
+// The amount may name the major unit, the minor unit, or both, in that
+// order.
+//
+// Returns an error for empty input, text without a currency unit, or
+// minor amounts of 100 or more.
+func ParseCurrency(s string) (float64, string, error) {
+	return parseCurrency(s)
+}
+
+// ParseRange reads a quantity that may be a range or an approximation, as
+// used for counts in running text:
+//
+//   - ranges: "üç-dörd", "3-4", "üç-dörd yüz" (300-400), "iki üç gün",
+//     "beşdən ona qədər", "beşdən onadək", "beş ilə on arası";
+//   - approximations: "yüzə yaxın", "yüzə qədər", "yüz ətrafında",
+//     "təxminən yüz", "onlarla" (20-99), "minlərlə", "bir neçə" (2-9),
+//     "on neçə" (11-19).
+//
+// A plain number is an exact Range with Low == High. Words after the
+// quantity, such as the counted noun in "üç-dörd nəfər", are returned in
+// Rest. Input is case-insensitive; digits may carry a dash before a case
+// ending ("100-ə yaxın").
+//
+// Returns an error for empty input, text that does not start with a
+// number, reversed ranges, and out-of-range values.
+func ParseRange(s string) (Range, error) {
+	return parseRange(s)
+}
+
+// FormatRoman returns n in canonical Roman numerals: FormatRoman(19) is
+// "XIX" and FormatRoman(1994) is "MCMXCIV".
+// Returns an empty string when n is outside 1-3999.
+func FormatRoman(n int64) string {
+	return formatRoman(n)
+}
+
+// ParseRoman converts a Roman numeral to an integer: "XIX" is 19. Input
+// is case-insensitive and surrounding whitespace is ignored. Only the
+// canonical spelling produced by FormatRoman is accepted ("IV", not
+// "IIII").
+//
+// Returns an error for empty input, non-numeral characters, or
+// non-canonical numerals.
+func ParseRoman(s string) (int64, error) {
+	return parseRoman(s)
+}
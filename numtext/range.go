@@ -0,0 +1,430 @@
+// Parsing of numeric ranges ("üç-dörd") and approximations ("yüzə yaxın",
+// "onlarla").
+package numtext
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// numCase is the case ending carried by the last word of a number.
+type numCase int
+
+const (
+	caseNone numCase = iota // not a number
+	caseNom                 // "beş"
+	caseDat                 // "beşə", "iyirmiyə"
+	caseAbl                 // "beşdən"
+	caseTerm                // "beşədək"
+)
+
+// caseEndings lists the dative and ablative endings stripped from number
+// words, longest first so "iyirmiyə" loses "yə" rather than "ə".
+var caseEndings = []struct {
+	suffix string
+	c      numCase
+}{
+	{"dan", caseAbl}, {"dən", caseAbl}, {"tan", caseAbl}, {"tən", caseAbl},
+	{"ya", caseDat}, {"yə", caseDat}, {"a", caseDat}, {"ə", caseDat},
+}
+
+// terminativeSuffix marks the terminative case ("yüzədək", up to a hundred).
+const terminativeSuffix = "dək"
+
+// hedgeWords precede a number and make it approximate.
+var hedgeWords = map[string]bool{
+	"təxminən": true, "təqribən": true, "təxmini": true, "haradasa": true,
+}
+
+// approxPlurals are the plural instrumentals of round numbers ("onlarla",
+// dozens) mapped to their magnitude.
+var approxPlurals = map[string]int64{
+	"onlarla":      10,
+	"yüzlərlə":     100,
+	"minlərlə":     1_000,
+	"milyonlarla":  1_000_000,
+	"milyardlarla": 1_000_000_000,
+}
+
+const (
+	wordSeveral = "neçə" // "bir neçə" (several), "on neçə" (ten-odd)
+	rangeDash   = "-"
+)
+
+// Range is a quantity read by ParseRange: an exact number (Low == High),
+// a range ("üç-dörd", "beşdən ona qədər"), or an approximation ("yüzə
+// yaxın", "onlarla"). Rest holds the words after the quantity, such as the
+// counted noun ("nəfər"), with their original case.
+type Range struct {
+	Low    int64  `json:"low"`
+	High   int64  `json:"high"`
+	Approx bool   `json:"approx,omitempty"`
+	Rest   string `json:"rest,omitempty"`
+}
+
+// quantity is a cardinal number read by readQuantity.
+type quantity struct {
+	value  int64
+	lead   int64   // value of the first word
+	scale  int64   // product of the magnitude words after the first, or 0 when other words follow it
+	c      numCase // case of the last word
+	n      int     // tokens consumed
+	digits bool    // the first word is written in digits
+}
+
+// parseRange parses a quantity expression followed by optional words.
+func parseRange(s string) (Range, error) {
+	text := rangeTokens(s)
+	if len(text) == 0 {
+		return Range{}, fmt.Errorf("numtext: empty input")
+	}
+	lower := make([]string, len(text))
+	for i, t := range text {
+		lower[i] = azcase.ToLower(t)
+	}
+
+	i, approx := 0, false
+	if hedgeWords[lower[0]] {
+		i, approx = 1, true
+	}
+	r, next, err := readRange(lower, i)
+	if err != nil {
+		return Range{}, err
+	}
+	r.Approx = r.Approx || approx
+	r.Rest = strings.Join(text[next:], " ")
+	return r, nil
+}
+
+// rangeTokens splits s into words, separating a dash between two numbers
+// ("üç-dörd" → "üç", "-", "dörd") and dropping the dash before a case
+// ending on digits ("100-ə" → "100ə"). Other hyphenated words stay whole.
+func rangeTokens(s string) []string {
+	var out []string
+	for _, f := range strings.Fields(s) {
+		for {
+			i := strings.IndexAny(f, "-–—")
+			if i <= 0 {
+				break
+			}
+			head := f[:i]
+			_, size := utf8.DecodeRuneInString(f[i:])
+			tail := f[i+size:]
+			if _, c := numberCase(azcase.ToLower(head)); c == caseNone || tail == "" {
+				break
+			}
+			if isDigits(head) && !isDigit(tail[0]) {
+				if _, c := numberCase(azcase.ToLower(head + tail)); c != caseNone {
+					f = head + tail
+				}
+				break
+			}
+			next := tail
+			if k := strings.IndexAny(next, "-–—"); k >= 0 {
+				next = next[:k]
+			}
+			if _, c := numberCase(azcase.ToLower(next)); c == caseNone {
+				break
+			}
+			out = append(out, head, rangeDash)
+			f = tail
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+// readRange reads a quantity expression from tokens[i:] and returns it
+// with the index of the first token after it.
+func readRange(tokens []string, i int) (Range, int, error) {
+	if i < len(tokens) {
+		if m, ok := approxPlurals[tokens[i]]; ok {
+			lo, hi := pluralRange(m)
+			return Range{Low: lo, High: hi, Approx: true}, i + 1, nil
+		}
+	}
+
+	q1, err := readQuantity(tokens, i)
+	if err != nil {
+		return Range{}, 0, err
+	}
+	if q1.n == 0 {
+		return Range{}, 0, fmt.Errorf("numtext: no number in %q", strings.Join(tokens, " "))
+	}
+	j := i + q1.n
+	r := Range{Low: q1.value, High: q1.value}
+	c := q1.c
+
+	switch {
+	case c == caseNom && j < len(tokens) && tokens[j] == wordSeveral:
+		// "bir neçə" (2-9), "on neçə" (11-19), optionally times a magnitude.
+		switch {
+		case q1.value == 1:
+			r.Low, r.High = 2, 9
+		case q1.value >= 10 && q1.value <= 90 && q1.value%10 == 0:
+			r.Low, r.High = q1.value+1, q1.value+9
+		default:
+			return Range{}, 0, fmt.Errorf("numtext: unexpected %q after %d", wordSeveral, q1.value)
+		}
+		r.Approx = true
+		j++
+		for j < len(tokens) && isMagnitude(tokens[j]) {
+			if r, err = scaleRange(r, wordValues[tokens[j]]); err != nil {
+				return Range{}, 0, err
+			}
+			j++
+		}
+		return r, j, nil
+
+	case c == caseNom && j < len(tokens) && approxPlurals[tokens[j]] != 0:
+		// "on minlərlə" (tens of thousands), "yüz minlərlə".
+		if q1.value != 10 && q1.value != 100 {
+			return Range{}, 0, fmt.Errorf("numtext: unexpected %q after %d", tokens[j], q1.value)
+		}
+		r.Low, r.High = pluralRange(q1.value)
+		if r, err = scaleRange(r, approxPlurals[tokens[j]]); err != nil {
+			return Range{}, 0, err
+		}
+		r.Approx = true
+		return r, j + 1, nil
+
+	case c == caseNom && j+1 < len(tokens) && tokens[j] == rangeDash:
+		// "üç-dörd", "üç-dörd yüz".
+		q2, err := readQuantity(tokens, j+1)
+		if err != nil {
+			return Range{}, 0, err
+		}
+		if q2.n == 0 {
+			return Range{}, 0, fmt.Errorf("numtext: missing number after %q", rangeDash)
+		}
+		r.Low, r.High = scaledLow(q1, q2), q2.value
+		j, c = j+1+q2.n, q2.c
+
+	case c == caseNom && j+2 < len(tokens) && (tokens[j] == "ilə" || tokens[j] == "və"):
+		// "beş ilə on arası", "beş və on arasında".
+		q2, err := readQuantity(tokens, j+1)
+		if err == nil && q2.n > 0 && q2.c == caseNom && j+1+q2.n < len(tokens) && isBetween(tokens[j+1+q2.n]) {
+			r.High = q2.value
+			j += q2.n + 2
+			if r.Low > r.High {
+				r.Low, r.High = r.High, r.Low
+			}
+			return r, j, nil
+		}
+
+	case c == caseNom && q1.n == 1 && !q1.digits && q1.value < 9 && j < len(tokens):
+		// "iki üç gün": adjacent digit words one apart.
+		q2, err := readQuantity(tokens, j)
+		if err == nil && q2.n > 0 && !q2.digits && q2.lead == q1.value+1 {
+			r.Low, r.High = scaledLow(q1, q2), q2.value
+			j, c = j+q2.n, q2.c
+		}
+
+	case c == caseAbl:
+		// "beşdən ona (qədər)", "beşdən onadək".
+		q2, err := readQuantity(tokens, j)
+		if err != nil {
+			return Range{}, 0, err
+		}
+		if q2.n == 0 || (q2.c != caseDat && q2.c != caseTerm) {
+			return Range{}, 0, fmt.Errorf("numtext: missing upper bound after %d", q1.value)
+		}
+		r.High = q2.value
+		j += q2.n
+		if q2.c == caseDat && j < len(tokens) && isUpTo(tokens[j]) {
+			j++
+		}
+		if r.Low > r.High {
+			return Range{}, 0, fmt.Errorf("numtext: range %d-%d is reversed", r.Low, r.High)
+		}
+		return r, j, nil
+	}
+
+	if r.Low > r.High {
+		return Range{}, 0, fmt.Errorf("numtext: range %d-%d is reversed", r.Low, r.High)
+	}
+
+	// Postpositions: "yüzə yaxın", "yüzə qədər", "yüz qədər", "yüz ətrafında".
+	switch {
+	case c == caseTerm:
+		r.Approx = true
+	case c == caseDat && j < len(tokens) && (tokens[j] == "yaxın" || isUpTo(tokens[j])):
+		r.Approx = true
+		j++
+	case c == caseNom && j < len(tokens) && isAround(tokens[j]):
+		r.Approx = true
+		j++
+	case c == caseAbl:
+		return Range{}, 0, fmt.Errorf("numtext: missing upper bound after %d", r.High)
+	}
+	return r, j, nil
+}
+
+// readQuantity reads a cardinal number from tokens[i:]: digits or number
+// words, of which only the last may carry a case ending. Digits may be
+// followed by magnitude words ("3 min"). n is 0 when tokens[i] is not a
+// number.
+func readQuantity(tokens []string, i int) (quantity, error) {
+	var (
+		words []string
+		q     quantity
+	)
+	for j := i; j < len(tokens); j++ {
+		base, c := numberCase(tokens[j])
+		if c == caseNone || (j > i && isDigits(base)) {
+			break
+		}
+		if j == i {
+			q.digits = isDigits(base)
+		} else if q.digits && !isMagnitude(base) {
+			break
+		}
+		// Two single digits in a row ("iki üç") are a range, not a compound.
+		if len(words) > 0 && isOnes(words[len(words)-1]) && isOnes(base) {
+			break
+		}
+		words = append(words, base)
+		q.c = c
+		if c != caseNom {
+			break
+		}
+	}
+	q.n = len(words)
+	if q.n == 0 {
+		return q, nil
+	}
+
+	var err error
+	if q.digits {
+		if q.lead, err = strconv.ParseInt(words[0], 10, 64); err != nil || q.lead > maxAbs {
+			return quantity{}, fmt.Errorf("numtext: out of range")
+		}
+	} else {
+		q.lead = wordValues[words[0]]
+	}
+	q.scale = 1
+	for _, w := range words[1:] {
+		if !isMagnitude(w) {
+			q.scale = 0
+			break
+		}
+		if q.scale > maxAbs/wordValues[w] {
+			return quantity{}, fmt.Errorf("numtext: out of range")
+		}
+		q.scale *= wordValues[w]
+	}
+
+	switch {
+	case q.digits:
+		if q.lead > 0 && q.scale > maxAbs/q.lead {
+			return quantity{}, fmt.Errorf("numtext: out of range")
+		}
+		q.value = q.lead * q.scale
+	default:
+		if q.value, err = parse(strings.Join(words, " ")); err != nil {
+			return quantity{}, err
+		}
+	}
+	return q, nil
+}
+
+// numberCase returns the number word or digit string in tok with its case
+// ending removed, and the case. The case is caseNone when tok is not a
+// number.
+func numberCase(tok string) (string, numCase) {
+	if isNumberBase(tok) {
+		return tok, caseNom
+	}
+	stem, term := strings.CutSuffix(tok, terminativeSuffix)
+	for _, e := range caseEndings {
+		base, ok := strings.CutSuffix(stem, e.suffix)
+		if !ok || !isNumberBase(base) {
+			continue
+		}
+		switch {
+		case !term:
+			return base, e.c
+		case e.c == caseDat:
+			return base, caseTerm
+		}
+	}
+	return "", caseNone
+}
+
+// scaledLow returns the lower bound of the range q1-q2. A bare lower
+// bound takes the magnitude of the upper one: "üç-dörd yüz" is 300-400.
+func scaledLow(q1, q2 quantity) int64 {
+	if q1.n == 1 && q2.scale > 1 && q1.value < q2.lead {
+		return q1.value * q2.scale
+	}
+	return q1.value
+}
+
+// pluralRange returns the range meant by the plural of magnitude m:
+// "onlarla" is 20-99, "minlərlə" is 2,000-999,999.
+func pluralRange(m int64) (int64, int64) {
+	next := m * 1_000
+	if m < 1_000 {
+		next = m * 10
+	}
+	return 2 * m, next - 1
+}
+
+// scaleRange multiplies r by magnitude m, extending High to the end of its
+// unit: 2-9 times a thousand is 2,000-9,999.
+func scaleRange(r Range, m int64) (Range, error) {
+	if r.High+1 > maxAbs/m {
+		return Range{}, fmt.Errorf("numtext: out of range")
+	}
+	r.Low, r.High = r.Low*m, (r.High+1)*m-1
+	return r, nil
+}
+
+// isNumberBase reports whether s is a number word or a digit string.
+func isNumberBase(s string) bool {
+	_, ok := wordValues[s]
+	return ok || isDigits(s)
+}
+
+// isMagnitude reports whether s is "yüz" or a larger magnitude word.
+func isMagnitude(s string) bool {
+	return wordValues[s] >= hundred
+}
+
+// isOnes reports whether s is a single-digit word other than "sıfır".
+func isOnes(s string) bool {
+	v, ok := wordValues[s]
+	return ok && v >= 1 && v <= 9
+}
+
+// isUpTo reports whether tok is a postposition taking the dative, "up to".
+func isUpTo(tok string) bool {
+	return tok == "qədər" || tok == "kimi" || tok == terminativeSuffix
+}
+
+// isAround reports whether tok marks an approximation after the nominative.
+func isAround(tok string) bool {
+	return tok == "qədər" || tok == "kimi" || tok == "ətrafında" || tok == "civarında"
+}
+
+// isBetween reports whether tok closes a "X ilə Y arası" range.
+func isBetween(tok string) bool {
+	return tok == "arası" || tok == "arasında" || tok == "aralığında"
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
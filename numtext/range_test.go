@@ -0,0 +1,91 @@
+package numtext
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		input   string
+		want    Range
+		wantErr bool
+	}{
+		{"plain number", "beş", Range{Low: 5, High: 5}, false},
+		{"plain compound", "yüz iyirmi üç kitab", Range{Low: 123, High: 123, Rest: "kitab"}, false},
+		{"dash range", "üç-dörd nəfər", Range{Low: 3, High: 4, Rest: "nəfər"}, false},
+		{"spaced dash", "üç - dörd nəfər", Range{Low: 3, High: 4, Rest: "nəfər"}, false},
+		{"en dash", "on–on beş gün", Range{Low: 10, High: 15, Rest: "gün"}, false},
+		{"digit range", "3-4 nəfər", Range{Low: 3, High: 4, Rest: "nəfər"}, false},
+		{"shared magnitude", "üç-dörd yüz manat", Range{Low: 300, High: 400, Rest: "manat"}, false},
+		{"digits shared magnitude", "3-4 min", Range{Low: 3000, High: 4000}, false},
+		{"compound bounds", "min-min beş yüz", Range{Low: 1000, High: 1500}, false},
+		{"adjacent words", "iki üç gün", Range{Low: 2, High: 3, Rest: "gün"}, false},
+		{"adjacent not consecutive", "iki beş", Range{Low: 2, High: 2, Rest: "beş"}, false},
+		{"from to", "beşdən ona qədər", Range{Low: 5, High: 10}, false},
+		{"from to bare", "yüz əllidən iki yüzə", Range{Low: 150, High: 200}, false},
+		{"from to terminative", "beşdən onadək gün", Range{Low: 5, High: 10, Rest: "gün"}, false},
+		{"from to digits", "100-dən 200-ə kimi", Range{Low: 100, High: 200}, false},
+		{"between", "beş ilə on arası", Range{Low: 5, High: 10}, false},
+		{"between locative", "beş və on arasında dərəcə", Range{Low: 5, High: 10, Rest: "dərəcə"}, false},
+		{"close to", "yüzə yaxın tələbə", Range{Low: 100, High: 100, Approx: true, Rest: "tələbə"}, false},
+		{"close to buffer y", "iyirmiyə yaxın", Range{Low: 20, High: 20, Approx: true}, false},
+		{"close to digits", "100-ə yaxın", Range{Low: 100, High: 100, Approx: true}, false},
+		{"up to", "yüzə qədər insan", Range{Low: 100, High: 100, Approx: true, Rest: "insan"}, false},
+		{"terminative", "yüzədək", Range{Low: 100, High: 100, Approx: true}, false},
+		{"around", "yüz ətrafında", Range{Low: 100, High: 100, Approx: true}, false},
+		{"hedge", "təxminən yüz əlli", Range{Low: 150, High: 150, Approx: true}, false},
+		{"hedged range", "Təqribən üç-dörd saat", Range{Low: 3, High: 4, Approx: true, Rest: "saat"}, false},
+		{"tens of", "onlarla insan", Range{Low: 20, High: 99, Approx: true, Rest: "insan"}, false},
+		{"hundreds of", "yüzlərlə", Range{Low: 200, High: 999, Approx: true}, false},
+		{"thousands of", "minlərlə", Range{Low: 2000, High: 999_999, Approx: true}, false},
+		{"tens of thousands", "on minlərlə", Range{Low: 20_000, High: 99_999, Approx: true}, false},
+		{"several", "bir neçə nəfər", Range{Low: 2, High: 9, Approx: true, Rest: "nəfər"}, false},
+		{"several thousand", "bir neçə min", Range{Low: 2000, High: 9999, Approx: true}, false},
+		{"ten-odd", "on neçə il", Range{Low: 11, High: 19, Approx: true, Rest: "il"}, false},
+		{"rest keeps case", "ÜÇ-DÖRD Nəfər", Range{Low: 3, High: 4, Rest: "Nəfər"}, false},
+		{"empty", "", Range{}, true},
+		{"no number", "sosial-iqtisadi", Range{}, true},
+		{"ordinal", "3-cü sinif", Range{}, true},
+		{"reversed", "dörd-üç", Range{}, true},
+		{"reversed from to", "ondan beşə", Range{}, true},
+		{"open bound", "yüzdən çox", Range{}, true},
+		{"trailing dash", "üç -", Range{Low: 3, High: 3, Rest: "-"}, false},
+		{"bad several", "beş neçə", Range{}, true},
+		{"out of range", "99999999999999999999", Range{}, true},
+		{"scaled out of range", "bir neçə kvintilyon", Range{}, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseRange(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRange(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseRange(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleParseRange() {
+	for _, s := range []string{"üç-dörd nəfər", "onlarla insan", "yüzə yaxın"} {
+		r, _ := ParseRange(s)
+		fmt.Println(r.Low, r.High, r.Approx, r.Rest)
+	}
+	// Output:
+	// 3 4 false nəfər
+	// 20 99 true insan
+	// 100 100 true
+}
+
+func BenchmarkParseRange(b *testing.B) {
+	for b.Loop() {
+		ParseRange("təxminən üç-dörd yüz nəfər")
+	}
+}
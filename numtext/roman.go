@@ -0,0 +1,159 @@
+// Roman numerals.
+package numtext
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxRoman is the largest number written in standard Roman numerals.
+const maxRoman = 3999
+
+// romanSymbols lists the Roman numeral symbols and subtractive pairs,
+// largest first.
+var romanSymbols = [...]struct {
+	value  int64
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// romanPeriodWords start the words after which a Roman numeral is read
+// as an ordinal: "XIX əsr", "III minillik", "XX yüzillikdə".
+var romanPeriodWords = []string{"əsr", "minillik", "yüzillik"}
+
+func formatRoman(n int64) string {
+	if n < 1 || n > maxRoman {
+		return ""
+	}
+	var b strings.Builder
+	for _, rs := range romanSymbols {
+		for n >= rs.value {
+			b.WriteString(rs.symbol)
+			n -= rs.value
+		}
+	}
+	return b.String()
+}
+
+func parseRoman(s string) (int64, error) {
+	upper := strings.ToUpper(strings.TrimSpace(s))
+	if upper == "" {
+		return 0, fmt.Errorf("numtext: empty input")
+	}
+	var n int64
+	rest := upper
+	for _, rs := range romanSymbols {
+		for strings.HasPrefix(rest, rs.symbol) {
+			n += rs.value
+			rest = rest[len(rs.symbol):]
+		}
+	}
+	// Only the canonical spelling is accepted: "IIII" and "IC" are not
+	// Roman numerals for 4 and 99.
+	if rest != "" || formatRoman(n) != upper {
+		return 0, fmt.Errorf("numtext: invalid Roman numeral %q", s)
+	}
+	return n, nil
+}
+
+// ── Verbalization ─────────────────────────────────────────────────────
+
+// romanStart reports whether a Roman numeral may start at text[i]: an
+// uppercase numeral letter not preceded by a letter or digit, or by a
+// hyphen after one (the second half of "I-II" is read with the first).
+func romanStart(text string, i int) bool {
+	if !isRomanSymbol(text[i]) {
+		return false
+	}
+	prev, n := utf8.DecodeLastRuneInString(text[:i])
+	if n == 0 {
+		return true
+	}
+	if prev == '-' {
+		before, _ := utf8.DecodeLastRuneInString(text[:i-n])
+		return !unicode.IsLetter(before) && !unicode.IsDigit(before)
+	}
+	return !unicode.IsLetter(prev) && !unicode.IsDigit(prev)
+}
+
+// matchRoman reads the Roman numeral, or range of two ("XIX-XX"), at
+// text[i] as an ordinal when it is followed by a period word ("XIX əsr")
+// or, for a single numeral, by a capitalized name ("II Şah Abbas",
+// "I Dünya müharibəsi"). The single letters C, D, L, and M are only read
+// before a period word, as they usually stand for themselves ("C
+// vitamini").
+func matchRoman(text string, i int) (string, int, bool) {
+	first, end, ok := romanAt(text, i)
+	if !ok {
+		return "", 0, false
+	}
+	spoken := convertOrdinal(first)
+	isRange := false
+	if end < len(text) && text[end] == '-' {
+		if second, e, ok := romanAt(text, end+1); ok && second > first {
+			spoken += "-" + convertOrdinal(second)
+			end, isRange = e, true
+		}
+	}
+
+	next := nextWordAfter(text, end)
+	if next == "" {
+		return "", 0, false
+	}
+	lower := strings.ToLower(next)
+	for _, w := range romanPeriodWords {
+		if strings.HasPrefix(lower, w) {
+			return spoken, end, true
+		}
+	}
+	r, _ := utf8.DecodeRuneInString(next)
+	if isRange || !unicode.IsUpper(r) || end-i == 1 && strings.IndexByte("CDLM", text[i]) >= 0 {
+		return "", 0, false
+	}
+	return spoken, end, true
+}
+
+// romanAt parses the Roman numeral at text[i], which must end at a token
+// boundary, and returns its value and end.
+func romanAt(text string, i int) (int64, int, bool) {
+	j := i
+	for j < len(text) && isRomanSymbol(text[j]) {
+		j++
+	}
+	if j == i || !tokenEnd(text, j) {
+		return 0, 0, false
+	}
+	n, err := parseRoman(text[i:j])
+	if err != nil {
+		return 0, 0, false
+	}
+	return n, j, true
+}
+
+// nextWordAfter returns the word after the single space at text[i], or ""
+// when text[i] is not a space or no word follows.
+func nextWordAfter(text string, i int) string {
+	if i >= len(text) || text[i] != ' ' {
+		return ""
+	}
+	i++
+	j := i
+	for j < len(text) {
+		r, size := utf8.DecodeRuneInString(text[j:])
+		if !unicode.IsLetter(r) {
+			break
+		}
+		j += size
+	}
+	return text[i:j]
+}
+
+// isRomanSymbol reports whether c is an uppercase Roman numeral letter.
+func isRomanSymbol(c byte) bool {
+	return strings.IndexByte("IVXLCDM", c) >= 0
+}
@@ -0,0 +1,125 @@
+package numtext
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormatRoman(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		n    int64
+		want string
+	}{
+		{1, "I"}, {4, "IV"}, {9, "IX"}, {14, "XIV"}, {19, "XIX"}, {21, "XXI"},
+		{40, "XL"}, {90, "XC"}, {400, "CD"}, {1994, "MCMXCIV"}, {2026, "MMXXVI"}, {3999, "MMMCMXCIX"},
+		{0, ""}, {-5, ""}, {4000, ""},
+	}
+	for _, tc := range cases {
+		if got := FormatRoman(tc.n); got != tc.want {
+			t.Errorf("FormatRoman(%d) = %q, want %q", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestParseRoman(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input string
+		want  int64
+	}{
+		{"I", 1}, {"IV", 4}, {"XIX", 19}, {"xix", 19}, {" XXI ", 21},
+		{"MCMXCIV", 1994}, {"MMMCMXCIX", 3999},
+	}
+	for _, tc := range cases {
+		got, err := ParseRoman(tc.input)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseRoman(%q) = %d, %v; want %d", tc.input, got, err, tc.want)
+		}
+	}
+}
+
+func TestParseRomanErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", "  ", "IIII", "IC", "VX", "MMMM", "XIXA", "12", "XİX"} {
+		if got, err := ParseRoman(input); err == nil {
+			t.Errorf("ParseRoman(%q) = %d, want error", input, got)
+		}
+	}
+}
+
+func TestRomanRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for n := int64(1); n <= maxRoman; n++ {
+		got, err := ParseRoman(FormatRoman(n))
+		if err != nil || got != n {
+			t.Fatalf("ParseRoman(FormatRoman(%d)) = %d, %v", n, got, err)
+		}
+	}
+}
+
+func TestVerbalizeRoman(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"century", "XIX əsr", "on doqquzuncu əsr"},
+		{"century inflected", "XXI əsrdə yaşayırıq", "iyirmi birinci əsrdə yaşayırıq"},
+		{"century genitive", "XX əsrin əvvəllərində", "iyirminci əsrin əvvəllərində"},
+		{"century range", "XIX-XX əsrlər", "on doqquzuncu-iyirminci əsrlər"},
+		{"millennium", "III minillik", "üçüncü minillik"},
+		{"single letter century", "V əsr", "beşinci əsr"},
+		{"regnal", "II Şah Abbas", "ikinci Şah Abbas"},
+		{"regnal first", "I Pyotr", "birinci Pyotr"},
+		{"numbered event", "II Dünya müharibəsi", "ikinci Dünya müharibəsi"},
+		{"in sentence", "Bu bina XVIII əsrdə tikilib.", "Bu bina on səkkizinci əsrdə tikilib."},
+
+		// Left unchanged
+		{"vitamin", "C vitamini", "C vitamini"},
+		{"vitamin capitalized", "D Vitamini", "D Vitamini"},
+		{"no context", "XIX", "XIX"},
+		{"lowercase follower", "II hissə", "II hissə"},
+		{"inside word", "XIXəsr", "XIXəsr"},
+		{"abbreviation", "MDB ölkələri", "MDB ölkələri"},
+		{"non-canonical", "IIII əsr", "IIII əsr"},
+		{"range before name", "I-II Şah", "I-II Şah"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Verbalize(tc.input); got != tc.want {
+				t.Errorf("Verbalize(%q) = %q, want %q", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func BenchmarkParseRoman(b *testing.B) {
+	for b.Loop() {
+		_, _ = ParseRoman("MCMXCIV")
+	}
+}
+
+func ExampleFormatRoman() {
+	fmt.Println(FormatRoman(19))
+	fmt.Println(FormatRoman(2026))
+	// Output:
+	// XIX
+	// MMXXVI
+}
+
+func ExampleParseRoman() {
+	n, err := ParseRoman("XIX")
+	fmt.Println(n, err)
+	fmt.Println(Verbalize("XIX əsrdə II Şah Abbas"))
+	// Output:
+	// 19 <nil>
+	// on doqquzuncu əsrdə ikinci Şah Abbas
+}
@@ -0,0 +1,476 @@
+// Spoken-form rewriting of numbers in running text.
+package numtext
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxVerbalizeBytes is the maximum input size for Verbalize. Larger
+// inputs are returned unchanged.
+const maxVerbalizeBytes = 1 << 20 // 1 MiB
+
+const (
+	wordPlus    = "üstəgəl"
+	wordPercent = "faiz"
+	wordYear    = "il"
+
+	// minPhoneDigits is the minimum number of digits in a phone number;
+	// shorter digit groups are read as separate numbers.
+	minPhoneDigits = 9
+
+	// maxPhoneDigits is the maximum length of an E.164 phone number.
+	maxPhoneDigits = 15
+)
+
+// monthNames lists the Azerbaijani month names, indexed by month number.
+var monthNames = [13]string{
+	"", "yanvar", "fevral", "mart", "aprel", "may", "iyun",
+	"iyul", "avqust", "sentyabr", "oktyabr", "noyabr", "dekabr",
+}
+
+// ordinalSuffixes lists the ordinal suffixes written after digits
+// ("5-ci", "2026-cı", "3-üncü").
+var ordinalSuffixes = map[string]bool{
+	"cı": true, "ci": true, "cu": true, "cü": true,
+	"ncı": true, "nci": true, "ncu": true, "ncü": true,
+	"ıncı": true, "inci": true, "uncu": true, "üncü": true,
+}
+
+// Verbalize rewrites the numbers in text into their spoken Azerbaijani
+// form, for text-to-speech preprocessing:
+//
+//   - dates dd.mm.yyyy, dd/mm/yyyy and yyyy-mm-dd: "21.03.2026" →
+//     "iyirmi bir mart iki min iyirmi altıncı il";
+//   - times hh:mm and hh:mm:ss: "09:05" → "doqquz sıfır beş", "14:00" →
+//     "on dörd";
+//   - phone numbers starting with "+", "(0" or "0", with at least nine
+//     digits, read group by group: "+994 50 123 45 67" → "üstəgəl doqquz
+//     yüz doxsan dörd əlli yüz iyirmi üç qırx beş altmış yeddi";
+//   - ordinals "5-ci", "2026-cı" → "beşinci", "iki min iyirmi altıncı";
+//   - Roman numerals before a century or millennium word ("XIX əsr",
+//     "XIX-XX əsrlər", "III minillik") or a capitalized name ("II Şah
+//     Abbas") → "on doqquzuncu əsr", "ikinci Şah Abbas";
+//   - decimals "3,5" → "üç tam onda beş", percentages "15%" → "on beş
+//     faiz", and integers; a hyphenated case suffix is joined to the
+//     spoken number ("2026-da" → "iki min iyirmi altıda"). Integers with a
+//     leading zero or beyond 10^18 are read digit by digit.
+//
+// Digits that are part of a word or code (A4, COVID-19, 5G) are left
+// unchanged, as is everything outside the rewritten numbers.
+// Returns the input unchanged for empty or oversized (>1 MiB) input.
+func Verbalize(text string) string {
+	if text == "" || len(text) > maxVerbalizeBytes {
+		return text
+	}
+
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(text); {
+		var spoken string
+		var end int
+		switch {
+		case numberStart(text, i):
+			spoken, end = verbalizeAt(text, i)
+		case romanStart(text, i):
+			var ok bool
+			if spoken, end, ok = matchRoman(text, i); !ok {
+				i++
+				continue
+			}
+		default:
+			i++
+			continue
+		}
+		if spoken == "" {
+			i = end
+			continue
+		}
+		b.WriteString(text[last:i])
+		b.WriteString(spoken)
+		last, i = end, end
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// numberStart reports whether a number may start at text[i]: a digit, or
+// a "+" or "(" before one, not preceded by a letter, digit, or a
+// punctuation mark after a letter (COVID-19).
+func numberStart(text string, i int) bool {
+	c := text[i]
+	switch {
+	case isDigit(c):
+	case (c == '+' || c == '(') && i+1 < len(text) && isDigit(text[i+1]):
+	default:
+		return false
+	}
+	prev, n := utf8.DecodeLastRuneInString(text[:i])
+	if n == 0 {
+		return true
+	}
+	if prev == '-' || prev == ',' || prev == '.' {
+		// Ranges and lists (2-3, 1,2, 1.2.3) are read; codes (COVID-19)
+		// are not.
+		before, _ := utf8.DecodeLastRuneInString(text[:i-n])
+		return !unicode.IsLetter(before)
+	}
+	return !unicode.IsLetter(prev) && !unicode.IsDigit(prev)
+}
+
+// verbalizeAt reads the number starting at text[i]. It returns the spoken
+// form and the end of the number, or "" and the end of the token when the
+// digits belong to a word or code and must be left unchanged.
+func verbalizeAt(text string, i int) (string, int) {
+	if s, end, ok := matchPhone(text, i); ok {
+		return s, end
+	}
+	if s, end, ok := matchDate(text, i); ok {
+		return s, end
+	}
+	if s, end, ok := matchTime(text, i); ok {
+		return s, end
+	}
+	return matchNumber(text, i)
+}
+
+// ── Dates and times ───────────────────────────────────────────────────
+
+// matchDate matches dd.mm.yyyy, dd/mm/yyyy or yyyy-mm-dd at text[i].
+func matchDate(text string, i int) (string, int, bool) {
+	g1, e1 := digitRun(text, i)
+	if e1 >= len(text) {
+		return "", 0, false
+	}
+	sep := text[e1]
+	if sep != '.' && sep != '/' && sep != '-' {
+		return "", 0, false
+	}
+	g2, e2 := digitRun(text, e1+1)
+	if e2 >= len(text) || text[e2] != sep {
+		return "", 0, false
+	}
+	g3, e3 := digitRun(text, e2+1)
+	if !tokenEnd(text, e3) {
+		return "", 0, false
+	}
+
+	var day, month, year string
+	switch {
+	case sep == '-' && len(g1) == 4 && len(g2) == 2 && len(g3) == 2:
+		year, month, day = g1, g2, g3
+	case sep != '-' && len(g1) >= 1 && len(g1) <= 2 && len(g2) == 2 && len(g3) == 4:
+		day, month, year = g1, g2, g3
+	default:
+		return "", 0, false
+	}
+	d, _ := strconv.Atoi(day)
+	m, _ := strconv.Atoi(month)
+	y, _ := strconv.ParseInt(year, 10, 64)
+	if d < 1 || d > 31 || m < 1 || m > 12 {
+		return "", 0, false
+	}
+	return convert(int64(d)) + " " + monthNames[m] + " " + convertOrdinal(y) + " " + wordYear, e3, true
+}
+
+// matchTime matches hh:mm or hh:mm:ss at text[i], with an optional
+// hyphenated suffix ("14:30-da").
+func matchTime(text string, i int) (string, int, bool) {
+	hh, end := digitRun(text, i)
+	if len(hh) > 2 || end >= len(text) || text[end] != ':' {
+		return "", 0, false
+	}
+	parts := []string{hh}
+	for end < len(text) && text[end] == ':' && len(parts) < 3 {
+		p, e := digitRun(text, end+1)
+		if len(p) != 2 {
+			return "", 0, false
+		}
+		parts = append(parts, p)
+		end = e
+	}
+	h, _ := strconv.Atoi(parts[0])
+	if h > 23 {
+		return "", 0, false
+	}
+	words := []string{convert(int64(h))}
+	for k, p := range parts[1:] {
+		v, _ := strconv.Atoi(p)
+		switch {
+		case v > 59:
+			return "", 0, false
+		case v == 0 && k == 0 && len(parts) == 2:
+			// hh:00 is read as the hour alone.
+		default:
+			words = append(words, readGroup(p))
+		}
+	}
+	spoken := strings.Join(words, " ")
+	if suffix, e, ok := hyphenSuffix(text, end); ok {
+		return spoken + suffix, e, true
+	}
+	if !tokenEnd(text, end) {
+		return "", 0, false
+	}
+	return spoken, end, true
+}
+
+// ── Phone numbers ─────────────────────────────────────────────────────
+
+// matchPhone matches a phone number at text[i]: "+" followed by digit
+// groups, or a national number starting with "0" or "(0" split into
+// groups by spaces, hyphens, or parentheses.
+func matchPhone(text string, i int) (string, int, bool) {
+	plus := text[i] == '+'
+	if !plus && text[i] != '0' && !(text[i] == '(' && text[i+1] == '0') {
+		return "", 0, false
+	}
+
+	var groups []string
+	digits := 0
+	j := i
+	if plus {
+		j++
+	}
+	for j < len(text) {
+		if text[j] == '(' {
+			j++
+		}
+		g, e := digitRun(text, j)
+		if g == "" {
+			break
+		}
+		groups = append(groups, g)
+		digits += len(g)
+		j = e
+		if j < len(text) && text[j] == ')' {
+			j++
+		}
+		// Groups are joined by a single separator before the next digit.
+		if j+1 < len(text) && (text[j] == ' ' || text[j] == '-') &&
+			(isDigit(text[j+1]) || text[j+1] == '(') {
+			j++
+			continue
+		}
+		break
+	}
+	if digits < minPhoneDigits || digits > maxPhoneDigits || !tokenEnd(text, j) {
+		return "", 0, false
+	}
+	// A national number must be split into groups; a bare run of digits
+	// starting with 0 is an ordinary number.
+	if !plus && len(groups) == 1 && text[i] != '(' {
+		if len(groups[0]) != 10 {
+			return "", 0, false
+		}
+	}
+	if len(groups) == 1 {
+		groups = splitPhone(groups[0], plus)
+	}
+
+	words := make([]string, 0, len(groups)+1)
+	if plus {
+		words = append(words, wordPlus)
+	}
+	for _, g := range groups {
+		words = append(words, readGroup(g))
+	}
+	return strings.Join(words, " "), j, true
+}
+
+// splitPhone splits an unbroken phone number into the groups it is read
+// in: +994 50 123 45 67 for Azerbaijani international numbers, 050 123 45
+// 67 for national ones, and single digits otherwise.
+func splitPhone(d string, international bool) []string {
+	var sizes []int
+	switch {
+	case international && len(d) == 12 && strings.HasPrefix(d, "994"):
+		sizes = []int{3, 2, 3, 2, 2}
+	case !international && len(d) == 10:
+		sizes = []int{3, 3, 2, 2}
+	default:
+		return strings.Split(d, "")
+	}
+	groups := make([]string, 0, len(sizes))
+	for _, n := range sizes {
+		groups = append(groups, d[:n])
+		d = d[n:]
+	}
+	return groups
+}
+
+// ── Numbers ───────────────────────────────────────────────────────────
+
+// matchNumber reads an integer, decimal, percentage, or ordinal at
+// text[i]. Digits followed directly by letters other than an ordinal
+// suffix are a code (5G, 4x4) and are returned with "" up to the end of
+// the token.
+func matchNumber(text string, i int) (string, int) {
+	intPart, end := digitRun(text, i)
+	if intPart == "" {
+		return "", i + 1
+	}
+
+	// Thousands groups: 10.000 or 1 000 000 are one number. The decimal
+	// separator is the comma, so a single ".ddd" group is a thousands group.
+	num := intPart
+	if len(intPart) <= 3 {
+		for _, sep := range []byte{'.', ' '} {
+			if n, e := thousandsGroups(text, end, sep); n > 0 {
+				num = strings.NewReplacer(".", "", " ", "").Replace(text[i:e])
+				end = e
+				break
+			}
+		}
+	}
+
+	// Decimal part. Dotted runs like 1.2.3 are not decimals.
+	spoken := ""
+	if end+1 < len(text) && (text[end] == ',' || num == intPart && text[end] == '.') && isDigit(text[end+1]) {
+		frac, e := digitRun(text, end+1)
+		dotted := e+1 < len(text) && text[e] == text[end] && isDigit(text[e+1]) ||
+			i >= 2 && text[i-1] == text[end] && isDigit(text[i-2])
+		if s := convertFloat(num+"."+frac, MathMode); s != "" && !dotted {
+			spoken, end = s, e
+		}
+	}
+	if spoken == "" {
+		spoken = readInteger(num)
+	}
+
+	if end < len(text) && text[end] == '%' {
+		return spoken + " " + wordPercent, end + 1
+	}
+	letters, e := letterRun(text, end)
+	if letters != "" {
+		// Letters joined to the digits: an ordinal (5ci) or a code (5G).
+		if ordinalSuffixes[strings.ToLower(letters)] && num == intPart {
+			return readOrdinal(num, spoken), e
+		}
+		return "", e
+	}
+	if end+1 < len(text) && text[end] == '-' {
+		if letters, e := letterRun(text, end+1); letters != "" {
+			if ordinalSuffixes[strings.ToLower(letters)] && num == intPart {
+				return readOrdinal(num, spoken), e
+			}
+			return spoken + letters, e
+		}
+	}
+	if end < len(text) && isDigit(text[end]) {
+		return "", end
+	}
+	return spoken, end
+}
+
+// thousandsGroups counts the groups of exactly three digits that follow
+// text[i:] each after sep, and returns the end of the last one.
+func thousandsGroups(text string, i int, sep byte) (int, int) {
+	n := 0
+	for i+4 <= len(text) && text[i] == sep {
+		g, e := digitRun(text, i+1)
+		if len(g) != 3 {
+			break
+		}
+		n, i = n+1, e
+	}
+	return n, i
+}
+
+// readInteger reads a run of digits as a cardinal, or digit by digit when
+// it has a leading zero or is out of range.
+func readInteger(d string) string {
+	if len(d) > 1 && d[0] == '0' || len(d) > 18 {
+		return readDigits(d)
+	}
+	n, err := strconv.ParseInt(d, 10, 64)
+	if err != nil {
+		return readDigits(d)
+	}
+	return convert(n)
+}
+
+// readOrdinal reads a run of digits as an ordinal; fallback is used when
+// it cannot be read as one.
+func readOrdinal(d, fallback string) string {
+	n, err := strconv.ParseInt(d, 10, 64)
+	if err != nil || len(d) > 1 && d[0] == '0' {
+		return fallback
+	}
+	return convertOrdinal(n)
+}
+
+// readGroup reads a digit group of a time or phone number: leading zeros
+// are read as "sıfır" ("05" → "sıfır beş", "050" → "sıfır əlli").
+func readGroup(g string) string {
+	var words []string
+	for len(g) > 1 && g[0] == '0' {
+		words = append(words, wordZero)
+		g = g[1:]
+	}
+	words = append(words, readInteger(g))
+	return strings.Join(words, " ")
+}
+
+// readDigits reads each digit of d separately.
+func readDigits(d string) string {
+	words := make([]string, len(d))
+	for k := range len(d) {
+		words[k] = ones[d[k]-'0']
+	}
+	return strings.Join(words, " ")
+}
+
+// ── Scanning helpers ──────────────────────────────────────────────────
+
+// hyphenSuffix returns the letters of a hyphenated suffix at text[i]
+// ("-da"), without the hyphen.
+func hyphenSuffix(text string, i int) (string, int, bool) {
+	if i+1 >= len(text) || text[i] != '-' {
+		return "", i, false
+	}
+	letters, e := letterRun(text, i+1)
+	return letters, e, letters != ""
+}
+
+// digitRun returns the ASCII digits starting at text[i] and their end.
+func digitRun(text string, i int) (string, int) {
+	j := i
+	for j < len(text) && isDigit(text[j]) {
+		j++
+	}
+	return text[i:j], j
+}
+
+// letterRun returns the letters starting at text[i] and their end.
+func letterRun(text string, i int) (string, int) {
+	j := i
+	for j < len(text) {
+		r, n := utf8.DecodeRuneInString(text[j:])
+		if !unicode.IsLetter(r) {
+			break
+		}
+		j += n
+	}
+	return text[i:j], j
+}
+
+// tokenEnd reports whether a number may end at text[i]: at the end of
+// text or before a character that is not a letter or digit.
+func tokenEnd(text string, i int) bool {
+	if i >= len(text) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(text[i:])
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
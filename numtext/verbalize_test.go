@@ -0,0 +1,97 @@
+package numtext
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestVerbalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		// Dates
+		{"date dotted", "21.03.2026", "iyirmi bir mart iki min iyirmi altıncı il"},
+		{"date slashed", "1/01/2000", "bir yanvar iki mininci il"},
+		{"date ISO", "2026-03-21", "iyirmi bir mart iki min iyirmi altıncı il"},
+		{"date in text", "Görüş 09.12.2025 tarixindədir.", "Görüş doqquz dekabr iki min iyirmi beşinci il tarixindədir."},
+		{"invalid month", "21.13.2026", "iyirmi bir.on üç.iki min iyirmi altı"},
+		{"version", "1.2.3", "bir.iki.üç"},
+		{"list", "1,2,3", "bir,iki,üç"},
+
+		// Times
+		{"time", "14:30", "on dörd otuz"},
+		{"time full hour", "14:00", "on dörd"},
+		{"time leading zero", "09:05", "doqquz sıfır beş"},
+		{"time seconds", "23:59:30", "iyirmi üç əlli doqquz otuz"},
+		{"time suffix", "saat 9:30-a qədər", "saat doqquz otuza qədər"},
+		{"invalid time", "25:70", "iyirmi beş:yetmiş"},
+
+		// Phone numbers
+		{"phone international", "+994 50 123 45 67", "üstəgəl doqquz yüz doxsan dörd əlli yüz iyirmi üç qırx beş altmış yeddi"},
+		{"phone unbroken", "+994501234567", "üstəgəl doqquz yüz doxsan dörd əlli yüz iyirmi üç qırx beş altmış yeddi"},
+		{"phone national", "050-123-45-67", "sıfır əlli yüz iyirmi üç qırx beş altmış yeddi"},
+		{"phone national unbroken", "0501234567", "sıfır əlli yüz iyirmi üç qırx beş altmış yeddi"},
+		{"phone parenthesized", "(012) 498-12-34", "sıfır on iki dörd yüz doxsan səkkiz on iki otuz dörd"},
+
+		// Ordinals and suffixes
+		{"ordinal hyphen", "5-ci sinif", "beşinci sinif"},
+		{"ordinal year", "2026-cı il", "iki min iyirmi altıncı il"},
+		{"ordinal attached", "10cu mərtəbə", "onuncu mərtəbə"},
+		{"case suffix", "2026-da", "iki min iyirmi altıda"},
+
+		// Numbers
+		{"integer", "12 nəfər", "on iki nəfər"},
+		{"decimal comma", "3,5 manat", "üç tam onda beş manat"},
+		{"decimal dot", "3.14", "üç tam yüzdə on dörd"},
+		{"percent", "15%", "on beş faiz"},
+		{"thousands dot", "10.000 manat", "on min manat"},
+		{"thousands space", "2 500 manat", "iki min beş yüz manat"},
+		{"thousands decimal", "1.000.000,5", "bir milyon tam onda beş"},
+		{"range", "2-3 gün", "iki-üç gün"},
+		{"leading zero", "007", "sıfır sıfır yeddi"},
+		{"beyond range", "1234567890123456789", "bir iki üç dörd beş altı yeddi səkkiz doqquz sıfır bir iki üç dörd beş altı yeddi səkkiz doqquz"},
+
+		// Left unchanged
+		{"code letter first", "A4 kağız", "A4 kağız"},
+		{"code hyphen", "COVID-19", "COVID-19"},
+		{"code letter last", "5G şəbəkə", "5G şəbəkə"},
+		{"no digits", "Salam, dünya!", "Salam, dünya!"},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Verbalize(tc.input); got != tc.want {
+				t.Errorf("Verbalize(%q) = %q, want %q", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerbalizeOversized(t *testing.T) {
+	t.Parallel()
+
+	s := strings.Repeat("1 ", maxVerbalizeBytes/2+1)
+	if got := Verbalize(s); got != s {
+		t.Error("Verbalize of oversized input should return the input unchanged")
+	}
+}
+
+func ExampleVerbalize() {
+	fmt.Println(Verbalize("21.03.2026 saat 14:30-da, 5-ci mərtəbə"))
+	// Output:
+	// iyirmi bir mart iki min iyirmi altıncı il saat on dörd otuzda, beşinci mərtəbə
+}
+
+func BenchmarkVerbalize(b *testing.B) {
+	s := "Görüş 21.03.2026 saat 14:30-da olacaq. Zəng: +994 50 123 45 67, qiymət 15% artıb."
+	for b.Loop() {
+		Verbalize(s)
+	}
+}
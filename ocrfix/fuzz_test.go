@@ -0,0 +1,60 @@
+package ocrfix
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzFixText(f *testing.F) {
+	f.Add("Azerbaycan Respublikasinin paytaxti Baki")
+	f.Add("rnekteblerde ve rnuellim")
+	f.Add("Azərbaycan Respublikası")
+	f.Add("VE Ve ve")
+	f.Add("sosial-iqtisadl Azerbaycan'in")
+	f.Add("https://mekteb.az info@mekteb.az")
+	f.Add("iPhone x1 2024-cu")
+	f.Add("")
+	f.Add("\xff\xfe")
+	f.Add("\x00")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		got, changes := FixText(s)
+
+		last := 0
+		var b strings.Builder
+		for _, c := range changes {
+			if c.Start < last || c.End < c.Start || c.End > len(s) {
+				t.Fatalf("bad offsets %v in %q", c, s)
+			}
+			if s[c.Start:c.End] != c.Original {
+				t.Errorf("%v: input[%d:%d] = %q", c, c.Start, c.End, s[c.Start:c.End])
+			}
+			b.WriteString(s[last:c.Start])
+			b.WriteString(c.Replacement)
+			last = c.End
+		}
+		b.WriteString(s[last:])
+		if b.String() != got {
+			t.Errorf("changes do not reconstruct the result:\ninput: %q\ngot:   %q\nbuilt: %q", s, got, b.String())
+		}
+		if utf8.ValidString(s) && !utf8.ValidString(got) {
+			t.Errorf("invalid UTF-8 output for %q: %q", s, got)
+		}
+	})
+}
+
+func FuzzFixWord(f *testing.F) {
+	f.Add("rnekteblerde")
+	f.Add("AZERBAYCAN")
+	f.Add("Bakı'nin")
+	f.Add("")
+	f.Add("\xff")
+
+	f.Fuzz(func(t *testing.T, word string) {
+		got := FixWord(word)
+		if utf8.ValidString(word) && !utf8.ValidString(got) {
+			t.Errorf("invalid UTF-8 output for %q: %q", word, got)
+		}
+	})
+}
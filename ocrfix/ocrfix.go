@@ -0,0 +1,335 @@
+// Package ocrfix corrects characteristic OCR errors in Azerbaijani text.
+//
+// Recognition engines trained mostly on other languages confuse
+// Azerbaijani letters in predictable ways: ə is read as e, ı as i or l,
+// ş as s, the diacritics of ç, ğ, ö, ü are lost, and "m" is split into
+// "rn" (or the reverse). These are not the typos spell targets: a human
+// mistypes one letter, while a scanned word often carries several
+// confusions at once ("rnekteblerde" for "məktəblərdə").
+//
+// FixText finds, for every word, the readings reachable through these
+// confusions that occur in the embedded corpus frequency list, and picks
+// the most frequent one, each confusion it undoes counting as a 30-fold
+// drop in frequency. The word is replaced when it is unknown (neither in
+// the frequency list nor a valid inflection of a dictionary stem per
+// [morph.Analyze]), or when the reading outweighs it by the same measure:
+// a corpus-attested form is kept unless the corpus overwhelmingly writes
+// it the other way ("ve" → "və", "kecir" → "keçir", but "de" and "sen"
+// stay).
+//
+// Two API layers:
+//
+//   - Structured: FixText returns the corrected text together with one
+//     Change per corrected word, with byte offsets into the input.
+//   - Convenience: FixWord corrects a single word.
+//
+// All functions are safe for concurrent use by multiple goroutines.
+//
+// Known limitations:
+//
+//   - Only readings that occur in the frequency list are proposed, so a
+//     rare inflected form is left unchanged even when its stem is known.
+//   - Confusions that produce another valid word of similar frequency
+//     ("de" and "də") are not corrected; that needs sentence context.
+//   - Words with digits or mixed case ("iPhone") are left unchanged, and
+//     only the part before an apostrophe is corrected (Bakı'nın).
+//
+// Input must be Azerbaijani Latin in NFC form.
+package ocrfix
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const (
+	maxInputBytes  = 1 << 20 // 1 MiB input guard
+	maxWordBytes   = 256     // longer words are left unchanged
+	minWordRunes   = 2       // shorter words are left unchanged
+	maxHyphenParts = 8       // words with more hyphen-separated parts are left unchanged
+	maxConfusions  = 6       // confusions undone per word
+	maxSearchNodes = 20000   // search budget per word
+
+	// confusionPenalty is the frequency ratio one undone confusion costs:
+	// a reading replaces a word attested in the corpus only if it is this
+	// many times more frequent per confusion.
+	confusionPenalty = 30
+
+	// inflectionFreq is the frequency assumed for a valid inflection that
+	// does not occur in the corpus.
+	inflectionFreq = 10
+)
+
+// confusions maps each lowercase letter to the letters OCR mistakes it
+// for. Reading one for the other is the same confusion in reverse, so the
+// table is symmetric. The "rn" ↔ "m" confusion is handled separately.
+var confusions = map[rune][]rune{
+	'e': {'ə'},
+	'ə': {'e'},
+	'i': {'ı', 'l'},
+	'ı': {'i', 'l'},
+	'l': {'ı', 'i'},
+	's': {'ş'},
+	'ş': {'s'},
+	'c': {'ç'},
+	'ç': {'c'},
+	'g': {'ğ'},
+	'ğ': {'g'},
+	'o': {'ö'},
+	'ö': {'o'},
+	'u': {'ü'},
+	'ü': {'u'},
+}
+
+// Change records one correction made by FixText. Start and End are byte
+// offsets into the original text, so text[Start:End] == Original.
+type Change struct {
+	Start       int    `json:"start"`       // byte offset in the original text, inclusive
+	End         int    `json:"end"`         // byte offset in the original text, exclusive
+	Original    string `json:"original"`    // word as recognized
+	Replacement string `json:"replacement"` // corrected word
+}
+
+// String returns a debug representation, e.g. Change("ve"→"və")[10:12].
+func (c Change) String() string {
+	return fmt.Sprintf("Change(%q→%q)[%d:%d]", c.Original, c.Replacement, c.Start, c.End)
+}
+
+// lexicon holds the corpus frequencies and the sorted word list used to
+// prune readings that no word starts with.
+type lexicon struct {
+	freq    map[string]int64
+	words   []string // sorted
+	maxFreq int64
+}
+
+// loadLexicon parses the embedded spell frequency list on first use.
+var loadLexicon = sync.OnceValue(func() *lexicon {
+	lines := bytes.Split(data.SpellFreq, []byte("\n"))
+	lx := &lexicon{
+		freq:  make(map[string]int64, len(lines)),
+		words: make([]string, 0, len(lines)),
+	}
+	for _, line := range lines {
+		sp := bytes.LastIndexByte(line, ' ')
+		if sp <= 0 {
+			continue
+		}
+		freq, err := strconv.ParseInt(string(line[sp+1:]), 10, 64)
+		if err != nil || freq < 0 {
+			continue
+		}
+		word := string(line[:sp])
+		if _, dup := lx.freq[word]; !dup {
+			lx.words = append(lx.words, word)
+		}
+		lx.freq[word] = freq
+		lx.maxFreq = max(lx.maxFreq, freq)
+	}
+	slices.Sort(lx.words)
+	return lx
+})
+
+// hasPrefix reports whether some word in the lexicon starts with prefix.
+func (lx *lexicon) hasPrefix(prefix string) bool {
+	i, _ := slices.BinarySearch(lx.words, prefix)
+	return i < len(lx.words) && strings.HasPrefix(lx.words[i], prefix)
+}
+
+// FixText corrects OCR confusions in every word of text. It returns the
+// corrected text and one Change per corrected word, sorted by Start, with
+// offsets into text. Returns text and nil changes when nothing needs
+// correcting, or for empty or oversized (>1 MiB) input.
+func FixText(text string) (string, []Change) {
+	if text == "" || len(text) > maxInputBytes {
+		return text, nil
+	}
+
+	var changes []Change
+	for _, tok := range tokenizer.WordTokens(text) {
+		if tok.Type != tokenizer.Word {
+			continue
+		}
+		if fixed := fixToken(tok.Text); fixed != tok.Text {
+			changes = append(changes, Change{
+				Start:       tok.Start,
+				End:         tok.End,
+				Original:    tok.Text,
+				Replacement: fixed,
+			})
+		}
+	}
+	if len(changes) == 0 {
+		return text, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+	last := 0
+	for _, c := range changes {
+		b.WriteString(text[last:c.Start])
+		b.WriteString(c.Replacement)
+		last = c.End
+	}
+	b.WriteString(text[last:])
+	return b.String(), changes
+}
+
+// FixWord returns word with its OCR confusions corrected, as FixText
+// corrects each word, or word unchanged when no correction applies.
+// Preserves the case pattern of the input (title-case, all-upper,
+// lowercase).
+func FixWord(word string) string {
+	if word == "" || len(word) > maxWordBytes {
+		return word
+	}
+	return fixToken(azcase.ComposeNFC(word))
+}
+
+// fixToken corrects each hyphen-separated part of a word token; of a part
+// with an apostrophe, only the stem before it.
+func fixToken(word string) string {
+	if len(word) > maxWordBytes {
+		return word
+	}
+	parts := strings.Split(word, "-")
+	if len(parts) > maxHyphenParts {
+		return word
+	}
+	for i, part := range parts {
+		stem, suffix := part, ""
+		if j := strings.IndexFunc(part, azcase.IsApostrophe); j > 0 {
+			stem, suffix = part[:j], part[j:]
+		}
+		parts[i] = fixPart(stem) + suffix
+	}
+	return strings.Join(parts, "-")
+}
+
+// fixPart corrects a run of letters.
+func fixPart(word string) string {
+	if utf8.RuneCountInString(word) < minWordRunes || !isLetters(word) {
+		return word
+	}
+	if !azcase.IsAllUpper(word) && !azcase.IsTitleCase(word) && azcase.ToLower(word) != word {
+		return word // mixed case
+	}
+
+	lower := azcase.ToLower(word)
+	lx := loadLexicon()
+	best, ok := lx.bestReading([]rune(lower))
+	if !ok {
+		return word
+	}
+	freq, known := lx.freq[lower]
+	if !known && isValidInflection(lower) {
+		freq, known = inflectionFreq, true
+	}
+	if known && best.score <= readingScore(freq, 0) {
+		return word
+	}
+	return azcase.ApplyCase(word, best.word)
+}
+
+// reading is a spelling reachable from a word by undoing OCR confusions.
+type reading struct {
+	word  string
+	score float64 // readingScore of its frequency and confusions
+}
+
+// readingScore returns the log frequency of a reading discounted by
+// confusionPenalty for each confusion undone.
+func readingScore(freq int64, confusions int) float64 {
+	return math.Log(float64(max(freq, 1))) - float64(confusions)*math.Log(confusionPenalty)
+}
+
+// bestReading returns the lexicon word reachable from runes by undoing at
+// least one confusion with the highest readingScore.
+func (lx *lexicon) bestReading(runes []rune) (reading, bool) {
+	s := searcher{lx: lx, runes: runes, budget: maxSearchNodes}
+	s.search(0, make([]rune, 0, len(runes)+1), 0)
+	return s.best, s.found
+}
+
+// searcher walks the readings of a word depth-first, pruning prefixes
+// that start no lexicon word.
+type searcher struct {
+	lx     *lexicon
+	runes  []rune
+	budget int
+	best   reading
+	found  bool
+}
+
+func (s *searcher) search(i int, buf []rune, n int) {
+	if s.budget <= 0 {
+		return
+	}
+	s.budget--
+	if s.found && s.best.score >= readingScore(s.lx.maxFreq, n) {
+		return // no reading below here can score higher
+	}
+	prefix := string(buf)
+	if prefix != "" && !s.lx.hasPrefix(prefix) {
+		return
+	}
+	if i == len(s.runes) {
+		if freq, ok := s.lx.freq[prefix]; ok && n > 0 {
+			if score := readingScore(freq, n); !s.found || score > s.best.score {
+				s.best, s.found = reading{word: prefix, score: score}, true
+			}
+		}
+		return
+	}
+
+	r := s.runes[i]
+	s.search(i+1, append(buf, r), n)
+	if n == maxConfusions {
+		return
+	}
+	for _, alt := range confusions[r] {
+		s.search(i+1, append(buf, alt), n+1)
+	}
+	switch {
+	case r == 'r' && i+1 < len(s.runes) && s.runes[i+1] == 'n':
+		s.search(i+2, append(buf, 'm'), n+1)
+	case r == 'm':
+		s.search(i+1, append(buf, 'r', 'n'), n+1)
+	}
+}
+
+// isValidInflection reports whether the lowercase word is a dictionary
+// stem or an inflection of one.
+func isValidInflection(word string) bool {
+	if morph.IsKnownStem(word) {
+		return true
+	}
+	for _, a := range morph.Analyze(word) {
+		if len(a.Morphemes) > 0 && morph.IsKnownStem(azcase.ToLower(a.Stem)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLetters reports whether s consists of letters only.
+func isLetters(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return true
+}
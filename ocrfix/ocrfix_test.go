@@ -0,0 +1,168 @@
+package ocrfix
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFixWord(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// -- Single confusions --
+		{"ve", "və"},
+		{"Baki", "Bakı"},
+		{"kecir", "keçir"},
+		{"Aga", "Ağa"},
+		{"davarn", "davam"},
+
+		// -- Several confusions in one word --
+		{"rnekteblerde", "məktəblərdə"},
+		{"Azerbaycan", "Azərbaycan"},
+		{"muellim", "müəllim"},
+		{"isci", "işçi"},
+		{"haqqinda", "haqqında"},
+
+		// -- Case is preserved --
+		{"Ve", "Və"},
+		{"VE", "VƏ"},
+		{"MEKTEB", "MƏKTƏB"},
+		{"Musteqil", "Müstəqil"},
+
+		// -- Hyphens and apostrophes --
+		{"sosial-iqtisadl", "sosial-iqtisadi"},
+		{"Azerbaycan'in", "Azərbaycan'in"},
+
+		// -- Valid words of similar frequency are kept --
+		{"de", "de"},
+		{"sen", "sen"},
+		{"el", "el"},
+		{"deyil", "deyil"},
+
+		// -- Left unchanged --
+		{"kitab", "kitab"},
+		{"Bakı", "Bakı"},
+		{"sosial-iqtisadi", "sosial-iqtisadi"},
+		{"iPhone", "iPhone"},
+		{"x1", "x1"},
+		{"a", "a"},
+		{"XYZQW", "XYZQW"},
+		{"", ""},
+		{strings.Repeat("e", maxWordBytes+1), strings.Repeat("e", maxWordBytes+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			if got := FixWord(tt.input); got != tt.want {
+				t.Errorf("FixWord(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixWordNFC(t *testing.T) {
+	// "gözel" with a decomposed ö (o + U+0308).
+	if got, want := FixWord("go\u0308zel"), "gözəl"; got != want {
+		t.Errorf("FixWord(decomposed) = %q, want %q", got, want)
+	}
+}
+
+func TestFixText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"sentence",
+			"Azerbaycan Respublikasinin paytaxti Baki seheridir ve ora gozeldir.",
+			"Azərbaycan Respublikasının paytaxtı Bakı şəhəridir və ora gözəldir."},
+		{"rn for m", "Bu rnekteb ve rnuellim.", "Bu məktəb və müəllim."},
+		{"numbers and punctuation kept", "2024-cu il, 15 rnanat!", "2024-cu il, 15 manat!"},
+		{"URLs and emails kept", "https://mekteb.az ve info@mekteb.az", "https://mekteb.az və info@mekteb.az"},
+		{"clean text", "Azərbaycan Respublikasının paytaxtı Bakı şəhəridir.",
+			"Azərbaycan Respublikasının paytaxtı Bakı şəhəridir."},
+		{"no words", "123, 456!", "123, 456!"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, changes := FixText(tt.input)
+			if got != tt.want {
+				t.Errorf("FixText(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			for _, c := range changes {
+				if tt.input[c.Start:c.End] != c.Original {
+					t.Errorf("%v: input[%d:%d] = %q", c, c.Start, c.End, tt.input[c.Start:c.End])
+				}
+				if c.Original == c.Replacement {
+					t.Errorf("%v: replacement equals original", c)
+				}
+			}
+			if got == tt.input && changes != nil {
+				t.Errorf("FixText(%q) changes = %v, want nil", tt.input, changes)
+			}
+		})
+	}
+}
+
+func TestFixTextChanges(t *testing.T) {
+	_, got := FixText("Bu rnekteb ve ev.")
+	want := []Change{
+		{Start: 3, End: 10, Original: "rnekteb", Replacement: "məktəb"},
+		{Start: 11, End: 13, Original: "ve", Replacement: "və"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("FixText changes = %v, want %v", got, want)
+	}
+}
+
+func TestFixTextLargeInput(t *testing.T) {
+	input := strings.Repeat("ve ", maxInputBytes/3+1)
+	got, changes := FixText(input)
+	if got != input || changes != nil {
+		t.Error("FixText should return oversized input unchanged")
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	c := Change{Start: 10, End: 12, Original: "ve", Replacement: "və"}
+	if got, want := c.String(), `Change("ve"→"və")[10:12]`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func BenchmarkFixText(b *testing.B) {
+	input := strings.Repeat("Azerbaycan Respublikasinin paytaxti Baki seheridir ve rnekteblerde dersler baslayir. ", 100)
+	FixText(input) // load the lexicon outside the timed loop
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		FixText(input)
+	}
+}
+
+func ExampleFixText() {
+	fixed, changes := FixText("Baki seheri ve rnekteb")
+	fmt.Println(fixed)
+	for _, c := range changes {
+		fmt.Println(c.Start, c.End, c.Original, "→", c.Replacement)
+	}
+	// Output:
+	// Bakı şəhəri və məktəb
+	// 0 4 Baki → Bakı
+	// 5 11 seheri → şəhəri
+	// 12 14 ve → və
+	// 15 22 rnekteb → məktəb
+}
+
+func ExampleFixWord() {
+	fmt.Println(FixWord("rnekteblerde"))
+	fmt.Println(FixWord("AZERBAYCAN"))
+	// Output:
+	// məktəblərdə
+	// AZƏRBAYCAN
+}
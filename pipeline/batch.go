@@ -0,0 +1,187 @@
+// Concurrent processing of many documents with one Pipeline.
+package pipeline
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Result is the output of ProcessStream for one document.
+type Result struct {
+	Index    int           // Position of the document in the input, from 0
+	Document Document      // Pipeline output for the document
+	Elapsed  time.Duration // Time spent processing the document
+}
+
+// Stats summarizes a ProcessBatch or ProcessStream run.
+type Stats struct {
+	Documents int           `json:"documents"` // Documents processed
+	Bytes     int64         `json:"bytes"`     // Total size of the processed documents
+	Workers   int           `json:"workers"`   // Number of concurrent workers
+	Elapsed   time.Duration `json:"elapsed"`   // Wall-clock time of the run
+}
+
+// DocsPerSecond returns the number of documents processed per second,
+// or 0 for an empty run.
+func (s Stats) DocsPerSecond() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Documents) / s.Elapsed.Seconds()
+}
+
+// BytesPerSecond returns the number of input bytes processed per second,
+// or 0 for an empty run.
+func (s Stats) BytesPerSecond() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Bytes) / s.Elapsed.Seconds()
+}
+
+// BatchOption configures ProcessBatch and ProcessStream.
+type BatchOption func(*batchOptions)
+
+type batchOptions struct {
+	workers int
+	stats   *Stats
+}
+
+// WithWorkers sets the number of documents processed concurrently.
+// n <= 0 selects runtime.GOMAXPROCS(0), the default.
+func WithWorkers(n int) BatchOption {
+	return func(o *batchOptions) { o.workers = n }
+}
+
+// WithStats stores the statistics of the run in *s when it completes:
+// when ProcessBatch returns, or before ProcessStream closes its result
+// channel.
+func WithStats(s *Stats) BatchOption {
+	return func(o *batchOptions) { o.stats = s }
+}
+
+// newBatchOptions applies opts over the defaults.
+func newBatchOptions(opts []BatchOption) batchOptions {
+	var o batchOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.workers <= 0 {
+		o.workers = runtime.GOMAXPROCS(0)
+	}
+	return o
+}
+
+// workerCounts is the share of the statistics gathered by one worker.
+type workerCounts struct {
+	docs  int
+	bytes int64
+}
+
+// report sums the counts of all workers into *o.stats, if set.
+func (o batchOptions) report(counts []workerCounts, start time.Time) {
+	if o.stats == nil {
+		return
+	}
+	s := Stats{Workers: len(counts), Elapsed: time.Since(start)}
+	for _, c := range counts {
+		s.Documents += c.docs
+		s.Bytes += c.bytes
+	}
+	*o.stats = s
+}
+
+// ProcessBatch runs the pipeline over texts concurrently and returns the
+// documents in input order: the result at index i is Process(texts[i]).
+// At most len(texts) workers are started.
+func (p *Pipeline) ProcessBatch(texts []string, opts ...BatchOption) []Document {
+	o := newBatchOptions(opts)
+	start := time.Now()
+	docs := make([]Document, len(texts))
+	counts := make([]workerCounts, min(o.workers, len(texts)))
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := range counts {
+		wg.Go(func() {
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(texts) {
+					return
+				}
+				docs[i] = p.Process(texts[i])
+				counts[w].docs++
+				counts[w].bytes += int64(len(texts[i]))
+			}
+		})
+	}
+	wg.Wait()
+
+	o.report(counts, start)
+	return docs
+}
+
+// ProcessStream runs the pipeline over the texts received from in
+// concurrently and sends one Result per text on the returned channel, in
+// completion order; Result.Index gives the position of the text in the
+// input. The channel is closed once in is closed and every text has been
+// processed, or when ctx is canceled; texts still queued are then
+// dropped. The caller must drain the channel or cancel ctx.
+func (p *Pipeline) ProcessStream(ctx context.Context, in <-chan string, opts ...BatchOption) <-chan Result {
+	o := newBatchOptions(opts)
+	start := time.Now()
+
+	type job struct {
+		index int
+		text  string
+	}
+	jobs := make(chan job)
+	out := make(chan Result, o.workers)
+	counts := make([]workerCounts, o.workers)
+
+	go func() {
+		defer close(jobs)
+		for i := 0; ; i++ {
+			select {
+			case text, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case jobs <- job{i, text}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := range counts {
+		wg.Go(func() {
+			for j := range jobs {
+				t := time.Now()
+				r := Result{Index: j.index, Document: p.Process(j.text), Elapsed: time.Since(t)}
+				select {
+				case out <- r:
+					counts[w].docs++
+					counts[w].bytes += int64(len(j.text))
+				case <-ctx.Done():
+					return
+				}
+			}
+		})
+	}
+
+	go func() {
+		wg.Wait()
+		o.report(counts, start)
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,147 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+var batchTexts = []string{
+	sample,
+	"Azerbaycan gozel olkedir.",
+	"",
+	"Bakı 2025-ci ildə böyüdü.",
+	strings.Repeat("a", maxInputBytes+1),
+	"Xidmet ela idi.",
+}
+
+func TestProcessBatch(t *testing.T) {
+	t.Parallel()
+	p := New(Normalize, Stems, NER, Sentiment)
+	for _, workers := range []int{0, 1, 3, 100} {
+		var st Stats
+		docs := p.ProcessBatch(batchTexts, WithWorkers(workers), WithStats(&st))
+		if len(docs) != len(batchTexts) {
+			t.Fatalf("workers=%d: got %d documents, want %d", workers, len(docs), len(batchTexts))
+		}
+		for i, text := range batchTexts {
+			if want := p.Process(text); !reflect.DeepEqual(docs[i], want) {
+				t.Errorf("workers=%d: docs[%d] = %+v, want %+v", workers, i, docs[i], want)
+			}
+		}
+		if st.Documents != len(batchTexts) {
+			t.Errorf("workers=%d: Stats.Documents = %d, want %d", workers, st.Documents, len(batchTexts))
+		}
+		var bytes int64
+		for _, text := range batchTexts {
+			bytes += int64(len(text))
+		}
+		if st.Bytes != bytes {
+			t.Errorf("workers=%d: Stats.Bytes = %d, want %d", workers, st.Bytes, bytes)
+		}
+		wantWorkers := workers
+		if workers <= 0 {
+			wantWorkers = runtime.GOMAXPROCS(0)
+		}
+		if wantWorkers = min(wantWorkers, len(batchTexts)); st.Workers != wantWorkers {
+			t.Errorf("workers=%d: Stats.Workers = %d, want %d", workers, st.Workers, wantWorkers)
+		}
+		if st.Elapsed <= 0 || st.DocsPerSecond() <= 0 || st.BytesPerSecond() <= 0 {
+			t.Errorf("workers=%d: Stats = %+v, want positive throughput", workers, st)
+		}
+	}
+}
+
+func TestProcessBatchEmpty(t *testing.T) {
+	t.Parallel()
+	var st Stats
+	if docs := New().ProcessBatch(nil, WithStats(&st)); len(docs) != 0 {
+		t.Errorf("ProcessBatch(nil) = %v, want empty", docs)
+	}
+	if st.Documents != 0 || st.Workers != 0 {
+		t.Errorf("Stats = %+v, want no documents or workers", st)
+	}
+}
+
+func TestStatsZeroElapsed(t *testing.T) {
+	t.Parallel()
+	s := Stats{Documents: 3, Bytes: 10}
+	if s.DocsPerSecond() != 0 || s.BytesPerSecond() != 0 {
+		t.Errorf("Stats with zero Elapsed: got %v docs/s, %v B/s, want 0", s.DocsPerSecond(), s.BytesPerSecond())
+	}
+}
+
+func TestProcessStream(t *testing.T) {
+	t.Parallel()
+	p := New(Stems, NER)
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, text := range batchTexts {
+			in <- text
+		}
+	}()
+
+	var st Stats
+	got := make([]Document, len(batchTexts))
+	seen := make([]bool, len(batchTexts))
+	for r := range p.ProcessStream(context.Background(), in, WithWorkers(3), WithStats(&st)) {
+		if r.Index < 0 || r.Index >= len(batchTexts) || seen[r.Index] {
+			t.Fatalf("unexpected Result.Index %d", r.Index)
+		}
+		seen[r.Index] = true
+		got[r.Index] = r.Document
+	}
+	for i, text := range batchTexts {
+		if want := p.Process(text); !seen[i] || !reflect.DeepEqual(got[i], want) {
+			t.Errorf("document %d = %+v, want %+v", i, got[i], want)
+		}
+	}
+	if st.Documents != len(batchTexts) || st.Workers != 3 {
+		t.Errorf("Stats = %+v, want %d documents and 3 workers", st, len(batchTexts))
+	}
+}
+
+func TestProcessStreamCancel(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string) // never closed
+	out := New().ProcessStream(ctx, in, WithWorkers(2))
+	in <- sample
+	<-out
+	cancel()
+	for range out {
+		// Drain: the channel must be closed after cancellation.
+	}
+}
+
+func BenchmarkProcessBatch(b *testing.B) {
+	p := New(Normalize, Stems, NER, Keywords, Sentiment)
+	texts := make([]string, 64)
+	var size int64
+	for i := range texts {
+		texts[i] = strings.Repeat(sample+" ", 10)
+		size += int64(len(texts[i]))
+	}
+	b.SetBytes(size)
+	for b.Loop() {
+		p.ProcessBatch(texts)
+	}
+}
+
+func ExamplePipeline_ProcessBatch() {
+	p := New(Normalize, Stems)
+	var st Stats
+	docs := p.ProcessBatch([]string{"Azerbaycan gozel seherdir.", "Xidmet ela idi."}, WithWorkers(2), WithStats(&st))
+	for _, doc := range docs {
+		fmt.Println(doc.Text, doc.Stems)
+	}
+	fmt.Println(st.Documents, st.Workers)
+	// Output:
+	// Azərbaycan gözəl seherdir. [Azərbaycan gözəl seher]
+	// Xidmət əla idi. [Xidmət əla idi]
+	// 2 2
+}
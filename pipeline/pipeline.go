@@ -0,0 +1,187 @@
+// Package pipeline runs several analysis modules over a text in one call.
+//
+// A Pipeline is built from the steps it should run:
+//
+//	p := pipeline.New(pipeline.Normalize, pipeline.Stems, pipeline.NER, pipeline.Sentiment)
+//	doc := p.Process(text)
+//
+// Tokenization always runs: every Document carries the word tokens
+// (tokenizer.WordTokens) and sentences (tokenizer.SentenceTokens) of the
+// processed text. The optional steps are:
+//
+//   - Normalize: normalize.New().NormalizeWithMap runs first; every later
+//     step sees the normalized text, and Document.Map maps its offsets
+//     back to the input.
+//   - Stems: morph.Stem of each Word token, reusing the tokens above.
+//   - NER: ner.Recognize, which matches patterns on the text and needs no
+//     tokens.
+//   - Keywords: keywords.ExtractFromStems over the stems above with the
+//     default limit, so the text is not tokenized or stemmed again. It
+//     skips the diacritic restoration of keywords.ExtractTextRank; run
+//     Normalize for the same effect.
+//   - Sentiment: sentiment.AnalyzeTokens over the tokens above; it stems
+//     the words itself, after its own per-word normalization.
+//
+// All byte offsets in a Document (tokens, sentences, entities) refer to
+// Document.Text.
+//
+// ProcessBatch and ProcessStream run a Pipeline over many documents
+// concurrently, one document per worker (runtime.GOMAXPROCS by default,
+// see WithWorkers), and WithStats reports the throughput of the run.
+//
+// A Pipeline is immutable and safe for concurrent use by multiple
+// goroutines.
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/az-ai-labs/az-lang-nlp/keywords"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/normalize"
+	"github.com/az-ai-labs/az-lang-nlp/sentiment"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// maxInputBytes is the maximum input size. Larger inputs return a zero Document.
+const maxInputBytes = 1 << 20 // 1 MiB
+
+// Step is an optional processing step of a Pipeline.
+type Step int
+
+const (
+	Normalize Step = iota // Diacritic restoration and NFC composition before tokenization
+	Stems                 // Stem of every Word token
+	NER                   // Named entities
+	Keywords              // Top keywords by TextRank
+	Sentiment             // Document sentiment
+)
+
+// stepNames maps Step values to their string representations.
+var stepNames = [...]string{
+	Normalize: "Normalize",
+	Stems:     "Stems",
+	NER:       "NER",
+	Keywords:  "Keywords",
+	Sentiment: "Sentiment",
+}
+
+// stepFromName maps string representations back to Step values.
+var stepFromName = map[string]Step{
+	"Normalize": Normalize,
+	"Stems":     Stems,
+	"NER":       NER,
+	"Keywords":  Keywords,
+	"Sentiment": Sentiment,
+}
+
+// String returns the name of the step (e.g. "NER").
+func (s Step) String() string {
+	if int(s) >= 0 && int(s) < len(stepNames) {
+		return stepNames[s]
+	}
+	return fmt.Sprintf("Step(%d)", int(s))
+}
+
+// MarshalJSON encodes the step as a JSON string (e.g. "NER").
+func (s Step) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "NER") into a Step.
+func (s *Step) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	v, ok := stepFromName[name]
+	if !ok {
+		return fmt.Errorf("pipeline: unknown step: %q", name)
+	}
+	*s = v
+	return nil
+}
+
+// Document is the combined output of a Pipeline. Fields of steps that
+// did not run are nil.
+type Document struct {
+	Text      string              `json:"text"`                // Processed text; normalized when Normalize ran
+	Map       normalize.OffsetMap `json:"-"`                   // Maps offsets in Text back to the input; identity unless Normalize ran
+	Tokens    []tokenizer.Token   `json:"tokens"`              // Word-level tokens of Text
+	Sentences []tokenizer.Token   `json:"sentences"`           // Sentences of Text
+	Stems     []string            `json:"stems,omitempty"`     // Stem of each Word token, in order
+	Entities  []ner.Entity        `json:"entities,omitempty"`  // Named entities, sorted by Start
+	Keywords  []keywords.Keyword  `json:"keywords,omitempty"`  // Top keywords, highest score first
+	Sentiment *sentiment.Result   `json:"sentiment,omitempty"` // Document sentiment
+}
+
+// Pipeline runs a fixed set of steps over texts.
+// The zero value runs tokenization only.
+type Pipeline struct {
+	steps [len(stepNames)]bool
+}
+
+// New returns a Pipeline running the given steps. Steps always run in
+// declaration order (Normalize first) regardless of argument order;
+// duplicates and unknown steps are ignored.
+func New(steps ...Step) *Pipeline {
+	p := &Pipeline{}
+	for _, s := range steps {
+		if int(s) >= 0 && int(s) < len(p.steps) {
+			p.steps[s] = true
+		}
+	}
+	return p
+}
+
+// Has reports whether the pipeline runs step s.
+func (p *Pipeline) Has(s Step) bool {
+	return int(s) >= 0 && int(s) < len(p.steps) && p.steps[s]
+}
+
+// Process runs the pipeline over text.
+// Returns a zero Document for empty or oversized (>1 MiB) input.
+func (p *Pipeline) Process(text string) Document {
+	if text == "" || len(text) > maxInputBytes {
+		return Document{}
+	}
+
+	doc := Document{Text: text}
+	if p.Has(Normalize) {
+		doc.Text, doc.Map = normalize.New().NormalizeWithMap(text)
+	}
+	doc.Tokens = tokenizer.WordTokens(doc.Text)
+	doc.Sentences = tokenizer.SentenceTokens(doc.Text)
+
+	var stems []string
+	if p.Has(Stems) || p.Has(Keywords) {
+		stems = wordStems(doc.Tokens)
+	}
+	if p.Has(Stems) {
+		doc.Stems = stems
+	}
+	if p.Has(NER) {
+		doc.Entities = ner.Recognize(doc.Text)
+	}
+	if p.Has(Keywords) {
+		doc.Keywords = keywords.ExtractFromStems(stems, nil, 0)
+	}
+	if p.Has(Sentiment) {
+		r := sentiment.AnalyzeTokens(doc.Text, doc.Tokens)
+		doc.Sentiment = &r
+	}
+	return doc
+}
+
+// wordStems returns morph.Stem of each Word token, in order.
+func wordStems(tokens []tokenizer.Token) []string {
+	stems := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		if tok.Type == tokenizer.Word {
+			stems = append(stems, morph.Stem(tok.Text))
+		}
+	}
+	return stems
+}
@@ -0,0 +1,212 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/keywords"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/sentiment"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const sample = "Bu kitab çox yaxşıdır. Əlaqə: +994501234567, info@gov.az"
+
+func TestProcessTokenizationOnly(t *testing.T) {
+	t.Parallel()
+	doc := New().Process(sample)
+	if doc.Text != sample {
+		t.Errorf("Text = %q, want %q", doc.Text, sample)
+	}
+	if !reflect.DeepEqual(doc.Tokens, tokenizer.WordTokens(sample)) {
+		t.Errorf("Tokens = %v", doc.Tokens)
+	}
+	if !reflect.DeepEqual(doc.Sentences, tokenizer.SentenceTokens(sample)) {
+		t.Errorf("Sentences = %v", doc.Sentences)
+	}
+	if doc.Stems != nil || doc.Entities != nil || doc.Keywords != nil || doc.Sentiment != nil {
+		t.Errorf("disabled steps produced output: %+v", doc)
+	}
+}
+
+func TestProcessAllSteps(t *testing.T) {
+	t.Parallel()
+	doc := New(Stems, NER, Keywords, Sentiment).Process(sample)
+
+	if want := morph.Stems(tokenizer.Words(sample)); !reflect.DeepEqual(doc.Stems, want) {
+		t.Errorf("Stems = %v, want %v", doc.Stems, want)
+	}
+	if want := ner.Recognize(sample); !reflect.DeepEqual(doc.Entities, want) {
+		t.Errorf("Entities = %v, want %v", doc.Entities, want)
+	}
+	if want := keywords.ExtractTextRank(sample, 0); !reflect.DeepEqual(doc.Keywords, want) {
+		t.Errorf("Keywords = %v, want %v", doc.Keywords, want)
+	}
+	if want := sentiment.Analyze(sample); doc.Sentiment == nil || !reflect.DeepEqual(*doc.Sentiment, want) {
+		t.Errorf("Sentiment = %v, want %v", doc.Sentiment, want)
+	}
+}
+
+func TestProcessKeywordsWithoutStems(t *testing.T) {
+	t.Parallel()
+	doc := New(Keywords).Process(sample)
+	if doc.Stems != nil {
+		t.Errorf("Stems = %v, want nil", doc.Stems)
+	}
+	want := keywords.ExtractFromStems(morph.Stems(tokenizer.Words(sample)), nil, 0)
+	if len(doc.Keywords) == 0 || !reflect.DeepEqual(doc.Keywords, want) {
+		t.Errorf("Keywords = %v, want %v", doc.Keywords, want)
+	}
+}
+
+func TestProcessNormalize(t *testing.T) {
+	t.Parallel()
+	const input = "Azerbaycan gozel seherdir."
+	doc := New(Normalize, Stems).Process(input)
+	if doc.Text != "Azərbaycan gözəl seherdir." {
+		t.Fatalf("Text = %q", doc.Text)
+	}
+	for _, tok := range doc.Tokens {
+		if doc.Text[tok.Start:tok.End] != tok.Text {
+			t.Errorf("token %v does not match Text", tok)
+		}
+	}
+	// "gözəl" maps back to "gozel" in the input.
+	start, end := doc.Map.OriginalRange(doc.Tokens[2].Start, doc.Tokens[2].End)
+	if got := input[start:end]; got != "gozel" {
+		t.Errorf("OriginalRange(%v) = %q, want %q", doc.Tokens[2], got, "gozel")
+	}
+	if len(doc.Stems) != 3 || doc.Stems[1] != "gözəl" {
+		t.Errorf("Stems = %v", doc.Stems)
+	}
+}
+
+func TestProcessEmptyAndOversized(t *testing.T) {
+	t.Parallel()
+	p := New(Normalize, Stems, NER, Keywords, Sentiment)
+	for _, input := range []string{"", strings.Repeat("a", maxInputBytes+1)} {
+		if doc := p.Process(input); !reflect.DeepEqual(doc, Document{}) {
+			t.Errorf("Process(len %d) = non-zero Document", len(input))
+		}
+	}
+}
+
+func TestNewIgnoresUnknownSteps(t *testing.T) {
+	t.Parallel()
+	p := New(Step(-1), Step(99), NER, NER)
+	for s := range Step(len(stepNames)) {
+		if got, want := p.Has(s), s == NER; got != want {
+			t.Errorf("Has(%v) = %v, want %v", s, got, want)
+		}
+	}
+	if p.Has(Step(99)) {
+		t.Error("Has(Step(99)) = true")
+	}
+}
+
+func TestStepString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		s    Step
+		want string
+	}{
+		{Normalize, "Normalize"},
+		{Stems, "Stems"},
+		{NER, "NER"},
+		{Keywords, "Keywords"},
+		{Sentiment, "Sentiment"},
+		{Step(99), "Step(99)"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("Step(%d).String() = %q, want %q", int(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestStepJSON(t *testing.T) {
+	t.Parallel()
+	data, err := json.Marshal([]Step{Normalize, Sentiment})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `["Normalize","Sentiment"]` {
+		t.Errorf("Marshal = %s", data)
+	}
+	var steps []Step
+	if err := json.Unmarshal(data, &steps); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(steps, []Step{Normalize, Sentiment}) {
+		t.Errorf("Unmarshal = %v", steps)
+	}
+	var s Step
+	if err := json.Unmarshal([]byte(`"Parse"`), &s); err == nil {
+		t.Error("Unmarshal unknown step: want error")
+	}
+}
+
+func TestDocumentJSON(t *testing.T) {
+	t.Parallel()
+	data, err := json.Marshal(New(NER).Process(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"text", "tokens", "sentences", "entities"} {
+		if _, ok := fields[key]; !ok {
+			t.Errorf("missing field %q in %s", key, data)
+		}
+	}
+	for _, key := range []string{"stems", "keywords", "sentiment"} {
+		if _, ok := fields[key]; ok {
+			t.Errorf("unexpected field %q for disabled step", key)
+		}
+	}
+}
+
+func TestConcurrentSafety(t *testing.T) {
+	t.Parallel()
+	p := New(Normalize, Stems, NER, Keywords, Sentiment)
+	want := p.Process(sample)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			if got := p.Process(sample); !reflect.DeepEqual(got.Stems, want.Stems) {
+				t.Errorf("concurrent Process: Stems = %v, want %v", got.Stems, want.Stems)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func BenchmarkProcess(b *testing.B) {
+	p := New(Normalize, Stems, NER, Keywords, Sentiment)
+	input := strings.Repeat(sample+" ", 100)
+	b.SetBytes(int64(len(input)))
+	for b.Loop() {
+		p.Process(input)
+	}
+}
+
+func ExamplePipeline_Process() {
+	p := New(Normalize, Stems, NER, Sentiment)
+	doc := p.Process("Xidmet ela idi. Elaqe: info@gov.az")
+	fmt.Println(doc.Text)
+	fmt.Println(doc.Stems)
+	fmt.Println(doc.Entities)
+	fmt.Println(doc.Sentiment.Sentiment)
+	// Output:
+	// Xidmət əla idi. Əlaqə: info@gov.az
+	// [Xidmət əla idi Əlaqə]
+	// [Email("info@gov.az")[27:38]]
+	// Positive
+}
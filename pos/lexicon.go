@@ -0,0 +1,365 @@
+package pos
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/numtext"
+)
+
+// ambiguity marks closed-class words whose tag depends on context.
+type ambiguity int
+
+const (
+	unambiguous   ambiguity = iota
+	demonstrative           // DET before a nominal, PRON otherwise (bu, o)
+	article                 // DET before a nominal, NUM otherwise (bir)
+	postposition            // ADP after a nominal, the lexical tag otherwise (sonra, kimi)
+)
+
+// tokenInfo is the lexical analysis of a token, refined by disambiguate.
+type tokenInfo struct {
+	tag       UPOS
+	lemma     string
+	amb       ambiguity
+	bare      bool // open-class word with no suffixes
+	cased     bool // carries a case suffix (oblique pronoun, noun or participle in a case)
+	capital   bool // first letter is uppercase
+	acronym   bool // two or more letters, all uppercase (ARDNŞ)
+	dictEntry bool // lemma was found in the morph dictionary
+}
+
+// closedEntry is a closed-class word.
+type closedEntry struct {
+	tag   UPOS
+	lemma string // lemma when it differs from the word
+	amb   ambiguity
+	cased bool
+}
+
+// closedClass maps lowercase closed-class words to their tags.
+var closedClass = map[string]closedEntry{
+	// Coordinating conjunctions
+	"və": {tag: CConj}, "ya": {tag: CConj}, "yaxud": {tag: CConj}, "yoxsa": {tag: CConj},
+	"amma": {tag: CConj}, "lakin": {tag: CConj}, "fəqət": {tag: CConj}, "ancaq": {tag: CConj},
+	"həm": {tag: CConj},
+
+	// Subordinating conjunctions
+	"ki": {tag: SConj}, "çünki": {tag: SConj}, "əgər": {tag: SConj}, "madam": {tag: SConj},
+	"sanki": {tag: SConj}, "guya": {tag: SConj},
+
+	// Postpositions
+	"ilə": {tag: Adp}, "üçün": {tag: Adp}, "haqqında": {tag: Adp}, "barədə": {tag: Adp},
+	"tərəfindən": {tag: Adp}, "ərzində": {tag: Adp}, "üzrə": {tag: Adp}, "dək": {tag: Adp},
+	"ötrü": {tag: Adp}, "savayı": {tag: Adp}, "görə": {tag: Adp}, "boyu": {tag: Adp},
+	"sonra": {tag: Adv, amb: postposition}, "əvvəl": {tag: Adv, amb: postposition},
+	"qədər": {tag: Adv, amb: postposition}, "kimi": {tag: Pron, lemma: "kim", amb: postposition, cased: true},
+	"qarşı": {tag: Adv, amb: postposition}, "doğru": {tag: Adj, amb: postposition},
+	"başqa": {tag: Adj, amb: postposition},
+
+	// Particles
+	"da": {tag: Part}, "də": {tag: Part}, "mi": {tag: Part}, "mı": {tag: Part},
+	"mu": {tag: Part}, "mü": {tag: Part}, "hətta": {tag: Part}, "yalnız": {tag: Part},
+	"məhz": {tag: Part}, "axı": {tag: Part}, "bəs": {tag: Part}, "kaş": {tag: Part},
+	"qoy": {tag: Part}, "məgər": {tag: Part},
+
+	// Auxiliaries
+	"deyil": {tag: Aux}, "idi": {tag: Aux, lemma: "i"}, "imiş": {tag: Aux, lemma: "i"},
+	"isə": {tag: Aux, lemma: "i"}, "ikən": {tag: Aux, lemma: "i"},
+	"idim": {tag: Aux, lemma: "i"}, "idin": {tag: Aux, lemma: "i"}, "idik": {tag: Aux, lemma: "i"},
+	"idiniz": {tag: Aux, lemma: "i"}, "idilər": {tag: Aux, lemma: "i"},
+
+	// Interjections
+	"bəli": {tag: Intj}, "xeyr": {tag: Intj}, "hə": {tag: Intj}, "salam": {tag: Intj},
+	"ah": {tag: Intj}, "oh": {tag: Intj}, "vay": {tag: Intj}, "ura": {tag: Intj},
+	"afərin": {tag: Intj},
+
+	// Determiners
+	"həmin": {tag: Det}, "hər": {tag: Det}, "bütün": {tag: Det}, "bəzi": {tag: Det},
+	"heç": {tag: Det}, "hansı": {tag: Det}, "neçə": {tag: Det}, "digər": {tag: Det},
+	"bu": {tag: Pron, amb: demonstrative}, "o": {tag: Pron, amb: demonstrative},
+	"şu": {tag: Pron, amb: demonstrative}, "bir": {tag: Num, amb: article},
+}
+
+// pronounForms lists personal, demonstrative, reflexive and interrogative
+// pronoun forms by lemma. Forms not in nominativePronouns carry a case
+// suffix.
+var pronounForms = map[string][]string{
+	"mən":   {"mən", "məni", "mənim", "mənə", "məndə", "məndən", "mənimlə"},
+	"sən":   {"sən", "səni", "sənin", "sənə", "səndə", "səndən", "səninlə"},
+	"o":     {"onu", "onun", "ona", "onda", "ondan", "onunla"},
+	"biz":   {"biz", "bizi", "bizim", "bizə", "bizdə", "bizdən", "bizimlə"},
+	"siz":   {"siz", "sizi", "sizin", "sizə", "sizdə", "sizdən", "sizinlə"},
+	"onlar": {"onlar", "onları", "onların", "onlara", "onlarda", "onlardan", "onlarla"},
+	"bu":    {"bunu", "bunun", "buna", "bunda", "bundan", "bununla"},
+	"bunlar": {"bunlar", "bunları", "bunların", "bunlara", "bunlarda", "bunlardan",
+		"bunlarla"},
+	"öz": {"özüm", "özün", "özü", "özümüz", "özünüz", "özləri", "özümü", "özünü",
+		"özünə", "özünün"},
+	"kim":  {"kim", "kimin", "kimə", "kimdə", "kimdən"},
+	"nə":   {"nə", "nəyi", "nəyin", "nəyə", "nədə", "nədən"},
+	"hamı": {"hamı", "hamını", "hamının", "hamıya"},
+}
+
+// nominativePronouns lists pronoun forms without a case suffix.
+var nominativePronouns = map[string]bool{
+	"mən": true, "sən": true, "biz": true, "siz": true, "onlar": true, "bunlar": true,
+	"özü": true, "özüm": true, "özün": true, "özümüz": true, "özünüz": true,
+	"özləri": true, "kim": true, "nə": true, "hamı": true,
+}
+
+func init() {
+	for lemma, forms := range pronounForms {
+		for _, f := range forms {
+			closedClass[f] = closedEntry{tag: Pron, lemma: lemma, cased: !nominativePronouns[f]}
+		}
+	}
+}
+
+// minAcronymRunes is the minimum length of an all-uppercase word treated
+// as an acronym rather than a capitalized single letter.
+const minAcronymRunes = 2
+
+// minUnknownChain is the minimum number of suffixes trusted as evidence
+// for a word whose stem is not in the dictionary.
+const minUnknownChain = 2
+
+// ordinalSuffixes are the ordinal endings after a consonant and after a
+// vowel, in all four harmony variants.
+var ordinalSuffixes = []string{
+	"ıncı", "inci", "uncu", "üncü",
+	"ncı", "nci", "ncu", "ncü",
+}
+
+// lexical tags a token in isolation.
+func lexical(tok string) tokenInfo {
+	if tok == "" || strings.TrimSpace(tok) == "" {
+		return tokenInfo{tag: X, lemma: tok}
+	}
+	word := azcase.ComposeNFC(tok)
+	lower := azcase.ToLower(word)
+	info := tokenInfo{
+		tag:     X,
+		lemma:   lower,
+		capital: azcase.IsTitleCase(word) || azcase.IsAllUpper(word),
+		acronym: azcase.IsAllUpper(word) && utf8.RuneCountInString(word) >= minAcronymRunes,
+	}
+
+	if !strings.ContainsFunc(word, unicode.IsLetter) {
+		info.tag = symbolTag(word)
+		return info
+	}
+	if strings.Contains(word, "://") || strings.Contains(word, "@") {
+		return info
+	}
+	if azcase.ContainsDigit(word) {
+		info.tag = numericTag(word)
+		return info
+	}
+	if e, ok := closedClass[lower]; ok {
+		info.tag, info.amb, info.cased = e.tag, e.amb, e.cased
+		if e.lemma != "" {
+			info.lemma = e.lemma
+		}
+		return info
+	}
+	if _, err := numtext.Parse(lower); err == nil {
+		info.tag = Num
+		return info
+	}
+	if isOrdinalWord(lower) {
+		info.tag = Adj
+		return info
+	}
+	// Proper nouns with an apostrophe-separated suffix (Bakı'da).
+	if i := strings.IndexFunc(word, azcase.IsApostrophe); i > 0 {
+		info.tag, info.cased = Noun, true
+		if info.capital {
+			info.tag = PropN
+		}
+		info.lemma = word[:i]
+		return info
+	}
+
+	open(word, &info)
+	return info
+}
+
+// symbolPunct lists punctuation characters that UD tags as symbols.
+const symbolPunct = "%‰#&*@§°/\\"
+
+// symbolTag tags a token without letters: numbers, punctuation, symbols.
+func symbolTag(tok string) UPOS {
+	if strings.ContainsFunc(tok, unicode.IsDigit) {
+		return numericTag(tok)
+	}
+	for _, r := range tok {
+		if !unicode.IsPunct(r) || strings.ContainsRune(symbolPunct, r) {
+			return Sym
+		}
+	}
+	return Punct
+}
+
+// numericTag tags a digit-containing token: ordinals (2026-cı) are
+// adjectives, other numbers (25, 5-də) numerals.
+func numericTag(tok string) UPOS {
+	for _, a := range morph.Analyze(tok) {
+		for _, m := range a.Morphemes {
+			if m.Tag == morph.Ordinal {
+				return Adj
+			}
+		}
+	}
+	return Num
+}
+
+// isOrdinalWord reports whether lower is a number word with an ordinal
+// suffix (birinci, ikinci, onuncu).
+func isOrdinalWord(lower string) bool {
+	for _, suf := range ordinalSuffixes {
+		base, ok := strings.CutSuffix(lower, suf)
+		if !ok || base == "" {
+			continue
+		}
+		if _, err := numtext.Parse(base); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// open tags an open-class word from its lemma and suffix chain.
+func open(word string, info *tokenInfo) {
+	lemma, p, err := morph.Lemmatize(word)
+	if err == nil {
+		info.dictEntry = true
+		info.lemma = azcase.ToLower(lemma.Form)
+		info.tag = fromMorphPOS(p)
+	} else {
+		info.tag = Noun
+	}
+
+	chain := suffixChain(word, lemma.Stem)
+	if err != nil && len(chain) < minUnknownChain {
+		// A single suffix on an unknown stem is weak evidence: loanwords
+		// such as "server" parse as serv+er.
+		chain = nil
+	}
+	info.bare = len(chain) == 0
+	for _, m := range chain {
+		switch {
+		case isCase(m.Tag):
+			info.cased = true
+		case isVerbal(m.Tag):
+			info.tag = Verb
+		}
+	}
+	if info.tag == Verb {
+		return
+	}
+	if last := lastDerivation(chain); last != 0 {
+		switch last {
+		case morph.DerivPriv, morph.DerivPoss:
+			info.tag = Adj
+		case morph.DerivAgent, morph.DerivAbstract:
+			info.tag = Noun
+		case morph.DerivVerb:
+			info.tag = Verb
+			return
+		}
+	}
+	// Adjectives and adverbs with nominal inflection are used as nouns
+	// (gözəllər, yaxşını).
+	if (info.tag == Adj || info.tag == Adv) && hasNominalInflection(chain) {
+		info.tag = Noun
+	}
+	if info.tag == X {
+		info.tag = Noun
+	}
+}
+
+// suffixChain returns the morphemes of the analysis of word whose stem is
+// stem (matched case-insensitively, allowing a softened final consonant:
+// ayağ for ayaq). Without a dictionary stem, the first analysis with
+// morphemes is used.
+func suffixChain(word, stem string) []morph.Morpheme {
+	analyses := morph.Analyze(word)
+	if stem == "" {
+		for _, a := range analyses {
+			if len(a.Morphemes) > 0 {
+				return a.Morphemes
+			}
+		}
+		return nil
+	}
+	want := azcase.ToLower(stem)
+	for _, a := range analyses {
+		if sameStem(azcase.ToLower(a.Stem), want) {
+			return a.Morphemes
+		}
+	}
+	return nil
+}
+
+// sameStem reports whether an analysis stem matches a dictionary stem,
+// ignoring a final consonant alternation.
+func sameStem(got, want string) bool {
+	if got == want {
+		return true
+	}
+	g, w := []rune(got), []rune(want)
+	return len(g) == len(w) && len(g) > 1 && string(g[:len(g)-1]) == string(w[:len(w)-1])
+}
+
+// fromMorphPOS maps a morph dictionary category to a UPOS tag.
+func fromMorphPOS(p morph.POS) UPOS {
+	switch p {
+	case morph.Noun:
+		return Noun
+	case morph.Verb:
+		return Verb
+	case morph.Adjective:
+		return Adj
+	case morph.Adverb:
+		return Adv
+	default:
+		return X
+	}
+}
+
+// isCase reports whether t is a case suffix.
+func isCase(t morph.MorphTag) bool {
+	return t >= morph.CaseGen && t <= morph.CaseIns
+}
+
+// isVerbal reports whether t only attaches to verbs: voice, negation,
+// tense, mood, participle and person suffixes.
+func isVerbal(t morph.MorphTag) bool {
+	return t >= morph.VoicePass && t <= morph.Pers3
+}
+
+// hasNominalInflection reports whether chain has a plural, possessive, or
+// case suffix.
+func hasNominalInflection(chain []morph.Morpheme) bool {
+	for _, m := range chain {
+		if m.Tag >= morph.Plural && m.Tag <= morph.CaseIns {
+			return true
+		}
+	}
+	return false
+}
+
+// lastDerivation returns the last derivational suffix in chain, or 0.
+func lastDerivation(chain []morph.Morpheme) morph.MorphTag {
+	var last morph.MorphTag
+	for _, m := range chain {
+		if m.Tag >= morph.DerivAgent && m.Tag <= morph.DerivVerb {
+			last = m.Tag
+		}
+	}
+	return last
+}
@@ -0,0 +1,202 @@
+// Package pos assigns part-of-speech tags to Azerbaijani tokens.
+//
+// Tags are the 17 coarse Universal Dependencies categories (NOUN, VERB,
+// ADJ, ADP, ...). Each token is tagged in two passes:
+//
+//   - Lexical: closed-class words (pronouns, postpositions, conjunctions,
+//     particles, auxiliaries) come from a built-in list; cardinal number
+//     words are recognized via numtext; open-class words are lemmatized
+//     with morph.Lemmatize and their dictionary category is refined by the
+//     suffix chain (a tense or person suffix makes a verb, a case or plural
+//     suffix makes a noun, -li/-siz make an adjective).
+//   - Contextual: simple rules over neighboring tags resolve ambiguous
+//     words. Demonstratives (bu, o) are determiners before a nominal and
+//     pronouns otherwise; "bir" is a determiner before a nominal; words
+//     such as sonra, qədər, kimi are postpositions after a nominal and
+//     adverbs otherwise; a bare adjective before a verb is an adverb;
+//     capitalized words outside sentence-initial position are proper nouns.
+//
+// Two functions are provided:
+//
+//   - Tag tags a pre-tokenized sequence, e.g. from tokenizer.Words.
+//   - TagText tokenizes text and tags every non-space token.
+//
+// All functions are safe for concurrent use by multiple goroutines.
+//
+// Known limitations:
+//
+//   - Participles and verbal nouns are tagged VERB; their nominal use
+//     (gələn il "next year") is not distinguished.
+//   - Predicate nominals with a copula (gözəldir) keep the category of
+//     their stem; the copula is not split off as AUX.
+//   - Unknown lowercase words default to NOUN unless their suffix chain
+//     is verbal.
+package pos
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// maxInputBytes is the maximum total input size. Larger inputs return nil.
+const maxInputBytes = 1 << 20 // 1 MiB
+
+// UPOS is a Universal Dependencies coarse part-of-speech tag.
+type UPOS int
+
+const (
+	X     UPOS = iota // Other: foreign words, URLs, unanalyzable tokens
+	Adj               // Adjective (gözəl, evsiz)
+	Adp               // Adposition; postpositions in Azerbaijani (üçün, ilə)
+	Adv               // Adverb (çox, tez)
+	Aux               // Auxiliary (deyil, idi)
+	CConj             // Coordinating conjunction (və, amma)
+	Det               // Determiner (bu, hər, bütün)
+	Intj              // Interjection (bəli, salam)
+	Noun              // Common noun (kitab, şəhərdə)
+	Num               // Cardinal numeral (iki, 25)
+	Part              // Particle (da, mı, hətta)
+	Pron              // Pronoun (mən, onlar)
+	PropN             // Proper noun (Bakı, Əli)
+	Punct             // Punctuation
+	SConj             // Subordinating conjunction (ki, çünki)
+	Sym               // Symbol (%, €, emoji)
+	Verb              // Verb, including participles and verbal nouns
+)
+
+// uposNames maps UPOS values to their Universal Dependencies names.
+var uposNames = [...]string{
+	X:     "X",
+	Adj:   "ADJ",
+	Adp:   "ADP",
+	Adv:   "ADV",
+	Aux:   "AUX",
+	CConj: "CCONJ",
+	Det:   "DET",
+	Intj:  "INTJ",
+	Noun:  "NOUN",
+	Num:   "NUM",
+	Part:  "PART",
+	Pron:  "PRON",
+	PropN: "PROPN",
+	Punct: "PUNCT",
+	SConj: "SCONJ",
+	Sym:   "SYM",
+	Verb:  "VERB",
+}
+
+// uposFromName maps Universal Dependencies names back to UPOS values.
+var uposFromName = map[string]UPOS{
+	"X":     X,
+	"ADJ":   Adj,
+	"ADP":   Adp,
+	"ADV":   Adv,
+	"AUX":   Aux,
+	"CCONJ": CConj,
+	"DET":   Det,
+	"INTJ":  Intj,
+	"NOUN":  Noun,
+	"NUM":   Num,
+	"PART":  Part,
+	"PRON":  Pron,
+	"PROPN": PropN,
+	"PUNCT": Punct,
+	"SCONJ": SConj,
+	"SYM":   Sym,
+	"VERB":  Verb,
+}
+
+// String returns the Universal Dependencies name of the tag (e.g. "NOUN").
+func (t UPOS) String() string {
+	if int(t) >= 0 && int(t) < len(uposNames) {
+		return uposNames[t]
+	}
+	return fmt.Sprintf("UPOS(%d)", int(t))
+}
+
+// MarshalJSON encodes the tag as a JSON string (e.g. "NOUN").
+func (t UPOS) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "NOUN") into a UPOS.
+func (t *UPOS) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := uposFromName[s]
+	if !ok {
+		return fmt.Errorf("pos: unknown tag: %q", s)
+	}
+	*t = v
+	return nil
+}
+
+// TagResult is the part of speech of one token.
+type TagResult struct {
+	Token string `json:"token"` // The token as given
+	Tag   UPOS   `json:"tag"`   // Coarse part-of-speech tag
+	Lemma string `json:"lemma"` // Dictionary form (infinitive for verbs), or the lowercased token when unknown
+}
+
+// String returns a debug representation, e.g. kitablar/NOUN.
+func (r TagResult) String() string {
+	return r.Token + "/" + r.Tag.String()
+}
+
+// Tag assigns a part-of-speech tag to each token of a sentence or text.
+// Tokens are typically produced by tokenizer.Words or by filtering
+// tokenizer.WordTokens; punctuation tokens may be included and help mark
+// sentence boundaries. Whitespace tokens are tagged X.
+//
+// Returns one result per token, in order. Returns nil for empty input or
+// when the tokens total more than 1 MiB.
+func Tag(tokens []string) []TagResult {
+	if len(tokens) == 0 {
+		return nil
+	}
+	total := 0
+	for _, t := range tokens {
+		total += len(t)
+	}
+	if total > maxInputBytes {
+		return nil
+	}
+
+	infos := make([]tokenInfo, len(tokens))
+	for i, t := range tokens {
+		infos[i] = lexical(t)
+	}
+	disambiguate(infos)
+
+	out := make([]TagResult, len(tokens))
+	for i, t := range tokens {
+		out[i] = TagResult{Token: t, Tag: infos[i].tag, Lemma: infos[i].lemma}
+	}
+	return out
+}
+
+// TagText tokenizes text with tokenizer.WordTokens and tags every token
+// except whitespace. URL and Email tokens are tagged X.
+// Returns nil for empty or oversized (>1 MiB) input.
+func TagText(text string) []TagResult {
+	if text == "" || len(text) > maxInputBytes {
+		return nil
+	}
+	var tokens []string
+	for _, tok := range tokenizer.WordTokens(text) {
+		if tok.Type != tokenizer.Space {
+			tokens = append(tokens, tok.Text)
+		}
+	}
+	return Tag(tokens)
+}
+
+// isSentenceEnd reports whether a punctuation token ends a sentence.
+func isSentenceEnd(tok string) bool {
+	return strings.ContainsAny(tok, ".!?…")
+}
@@ -0,0 +1,178 @@
+package pos
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// tags renders results as space-separated tag names.
+func tags(results []TagResult) string {
+	names := make([]string, len(results))
+	for i, r := range results {
+		names[i] = r.Tag.String()
+	}
+	return strings.Join(names, " ")
+}
+
+// ---------------------------------------------------------------------------
+// TagText — table-driven tests
+// ---------------------------------------------------------------------------
+
+func TestTagText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"simple sentence", "Mən kitab oxudum.", "PRON NOUN VERB PUNCT"},
+		{"case suffix noun", "Uşaqlar məktəbə getdi.", "NOUN NOUN VERB PUNCT"},
+		{"derived adjective", "Müəllim ağıllı və evsiz idi.", "NOUN ADJ CCONJ ADJ AUX PUNCT"},
+		{"demonstrative determiner", "O kitab gözəldir.", "DET NOUN ADJ PUNCT"},
+		{"demonstrative pronoun", "O gəldi.", "PRON VERB PUNCT"},
+		{"article", "bir gözəl kitab", "DET ADJ NOUN"},
+		{"numeral", "iki kitab və 25 dəftər", "NUM NOUN CCONJ NUM NOUN"},
+		{"ordinal", "ikinci kitab", "ADJ NOUN"},
+		{"postposition after noun", "evdən sonra", "NOUN ADP"},
+		{"adverb sentence-initially", "Sonra getdi.", "ADV VERB PUNCT"},
+		{"postposition after oblique pronoun", "mənim kimi", "PRON ADP"},
+		{"adjective before verb", "Uşaq yaxşı oxuyur.", "NOUN ADV VERB PUNCT"},
+		{"closed class", "Gəldi, çünki bəli deyil.", "VERB PUNCT SCONJ INTJ AUX PUNCT"},
+		{"proper noun mid-sentence", "Dünən Bakıda idim.", "ADV PROPN AUX PUNCT"},
+		{"proper noun apostrophe", "Bakı'da qar var.", "PROPN NOUN ADJ PUNCT"},
+		{"acronym", "ARDNŞ hesabat verdi.", "PROPN NOUN VERB PUNCT"},
+		{"symbol", "5 % artdı", "NUM SYM VERB"},
+		{"url", "https://example.az saytında", "X NOUN"},
+		{"unknown loanword", "server işləyir", "NOUN VERB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tags(TagText(tt.text))
+			if got != tt.want {
+				t.Errorf("TagText(%q) = %s, want %s\n%v", tt.text, got, tt.want, TagText(tt.text))
+			}
+		})
+	}
+}
+
+func TestTagLemma(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		token string
+		want  string
+	}{
+		{"kitablarımızdan", "kitab"},
+		{"gəldi", "gəlmək"},
+		{"onların", "onlar"},
+		{"Bakı'da", "Bakı"},
+		{"idi", "i"},
+		{"zentrix", "zentrix"},
+		{"ARDNŞ", "ARDNŞ"},
+	}
+	for _, tt := range tests {
+		got := Tag([]string{tt.token})[0].Lemma
+		if got != tt.want {
+			t.Errorf("Tag(%q).Lemma = %q, want %q", tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestTagPreTokenized(t *testing.T) {
+	t.Parallel()
+
+	tokens := []string{"O", "kitab", "", " ", "oxudu"}
+	got := Tag(tokens)
+	if len(got) != len(tokens) {
+		t.Fatalf("Tag returned %d results, want %d", len(got), len(tokens))
+	}
+	for i, r := range got {
+		if r.Token != tokens[i] {
+			t.Errorf("result %d token = %q, want %q", i, r.Token, tokens[i])
+		}
+	}
+	if want := "DET NOUN X X VERB"; tags(got) != want {
+		t.Errorf("Tag(%q) = %s, want %s", tokens, tags(got), want)
+	}
+}
+
+func TestTagEmpty(t *testing.T) {
+	t.Parallel()
+
+	if got := Tag(nil); got != nil {
+		t.Errorf("Tag(nil) = %v, want nil", got)
+	}
+	if got := TagText(""); got != nil {
+		t.Errorf(`TagText("") = %v, want nil`, got)
+	}
+	if got := TagText(strings.Repeat("a", maxInputBytes+1)); got != nil {
+		t.Errorf("TagText(oversized) returned %d results, want nil", len(got))
+	}
+}
+
+// ---------------------------------------------------------------------------
+// UPOS
+// ---------------------------------------------------------------------------
+
+func TestUPOSJSON(t *testing.T) {
+	t.Parallel()
+
+	for tag := X; tag <= Verb; tag++ {
+		data, err := json.Marshal(tag)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", tag, err)
+		}
+		var got UPOS
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if got != tag {
+			t.Errorf("round trip %v = %v", tag, got)
+		}
+	}
+
+	var u UPOS
+	if err := json.Unmarshal([]byte(`"NOPE"`), &u); err == nil {
+		t.Error("Unmarshal of unknown tag should fail")
+	}
+	if got := UPOS(99).String(); got != "UPOS(99)" {
+		t.Errorf("UPOS(99).String() = %q", got)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Benchmarks
+// ---------------------------------------------------------------------------
+
+func BenchmarkTagText(b *testing.B) {
+	s := "Dünən Bakıda çox gözəl bir kitab oxudum, çünki müəllim onu tövsiyə etmişdi."
+	for b.Loop() {
+		TagText(s)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Examples
+// ---------------------------------------------------------------------------
+
+func ExampleTag() {
+	for _, r := range Tag([]string{"Bu", "kitabı", "Bakıda", "aldım"}) {
+		fmt.Println(r.Token, r.Tag, r.Lemma)
+	}
+	// Output:
+	// Bu DET bu
+	// kitabı NOUN kitab
+	// Bakıda PROPN Bakı
+	// aldım VERB almaq
+}
+
+func ExampleTagText() {
+	fmt.Println(TagText("Uşaqlar evdən sonra məktəbə getdi."))
+	// Output:
+	// [Uşaqlar/NOUN evdən/NOUN sonra/ADP məktəbə/NOUN getdi/VERB ./PUNCT]
+}
@@ -0,0 +1,95 @@
+package pos
+
+import "github.com/az-ai-labs/az-lang-nlp/azcase"
+
+// disambiguate applies contextual rules to lexically tagged tokens, left
+// to right, so that each rule sees the final tags of preceding tokens.
+func disambiguate(infos []tokenInfo) {
+	for i := range infos {
+		info := &infos[i]
+		prev, next := prevWord(infos, i), nextWord(infos, i)
+
+		switch info.amb {
+		case demonstrative, article:
+			if next != nil && isNominal(next.tag) {
+				info.tag = Det
+			}
+		case postposition:
+			if prev != nil && governsPostposition(prev) {
+				info.tag = Adp
+			}
+		}
+
+		// A bare adjective modifying a verb is an adverb (yaxşı oxuyur).
+		if info.tag == Adj && info.bare && info.amb == unambiguous &&
+			next != nil && next.tag == Verb {
+			info.tag = Adv
+		}
+
+		// Capitalized nouns are proper nouns, except sentence-initially
+		// where only acronyms and words missing from the dictionary are.
+		if info.capital && (info.tag == Noun || info.tag == Adj) {
+			if info.acronym || !info.dictEntry || !sentenceInitial(infos, i) {
+				info.tag = PropN
+				info.lemma = properLemma(info)
+			}
+		}
+	}
+}
+
+// properLemma restores the capitalization of a proper noun's lemma.
+func properLemma(info *tokenInfo) string {
+	if info.acronym {
+		return azcase.ToUpper(info.lemma)
+	}
+	return azcase.UpperFirst(info.lemma)
+}
+
+// prevWord returns the closest preceding token that is not punctuation
+// within the same clause, or nil.
+func prevWord(infos []tokenInfo, i int) *tokenInfo {
+	if i == 0 || infos[i-1].tag == Punct || infos[i-1].tag == X {
+		return nil
+	}
+	return &infos[i-1]
+}
+
+// nextWord returns the following token unless it is punctuation, or nil.
+func nextWord(infos []tokenInfo, i int) *tokenInfo {
+	if i+1 >= len(infos) || infos[i+1].tag == Punct || infos[i+1].tag == X {
+		return nil
+	}
+	return &infos[i+1]
+}
+
+// sentenceInitial reports whether token i starts a sentence: it is first,
+// or follows sentence-final punctuation (possibly with opening quotes or
+// brackets in between).
+func sentenceInitial(infos []tokenInfo, i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		if infos[j].tag != Punct {
+			return false
+		}
+		if isSentenceEnd(infos[j].lemma) {
+			return true
+		}
+	}
+	return true
+}
+
+// isNominal reports whether a determiner can precede a word tagged t.
+func isNominal(t UPOS) bool {
+	return t == Noun || t == Adj || t == PropN || t == Num
+}
+
+// governsPostposition reports whether a postposition can follow prev:
+// a noun or numeral, or a pronoun or participle in a case.
+func governsPostposition(prev *tokenInfo) bool {
+	switch prev.tag {
+	case Noun, PropN, Num:
+		return true
+	case Pron, Verb:
+		return prev.cased
+	}
+	return false
+}
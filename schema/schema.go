@@ -0,0 +1,186 @@
+// Package schema defines a stable, versioned JSON representation of the
+// results of the analysis packages.
+//
+// Each package marshals its own types ad hoc: enums as strings, but
+// datetime.Components as a bitmask, time.Duration as nanoseconds, and
+// weekdays as numbers. The types here fix one documented layout for
+// tokens, morphological analyses, named entities, date/time results,
+// chunks, and validation reports, so external services can decode them
+// without tracking changes to the Go types across releases.
+//
+// Two API layers are provided:
+//
+//   - Structured: FromTokens, FromAnalyses, FromEntities, FromDateTimes,
+//     FromChunks, and FromReport convert module results into the schema
+//     types, which encode with encoding/json.
+//   - Document: Marshal wraps a module result in a Document carrying
+//     SchemaVersion and its Kind; Unmarshal decodes one and rejects
+//     unsupported versions.
+//
+// Conventions shared by every type:
+//
+//   - Field names are snake_case; byte offsets are "start" (inclusive)
+//     and "end" (exclusive) into the analyzed text.
+//   - Enumerations are their String names ("Word", "Plural", "Phone",
+//     "spelling"), as the packages' own MarshalJSON writes them.
+//   - Times are RFC 3339 strings and durations are seconds.
+//   - Optional fields are omitted when empty.
+//
+// SchemaVersion is bumped only on incompatible changes; new optional
+// fields may be added without a bump.
+//
+// All functions are safe for concurrent use by multiple goroutines.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/az-ai-labs/az-lang-nlp/chunker"
+	"github.com/az-ai-labs/az-lang-nlp/datetime"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+	"github.com/az-ai-labs/az-lang-nlp/validate"
+)
+
+// SchemaVersion is the version of the schema written by Marshal.
+const SchemaVersion = 1
+
+// Kind identifies which result a Document carries.
+type Kind int
+
+const (
+	KindTokens    Kind = iota // Document.Tokens
+	KindAnalyses              // Document.Analyses
+	KindEntities              // Document.Entities
+	KindDateTimes             // Document.DateTimes
+	KindChunks                // Document.Chunks
+	KindReport                // Document.Report
+)
+
+// kindNames maps Kind values to their string names.
+var kindNames = [...]string{
+	KindTokens:    "tokens",
+	KindAnalyses:  "analyses",
+	KindEntities:  "entities",
+	KindDateTimes: "datetimes",
+	KindChunks:    "chunks",
+	KindReport:    "report",
+}
+
+// kindFromName maps string names back to Kind values.
+var kindFromName = map[string]Kind{
+	"tokens":    KindTokens,
+	"analyses":  KindAnalyses,
+	"entities":  KindEntities,
+	"datetimes": KindDateTimes,
+	"chunks":    KindChunks,
+	"report":    KindReport,
+}
+
+// String returns the name of the kind (e.g. "tokens").
+func (k Kind) String() string {
+	if int(k) >= 0 && int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// MarshalJSON encodes the kind as a JSON string (e.g. "tokens").
+func (k Kind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "tokens") into a Kind.
+func (k *Kind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := kindFromName[s]
+	if !ok {
+		const maxErrLen = 50
+		if len(s) > maxErrLen {
+			s = s[:maxErrLen] + "..."
+		}
+		return fmt.Errorf("schema: unknown kind: %q", s)
+	}
+	*k = v
+	return nil
+}
+
+// Document is the top-level JSON envelope. Exactly the field named by
+// Kind is set; it is omitted when empty, so Kind also tells an empty
+// result apart from a missing one.
+type Document struct {
+	SchemaVersion int        `json:"schema_version"`
+	Kind          Kind       `json:"kind"`
+	Tokens        []Token    `json:"tokens,omitempty"`
+	Analyses      []Analysis `json:"analyses,omitempty"`
+	Entities      []Entity   `json:"entities,omitempty"`
+	DateTimes     []DateTime `json:"datetimes,omitempty"`
+	Chunks        []Chunk    `json:"chunks,omitempty"`
+	Report        *Report    `json:"report,omitempty"`
+}
+
+// NewDocument wraps a module result in a Document. v must be one of
+// []tokenizer.Token, []morph.Analysis, []ner.Entity, []datetime.Result,
+// []chunker.Chunk, or validate.Report (or a pointer to one).
+func NewDocument(v any) (Document, error) {
+	doc := Document{SchemaVersion: SchemaVersion}
+	switch v := v.(type) {
+	case []tokenizer.Token:
+		doc.Kind, doc.Tokens = KindTokens, FromTokens(v)
+	case []morph.Analysis:
+		doc.Kind, doc.Analyses = KindAnalyses, FromAnalyses(v)
+	case []ner.Entity:
+		doc.Kind, doc.Entities = KindEntities, FromEntities(v)
+	case []datetime.Result:
+		doc.Kind, doc.DateTimes = KindDateTimes, FromDateTimes(v)
+	case []chunker.Chunk:
+		doc.Kind, doc.Chunks = KindChunks, FromChunks(v)
+	case validate.Report:
+		r := FromReport(v)
+		doc.Kind, doc.Report = KindReport, &r
+	case *validate.Report:
+		if v == nil {
+			return Document{}, fmt.Errorf("schema: nil *validate.Report")
+		}
+		r := FromReport(*v)
+		doc.Kind, doc.Report = KindReport, &r
+	default:
+		return Document{}, fmt.Errorf("schema: unsupported type %T", v)
+	}
+	return doc, nil
+}
+
+// Marshal encodes a module result as a Document (see NewDocument).
+func Marshal(v any) ([]byte, error) {
+	doc, err := NewDocument(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+// Unmarshal decodes a Document produced by Marshal. Returns an error for
+// malformed JSON, unsupported schema versions, or a missing kind.
+func Unmarshal(data []byte) (Document, error) {
+	var raw struct {
+		Document
+		Kind *Kind `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Document{}, fmt.Errorf("schema: decoding document: %w", err)
+	}
+	doc := raw.Document
+	if doc.SchemaVersion < 1 || doc.SchemaVersion > SchemaVersion {
+		return Document{}, fmt.Errorf("schema: unsupported schema version %d", doc.SchemaVersion)
+	}
+	if raw.Kind == nil {
+		return Document{}, fmt.Errorf("schema: missing kind")
+	}
+	doc.Kind = *raw.Kind
+	return doc, nil
+}
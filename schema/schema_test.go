@@ -0,0 +1,238 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/chunker"
+	"github.com/az-ai-labs/az-lang-nlp/datetime"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+	"github.com/az-ai-labs/az-lang-nlp/validate"
+)
+
+// TestMarshalLayout pins the encoded form of every kind: a change here is
+// a change to the published schema.
+func TestMarshalLayout(t *testing.T) {
+	t.Parallel()
+
+	ref := time.Date(2026, 2, 20, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{
+			"tokens",
+			tokenizer.WordTokens("Salam, dünya"),
+			`{"schema_version":1,"kind":"tokens","tokens":[` +
+				`{"text":"Salam","start":0,"end":5,"type":"Word"},` +
+				`{"text":",","start":5,"end":6,"type":"Punctuation"},` +
+				`{"text":" ","start":6,"end":7,"type":"Space"},` +
+				`{"text":"dünya","start":7,"end":13,"type":"Word"}]}`,
+		},
+		{
+			"analyses",
+			[]morph.Analysis{
+				{Stem: "kitab", Morphemes: []morph.Morpheme{{Surface: "lar", Tag: morph.Plural}}},
+				{Stem: "kitablar"},
+			},
+			`{"schema_version":1,"kind":"analyses","analyses":[` +
+				`{"stem":"kitab","morphemes":[{"surface":"lar","tag":"Plural"}]},` +
+				`{"stem":"kitablar","morphemes":[]}]}`,
+		},
+		{
+			"entities",
+			[]ner.Entity{
+				{Text: "0501234567", Start: 4, End: 14, Type: ner.Phone},
+				{Text: "5 mart 2026", Start: 20, End: 31, Type: ner.Date, Time: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+			},
+			`{"schema_version":1,"kind":"entities","entities":[` +
+				`{"text":"0501234567","start":4,"end":14,"type":"Phone"},` +
+				`{"text":"5 mart 2026","start":20,"end":31,"type":"Date","time":"2026-03-05T00:00:00Z"}]}`,
+		},
+		{
+			"datetimes",
+			append(datetime.Extract("5 mart saat 14:30", ref), datetime.Extract("2 saat 30 dəqiqə", ref)...),
+			`{"schema_version":1,"kind":"datetimes","datetimes":[` +
+				`{"text":"5 mart saat 14:30","start":0,"end":17,"type":"DateTime","time":"2026-03-05T14:30:00Z",` +
+				`"explicit":["month","day","hour","minute"]},` +
+				`{"text":"2 saat 30 dəqiqə","start":0,"end":18,"type":"Duration",` +
+				`"duration_seconds":9000,"explicit":[]}]}`,
+		},
+		{
+			"chunks",
+			[]chunker.Chunk{{Text: "Giriş", Start: 0, End: 6, Index: 0, ID: "a1b2-0", Source: "doc", Heading: "Giriş", Path: []string{"Giriş"}}},
+			`{"schema_version":1,"kind":"chunks","chunks":[` +
+				`{"text":"Giriş","start":0,"end":6,"index":0,"id":"a1b2-0","source":"doc","heading":"Giriş","path":["Giriş"]}]}`,
+		},
+		{
+			"report",
+			validate.Report{Score: 100, Stats: validate.Stats{Lines: 1}},
+			`{"schema_version":1,"kind":"report","report":{"score":100,"issues":[],"stats":` +
+				`{"lines":1,"trailing_spaces":0,"tab_indented":0,"space_indented":0,"lf_endings":0,"crlf_endings":0,"final_newline":false}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			data, err := Marshal(tt.v)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Marshal =\n%s\nwant\n%s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ref := time.Date(2026, 2, 20, 10, 30, 0, 0, time.UTC)
+	text := "Hər bazar ertəsi saat 10:00 görüş. Əlaqə: info@gov.az, 1 500 manat."
+	report := validate.Validate("Bu metn  yoxlanilir .")
+	inputs := []any{
+		tokenizer.WordTokens(text),
+		morph.Analyze("kitablarımızdan"),
+		ner.Recognize(text),
+		datetime.Extract(text, ref),
+		chunker.BySize(text, 20, 5),
+		report,
+		&report,
+	}
+	for _, v := range inputs {
+		data, err := Marshal(v)
+		if err != nil {
+			t.Fatalf("Marshal(%T): %v", v, err)
+		}
+		doc, err := Unmarshal(data)
+		if err != nil {
+			t.Fatalf("Unmarshal(%T): %v\n%s", v, err, data)
+		}
+		want, _ := NewDocument(v)
+		if !reflect.DeepEqual(doc, want) {
+			t.Errorf("%T: round trip =\n%+v\nwant\n%+v", v, doc, want)
+		}
+	}
+}
+
+func TestNewDocumentErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []any{nil, "text", []string{"a"}, (*validate.Report)(nil)} {
+		if _, err := NewDocument(v); err == nil {
+			t.Errorf("NewDocument(%#v): expected error", v)
+		}
+		if _, err := Marshal(v); err == nil {
+			t.Errorf("Marshal(%#v): expected error", v)
+		}
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"malformed", `{"schema_version":`, "decoding document"},
+		{"missing version", `{"kind":"tokens"}`, "unsupported schema version 0"},
+		{"future version", `{"schema_version":2,"kind":"tokens"}`, "unsupported schema version 2"},
+		{"missing kind", `{"schema_version":1}`, "missing kind"},
+		{"unknown kind", `{"schema_version":1,"kind":"stems"}`, "unknown kind"},
+		{"bad enum", `{"schema_version":1,"kind":"tokens","tokens":[{"type":"Noun"}]}`, "unknown token type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Unmarshal([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Unmarshal(%s) error = %v, want containing %q", tt.data, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalEmptyResult(t *testing.T) {
+	t.Parallel()
+
+	data, err := Marshal([]ner.Entity{})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want := `{"schema_version":1,"kind":"entities"}`; string(data) != want {
+		t.Errorf("Marshal(empty) = %s, want %s", data, want)
+	}
+	doc, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if doc.Kind != KindEntities || doc.Entities != nil {
+		t.Errorf("Unmarshal(empty) = %+v", doc)
+	}
+}
+
+func TestKindString(t *testing.T) {
+	t.Parallel()
+
+	for k := KindTokens; k <= KindReport; k++ {
+		if kindFromName[k.String()] != k {
+			t.Errorf("Kind(%d): name %q does not map back", int(k), k.String())
+		}
+	}
+	if len(kindFromName) != len(kindNames) {
+		t.Errorf("kindFromName has %d entries, kindNames %d", len(kindFromName), len(kindNames))
+	}
+	if got := Kind(99).String(); got != "Kind(99)" {
+		t.Errorf("Kind(99).String() = %q", got)
+	}
+}
+
+func TestKindJSON(t *testing.T) {
+	t.Parallel()
+
+	for k := KindTokens; k <= KindReport; k++ {
+		data, err := json.Marshal(k)
+		if err != nil {
+			t.Fatalf("Marshal(%s): %v", k, err)
+		}
+		var got Kind
+		if err := json.Unmarshal(data, &got); err != nil || got != k {
+			t.Errorf("round trip %s: got %s, err %v", k, got, err)
+		}
+	}
+
+	var k Kind
+	err := json.Unmarshal([]byte(`"`+strings.Repeat("x", 100)+`"`), &k)
+	if err == nil || len(err.Error()) > 100 {
+		t.Errorf("long unknown kind: error %v", err)
+	}
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	text := strings.Repeat("Bakı şəhərində 5 mart 2026-cı ildə görüş keçiriləcək. ", 20)
+	tokens := tokenizer.WordTokens(text)
+	for b.Loop() {
+		Marshal(tokens)
+	}
+}
+
+func ExampleMarshal() {
+	data, _ := Marshal(tokenizer.WordTokens("Salam!"))
+	fmt.Println(string(data))
+
+	doc, _ := Unmarshal(data)
+	fmt.Println(doc.Kind, doc.Tokens[0].Text, doc.Tokens[0].Type)
+	// Output:
+	// {"schema_version":1,"kind":"tokens","tokens":[{"text":"Salam","start":0,"end":5,"type":"Word"},{"text":"!","start":5,"end":6,"type":"Punctuation"}]}
+	// tokens Salam Word
+}
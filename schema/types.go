@@ -0,0 +1,275 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/chunker"
+	"github.com/az-ai-labs/az-lang-nlp/datetime"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+	"github.com/az-ai-labs/az-lang-nlp/validate"
+)
+
+// Token is a tokenizer.Token.
+type Token struct {
+	Text  string              `json:"text"`
+	Start int                 `json:"start"`
+	End   int                 `json:"end"`
+	Type  tokenizer.TokenType `json:"type"` // "Word", "Number", "Punctuation", ...
+}
+
+// Morpheme is a morph.Morpheme.
+type Morpheme struct {
+	Surface string         `json:"surface"` // Suffix as written ("lar")
+	Tag     morph.MorphTag `json:"tag"`     // "Plural", "CaseAbl", ...
+}
+
+// Analysis is a morph.Analysis. Morphemes is empty, not null, for a bare
+// stem.
+type Analysis struct {
+	Stem      string     `json:"stem"`
+	Morphemes []Morpheme `json:"morphemes"`
+	Score     float64    `json:"score,omitempty"` // Relative confidence, 0.0-1.0
+}
+
+// Entity is a ner.Entity.
+type Entity struct {
+	Text     string         `json:"text"`
+	Start    int            `json:"start"`
+	End      int            `json:"end"`
+	Type     ner.EntityType `json:"type"` // "Phone", "Email", "Money", ...
+	Labeled  bool           `json:"labeled,omitempty"`
+	Value    float64        `json:"value,omitempty"`    // Money and Percent
+	Currency string         `json:"currency,omitempty"` // Money: ISO 4217 code
+	Time     *time.Time     `json:"time,omitempty"`     // Date
+	Address  *Address       `json:"address,omitempty"`  // Address
+
+	Confidence float64 `json:"confidence,omitempty"` // 0.0-1.0
+	Source     string  `json:"source,omitempty"`     // Rule that matched, e.g. "regex:voen_labeled"
+}
+
+// Address is a ner.PostalAddress.
+type Address struct {
+	PostalCode string `json:"postal_code,omitempty"` // "AZ1000"
+	City       string `json:"city,omitempty"`
+	District   string `json:"district,omitempty"`
+	Settlement string `json:"settlement,omitempty"`
+	Street     string `json:"street,omitempty"` // With its kind: "Nizami küçəsi"
+	House      string `json:"house,omitempty"`
+	Apartment  string `json:"apartment,omitempty"`
+}
+
+// DateTime is a datetime.Result.
+type DateTime struct {
+	Text            string        `json:"text"`
+	Start           int           `json:"start"`
+	End             int           `json:"end"`
+	Type            datetime.Type `json:"type"`                       // "Date", "Time", "DateTime", "Duration", "Recurrence", "Period"
+	Time            *time.Time    `json:"time,omitempty"`             // Resolved time; absent for durations
+	DurationSeconds float64       `json:"duration_seconds,omitempty"` // Duration
+	Explicit        []string      `json:"explicit"`                   // Components written in the text, see componentNames
+
+	Approximate      bool    `json:"approximate,omitempty"`
+	ToleranceSeconds float64 `json:"tolerance_seconds,omitempty"` // Approximate: half-width of the window around Time
+
+	Order      datetime.DateOrder `json:"order,omitempty"` // "DMY", "MDY", "YMD"
+	Ambiguous  bool               `json:"ambiguous,omitempty"`
+	Anaphoric  bool               `json:"anaphoric,omitempty"`
+	Holiday    string             `json:"holiday,omitempty"`
+	Recurrence *Recurrence        `json:"recurrence,omitempty"` // Recurrence
+	Period     *Period            `json:"period,omitempty"`     // Period
+}
+
+// Period is a datetime.Period.
+type Period struct {
+	FirstYear int `json:"first_year"` // Negative before the common era
+	LastYear  int `json:"last_year"`  // Inclusive
+}
+
+// Recurrence is a datetime.Recurrence.
+type Recurrence struct {
+	Frequency datetime.Frequency `json:"frequency"` // "Daily", "Weekly", "Monthly", "Yearly"
+	Interval  int                `json:"interval"`
+	Weekdays  []string           `json:"weekdays,omitempty"`  // English names, "Monday" through "Sunday"
+	MonthDay  int                `json:"month_day,omitempty"` // 1-31, or -1 for the last day
+	Month     int                `json:"month,omitempty"`     // 1-12
+	RRULE     string             `json:"rrule"`               // RFC 5545 rule, e.g. "FREQ=WEEKLY;BYDAY=MO"
+}
+
+// Chunk is a chunker.Chunk.
+type Chunk struct {
+	Text    string   `json:"text"`
+	Start   int      `json:"start"`
+	End     int      `json:"end"`
+	Index   int      `json:"index"`
+	ID      string   `json:"id,omitempty"`
+	Source  string   `json:"source,omitempty"`
+	Heading string   `json:"heading,omitempty"`
+	Path    []string `json:"path,omitempty"`
+}
+
+// Issue is a validate.Issue.
+type Issue struct {
+	Text       string             `json:"text"`
+	Start      int                `json:"start"`
+	End        int                `json:"end"`
+	Type       validate.IssueType `json:"type"`     // "spelling", "punctuation", ...
+	Severity   validate.Severity  `json:"severity"` // "info", "warning", "error"
+	Message    string             `json:"message"`
+	Suggestion string             `json:"suggestion,omitempty"`
+}
+
+// Report is a validate.Report. Issues is empty, not null, for clean text.
+type Report struct {
+	Score  int            `json:"score"`
+	Issues []Issue        `json:"issues"`
+	Stats  validate.Stats `json:"stats"`
+}
+
+// componentNames lists the Explicit names of datetime.Components bits,
+// in bit order.
+var componentNames = [...]struct {
+	bit  datetime.Components
+	name string
+}{
+	{datetime.HasYear, "year"},
+	{datetime.HasMonth, "month"},
+	{datetime.HasDay, "day"},
+	{datetime.HasHour, "hour"},
+	{datetime.HasMinute, "minute"},
+	{datetime.HasSecond, "second"},
+	{datetime.HasZone, "zone"},
+}
+
+// FromTokens converts tokens to the schema. Returns nil for nil input.
+func FromTokens(tokens []tokenizer.Token) []Token {
+	if tokens == nil {
+		return nil
+	}
+	out := make([]Token, len(tokens))
+	for i, t := range tokens {
+		out[i] = Token{Text: t.Text, Start: t.Start, End: t.End, Type: t.Type}
+	}
+	return out
+}
+
+// FromAnalyses converts morphological analyses to the schema. Returns nil
+// for nil input.
+func FromAnalyses(analyses []morph.Analysis) []Analysis {
+	if analyses == nil {
+		return nil
+	}
+	out := make([]Analysis, len(analyses))
+	for i, a := range analyses {
+		ms := make([]Morpheme, len(a.Morphemes))
+		for j, m := range a.Morphemes {
+			ms[j] = Morpheme{Surface: m.Surface, Tag: m.Tag}
+		}
+		out[i] = Analysis{Stem: a.Stem, Morphemes: ms, Score: a.Score}
+	}
+	return out
+}
+
+// FromEntities converts named entities to the schema. Returns nil for nil
+// input.
+func FromEntities(entities []ner.Entity) []Entity {
+	if entities == nil {
+		return nil
+	}
+	out := make([]Entity, len(entities))
+	for i, e := range entities {
+		out[i] = Entity{
+			Text: e.Text, Start: e.Start, End: e.End, Type: e.Type, Labeled: e.Labeled,
+			Value: e.Value, Currency: e.Currency, Confidence: e.Confidence, Source: e.Source,
+		}
+		if !e.Time.IsZero() {
+			t := e.Time
+			out[i].Time = &t
+		}
+		if a := e.Address; a != nil {
+			out[i].Address = &Address{
+				PostalCode: a.PostalCode, City: a.City, District: a.District, Settlement: a.Settlement,
+				Street: a.Street, House: a.House, Apartment: a.Apartment,
+			}
+		}
+	}
+	return out
+}
+
+// FromDateTimes converts date/time results to the schema. Returns nil for
+// nil input.
+func FromDateTimes(results []datetime.Result) []DateTime {
+	if results == nil {
+		return nil
+	}
+	out := make([]DateTime, len(results))
+	for i, r := range results {
+		dt := DateTime{
+			Text: r.Text, Start: r.Start, End: r.End, Type: r.Type,
+			DurationSeconds: r.Duration.Seconds(),
+			Explicit:        explicitNames(r.Explicit),
+			Order:           r.Order, Ambiguous: r.Ambiguous, Anaphoric: r.Anaphoric, Holiday: r.Holiday,
+			Approximate: r.Approximate, ToleranceSeconds: r.Tolerance.Seconds(),
+		}
+		if !r.Time.IsZero() {
+			t := r.Time
+			dt.Time = &t
+		}
+		if p := r.Period; p != nil {
+			dt.Period = &Period{FirstYear: p.FirstYear, LastYear: p.LastYear}
+		}
+		if rec := r.Recurrence; rec != nil {
+			dt.Recurrence = &Recurrence{
+				Frequency: rec.Frequency,
+				Interval:  rec.Interval,
+				MonthDay:  rec.MonthDay,
+				Month:     int(rec.Month),
+				RRULE:     rec.RRULE(),
+			}
+			for _, wd := range rec.Weekdays {
+				dt.Recurrence.Weekdays = append(dt.Recurrence.Weekdays, wd.String())
+			}
+		}
+		out[i] = dt
+	}
+	return out
+}
+
+// explicitNames returns the names of the components set in c.
+func explicitNames(c datetime.Components) []string {
+	names := []string{}
+	for _, cn := range componentNames {
+		if c&cn.bit != 0 {
+			names = append(names, cn.name)
+		}
+	}
+	return names
+}
+
+// FromChunks converts chunks to the schema. Returns nil for nil input.
+func FromChunks(chunks []chunker.Chunk) []Chunk {
+	if chunks == nil {
+		return nil
+	}
+	out := make([]Chunk, len(chunks))
+	for i, c := range chunks {
+		out[i] = Chunk{
+			Text: c.Text, Start: c.Start, End: c.End, Index: c.Index,
+			ID: c.ID, Source: c.Source, Heading: c.Heading, Path: c.Path,
+		}
+	}
+	return out
+}
+
+// FromReport converts a validation report to the schema.
+func FromReport(r validate.Report) Report {
+	out := Report{Score: r.Score, Issues: make([]Issue, len(r.Issues)), Stats: r.Stats}
+	for i, is := range r.Issues {
+		out.Issues[i] = Issue{
+			Text: is.Text, Start: is.Start, End: is.End, Type: is.Type, Severity: is.Severity,
+			Message: is.Message, Suggestion: is.Suggestion,
+		}
+	}
+	return out
+}
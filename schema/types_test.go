@@ -0,0 +1,211 @@
+package schema
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/az-ai-labs/az-lang-nlp/chunker"
+	"github.com/az-ai-labs/az-lang-nlp/datetime"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/ner"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+	"github.com/az-ai-labs/az-lang-nlp/validate"
+)
+
+func TestFromNil(t *testing.T) {
+	t.Parallel()
+
+	if FromTokens(nil) != nil || FromAnalyses(nil) != nil || FromEntities(nil) != nil ||
+		FromDateTimes(nil) != nil || FromChunks(nil) != nil {
+		t.Error("nil input should convert to nil")
+	}
+	if r := FromReport(validate.Report{Score: 100}); r.Issues == nil {
+		t.Error("FromReport: Issues should be empty, not nil")
+	}
+}
+
+func TestFromDateTimes(t *testing.T) {
+	t.Parallel()
+
+	ref := time.Date(2026, 2, 20, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		text     string
+		explicit []string
+		duration float64
+		rrule    string
+		weekdays []string
+	}{
+		{"5 mart 2026", []string{"year", "month", "day"}, 0, "", nil},
+		{"saat 15:00 (Bakı vaxtı ilə)", []string{"hour", "minute", "zone"}, 0, "", nil},
+		{"2 saat 30 dəqiqə", []string{}, 9000, "", nil},
+		{"hər bazar ertəsi və cümə", []string{"year", "month", "day"}, 0, "FREQ=WEEKLY;BYDAY=MO,FR", []string{"Monday", "Friday"}},
+		{"hər ayın son günü", []string{"year", "month", "day"}, 0, "FREQ=MONTHLY;BYMONTHDAY=-1", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			t.Parallel()
+			r, err := datetime.Parse(tt.text, ref)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.text, err)
+			}
+			dt := FromDateTimes([]datetime.Result{r})[0]
+			if !slices.Equal(dt.Explicit, tt.explicit) {
+				t.Errorf("Explicit = %q, want %q", dt.Explicit, tt.explicit)
+			}
+			if dt.DurationSeconds != tt.duration {
+				t.Errorf("DurationSeconds = %v, want %v", dt.DurationSeconds, tt.duration)
+			}
+			if tt.rrule == "" {
+				if dt.Recurrence != nil {
+					t.Errorf("Recurrence = %+v, want nil", dt.Recurrence)
+				}
+				return
+			}
+			if dt.Recurrence == nil {
+				t.Fatal("Recurrence = nil")
+			}
+			if dt.Recurrence.RRULE != tt.rrule || !slices.Equal(dt.Recurrence.Weekdays, tt.weekdays) {
+				t.Errorf("Recurrence = %+v, want rrule %q weekdays %q", dt.Recurrence, tt.rrule, tt.weekdays)
+			}
+		})
+	}
+}
+
+func TestFromDateTimesPeriod(t *testing.T) {
+	t.Parallel()
+
+	for text, want := range map[string]Period{
+		"XIX əsr":    {FirstYear: 1801, LastYear: 1900},
+		"e.ə. V əsr": {FirstYear: -500, LastYear: -401},
+	} {
+		r, err := datetime.Parse(text, time.Time{})
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", text, err)
+		}
+		dt := FromDateTimes([]datetime.Result{r})[0]
+		if dt.Period == nil || *dt.Period != want {
+			t.Errorf("%q: Period = %+v, want %+v", text, dt.Period, want)
+		}
+		if want.FirstYear < 0 && dt.Time != nil {
+			t.Errorf("%q: Time = %v, want nil", text, dt.Time)
+		}
+		if _, err := json.Marshal(dt); err != nil {
+			t.Errorf("%q: Marshal: %v", text, err)
+		}
+	}
+}
+
+func TestFromDateTimesApproximate(t *testing.T) {
+	t.Parallel()
+
+	r, err := datetime.Parse("təxminən saat 5-də", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dt := FromDateTimes([]datetime.Result{r})[0]
+	if !dt.Approximate || dt.ToleranceSeconds != 1800 {
+		t.Errorf("Approximate = %v, ToleranceSeconds = %v, want true, 1800", dt.Approximate, dt.ToleranceSeconds)
+	}
+}
+
+func TestFromEntities(t *testing.T) {
+	t.Parallel()
+
+	text := "Qiymət 1 500 manat, tarix 5 mart 2026, FIN: 5ARPXK2"
+	entities := FromEntities(ner.Recognize(text))
+	data, err := json.Marshal(entities)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	for _, e := range entities {
+		if text[e.Start:e.End] != e.Text {
+			t.Errorf("offset invariant broken: %q != %q", text[e.Start:e.End], e.Text)
+		}
+		switch e.Type {
+		case ner.Date:
+			if e.Time == nil {
+				t.Errorf("Date entity %q without time", e.Text)
+			}
+		case ner.Money:
+			if e.Value != 1500 || e.Currency != "AZN" || e.Time != nil {
+				t.Errorf("Money entity = %+v", e)
+			}
+		}
+	}
+	if got := strings.Count(string(data), `"time"`); got != 1 {
+		t.Errorf("expected one time field, got %d: %s", got, data)
+	}
+	if !strings.Contains(string(data), `"labeled":true`) {
+		t.Errorf("labeled FIN not marked: %s", data)
+	}
+	if !strings.Contains(string(data), `"confidence":0.95,"source":"regex:fin_labeled"`) {
+		t.Errorf("labeled FIN without confidence and source: %s", data)
+	}
+}
+
+func TestFromEntitiesAddress(t *testing.T) {
+	t.Parallel()
+
+	entities := FromEntities(ner.Recognize("Nizami küçəsi 25, mənzil 14"))
+	if len(entities) != 1 || entities[0].Address == nil {
+		t.Fatalf("FromEntities = %+v, want one address", entities)
+	}
+	want := Address{Street: "Nizami küçəsi", House: "25", Apartment: "14"}
+	if got := *entities[0].Address; got != want {
+		t.Errorf("Address = %+v, want %+v", got, want)
+	}
+}
+
+func TestFromAnalyses(t *testing.T) {
+	t.Parallel()
+
+	analyses := morph.Analyze("kitablarımızdan")
+	got := FromAnalyses(analyses)
+	if len(got) != len(analyses) {
+		t.Fatalf("got %d analyses, want %d", len(got), len(analyses))
+	}
+	for i, a := range analyses {
+		if got[i].Stem != a.Stem || len(got[i].Morphemes) != len(a.Morphemes) || got[i].Score != a.Score {
+			t.Errorf("[%d] = %+v, want %v", i, got[i], a)
+		}
+		if got[i].Morphemes == nil {
+			t.Errorf("[%d] Morphemes is nil", i)
+		}
+	}
+}
+
+func TestFromTokensAndChunks(t *testing.T) {
+	t.Parallel()
+
+	text := "Birinci cümlə. İkinci cümlə daha uzundur."
+	tokens := tokenizer.WordTokens(text)
+	for i, tok := range FromTokens(tokens) {
+		if tok.Text != tokens[i].Text || tok.Start != tokens[i].Start || tok.End != tokens[i].End || tok.Type != tokens[i].Type {
+			t.Errorf("[%d] = %+v, want %v", i, tok, tokens[i])
+		}
+	}
+	chunks := chunker.BySize(text, 16, 4)
+	for i, c := range FromChunks(chunks) {
+		if c.Text != chunks[i].Text || c.Index != chunks[i].Index || text[c.Start:c.End] != c.Text {
+			t.Errorf("[%d] = %+v, want %v", i, c, chunks[i])
+		}
+	}
+}
+
+func TestFromReport(t *testing.T) {
+	t.Parallel()
+
+	r := validate.Validate("Bu metn  yoxlanilir .")
+	got := FromReport(r)
+	if got.Score != r.Score || len(got.Issues) != len(r.Issues) || got.Stats != r.Stats {
+		t.Fatalf("FromReport = %+v, want %+v", got, r)
+	}
+	for i, is := range got.Issues {
+		if is.Type != r.Issues[i].Type || is.Severity != r.Issues[i].Severity || is.Message != r.Issues[i].Message {
+			t.Errorf("[%d] = %+v, want %+v", i, is, r.Issues[i])
+		}
+	}
+}
@@ -0,0 +1,146 @@
+//go:build ignore
+
+// buildbigrams generates data/spell_bigrams.txt — word bigram frequencies used
+// by spell.CorrectContext to rank candidates by their neighbors. Run from the
+// project root:
+//
+//	go run scripts/buildbigrams.go
+//
+// The corpora are not part of the repository; place them at the paths
+// below first. The script exits without touching the output when no
+// corpus can be read or no pair reaches the minimum frequency, so the
+// embedded seed list is never replaced by an empty file.
+//
+// Output format: one entry per line, "word1 word2 frequency\n", sorted
+// descending by frequency. Only pairs of adjacent words separated by
+// whitespace are counted; punctuation breaks a pair. Pairs are included only
+// when frequency >= 20.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const (
+	corpusAzPath   = "data/corpus/az-corpus/sentences.txt"
+	corpusWikiPath = "data/corpus/azwiki/articles.txt"
+	outputPath     = "data/spell_bigrams.txt"
+	bigramMinFreq  = 20
+	scannerBufSize = 4 * 1024 * 1024 // 4 MB — handles very long lines
+)
+
+type bigramEntry struct {
+	pair string
+	freq int
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("[buildbigrams] ")
+
+	freq := make(map[string]int, 1<<20)
+
+	corpora := []string{corpusAzPath, corpusWikiPath}
+	read := 0
+	for _, path := range corpora {
+		n, err := processCorpus(path, freq)
+		if err != nil {
+			log.Printf("warning: skipping corpus %q: %v", path, err)
+			continue
+		}
+		log.Printf("processed %d lines from %s", n, path)
+		read++
+	}
+	if read == 0 {
+		log.Fatalf("no corpus could be read; %s left unchanged", outputPath)
+	}
+
+	var entries []bigramEntry
+	for pair, f := range freq {
+		if f >= bigramMinFreq {
+			entries = append(entries, bigramEntry{pair, f})
+		}
+	}
+
+	if len(entries) == 0 {
+		log.Fatalf("no bigram reaches frequency %d; %s left unchanged", bigramMinFreq, outputPath)
+	}
+
+	// Sort descending by frequency, then alphabetically for stability.
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].freq != entries[j].freq {
+			return entries[i].freq > entries[j].freq
+		}
+		return entries[i].pair < entries[j].pair
+	})
+
+	if err := writeOutput(outputPath, entries); err != nil {
+		log.Fatalf("cannot write output: %v", err)
+	}
+	log.Printf("wrote %d entries to %s", len(entries), outputPath)
+}
+
+// processCorpus reads a plain-text corpus file line by line, tokenizes each
+// line, and counts lowercased pairs of adjacent words into freq.
+// Returns the number of lines processed.
+func processCorpus(path string, freq map[string]int) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	buf := make([]byte, scannerBufSize)
+	sc.Buffer(buf, scannerBufSize)
+
+	lines := 0
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" {
+			continue
+		}
+		prev := ""
+		for _, tok := range tokenizer.WordTokens(line) {
+			switch tok.Type {
+			case tokenizer.Word:
+				lower := azcase.ToLower(tok.Text)
+				if prev != "" {
+					freq[prev+" "+lower]++
+				}
+				prev = lower
+			case tokenizer.Space:
+				// Whitespace keeps the pair open.
+			default:
+				prev = ""
+			}
+		}
+		lines++
+		if lines%100_000 == 0 {
+			fmt.Fprintf(os.Stderr, "[buildbigrams] %s: %d lines processed\n", path, lines)
+		}
+	}
+	return lines, sc.Err()
+}
+
+// writeOutput writes sorted bigram entries to path.
+func writeOutput(path string, entries []bigramEntry) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	bw := bufio.NewWriterSize(f, 4*1024*1024)
+	for _, e := range entries {
+		fmt.Fprintf(bw, "%s %d\n", e.pair, e.freq)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,169 @@
+package sentiment
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Lexicon file format (UTF-8 text, one entry per line), the format of the
+// embedded data/lexicon.txt:
+//
+//	# comment
+//	kredit<TAB>0.2
+//	faiz<TAB>-0.3
+//
+// Each entry maps a stem, as produced by the package's normalize →
+// morph.Stem → lowercase pipeline, to a score in -1.0..+1.0. Stems are
+// lowercased when loaded. Empty lines and lines starting with '#' are
+// ignored; a later entry for the same stem replaces an earlier one.
+const maxLexiconLineBytes = 4096
+
+// Lexicon is a sentiment lexicon: a score for each of a set of stems.
+// The package-level functions use the embedded lexicon, DefaultLexicon;
+// a domain lexicon (banking, telecom) loaded with LoadLexicon can replace
+// it, or extend it through Merge. Lexicon exposes the same Analyze, Score,
+// IsPositive, and AnalyzeSentences methods, with the same negation,
+// modifier, emotion, and irony rules, and Lookup to audit the score a
+// word contributes.
+//
+// A Lexicon is immutable after loading and safe for concurrent use.
+type Lexicon struct {
+	scores map[string]float64
+}
+
+// LexiconEntry is a lexicon stem and its score.
+type LexiconEntry struct {
+	Stem  string  `json:"stem"`
+	Score float64 `json:"score"` // -1.0 to +1.0
+}
+
+// DefaultLexicon returns the embedded lexicon used by the package-level
+// functions.
+func DefaultLexicon() *Lexicon {
+	return defaultLexicon
+}
+
+// LoadLexicon reads a lexicon in the text format described above. The
+// result holds only the entries of r; use DefaultLexicon().Merge to add
+// them to the embedded lexicon instead.
+// Returns an error naming the offending line for malformed input.
+func LoadLexicon(r io.Reader) (*Lexicon, error) {
+	l := &Lexicon{scores: make(map[string]float64, 256)} //nolint:mnd
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, maxLexiconLineBytes), maxLexiconLineBytes)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		stem, val, ok := strings.Cut(line, "\t")
+		if !ok {
+			return nil, fmt.Errorf("sentiment: lexicon line %d: missing tab separator", lineNo)
+		}
+		stem = azcase.ToLower(azcase.ComposeNFC(strings.TrimSpace(stem)))
+		if strings.ContainsFunc(stem, unicode.IsSpace) {
+			return nil, fmt.Errorf("sentiment: lexicon line %d: stem must be a single word, got %q", lineNo, stem)
+		}
+		score, err := parseWeight(strings.TrimSpace(val))
+		if err != nil {
+			return nil, fmt.Errorf("sentiment: lexicon line %d: %w", lineNo, err)
+		}
+		if score < -1 || score > 1 {
+			return nil, fmt.Errorf("sentiment: lexicon line %d: score %v out of range -1..1", lineNo, score)
+		}
+		l.scores[stem] = score
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("sentiment: reading lexicon: %w", err)
+	}
+	return l, nil
+}
+
+// Merge returns a new lexicon with the entries of l and other, other's
+// score winning for a stem in both. Neither lexicon is modified, so
+// DefaultLexicon().Merge(domain) extends the embedded lexicon with a
+// domain lexicon and overrides the scores it disagrees with.
+func (l *Lexicon) Merge(other *Lexicon) *Lexicon {
+	m := &Lexicon{scores: make(map[string]float64, l.Len()+other.Len())}
+	if l != nil {
+		maps.Copy(m.scores, l.scores)
+	}
+	if other != nil {
+		maps.Copy(m.scores, other.scores)
+	}
+	return m
+}
+
+// Lookup returns the lexicon entry that word matches when analyzed: the
+// word is normalized and stemmed as in Analyze, so "kreditlərdən" finds
+// the entry for "kredit". Reports false for a word with no entry.
+// Lookup gives the base score of a word; Result.Hits shows the modifiers
+// and negators that adjusted it in a particular text.
+func (l *Lexicon) Lookup(word string) (LexiconEntry, bool) {
+	if l == nil || len(word) > maxInputBytes {
+		return LexiconEntry{}, false
+	}
+	stem := wordStem(azcase.ComposeNFC(strings.TrimSpace(word)))
+	score, ok := l.scores[stem]
+	if !ok {
+		return LexiconEntry{}, false
+	}
+	return LexiconEntry{Stem: stem, Score: score}, true
+}
+
+// Lookup returns the entry of the embedded lexicon that word matches.
+// See Lexicon.Lookup.
+func Lookup(word string) (LexiconEntry, bool) {
+	return defaultLexicon.Lookup(word)
+}
+
+// Len returns the number of stems in the lexicon.
+func (l *Lexicon) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.scores)
+}
+
+// Entries returns all entries of the lexicon, sorted by stem.
+func (l *Lexicon) Entries() []LexiconEntry {
+	if l == nil {
+		return nil
+	}
+	out := make([]LexiconEntry, 0, len(l.scores))
+	for _, stem := range slices.Sorted(maps.Keys(l.scores)) {
+		out = append(out, LexiconEntry{Stem: stem, Score: l.scores[stem]})
+	}
+	return out
+}
+
+// Analyze returns detailed sentiment analysis of text, scoring words
+// against the lexicon. Returns a zero Result for empty or oversized
+// input, or a nil Lexicon.
+func (l *Lexicon) Analyze(text string) Result {
+	if l == nil || text == "" || len(text) > maxInputBytes {
+		return Result{}
+	}
+	return l.analyze(text)
+}
+
+// Score returns the aggregate sentiment score (-1.0 to +1.0) under the
+// lexicon.
+func (l *Lexicon) Score(text string) float64 {
+	return l.Analyze(text).Score
+}
+
+// IsPositive returns true if overall sentiment under the lexicon is
+// positive.
+func (l *Lexicon) IsPositive(text string) bool {
+	return l.Analyze(text).Sentiment == Positive
+}
@@ -0,0 +1,192 @@
+package sentiment
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const bankingLexicon = `# Banking domain lexicon
+komissiya	-0.4
+gecik	-0.6
+Kredit	0.1
+`
+
+func TestLoadLexicon(t *testing.T) {
+	l, err := LoadLexicon(strings.NewReader(bankingLexicon))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []LexiconEntry{{"gecik", -0.6}, {"komissiya", -0.4}, {"kredit", 0.1}}
+	if got := l.Entries(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+	if l.Len() != len(want) {
+		t.Errorf("Len() = %d, want %d", l.Len(), len(want))
+	}
+	if _, ok := l.Lookup("gözəl"); ok {
+		t.Error("loaded lexicon has an embedded entry; it should replace the embedded lexicon")
+	}
+	if got := l.Score("Bu gözəl kartdır."); got != 0 {
+		t.Errorf("Score with replaced lexicon = %v, want 0", got)
+	}
+}
+
+func TestLoadLexiconErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"no tab", "kredit 0.1", "line 1: missing tab separator"},
+		{"bad score", "# c\nkredit\tçox", `line 2: invalid weight "çox"`},
+		{"out of range", "kredit\t1.5", "line 1: score 1.5 out of range"},
+		{"nan", "kredit\tNaN", "line 1: invalid weight"},
+		{"phrase", "pis xidmət\t-0.5", `line 1: stem must be a single word, got "pis xidmət"`},
+		{"long line", strings.Repeat("a", maxLexiconLineBytes) + "\t0.5", "reading lexicon"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadLexicon(strings.NewReader(tt.in))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadLexicon(%q) error = %v, want containing %q", tt.in, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := LoadLexicon(errReader{}); err == nil || !strings.Contains(err.Error(), "reading lexicon") {
+		t.Errorf("LoadLexicon(failing reader) error = %v", err)
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("disk failure") }
+
+func TestLexiconMerge(t *testing.T) {
+	banking, err := LoadLexicon(strings.NewReader(bankingLexicon))
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged := DefaultLexicon().Merge(banking)
+
+	if got, want := merged.Len(), DefaultLexicon().Len()+2; got != want {
+		t.Errorf("merged Len() = %d, want %d (two new stems, one override)", got, want)
+	}
+	if e, ok := merged.Lookup("kreditlərdən"); !ok || e != (LexiconEntry{"kredit", 0.1}) {
+		t.Errorf("merged Lookup(kreditlərdən) = %v, %v; want the domain score", e, ok)
+	}
+	if e, ok := DefaultLexicon().Lookup("kredit"); !ok || e.Score == 0.1 {
+		t.Errorf("Merge modified the embedded lexicon: %v, %v", e, ok)
+	}
+	if _, ok := banking.Lookup("gözəl"); ok {
+		t.Error("Merge modified its argument")
+	}
+
+	text := "Komissiya tutuldu, ödəniş də gecikdi."
+	if got := Score(text); got != 0 {
+		t.Errorf("embedded Score(%q) = %v, want 0", text, got)
+	}
+	r := merged.Analyze(text)
+	if r.Sentiment != Negative || len(r.Hits) != 2 {
+		t.Fatalf("merged Analyze(%q) = %v with hits %v, want Negative with 2 hits", text, r, r.Hits)
+	}
+	if h := r.Hits[1]; h.Word != "gecikdi" || h.Stem != "gecik" || h.Base != -0.6 {
+		t.Errorf("hit = %+v, want gecikdi from stem gecik at -0.6", h)
+	}
+	if merged.IsPositive(text) {
+		t.Errorf("merged IsPositive(%q) = true", text)
+	}
+
+	// Negation and modifiers apply to domain words too.
+	if h := merged.Analyze("Ödəniş heç gecikmədi.").Hits; len(h) != 1 || !h[0].Negated || h[0].Modifier != "heç" || h[0].Score <= 0 {
+		t.Errorf("negated domain word hits = %+v", h)
+	}
+
+	b := merged.AnalyzeSentences("Kart gözəldir. Pul gecikdi.")
+	if b.MostNegative == nil || b.MostNegative.Text != "Pul gecikdi." {
+		t.Errorf("merged AnalyzeSentences MostNegative = %+v", b.MostNegative)
+	}
+}
+
+func TestLexiconLookup(t *testing.T) {
+	tests := []struct {
+		word string
+		want LexiconEntry
+		ok   bool
+	}{
+		{"gözəl", LexiconEntry{"gözəl", 0.9}, true},
+		{"Gözəldir", LexiconEntry{"gözəl", 0.9}, true},
+		{" pis ", LexiconEntry{"pis", -0.8}, true},
+		{"kitab", LexiconEntry{}, false},
+		{"123", LexiconEntry{}, false},
+		{"", LexiconEntry{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := Lookup(tt.word)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Lookup(%q) = %v, %v; want %v, %v", tt.word, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestLexiconLookupMatchesHits(t *testing.T) {
+	// Every hit of an analysis is explained by Lookup of its word.
+	r := Analyze("Otel çox gözəl idi, amma xidmət pis və kobud oldu.")
+	for _, h := range r.Hits {
+		e, ok := Lookup(h.Word)
+		if !ok || e.Stem != h.Stem || e.Score != h.Base {
+			t.Errorf("Lookup(%q) = %v, %v; hit has stem %q base %v", h.Word, e, ok, h.Stem, h.Base)
+		}
+	}
+}
+
+func TestNilLexicon(t *testing.T) {
+	var l *Lexicon
+	if r := l.Analyze("gözəl"); r.Sentiment != Neutral || r.Total != 0 {
+		t.Errorf("nil Analyze = %v", r)
+	}
+	if b := l.AnalyzeSentences("gözəl"); b.Sentences != nil {
+		t.Errorf("nil AnalyzeSentences = %+v", b)
+	}
+	if _, ok := l.Lookup("gözəl"); ok || l.Len() != 0 || l.Entries() != nil {
+		t.Error("nil lexicon has entries")
+	}
+	if got := l.Merge(DefaultLexicon()).Len(); got != DefaultLexicon().Len() {
+		t.Errorf("nil Merge Len() = %d", got)
+	}
+}
+
+func TestDefaultLexiconMatchesPackage(t *testing.T) {
+	for _, text := range []string{"Bu film gözəl idi", "Xidmət çox yaxşı deyil", "Otel gözəldir. Amma xidmət pis idi."} {
+		if got, want := DefaultLexicon().Analyze(text), Analyze(text); fmt.Sprint(got, got.Hits) != fmt.Sprint(want, want.Hits) {
+			t.Errorf("DefaultLexicon().Analyze(%q) = %v, Analyze = %v", text, got, want)
+		}
+	}
+}
+
+func BenchmarkLoadLexicon(b *testing.B) {
+	raw := strings.Repeat(bankingLexicon, 100)
+	for b.Loop() {
+		if _, err := LoadLexicon(strings.NewReader(raw)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func ExampleLoadLexicon() {
+	banking, err := LoadLexicon(strings.NewReader("komissiya\t-0.4\ngecik\t-0.6\n"))
+	if err != nil {
+		panic(err)
+	}
+	lex := DefaultLexicon().Merge(banking)
+
+	text := "Komissiya tutuldu, ödəniş də gecikdi."
+	fmt.Println(Analyze(text).Sentiment, lex.Analyze(text).Sentiment)
+	e, _ := lex.Lookup("gecikdi")
+	fmt.Println(e.Stem, e.Score)
+	// Output:
+	// Neutral Negative
+	// gecik -0.6
+}
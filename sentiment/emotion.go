@@ -0,0 +1,172 @@
+package sentiment
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/data"
+)
+
+// Emotion is a basic emotion category.
+type Emotion int
+
+const (
+	NoEmotion Emotion = iota // zero value: no emotion word found
+	Joy
+	Anger
+	Sadness
+	Fear
+	Surprise
+)
+
+// emotionNames maps Emotion values to their string names.
+var emotionNames = [...]string{
+	NoEmotion: "None",
+	Joy:       "Joy",
+	Anger:     "Anger",
+	Sadness:   "Sadness",
+	Fear:      "Fear",
+	Surprise:  "Surprise",
+}
+
+// emotionFromName maps string names back to Emotion values.
+var emotionFromName = map[string]Emotion{
+	"None":     NoEmotion,
+	"Joy":      Joy,
+	"Anger":    Anger,
+	"Sadness":  Sadness,
+	"Fear":     Fear,
+	"Surprise": Surprise,
+}
+
+// String returns the name of the emotion.
+func (e Emotion) String() string {
+	if int(e) >= 0 && int(e) < len(emotionNames) {
+		return emotionNames[e]
+	}
+	return fmt.Sprintf("Emotion(%d)", int(e))
+}
+
+// MarshalJSON encodes the emotion as a JSON string (e.g. "Anger").
+func (e Emotion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Anger") into an Emotion.
+func (e *Emotion) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := emotionFromName[s]
+	if !ok {
+		return fmt.Errorf("sentiment: unknown emotion: %q", s)
+	}
+	*e = v
+	return nil
+}
+
+// Emotions is a distribution over the basic emotions: the share of the
+// emotion words of a text in each category. The shares sum to 1, or are
+// all zero when the text has no emotion word.
+type Emotions struct {
+	Joy      float64 `json:"joy"`
+	Anger    float64 `json:"anger"`
+	Sadness  float64 `json:"sadness"`
+	Fear     float64 `json:"fear"`
+	Surprise float64 `json:"surprise"`
+}
+
+// Get returns the share of emotion e, or 0 for NoEmotion.
+func (m Emotions) Get(e Emotion) float64 {
+	switch e {
+	case Joy:
+		return m.Joy
+	case Anger:
+		return m.Anger
+	case Sadness:
+		return m.Sadness
+	case Fear:
+		return m.Fear
+	case Surprise:
+		return m.Surprise
+	}
+	return 0
+}
+
+// Dominant returns the emotion with the largest share, the first in
+// declaration order on ties, or NoEmotion when all shares are zero.
+func (m Emotions) Dominant() Emotion {
+	best, share := NoEmotion, 0.0
+	for e := Joy; e <= Surprise; e++ {
+		if v := m.Get(e); v > share {
+			best, share = e, v
+		}
+	}
+	return best
+}
+
+// emotionLexicon maps stems to emotion categories, built once at init.
+var emotionLexicon map[string]Emotion
+
+func init() {
+	emotionLexicon = parseEmotionLexicon(data.EmotionLexicon)
+}
+
+// parseEmotionLexicon parses tab-separated "stem\temotion" lines, where
+// emotion is a lowercase Emotion name.
+func parseEmotionLexicon(raw string) map[string]Emotion {
+	m := make(map[string]Emotion, 128) //nolint:mnd
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		stem, name, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		name = strings.TrimSpace(name)
+		e, ok := emotionFromName[strings.ToUpper(name[:1])+name[1:]]
+		if !ok || e == NoEmotion {
+			continue
+		}
+		m[strings.TrimSpace(stem)] = e
+	}
+	return m
+}
+
+// scoreEmotions returns the emotion distribution of words, whose stems
+// are stems as produced by wordStem. Each emotion word counts once,
+// scaled by an intensifier or diminisher before it; negated emotion words
+// ("qorxmadım", "narahat deyil") are not counted.
+func (l *Lexicon) scoreEmotions(words, stems []string) Emotions {
+	var weights [len(emotionNames)]float64
+	total := 0.0
+	for i, stem := range stems {
+		e, ok := emotionLexicon[stem]
+		if !ok {
+			continue
+		}
+		if isNegatedVerb(words[i], stem) || l.negatorAfter(words, stems, i) >= 0 {
+			continue
+		}
+		w := 1.0
+		if _, _, mult, ok := modifierBefore(words, stems, i); ok {
+			w = mult
+		}
+		weights[e] += w
+		total += w
+	}
+	if total == 0 {
+		return Emotions{}
+	}
+	return Emotions{
+		Joy:      weights[Joy] / total,
+		Anger:    weights[Anger] / total,
+		Sadness:  weights[Sadness] / total,
+		Fear:     weights[Fear] / total,
+		Surprise: weights[Surprise] / total,
+	}
+}
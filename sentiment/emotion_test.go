@@ -0,0 +1,109 @@
+package sentiment
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAnalyzeEmotion(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Emotion
+	}{
+		{"anger", "Sifarişim gecikdi, çox əsəbiləşdim!", Anger},
+		{"anger noun", "Bu rəzalətdir, qəzəbliyəm.", Anger},
+		{"sadness", "Çox məyus oldum.", Sadness},
+		{"sadness verb", "Evi çox darıxıram.", Sadness},
+		{"fear", "Qorxuram ki, pulum itəcək.", Fear},
+		{"joy", "Hədiyyəyə çox sevindim.", Joy},
+		{"surprise", "Vay, bu inanılmazdır!", Surprise},
+		{"negated verb not counted", "Heç qorxmadım.", NoEmotion},
+		{"negated by deyil not counted", "O narahat deyil.", NoEmotion},
+		{"no emotion words", "Bu gün hava yaxşıdır.", NoEmotion},
+		{"empty", "", NoEmotion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Analyze(tt.input).Emotion; got != tt.want {
+				t.Errorf("Analyze(%q).Emotion = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmotionsDistribution(t *testing.T) {
+	// The intensified "çox sevindim" outweighs the diminished "bir az
+	// təəccübləndim": 1.5 to 0.5.
+	r := Analyze("Bir az təəccübləndim, amma çox sevindim.")
+	if r.Emotion != Joy {
+		t.Errorf("Emotion = %v, want Joy", r.Emotion)
+	}
+	if math.Abs(r.Emotions.Joy-0.75) > 1e-9 || math.Abs(r.Emotions.Surprise-0.25) > 1e-9 {
+		t.Errorf("Emotions = %+v, want Joy 0.75, Surprise 0.25", r.Emotions)
+	}
+	sum := 0.0
+	for e := Joy; e <= Surprise; e++ {
+		sum += r.Emotions.Get(e)
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("shares sum to %v, want 1", sum)
+	}
+	if r.Emotions.Get(NoEmotion) != 0 {
+		t.Error("Get(NoEmotion) should be 0")
+	}
+}
+
+func TestEmotionSentences(t *testing.T) {
+	b := AnalyzeSentences("Çox sevindim. Sonra çox əsəbiləşdim.")
+	if len(b.Sentences) != 2 {
+		t.Fatalf("got %d sentences, want 2", len(b.Sentences))
+	}
+	if got := b.Sentences[0].Result.Emotion; got != Joy {
+		t.Errorf("sentence 0 emotion = %v, want Joy", got)
+	}
+	if got := b.Sentences[1].Result.Emotion; got != Anger {
+		t.Errorf("sentence 1 emotion = %v, want Anger", got)
+	}
+}
+
+func TestEmotionEnum(t *testing.T) {
+	for e := NoEmotion; e <= Surprise; e++ {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", e, err)
+		}
+		var got Emotion
+		if err := json.Unmarshal(data, &got); err != nil || got != e {
+			t.Errorf("round trip %v: got %v, err %v", e, got, err)
+		}
+	}
+	if s := Emotion(99).String(); s != "Emotion(99)" {
+		t.Errorf("String() = %q, want %q", s, "Emotion(99)")
+	}
+	var e Emotion
+	if err := json.Unmarshal([]byte(`"Boredom"`), &e); err == nil {
+		t.Error("expected error for unknown emotion")
+	}
+}
+
+func TestEmotionLexiconLoaded(t *testing.T) {
+	for _, stem := range []string{"sevin", "qəzəb", "kədər", "qorx", "təəccüb"} {
+		if _, ok := emotionLexicon[stem]; !ok {
+			t.Errorf("emotion lexicon missing %q", stem)
+		}
+	}
+}
+
+func ExampleResult_emotion() {
+	for _, s := range []string{"Sifarişim gecikdi, çox əsəbiləşdim!", "Çox məyus oldum."} {
+		r := Analyze(s)
+		fmt.Println(r.Sentiment, r.Emotion)
+	}
+	// Output:
+	// Negative Anger
+	// Negative Sadness
+}
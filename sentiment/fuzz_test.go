@@ -11,6 +11,8 @@ func FuzzAnalyze(f *testing.F) {
 	f.Add("")
 	f.Add("123 456")
 	f.Add("yaxşı pis yaxşı")
+	f.Add("Xidmət yaxşı idi, amma yemək pis idi")
+	f.Add("«Əla» xidmət, guya")
 
 	f.Fuzz(func(t *testing.T, s string) {
 		r := Analyze(s)
@@ -25,9 +27,9 @@ func FuzzAnalyze(f *testing.F) {
 			t.Errorf("Score is NaN or Inf: %v", r.Score)
 		}
 
-		// Sentiment must be one of the three valid values.
+		// Sentiment must be one of the four valid values.
 		switch r.Sentiment {
-		case Negative, Neutral, Positive:
+		case Negative, Neutral, Positive, Mixed:
 			// ok
 		default:
 			t.Errorf("invalid Sentiment: %d", r.Sentiment)
@@ -46,6 +48,19 @@ func FuzzAnalyze(f *testing.F) {
 			t.Errorf("Total (%d) < Positive (%d) + Negative (%d)", r.Total, r.Positive, r.Negative)
 		}
 
+		// The sub-scores split Score by sign.
+		if r.PositiveScore < 0 || r.NegativeScore > 0 || math.Abs(r.PositiveScore+r.NegativeScore-r.Score) > 1e-9 {
+			t.Errorf("PositiveScore %.3f + NegativeScore %.3f != Score %.3f", r.PositiveScore, r.NegativeScore, r.Score)
+		}
+
+		// Mixed needs both polarities.
+		if r.Sentiment == Mixed {
+			if r.Positive == 0 || r.Negative == 0 {
+				t.Errorf("Mixed with pos=%d, neg=%d", r.Positive, r.Negative)
+			}
+			return
+		}
+
 		// Polarity consistency.
 		if r.Score > 0 && r.Sentiment != Positive {
 			t.Errorf("Score %.3f but Sentiment %v", r.Score, r.Sentiment)
@@ -1,7 +1,6 @@
 package sentiment
 
 import (
-	"strconv"
 	"strings"
 	"unicode"
 
@@ -15,59 +14,69 @@ import (
 // negationWord is the Azerbaijani copula used to negate predicates.
 const negationWord = "deyil"
 
-// lexicon maps stems to sentiment scores, built once at init.
-var lexicon map[string]float64
+// negationWindow is the number of words after a lexicon word searched for
+// a negator.
+const negationWindow = 3
 
-func init() {
-	lexicon = parseLexicon(data.SentimentLexicon)
+// modifiers maps intensifiers (multiplier > 1) and diminishers (< 1) to
+// the factor they apply to the score of the lexicon word they directly
+// precede. Keys are lowercase words or two-word phrases.
+var modifiers = map[string]float64{
+	"çox":          1.5,
+	"olduqca":      1.5,
+	"lap":          1.5,
+	"ən":           1.5,
+	"heç":          1.5,
+	"tamamilə":     1.5,
+	"xeyli":        1.3,
+	"daha":         1.25,
+	"son dərəcə":   1.75,
+	"həddən artıq": 1.75,
+	"azca":         0.5,
+	"biraz":        0.5,
+	"bir az":       0.5,
+	"bir qədər":    0.5,
+	"bir balaca":   0.5,
+	"nisbətən":     0.6,
 }
 
-// parseLexicon parses tab-separated "stem\tscore" lines.
-func parseLexicon(raw string) map[string]float64 {
-	m := make(map[string]float64, 256) //nolint:mnd
-	for _, line := range strings.Split(raw, "\n") {
-		line = strings.TrimSpace(line)
-		if line == "" || line[0] == '#' {
-			continue
-		}
-		parts := strings.SplitN(line, "\t", 2) //nolint:mnd
-		if len(parts) != 2 {                   //nolint:mnd
-			continue
-		}
-		stem := strings.TrimSpace(parts[0])
-		score, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
-		if err != nil {
-			continue
-		}
-		m[stem] = score
+// defaultLexicon is the embedded sentiment lexicon, loaded once at init.
+var defaultLexicon *Lexicon
+
+func init() {
+	l, err := LoadLexicon(strings.NewReader(data.SentimentLexicon))
+	if err != nil {
+		panic(err)
 	}
-	return m
+	defaultLexicon = l
 }
 
 // analyze implements the core sentiment analysis pipeline.
-func analyze(text string) Result {
-	text = azcase.ComposeNFC(text)
-	words := tokenizer.Words(text)
+func (l *Lexicon) analyze(text string) Result {
+	return l.analyzeTokens(text, tokenizer.WordTokens(text))
+}
+
+// analyzeTokens is analyze over the word tokens of text.
+func (l *Lexicon) analyzeTokens(text string, tokens []tokenizer.Token) Result {
+	words, stems, starts := tokenWords(tokens, 0)
+	r := l.scoreWords(words, stems, starts)
+	r.Ironic = isIronic(text, 0, words, r.Hits)
+	return r
+}
+
+// scoreWords scores words against the lexicon. stems[i] is the stem of
+// words[i] as produced by wordStem, and starts[i] its byte offset. Every
+// contributing word is recorded as a Hit in the result, and the emotion
+// words make up Result.Emotions.
+func (l *Lexicon) scoreWords(words, stems []string, starts []int) Result {
 	if len(words) == 0 {
 		return Result{}
 	}
 
-	// Pre-compute stems to avoid double stemming during negation lookahead.
-	stems := make([]string, len(words))
-	for i, word := range words {
-		if isNonLinguistic(word) {
-			continue
-		}
-		stems[i] = azcase.ToLower(morph.Stem(normalize.NormalizeWord(word)))
-	}
-
 	var (
-		sum      float64
-		scored   int
-		posCount int
-		negCount int
+		hits []Hit
+		idx  []int // idx[k] is the word index of hits[k]
 	)
-
 	for i, word := range words {
 		if isNonLinguistic(word) {
 			continue
@@ -80,65 +89,209 @@ func analyze(text string) Result {
 			continue
 		}
 
-		score, ok := lexicon[stem]
+		base, ok := l.scores[stem]
 		if !ok {
 			continue
 		}
 
-		// Negate score when the next meaningful word is "deyil".
-		if followedByNeg(stems, i) {
-			score = -score
+		h := Hit{Word: word, Stem: stem, Start: starts[i], End: starts[i] + len(word), Base: base, Multiplier: 1}
+
+		// A modifier that is itself a lexicon word ("heç") is not scored.
+		if from, mod, mult, ok := modifierBefore(words, stems, i); ok {
+			h.Modifier, h.Multiplier = mod, mult
+			for len(idx) > 0 && idx[len(idx)-1] >= from {
+				hits, idx = hits[:len(hits)-1], idx[:len(idx)-1]
+			}
 		}
 
-		sum += score
-		scored++
-		if score > 0 {
-			posCount++
-		} else if score < 0 {
-			negCount++
+		if isNegatedVerb(word, stem) {
+			h.Negated, h.Negator = true, word
+		}
+		if j := l.negatorAfter(words, stems, i); j >= 0 {
+			h.Negated = !h.Negated
+			h.Negator = words[j]
 		}
+
+		h.Score = max(-1, min(1, base*h.Multiplier))
+		if h.Negated {
+			h.Score = -h.Score
+		}
+		hits = append(hits, h)
+		idx = append(idx, i)
 	}
 
-	if scored == 0 {
+	emotions := l.scoreEmotions(words, stems)
+	if len(hits) == 0 {
 		return Result{
 			Sentiment: Neutral,
 			Total:     len(words),
+			Emotion:   emotions.Dominant(),
+			Emotions:  emotions,
 		}
 	}
 
-	avg := sum / float64(scored)
+	var (
+		posSum   float64
+		negSum   float64
+		posCount int
+		negCount int
+	)
+	for _, h := range hits {
+		if h.Score > 0 {
+			posSum += h.Score
+			posCount++
+		} else if h.Score < 0 {
+			negSum += h.Score
+			negCount++
+		}
+	}
+	n := float64(len(hits))
+	avg := (posSum + negSum) / n
 
-	var polarity Sentiment
-	switch {
-	case avg > 0:
-		polarity = Positive
-	case avg < 0:
-		polarity = Negative
-	default:
-		polarity = Neutral
+	sentiment := polarity(avg)
+	if isMixed(hits, contrasted(words, hits, idx)) {
+		sentiment = Mixed
 	}
 
 	return Result{
-		Sentiment: polarity,
-		Score:     avg,
-		Positive:  posCount,
-		Negative:  negCount,
-		Total:     len(words),
+		Sentiment:     sentiment,
+		Score:         avg,
+		Positive:      posCount,
+		Negative:      negCount,
+		Total:         len(words),
+		Emotion:       emotions.Dominant(),
+		Emotions:      emotions,
+		Hits:          hits,
+		PositiveScore: posSum / n,
+		NegativeScore: negSum / n,
 	}
 }
 
-// followedByNeg reports whether the next non-empty stem after position idx
-// is the negation word "deyil".
-func followedByNeg(stems []string, idx int) bool {
-	for j := idx + 1; j < len(stems); j++ {
+// wordTokens tokenizes text and returns its words, their stems as produced
+// by wordStem, and their byte offsets in text shifted by base.
+func wordTokens(text string, base int) (words, stems []string, starts []int) {
+	return tokenWords(tokenizer.WordTokens(text), base)
+}
+
+// tokenWords returns the Word tokens of tokens as words, their stems as
+// produced by wordStem, and their byte offsets shifted by base.
+func tokenWords(tokens []tokenizer.Token, base int) (words, stems []string, starts []int) {
+	for _, tok := range tokens {
+		if tok.Type != tokenizer.Word {
+			continue
+		}
+		words = append(words, tok.Text)
+		stems = append(stems, wordStem(azcase.ComposeNFC(tok.Text)))
+		starts = append(starts, base+tok.Start)
+	}
+	return words, stems, starts
+}
+
+// wordStems tokenizes text and returns its words alongside their normalized,
+// lowercased stems. Non-linguistic words (digits, symbols) get an empty stem.
+// Stems are pre-computed to avoid double stemming during negation lookahead.
+func wordStems(text string) (words, stems []string) {
+	text = azcase.ComposeNFC(text)
+	words = tokenizer.Words(text)
+	stems = make([]string, len(words))
+	for i, word := range words {
+		stems[i] = wordStem(word)
+	}
+	return words, stems
+}
+
+// wordStem returns the normalized, lowercased stem of an NFC word, or ""
+// for non-linguistic words.
+func wordStem(word string) string {
+	if isNonLinguistic(word) {
+		return ""
+	}
+	return azcase.ToLower(morph.Stem(normalize.NormalizeWord(word)))
+}
+
+// polarity maps a score to its sentiment polarity by sign.
+func polarity(score float64) Sentiment {
+	switch {
+	case score > 0:
+		return Positive
+	case score < 0:
+		return Negative
+	default:
+		return Neutral
+	}
+}
+
+// negatorAfter returns the index of the negator that negates the lexicon
+// word at idx, or -1. A negator is "deyil" or a negated verb ("olmadı")
+// among the next negationWindow words, with no other lexicon word between.
+func (l *Lexicon) negatorAfter(words, stems []string, idx int) int {
+	seen := 0
+	for j := idx + 1; j < len(stems) && seen < negationWindow; j++ {
 		if stems[j] == "" {
 			continue
 		}
-		return stems[j] == negationWord
+		if stems[j] == negationWord || isNegatedVerb(words[j], stems[j]) || isNegatedPresent(words[j]) {
+			return j
+		}
+		if _, ok := l.scores[stems[j]]; ok {
+			return -1
+		}
+		seen++
+	}
+	return -1
+}
+
+// isNegatedVerb reports whether word is stem followed by the verbal
+// negation suffix -ma/-mə ("bəyənmədim", "olmadı").
+func isNegatedVerb(word, stem string) bool {
+	for _, a := range morph.Analyze(azcase.ToLower(normalize.NormalizeWord(azcase.ComposeNFC(word)))) {
+		if a.Stem == stem && len(a.Morphemes) > 0 && a.Morphemes[0].Tag == morph.Negation {
+			return true
+		}
 	}
 	return false
 }
 
+// isNegatedPresent reports whether word is a present-tense negative verb
+// ("gəlmir", "işləmirəm"). morph reads these as a stem ending in the
+// negation -m plus -ir, so the stem without -m must be a known verb. The
+// rule is not applied to lexicon words themselves, since the lexicon lists
+// such stems with their negative meaning ("yaram" for "yaramır").
+func isNegatedPresent(word string) bool {
+	for _, a := range morph.Analyze(azcase.ToLower(normalize.NormalizeWord(azcase.ComposeNFC(word)))) {
+		if len(a.Morphemes) > 0 && a.Morphemes[0].Tag == morph.TensePresent &&
+			strings.HasSuffix(a.Stem, "m") && morph.IsKnownStem(strings.TrimSuffix(a.Stem, "m")) {
+			return true
+		}
+	}
+	return false
+}
+
+// modifierBefore looks up an intensifier or diminisher directly before the
+// word at idx, preferring two-word phrases. It returns the index of the
+// modifier's first word, its lowercase text, and its multiplier.
+func modifierBefore(words, stems []string, idx int) (from int, mod string, mult float64, ok bool) {
+	lower := func(i int) string {
+		if stems[i] == "" {
+			return ""
+		}
+		return azcase.ToLower(normalize.NormalizeWord(azcase.ComposeNFC(words[i])))
+	}
+	if idx >= 2 {
+		mod = lower(idx-2) + " " + lower(idx-1)
+		if mult, ok = modifiers[mod]; ok {
+			return idx - 2, mod, mult, true
+		}
+	}
+	if idx >= 1 {
+		mod = lower(idx - 1)
+		if mult, ok = modifiers[mod]; ok {
+			return idx - 1, mod, mult, true
+		}
+	}
+	return 0, "", 0, false
+}
+
 // isNonLinguistic reports whether a word token is non-linguistic
 // (all digits, or contains no letters).
 func isNonLinguistic(word string) bool {
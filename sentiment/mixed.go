@@ -0,0 +1,108 @@
+package sentiment
+
+import (
+	"regexp"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Ratios of the weaker polarity to the stronger one above which a text
+// is Mixed.
+const (
+	mixedRatio         = 0.5
+	contrastMixedRatio = 0.25 // when a contrastive conjunction separates them
+)
+
+// mixedMinScore is the word score magnitude that makes a polarity clear.
+// Only clear words weigh in the Mixed rule, so that mildly colored words
+// ("xidmət", "bahalı") do not make a text Mixed.
+const mixedMinScore = 0.6
+
+// contrastWords are the conjunctions that oppose the clauses they join
+// ("yaxşı idi, amma bahalı").
+var contrastWords = map[string]bool{
+	"amma":  true,
+	"ancaq": true,
+	"lakin": true,
+	"fəqət": true,
+}
+
+// ironyParticles mark the claim they accompany as doubtful: "guya
+// keyfiyyətli məhsuldur".
+var ironyParticles = map[string]bool{
+	"guya": true,
+	"güya": true,
+}
+
+// reScareQuote matches a short quoted span, the scare quotes of «əla»
+// xidmət. Longer quotations are citations, not irony.
+var reScareQuote = regexp.MustCompile(`«[^«»]{1,40}»|“[^“”]{1,40}”|"[^"]{1,40}"`)
+
+// isMixed reports whether the clearly positive and clearly negative words
+// of hits are close enough in weight for the text to be Mixed. contrast
+// lowers the threshold.
+func isMixed(hits []Hit, contrast bool) bool {
+	var pos, neg float64
+	for _, h := range hits {
+		switch {
+		case h.Score >= mixedMinScore:
+			pos += h.Score
+		case h.Score <= -mixedMinScore:
+			neg -= h.Score
+		}
+	}
+	if pos == 0 || neg == 0 {
+		return false
+	}
+	ratio := mixedRatio
+	if contrast {
+		ratio = contrastMixedRatio
+	}
+	return min(pos, neg) >= ratio*max(pos, neg)
+}
+
+// contrasted reports whether a contrastive conjunction stands between two
+// consecutive hits of opposite polarity. idx[k] is the word index of
+// hits[k].
+func contrasted(words []string, hits []Hit, idx []int) bool {
+	for k := 1; k < len(hits); k++ {
+		if hits[k-1].Score*hits[k].Score >= 0 {
+			continue
+		}
+		for i := idx[k-1] + 1; i < idx[k]; i++ {
+			if contrastWords[azcase.ToLower(words[i])] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isIronic reports whether an irony cue marks the positive hits of text,
+// whose offsets are shifted by base: a positive word in scare quotes, or
+// an irony particle among words.
+func isIronic(text string, base int, words []string, hits []Hit) bool {
+	positive := false
+	for _, h := range hits {
+		if h.Score > 0 {
+			positive = true
+			break
+		}
+	}
+	if !positive {
+		return false
+	}
+	for _, w := range words {
+		if ironyParticles[azcase.ToLower(w)] {
+			return true
+		}
+	}
+	for _, m := range reScareQuote.FindAllStringIndex(text, -1) {
+		for _, h := range hits {
+			if h.Score > 0 && h.Start-base > m[0] && h.End-base < m[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
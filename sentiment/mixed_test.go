@@ -0,0 +1,83 @@
+package sentiment
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAnalyzeMixed(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Sentiment
+	}{
+		{"contrast", "Xidmət yaxşı idi, amma yemək pis idi.", Mixed},
+		{"no conjunction", "Otel gözəl idi, yemək isə pis.", Mixed},
+		{"intensified", "Məhsul əla idi, çatdırılma isə çox pis oldu", Mixed},
+		{"mild negative", "Yaxşı amma bahalı", Positive},
+		{"weak side", "Əla, gözəl, super, amma bir az pis", Positive},
+		{"one polarity", "Bu pis və çirkin bir hadisədir", Negative},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Analyze(tt.input)
+			if r.Sentiment != tt.want {
+				t.Errorf("Analyze(%q) = %v, want %v", tt.input, r, tt.want)
+			}
+			if r.PositiveScore < 0 || r.NegativeScore > 0 || math.Abs(r.PositiveScore+r.NegativeScore-r.Score) > 1e-9 {
+				t.Errorf("PositiveScore %.3f + NegativeScore %.3f != Score %.3f", r.PositiveScore, r.NegativeScore, r.Score)
+			}
+		})
+	}
+}
+
+func TestAnalyzeIronic(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"«Əla» xidmət! İki saat gözlədik.", true},
+		{"Çox “yaxşı” xidmət", true},
+		{"Guya keyfiyyətli məhsuldur.", true},
+		{"Əla xidmət!", false},
+		{"«Pis» xidmət", false},        // only positive words
+		{"Guya pis məhsuldur.", false}, // likewise
+		{`O dedi: "bu kitab çox yaxşı və maraqlıdır, hamıya tövsiyə edirəm"`, false}, // a citation
+	}
+	for _, tt := range tests {
+		r := Analyze(tt.input)
+		if r.Ironic != tt.want {
+			t.Errorf("Analyze(%q).Ironic = %v, want %v", tt.input, r.Ironic, tt.want)
+		}
+	}
+
+	b := AnalyzeSentences("Otel gözəl idi. Xidmət isə «əla» idi, iki saat gözlədik.")
+	if len(b.Sentences) != 2 || b.Sentences[0].Result.Ironic || !b.Sentences[1].Result.Ironic {
+		t.Errorf("AnalyzeSentences: sentences = %+v", b.Sentences)
+	}
+}
+
+func TestAnalyzeSentencesMixed(t *testing.T) {
+	b := AnalyzeSentences("Xidmət yaxşı idi, amma yemək pis idi. Ümumiyyətlə əla.")
+	if b.Sentences[0].Result.Sentiment != Mixed {
+		t.Fatalf("sentence 0 = %v, want Mixed", b.Sentences[0].Result)
+	}
+	if b.MostPositive == nil || b.MostPositive.Start == 0 {
+		t.Errorf("MostPositive = %+v, want the second sentence", b.MostPositive)
+	}
+}
+
+func BenchmarkAnalyzeMixed(b *testing.B) {
+	const text = "Xidmət yaxşı idi, amma yemək pis idi. «Əla» xidmət, iki saat gözlədik."
+	for b.Loop() {
+		Analyze(text)
+	}
+}
+
+func ExampleResult_mixed() {
+	r := Analyze("Məhsul əla idi, amma çatdırılma çox pis oldu.")
+	fmt.Printf("%v %.2f %.2f\n", r.Sentiment, r.PositiveScore, r.NegativeScore)
+	// Output:
+	// Mixed 0.45 -0.50
+}
@@ -0,0 +1,210 @@
+package sentiment
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Model file format (UTF-8 text, one entry per line):
+//
+//	# comment
+//	@order<TAB>2
+//	@bias<TAB>-0.05
+//	yaxşı<TAB>1.2
+//	yaxşı deyil<TAB>-2.1
+//
+// Feature lines map a stem n-gram (stems joined by a single space, as
+// produced by the package's normalize → morph.Stem → lowercase pipeline)
+// to a weight. Directive lines start with '@'. Empty lines and lines
+// starting with '#' are ignored.
+const (
+	maxModelLineBytes = 4096
+	maxModelOrder     = 3
+	defaultModelOrder = 2
+)
+
+// Model is a linear classifier over stem n-gram features, trained offline.
+// The decision value is bias plus the sum of weights of all n-grams present
+// in the text (counted with multiplicity), squashed to -1.0..+1.0 via tanh.
+//
+// When none of a text's features are known to the model, the embedded
+// lexicon is used as a fallback, so a Model is never worse at coverage
+// than the package-level functions.
+//
+// A Model is immutable after loading and safe for concurrent use.
+type Model struct {
+	weights map[string]float64
+	bias    float64
+	order   int // maximum n-gram length
+}
+
+// LoadModel reads a linear model in the text format described above.
+// Returns an error naming the offending line for malformed input.
+func LoadModel(r io.Reader) (*Model, error) {
+	m := &Model{weights: make(map[string]float64), order: defaultModelOrder}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, maxModelLineBytes), maxModelLineBytes)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		key, val, ok := strings.Cut(line, "\t")
+		if !ok {
+			return nil, fmt.Errorf("sentiment: model line %d: missing tab separator", lineNo)
+		}
+		key = strings.TrimSpace(key)
+		val = strings.TrimSpace(val)
+		if err := m.setEntry(key, val); err != nil {
+			return nil, fmt.Errorf("sentiment: model line %d: %w", lineNo, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("sentiment: reading model: %w", err)
+	}
+	return m, nil
+}
+
+// Save writes the model to w in the text format read by LoadModel, with
+// features sorted for stable diffs.
+func (m *Model) Save(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "@order\t%d\n", m.order)
+	fmt.Fprintf(bw, "@bias\t%s\n", strconv.FormatFloat(m.bias, 'g', -1, 64))
+	for _, gram := range slices.Sorted(maps.Keys(m.weights)) {
+		fmt.Fprintf(bw, "%s\t%s\n", gram, strconv.FormatFloat(m.weights[gram], 'g', -1, 64))
+	}
+	return bw.Flush()
+}
+
+// setEntry applies one parsed key/value line to the model.
+func (m *Model) setEntry(key, val string) error {
+	switch key {
+	case "@order":
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 1 || n > maxModelOrder {
+			return fmt.Errorf("order must be 1..%d, got %q", maxModelOrder, val)
+		}
+		m.order = n
+		return nil
+	case "@bias":
+		b, err := parseWeight(val)
+		if err != nil {
+			return err
+		}
+		m.bias = b
+		return nil
+	}
+	if strings.HasPrefix(key, "@") {
+		return fmt.Errorf("unknown directive %q", key)
+	}
+	if key == "" {
+		return fmt.Errorf("empty feature")
+	}
+	w, err := parseWeight(val)
+	if err != nil {
+		return err
+	}
+	m.weights[strings.Join(strings.Fields(key), " ")] = w
+	return nil
+}
+
+// parseWeight parses a finite float weight.
+func parseWeight(s string) (float64, error) {
+	w, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(w) || math.IsInf(w, 0) {
+		return 0, fmt.Errorf("invalid weight %q", s)
+	}
+	return w, nil
+}
+
+// Analyze returns detailed sentiment analysis of text using the model.
+// Positive and Negative count words whose unigram weight is positive or
+// negative. Emotions come from the embedded emotion lexicon. Falls back
+// to the embedded lexicon when no feature matches.
+// Returns a zero Result for empty or oversized input, or a nil Model.
+func (m *Model) Analyze(text string) Result {
+	if m == nil || text == "" || len(text) > maxInputBytes {
+		return Result{}
+	}
+	words, stems := wordStems(text)
+	if len(words) == 0 {
+		return Result{}
+	}
+
+	z := m.bias
+	matched := false
+	var posCount, negCount int
+	for i := range stems {
+		if stems[i] == "" {
+			continue
+		}
+		for n := 1; n <= m.order && i+n <= len(stems); n++ {
+			gram, ok := joinStems(stems[i : i+n])
+			if !ok {
+				break
+			}
+			w, found := m.weights[gram]
+			if !found {
+				continue
+			}
+			matched = true
+			z += w
+			if n == 1 {
+				if w > 0 {
+					posCount++
+				} else if w < 0 {
+					negCount++
+				}
+			}
+		}
+	}
+
+	if !matched {
+		return defaultLexicon.analyze(text)
+	}
+
+	score := math.Tanh(z)
+	emotions := defaultLexicon.scoreEmotions(words, stems)
+	return Result{
+		Sentiment: polarity(score),
+		Score:     score,
+		Positive:  posCount,
+		Negative:  negCount,
+		Total:     len(words),
+		Emotion:   emotions.Dominant(),
+		Emotions:  emotions,
+	}
+}
+
+// Score returns the aggregate sentiment score (-1.0 to +1.0) using the model.
+func (m *Model) Score(text string) float64 {
+	return m.Analyze(text).Score
+}
+
+// IsPositive returns true if overall sentiment under the model is positive.
+func (m *Model) IsPositive(text string) bool {
+	return m.Analyze(text).Sentiment == Positive
+}
+
+// joinStems joins stems into an n-gram feature key. Returns false if any
+// stem is empty (non-linguistic token), since n-grams never span them.
+func joinStems(stems []string) (string, bool) {
+	if len(stems) == 1 {
+		return stems[0], stems[0] != ""
+	}
+	for _, s := range stems {
+		if s == "" {
+			return "", false
+		}
+	}
+	return strings.Join(stems, " "), true
+}
@@ -0,0 +1,157 @@
+package sentiment
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testModel = `# test model
+@order	2
+@bias	-0.1
+
+xidmət	0.2
+yaxşı	1.5
+bahalı	-1.0
+yaxşı deyil	-4.0
+`
+
+func mustLoadModel(t *testing.T, src string) *Model {
+	t.Helper()
+	m, err := LoadModel(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("LoadModel: %v", err)
+	}
+	return m
+}
+
+func TestLoadModel(t *testing.T) {
+	m := mustLoadModel(t, testModel)
+	if m.order != 2 {
+		t.Errorf("order = %d, want 2", m.order)
+	}
+	if m.bias != -0.1 {
+		t.Errorf("bias = %v, want -0.1", m.bias)
+	}
+	if got := m.weights["yaxşı deyil"]; got != -4.0 {
+		t.Errorf("weights[yaxşı deyil] = %v, want -4.0", got)
+	}
+	if len(m.weights) != 4 {
+		t.Errorf("len(weights) = %d, want 4", len(m.weights))
+	}
+}
+
+func TestLoadModelErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"missing tab", "yaxşı 1.0\n"},
+		{"bad weight", "yaxşı\tabc\n"},
+		{"NaN weight", "yaxşı\tNaN\n"},
+		{"infinite weight", "yaxşı\t+Inf\n"},
+		{"bad order", "@order\t9\n"},
+		{"unknown directive", "@foo\t1\n"},
+		{"empty feature", "\t1.0\n"},
+		{"line too long", strings.Repeat("a", maxModelLineBytes+1) + "\t1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadModel(strings.NewReader(tt.src)); err == nil {
+				t.Errorf("LoadModel(%q) error = nil, want error", tt.name)
+			}
+		})
+	}
+}
+
+func TestModelSave(t *testing.T) {
+	m := mustLoadModel(t, testModel)
+	var buf strings.Builder
+	if err := m.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	want := "@order\t2\n@bias\t-0.1\nbahalı\t-1\nxidmət\t0.2\nyaxşı\t1.5\nyaxşı deyil\t-4\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Save wrote\n%s\nwant\n%s", got, want)
+	}
+	if loaded := mustLoadModel(t, buf.String()); !reflect.DeepEqual(loaded, m) {
+		t.Error("model differs after Save/LoadModel round trip")
+	}
+}
+
+func TestModelAnalyze(t *testing.T) {
+	m := mustLoadModel(t, testModel)
+
+	tests := []struct {
+		name    string
+		input   string
+		wantPol Sentiment
+	}{
+		{"unigram positive", "Xidmət yaxşı idi", Positive},
+		{"unigram negative", "Çox bahalı", Negative},
+		{"bigram overrides unigram", "Bu yaxşı deyil", Negative},
+		{"lexicon fallback", "Bu film gözəl idi", Positive},
+		{"no features neutral", "Bakı paytaxtdır", Neutral},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := m.Analyze(tt.input)
+			if got.Sentiment != tt.wantPol {
+				t.Errorf("Analyze(%q) = %v, want %v", tt.input, got, tt.wantPol)
+			}
+			if got.Score < -1 || got.Score > 1 {
+				t.Errorf("Score = %v, out of range", got.Score)
+			}
+		})
+	}
+
+	t.Run("fallback matches lexicon", func(t *testing.T) {
+		in := "Bu film gözəl və maraqlı idi"
+		if got, want := m.Analyze(in), Analyze(in); !reflect.DeepEqual(got, want) {
+			t.Errorf("Analyze(%q) = %v, want lexicon result %v", in, got, want)
+		}
+	})
+
+	t.Run("empty and nil", func(t *testing.T) {
+		if got := m.Analyze(""); !reflect.DeepEqual(got, Result{}) {
+			t.Errorf("Analyze(\"\") = %v, want zero", got)
+		}
+		var nilModel *Model
+		if got := nilModel.Analyze("yaxşı"); !reflect.DeepEqual(got, Result{}) {
+			t.Errorf("nil Model Analyze = %v, want zero", got)
+		}
+	})
+
+	t.Run("Score and IsPositive", func(t *testing.T) {
+		if m.Score("yaxşı") <= 0 {
+			t.Error("Score(yaxşı) <= 0")
+		}
+		if !m.IsPositive("yaxşı") || m.IsPositive("bahalı") {
+			t.Error("IsPositive mismatch")
+		}
+	})
+}
+
+func BenchmarkModelAnalyze(b *testing.B) {
+	m, err := LoadModel(strings.NewReader(testModel))
+	if err != nil {
+		b.Fatal(err)
+	}
+	text := strings.Repeat("Xidmət yaxşı idi, amma bahalı deyil. ", 20)
+	for b.Loop() {
+		m.Analyze(text)
+	}
+}
+
+func ExampleLoadModel() {
+	m, err := LoadModel(strings.NewReader("yaxşı\t1.5\nyaxşı deyil\t-4.0\n"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(m.Analyze("Xidmət yaxşı idi").Sentiment)
+	fmt.Println(m.Analyze("Xidmət yaxşı deyil").Sentiment)
+	// Output:
+	// Positive
+	// Negative
+}
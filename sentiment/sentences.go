@@ -0,0 +1,107 @@
+package sentiment
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// Hit is a lexicon word that contributed to a score, with the rules that
+// adjusted its lexicon score.
+type Hit struct {
+	Word       string  `json:"word"`               // word as it appears in the text
+	Stem       string  `json:"stem"`               // lexicon stem the word matched
+	Start      int     `json:"start"`              // byte offset in the original text (inclusive)
+	End        int     `json:"end"`                // byte offset in the original text (exclusive)
+	Score      float64 `json:"score"`              // final score: Base scaled by Multiplier, sign-flipped when negated
+	Base       float64 `json:"base"`               // lexicon score
+	Multiplier float64 `json:"multiplier"`         // intensifier/diminisher factor, 1 when unmodified
+	Modifier   string  `json:"modifier,omitempty"` // intensifier or diminisher before the word ("çox", "bir az")
+	Negated    bool    `json:"negated"`            // polarity flipped by a negator
+	Negator    string  `json:"negator,omitempty"`  // word that negated it ("deyil", "olmadı", or the word itself)
+}
+
+// SentenceResult is the sentiment of one sentence.
+type SentenceResult struct {
+	Text   string `json:"text"`   // sentence text, without surrounding whitespace
+	Start  int    `json:"start"`  // byte offset in the original text (inclusive)
+	End    int    `json:"end"`    // byte offset in the original text (exclusive)
+	Result Result `json:"result"` // sentiment of the sentence alone
+	Hits   []Hit  `json:"hits"`   // contributing lexicon words, in text order (Result.Hits)
+}
+
+// Span is a scored region of the text.
+type Span struct {
+	Text  string  `json:"text"`
+	Start int     `json:"start"` // byte offset (inclusive)
+	End   int     `json:"end"`   // byte offset (exclusive)
+	Score float64 `json:"score"`
+}
+
+// Breakdown is a per-sentence sentiment analysis of a text.
+type Breakdown struct {
+	Result       Result           `json:"result"`                  // whole-text sentiment, as returned by Analyze
+	Sentences    []SentenceResult `json:"sentences"`               // one entry per sentence, in text order
+	MostPositive *Span            `json:"most_positive,omitempty"` // highest-scoring positive sentence
+	MostNegative *Span            `json:"most_negative,omitempty"` // lowest-scoring negative sentence
+}
+
+// AnalyzeSentences splits text into sentences and scores each one
+// separately, recording the lexicon words that contributed to each score.
+// MostPositive and MostNegative point at the strongest positive and
+// negative sentences (the earliest on ties) so that review pipelines can
+// show which part of a comment carried the sentiment; they are nil when
+// no sentence has that polarity. A Mixed sentence counts by the sign of
+// its score.
+//
+// Offsets refer to text and satisfy text[s.Start:s.End] == s.Text for
+// sentences, spans, and hits. Negation ("deyil") does not carry across
+// sentence boundaries. Returns a zero Breakdown for empty or oversized
+// input.
+func AnalyzeSentences(text string) Breakdown {
+	return defaultLexicon.AnalyzeSentences(text)
+}
+
+// AnalyzeSentences is like the package-level AnalyzeSentences but scores
+// words against the lexicon l.
+func (l *Lexicon) AnalyzeSentences(text string) Breakdown {
+	if l == nil || text == "" || len(text) > maxInputBytes {
+		return Breakdown{}
+	}
+
+	b := Breakdown{Result: l.analyze(text)}
+	for _, sent := range tokenizer.SentenceTokens(text) {
+		sr := l.analyzeSentence(sent)
+		b.Sentences = append(b.Sentences, sr)
+
+		span := &Span{Text: sr.Text, Start: sr.Start, End: sr.End, Score: sr.Result.Score}
+		switch polarity(sr.Result.Score) {
+		case Positive:
+			if b.MostPositive == nil || span.Score > b.MostPositive.Score {
+				b.MostPositive = span
+			}
+		case Negative:
+			if b.MostNegative == nil || span.Score < b.MostNegative.Score {
+				b.MostNegative = span
+			}
+		}
+	}
+	return b
+}
+
+// analyzeSentence scores a single sentence token, trimmed of surrounding
+// whitespace.
+func (l *Lexicon) analyzeSentence(sent tokenizer.Token) SentenceResult {
+	trimmed := strings.TrimLeftFunc(sent.Text, unicode.IsSpace)
+	sent.Start += len(sent.Text) - len(trimmed)
+	sent.Text = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+	sent.End = sent.Start + len(sent.Text)
+
+	sr := SentenceResult{Text: sent.Text, Start: sent.Start, End: sent.End}
+	words, stems, starts := wordTokens(sent.Text, sent.Start)
+	sr.Result = l.scoreWords(words, stems, starts)
+	sr.Result.Ironic = isIronic(sent.Text, sent.Start, words, sr.Result.Hits)
+	sr.Hits = sr.Result.Hits
+	return sr
+}
@@ -0,0 +1,114 @@
+package sentiment
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const reviewText = "Otel çox gözəldir. Amma xidmət pis idi. Yemək dadlı deyil."
+
+func TestAnalyzeSentences(t *testing.T) {
+	b := AnalyzeSentences(reviewText)
+	if !reflect.DeepEqual(b.Result, Analyze(reviewText)) {
+		t.Errorf("Result = %v, want Analyze result %v", b.Result, Analyze(reviewText))
+	}
+	want := []struct {
+		text string
+		pol  Sentiment
+		hits []string
+	}{
+		{"Otel çox gözəldir.", Positive, []string{"gözəldir"}},
+		{"Amma xidmət pis idi.", Negative, []string{"xidmət", "pis"}},
+		{"Yemək dadlı deyil.", Negative, []string{"dadlı"}},
+	}
+	if len(b.Sentences) != len(want) {
+		t.Fatalf("got %d sentences, want %d: %+v", len(b.Sentences), len(want), b.Sentences)
+	}
+	for i, w := range want {
+		s := b.Sentences[i]
+		if s.Text != w.text || s.Result.Sentiment != w.pol {
+			t.Errorf("sentence %d = %q %v, want %q %v", i, s.Text, s.Result.Sentiment, w.text, w.pol)
+		}
+		var hits []string
+		for _, h := range s.Hits {
+			hits = append(hits, h.Word)
+		}
+		if fmt.Sprint(hits) != fmt.Sprint(w.hits) {
+			t.Errorf("sentence %d hits = %v, want %v", i, hits, w.hits)
+		}
+	}
+
+	neg := b.Sentences[2].Hits[0]
+	if !neg.Negated || neg.Score >= 0 {
+		t.Errorf("negated hit = %+v, want Negated with negative score", neg)
+	}
+	if b.MostPositive == nil || b.MostPositive.Text != want[0].text {
+		t.Errorf("MostPositive = %+v, want %q", b.MostPositive, want[0].text)
+	}
+	if b.MostNegative == nil || b.MostNegative.Text != want[2].text {
+		t.Errorf("MostNegative = %+v, want %q", b.MostNegative, want[2].text)
+	}
+}
+
+func TestAnalyzeSentencesOffsets(t *testing.T) {
+	inputs := []string{
+		reviewText,
+		"  Çox yaxşı!\n\nƏla xidmət.  ",
+		"Gözəl.Pis.",
+		"Bu pis deyil. Dəhşətli!",
+	}
+	for _, text := range inputs {
+		b := AnalyzeSentences(text)
+		for _, s := range b.Sentences {
+			if text[s.Start:s.End] != s.Text {
+				t.Errorf("%q: sentence text[%d:%d] = %q, want %q", text, s.Start, s.End, text[s.Start:s.End], s.Text)
+			}
+			if s.Text != strings.TrimSpace(s.Text) {
+				t.Errorf("%q: sentence %q not trimmed", text, s.Text)
+			}
+			for _, h := range s.Hits {
+				if text[h.Start:h.End] != h.Word {
+					t.Errorf("%q: hit text[%d:%d] = %q, want %q", text, h.Start, h.End, text[h.Start:h.End], h.Word)
+				}
+			}
+		}
+		for _, sp := range []*Span{b.MostPositive, b.MostNegative} {
+			if sp != nil && text[sp.Start:sp.End] != sp.Text {
+				t.Errorf("%q: span text[%d:%d] = %q, want %q", text, sp.Start, sp.End, text[sp.Start:sp.End], sp.Text)
+			}
+		}
+	}
+}
+
+func TestAnalyzeSentencesEmpty(t *testing.T) {
+	for _, text := range []string{"", strings.Repeat("a", maxInputBytes+1)} {
+		b := AnalyzeSentences(text)
+		if b.Sentences != nil || b.MostPositive != nil || b.MostNegative != nil || !reflect.DeepEqual(b.Result, Result{}) {
+			t.Errorf("AnalyzeSentences(len %d) = %+v, want zero Breakdown", len(text), b)
+		}
+	}
+	b := AnalyzeSentences("Bakı Azərbaycanın paytaxtıdır.")
+	if b.MostPositive != nil || b.MostNegative != nil {
+		t.Errorf("neutral text has spans: %+v", b)
+	}
+}
+
+func BenchmarkAnalyzeSentences(b *testing.B) {
+	for b.Loop() {
+		AnalyzeSentences(reviewText)
+	}
+}
+
+func ExampleAnalyzeSentences() {
+	b := AnalyzeSentences("Otel çox gözəldir. Amma xidmət pis idi.")
+	for _, s := range b.Sentences {
+		fmt.Printf("%s %s\n", s.Result.Sentiment, s.Text)
+	}
+	fmt.Printf("most negative: %q at %d\n", b.MostNegative.Text, b.MostNegative.Start)
+	// Output:
+	// Positive Otel çox gözəldir.
+	// Negative Amma xidmət pis idi.
+	// most negative: "Amma xidmət pis idi." at 22
+}
@@ -1,18 +1,67 @@
 // Package sentiment performs lexicon-based sentiment analysis of Azerbaijani text.
 //
 // The analyzer tokenizes input, normalizes diacritics, stems each word, and
-// looks up the stem in an embedded sentiment lexicon. Word scores are averaged
-// to produce an aggregate sentiment score.
+// looks up the stem in an embedded sentiment lexicon. A rule layer then
+// adjusts each word score before the scores are averaged:
+//
+//   - Negation flips the polarity of a word followed within three words by
+//     "deyil" or a negated verb ("yaxşı deyil", "yaxşı olmadı"), or of a
+//     verb negated with -ma/-mə ("bəyənmədim").
+//   - Intensifiers ("çox", "olduqca", "son dərəcə") and diminishers ("bir
+//     az", "nisbətən") directly before a word scale its score, clamped to
+//     [-1, 1].
+//
+// Result.Hits explains every contributing word: its lexicon score, the
+// modifier and negator applied, and its final score.
+//
+// Beyond polarity, Result.Emotions gives the distribution of the basic
+// emotions (joy, anger, sadness, fear, surprise) among the words of an
+// embedded emotion lexicon, and Result.Emotion the dominant one, so that
+// an angry complaint ("çox əsəbiləşdim") can be told from a sad one ("çox
+// məyus oldum"). Emotion words follow the same modifier and negation
+// rules: intensified words weigh more and negated ones are not counted.
 //
 // Three convenience functions are provided:
 //
-//   - Analyze returns a full Result with score, polarity, and word counts.
+//   - Analyze returns a full Result with score, polarity, and word counts;
+//     AnalyzeTokens does the same over tokens the caller already has.
 //   - Score returns the aggregate score (-1.0 to +1.0).
-//   - IsPositive returns true when overall sentiment is positive.
+//   - IsPositive returns true when overall sentiment is positive (not
+//     Mixed).
+//
+// AnalyzeSentences scores each sentence separately and reports the
+// contributing lexicon words and the strongest positive and negative
+// sentences with byte offsets.
+//
+// A domain lexicon (banking, telecom) in the format of the embedded one
+// can be read with LoadLexicon and used alone, or added to the embedded
+// lexicon with DefaultLexicon().Merge. Lexicon exposes the same
+// Analyze/Score/IsPositive/AnalyzeSentences methods, and Lookup returns
+// the stem and score a word matches, to audit why a text got its score.
+//
+// A linear model over stem n-grams trained offline can be loaded with
+// LoadModel, or trained from labeled texts with Train and written with
+// Model.Save. Model exposes the same Analyze/Score/IsPositive methods,
+// plus Predict for three-way classification, and falls back to the
+// embedded lexicon for texts with no known features.
+//
+// A text with both clearly positive and clearly negative words (scores
+// of magnitude 0.6 or more) is reported as Mixed rather than averaged
+// into a misleading neutral, when the weaker side of these words weighs
+// at least half of the stronger one, or a quarter when a contrastive
+// conjunction ("amma", "ancaq", "lakin", "fəqət") separates words of
+// opposite polarity. Result.PositiveScore and
+// Result.NegativeScore carry the two sides, and Score their sum.
+//
+// Result.Ironic flags a likely ironic use of positive words: a positive
+// word in quotation marks ("«əla» xidmət") or a text with the particle
+// "guya" ("guya keyfiyyətli məhsuldur"). The flag is a hint for the
+// caller and does not change the score.
 //
-// v1 limitations:
-//   - No intensifier/diminisher support.
-//   - Sarcasm is not detected.
+// Limitations:
+//   - Modifiers apply only to the word directly after them.
+//   - Irony is flagged only by the cues above; sarcasm without them is
+//     not detected.
 //
 // All functions are safe for concurrent use by multiple goroutines.
 package sentiment
@@ -20,18 +69,27 @@ package sentiment
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
 )
 
 // maxInputBytes is the maximum input size. Inputs exceeding this return a zero Result.
 const maxInputBytes = 1 << 20 // 1 MiB
 
-// Sentiment represents the sentiment polarity.
+// Sentiment represents the sentiment polarity. Negative, Neutral, and
+// Positive carry the sign of the polarity; Mixed has no sign, so compare
+// against the constants (r.Sentiment == Positive) rather than testing
+// r.Sentiment > 0, and use Score for the direction of a Mixed text.
 type Sentiment int
 
 const (
 	Negative Sentiment = -1
 	Neutral  Sentiment = 0
 	Positive Sentiment = 1
+	// Mixed marks a text with both clearly positive and clearly negative
+	// words ("xidmət yaxşı idi, amma yemək pis idi"), whose Score would
+	// otherwise average out near neutral.
+	Mixed Sentiment = 2
 )
 
 // sentimentNames maps Sentiment values to their string names.
@@ -39,6 +97,7 @@ var sentimentNames = map[Sentiment]string{
 	Negative: "Negative",
 	Neutral:  "Neutral",
 	Positive: "Positive",
+	Mixed:    "Mixed",
 }
 
 // sentimentFromName maps string names back to Sentiment values.
@@ -46,6 +105,7 @@ var sentimentFromName = map[string]Sentiment{
 	"Negative": Negative,
 	"Neutral":  Neutral,
 	"Positive": Positive,
+	"Mixed":    Mixed,
 }
 
 // String returns the name of the sentiment polarity.
@@ -78,10 +138,20 @@ func (s *Sentiment) UnmarshalJSON(data []byte) error {
 // Result holds the sentiment analysis output.
 type Result struct {
 	Sentiment Sentiment `json:"sentiment"`
-	Score     float64   `json:"score"`    // -1.0 to +1.0
-	Positive  int       `json:"positive"` // count of positive words
-	Negative  int       `json:"negative"` // count of negative words
-	Total     int       `json:"total"`    // total analyzed words
+	Score     float64   `json:"score"`          // -1.0 to +1.0
+	Positive  int       `json:"positive"`       // count of positive words
+	Negative  int       `json:"negative"`       // count of negative words
+	Total     int       `json:"total"`          // total analyzed words
+	Emotion   Emotion   `json:"emotion"`        // dominant emotion, NoEmotion when none is expressed
+	Emotions  Emotions  `json:"emotions"`       // share of each emotion among the emotion words
+	Hits      []Hit     `json:"hits,omitempty"` // per-word explanation of the lexicon score, in text order
+
+	// PositiveScore and NegativeScore split Score by the sign of the word
+	// scores: Score = PositiveScore + NegativeScore, PositiveScore >= 0
+	// and NegativeScore <= 0.
+	PositiveScore float64 `json:"positive_score"`
+	NegativeScore float64 `json:"negative_score"`
+	Ironic        bool    `json:"ironic,omitempty"` // an irony cue marks a positive word (see package doc)
 }
 
 // String returns a debug representation of the result.
@@ -96,7 +166,18 @@ func Analyze(text string) Result {
 	if text == "" || len(text) > maxInputBytes {
 		return Result{}
 	}
-	return analyze(text)
+	return defaultLexicon.analyze(text)
+}
+
+// AnalyzeTokens is like Analyze but takes the word tokens of text, as
+// returned by tokenizer.WordTokens(text), so that a caller running several
+// modules tokenizes the text only once. Hit offsets are those of the
+// tokens. Returns a zero Result for empty or oversized text.
+func AnalyzeTokens(text string, tokens []tokenizer.Token) Result {
+	if text == "" || len(text) > maxInputBytes {
+		return Result{}
+	}
+	return defaultLexicon.analyzeTokens(text, tokens)
 }
 
 // Score returns the aggregate sentiment score (-1.0 to +1.0).
@@ -3,8 +3,11 @@ package sentiment
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
 )
 
 func TestAnalyze(t *testing.T) {
@@ -119,6 +122,78 @@ func TestAnalyze(t *testing.T) {
 	}
 }
 
+func TestAnalyzeRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantPol     Sentiment
+		wantWord    string  // explained word
+		wantMod     string  // Hit.Modifier
+		wantMult    float64 // Hit.Multiplier
+		wantNegator string  // Hit.Negator, "" when not negated
+	}{
+		{"deyil", "Yemək yaxşı deyil", Negative, "yaxşı", "", 1, "deyil"},
+		{"deyil window", "Yaxşı film deyil", Negative, "Yaxşı", "", 1, "deyil"},
+		{"negated verb after", "Bu yaxşı olmadı", Negative, "yaxşı", "", 1, "olmadı"},
+		{"negated present after", "Pis proqramdır, heç xoşuma gəlmir", Negative, "xoşuma", "heç", 1.5, "gəlmir"},
+		{"intensifier", "Olduqca gözəl", Positive, "gözəl", "olduqca", 1.5, ""},
+		{"phrase intensifier", "Son dərəcə pis", Negative, "pis", "son dərəcə", 1.75, ""},
+		{"diminisher", "Bir az pis", Negative, "pis", "bir az", 0.5, ""},
+		{"negated intensified", "Çox yaxşı deyil", Negative, "yaxşı", "çox", 1.5, "deyil"},
+		{"lexicon word between", "Pis, amma gözəl deyil", Negative, "Pis", "", 1, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Analyze(tt.input)
+			if got.Sentiment != tt.wantPol {
+				t.Errorf("Analyze(%q) = %v, want %v", tt.input, got, tt.wantPol)
+			}
+			var h *Hit
+			for i := range got.Hits {
+				if got.Hits[i].Word == tt.wantWord {
+					h = &got.Hits[i]
+				}
+			}
+			if h == nil {
+				t.Fatalf("no hit for %q in %+v", tt.wantWord, got.Hits)
+			}
+			if h.Modifier != tt.wantMod || h.Multiplier != tt.wantMult {
+				t.Errorf("modifier = %q x%v, want %q x%v", h.Modifier, h.Multiplier, tt.wantMod, tt.wantMult)
+			}
+			if h.Negated != (tt.wantNegator != "") || h.Negator != tt.wantNegator {
+				t.Errorf("negated = %v by %q, want by %q", h.Negated, h.Negator, tt.wantNegator)
+			}
+			want := max(-1, min(1, h.Base*h.Multiplier))
+			if h.Negated {
+				want = -want
+			}
+			if h.Score != want {
+				t.Errorf("Score = %v, want %v", h.Score, want)
+			}
+			if tt.input[h.Start:h.End] != h.Word {
+				t.Errorf("text[%d:%d] = %q, want %q", h.Start, h.End, tt.input[h.Start:h.End], h.Word)
+			}
+		})
+	}
+}
+
+func TestAnalyzeModifierNotScored(t *testing.T) {
+	// "heç" is a lexicon word, but not when it modifies the next word.
+	got := Analyze("heç pis")
+	if len(got.Hits) != 1 || got.Hits[0].Word != "pis" {
+		t.Errorf("Hits = %+v, want only pis", got.Hits)
+	}
+}
+
+func TestAnalyzeTokens(t *testing.T) {
+	for _, text := range []string{"Xidmət yaxşı idi, amma yemək pis idi.", "Bu film heç də maraqlı deyil", "", "123"} {
+		got := AnalyzeTokens(text, tokenizer.WordTokens(text))
+		if want := Analyze(text); !reflect.DeepEqual(got, want) {
+			t.Errorf("AnalyzeTokens(%q) = %+v, want %+v", text, got, want)
+		}
+	}
+}
+
 func TestScore(t *testing.T) {
 	score := Score("Bu çox gözəl bir gündür")
 	if score <= 0 {
@@ -144,6 +219,7 @@ func TestSentimentEnum(t *testing.T) {
 			{Negative, "Negative"},
 			{Neutral, "Neutral"},
 			{Positive, "Positive"},
+			{Mixed, "Mixed"},
 			{Sentiment(42), "Sentiment(42)"},
 		}
 		for _, tt := range tests {
@@ -154,7 +230,7 @@ func TestSentimentEnum(t *testing.T) {
 	})
 
 	t.Run("JSON round-trip", func(t *testing.T) {
-		for _, s := range []Sentiment{Negative, Neutral, Positive} {
+		for _, s := range []Sentiment{Negative, Neutral, Positive, Mixed} {
 			data, err := json.Marshal(s)
 			if err != nil {
 				t.Fatalf("Marshal(%v): %v", s, err)
@@ -195,13 +271,13 @@ func TestResultString(t *testing.T) {
 }
 
 func TestLexiconLoaded(t *testing.T) {
-	if len(lexicon) == 0 {
+	if defaultLexicon.Len() == 0 {
 		t.Fatal("lexicon is empty; embedding failed")
 	}
 	// Spot-check a few known entries.
 	checks := []string{"yaxşı", "pis", "gözəl", "nifrət"}
 	for _, stem := range checks {
-		if _, ok := lexicon[stem]; !ok {
+		if _, ok := defaultLexicon.scores[stem]; !ok {
 			t.Errorf("lexicon missing expected stem %q", stem)
 		}
 	}
@@ -271,11 +347,20 @@ func ExampleSentiment_String() {
 	// Negative
 }
 
+func ExampleAnalyze_hits() {
+	for _, h := range Analyze("Xidmət çox yaxşı deyil").Hits {
+		fmt.Printf("%s: base=%.2f x%.2f negator=%q score=%.2f\n", h.Word, h.Base, h.Multiplier, h.Negator, h.Score)
+	}
+	// Output:
+	// Xidmət: base=0.40 x1.00 negator="" score=0.40
+	// yaxşı: base=0.80 x1.50 negator="deyil" score=-1.00
+}
+
 func ExampleResult_String() {
 	r := Analyze("Bu film çox gözəl idi")
 	fmt.Println(r)
 	// Output:
-	// Positive(score=0.90, pos=1, neg=0, total=5)
+	// Positive(score=1.00, pos=1, neg=0, total=5)
 }
 
 func ExampleSentiment_MarshalJSON() {
@@ -0,0 +1,138 @@
+package sentiment
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+)
+
+// Training parameters for Train.
+const (
+	trainEpochs       = 30   // passes over the samples
+	trainLearningRate = 0.2  // initial SGD step size, decayed per epoch
+	trainL2           = 1e-3 // L2 regularization strength
+
+	// predictNeutralBand is the score range around 0 that Predict reports
+	// as Neutral: a positive probability between 1/3 and 2/3.
+	predictNeutralBand = 1.0 / 3
+)
+
+// Labeled is a training sample for Train: a text and its polarity.
+type Labeled struct {
+	Text      string    `json:"text"`
+	Sentiment Sentiment `json:"sentiment"`
+}
+
+// Train fits a Model to labeled samples by logistic regression over stem
+// unigrams and bigrams, extracted by the same pipeline Model.Analyze uses.
+// The model's score tanh(z) is 2p-1 for the fitted probability p that a
+// text is positive; Neutral samples are fitted to p = 0.5, so a model
+// trained with them scores neutral texts near 0 (see Predict).
+//
+// Training is deterministic: stochastic gradient descent over the samples
+// in the given order, with L2 regularization. The result can be saved
+// with Model.Save and loaded back with LoadModel.
+//
+// Returns an error when a sample has an unknown or Mixed label or oversized text,
+// when no sample has any features, or when the samples do not include
+// both a Positive and a Negative one.
+func Train(samples []Labeled) (*Model, error) {
+	type sample struct {
+		grams  []string // sorted, so that training is deterministic
+		counts []int    // counts[i] is the count of grams[i]
+		target float64  // probability of Positive
+	}
+
+	var (
+		train    = make([]sample, 0, len(samples))
+		pos, neg bool
+	)
+	for i, s := range samples {
+		if _, ok := sentimentNames[s.Sentiment]; !ok || s.Sentiment == Mixed {
+			return nil, fmt.Errorf("sentiment: sample %d: unknown label %v", i, s.Sentiment)
+		}
+		if len(s.Text) > maxInputBytes {
+			return nil, fmt.Errorf("sentiment: sample %d: text exceeds %d bytes", i, maxInputBytes)
+		}
+		features := textFeatures(s.Text, defaultModelOrder)
+		if len(features) == 0 {
+			continue
+		}
+		pos = pos || s.Sentiment == Positive
+		neg = neg || s.Sentiment == Negative
+		grams := slices.Sorted(maps.Keys(features))
+		counts := make([]int, len(grams))
+		for j, gram := range grams {
+			counts[j] = features[gram]
+		}
+		train = append(train, sample{grams: grams, counts: counts, target: float64(s.Sentiment+1) / 2})
+	}
+	if len(train) == 0 {
+		return nil, errors.New("sentiment: no training sample has features")
+	}
+	if !pos || !neg {
+		return nil, errors.New("sentiment: training needs both positive and negative samples")
+	}
+
+	m := &Model{weights: make(map[string]float64), order: defaultModelOrder}
+	for epoch := range trainEpochs {
+		rate := trainLearningRate / math.Sqrt(float64(epoch+1))
+		for _, s := range train {
+			z := m.bias
+			for j, gram := range s.grams {
+				z += m.weights[gram] * float64(s.counts[j])
+			}
+			// The log-loss gradient with respect to z, for p = σ(2z).
+			g := 2 * (sigmoid(2*z) - s.target)
+			m.bias -= rate * g
+			for j, gram := range s.grams {
+				w := m.weights[gram]
+				m.weights[gram] = w - rate*(g*float64(s.counts[j])+trainL2*w)
+			}
+		}
+	}
+	return m, nil
+}
+
+// Predict classifies text as Positive, Negative, or Neutral under the
+// model. Unlike Analyze, which takes the sign of the score, it reports
+// Neutral for scores within ±1/3, where the fitted probability of a
+// positive text is between 1/3 and 2/3. Like Analyze, it falls back to the
+// embedded lexicon when no feature matches.
+func (m *Model) Predict(text string) Sentiment {
+	score := m.Analyze(text).Score
+	switch {
+	case score > predictNeutralBand:
+		return Positive
+	case score < -predictNeutralBand:
+		return Negative
+	default:
+		return Neutral
+	}
+}
+
+// textFeatures returns the stem n-grams of text up to order, with counts,
+// as Model.Analyze matches them.
+func textFeatures(text string, order int) map[string]int {
+	if text == "" {
+		return nil
+	}
+	_, stems := wordStems(text)
+	features := make(map[string]int)
+	for i := range stems {
+		for n := 1; n <= order && i+n <= len(stems); n++ {
+			gram, ok := joinStems(stems[i : i+n])
+			if !ok {
+				break
+			}
+			features[gram]++
+		}
+	}
+	return features
+}
+
+func sigmoid(x float64) float64 {
+	return 1 / (1 + math.Exp(-x))
+}
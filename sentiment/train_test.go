@@ -0,0 +1,146 @@
+package sentiment
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// trainSamples is a small set of labeled product and service reviews.
+var trainSamples = []Labeled{
+	{"Xidmət əla idi, çox razı qaldım.", Positive},
+	{"Çatdırılma sürətli oldu, təşəkkür edirəm.", Positive},
+	{"Yemək dadlı idi, hamıya tövsiyə edirəm.", Positive},
+	{"Personal mehriban və diqqətli idi.", Positive},
+	{"Keyfiyyət əla, qiymət münasib.", Positive},
+	{"Otel təmiz idi, yenə gələcəyik.", Positive},
+	{"Xidmət pis idi, heç razı qalmadım.", Negative},
+	{"Çatdırılma gecikdi, sifariş yarımçıq gəldi.", Negative},
+	{"Yemək dadsız və soyuq idi.", Negative},
+	{"Personal kobud davrandı.", Negative},
+	{"Keyfiyyət aşağı, qiymət baha.", Negative},
+	{"Otel çirkli idi, bir daha gəlmərik.", Negative},
+	{"Sifariş çərşənbə günü gəldi.", Neutral},
+	{"Otel şəhərin mərkəzində yerləşir.", Neutral},
+	{"Menyuda on iki yemək var.", Neutral},
+}
+
+func TestTrain(t *testing.T) {
+	m, err := Train(trainSamples)
+	if err != nil {
+		t.Fatalf("Train: %v", err)
+	}
+	if m.order != defaultModelOrder {
+		t.Errorf("order = %d, want %d", m.order, defaultModelOrder)
+	}
+	if m.weights["kobud"] >= 0 || m.weights["mehriban"] <= 0 {
+		t.Errorf("weights kobud = %v, mehriban = %v: wrong signs", m.weights["kobud"], m.weights["mehriban"])
+	}
+
+	tests := []struct {
+		input string
+		want  Sentiment
+	}{
+		{"Xidmət əla idi", Positive},
+		{"Çatdırılma sürətli oldu", Positive},
+		{"Personal kobud davrandı", Negative},
+		{"Keyfiyyət aşağı idi", Negative},
+		{"Otel mərkəzdə yerləşir", Neutral},
+	}
+	for _, tt := range tests {
+		if got := m.Predict(tt.input); got != tt.want {
+			t.Errorf("Predict(%q) = %v (score %.2f), want %v", tt.input, got, m.Score(tt.input), tt.want)
+		}
+	}
+}
+
+func TestTrainDeterministic(t *testing.T) {
+	a, err := Train(trainSamples)
+	if err != nil {
+		t.Fatalf("Train: %v", err)
+	}
+	b, err := Train(trainSamples)
+	if err != nil {
+		t.Fatalf("Train: %v", err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Error("Train is not deterministic")
+	}
+}
+
+func TestTrainErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		samples []Labeled
+	}{
+		{"no samples", nil},
+		{"unknown label", []Labeled{{"əla", Positive}, {"pis", Sentiment(3)}}},
+		{"mixed label", []Labeled{{"əla", Positive}, {"pis", Mixed}}},
+		{"oversized text", []Labeled{{strings.Repeat("a", maxInputBytes+1), Positive}}},
+		{"no features", []Labeled{{"123 !!", Positive}, {"", Negative}}},
+		{"positive only", []Labeled{{"əla", Positive}, {"yaxşı", Positive}}},
+		{"no negative", []Labeled{{"əla", Positive}, {"masa", Neutral}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Train(tt.samples); err == nil {
+				t.Errorf("Train(%s) error = nil, want error", tt.name)
+			}
+		})
+	}
+}
+
+func TestTrainSaveLoad(t *testing.T) {
+	m, err := Train(trainSamples)
+	if err != nil {
+		t.Fatalf("Train: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := m.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	loaded, err := LoadModel(&buf)
+	if err != nil {
+		t.Fatalf("LoadModel: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, m) {
+		t.Error("model differs after Save/LoadModel round trip")
+	}
+}
+
+func TestPredictFallback(t *testing.T) {
+	m := mustLoadModel(t, testModel)
+	if got := m.Predict("Bu film gözəl idi"); got != Positive {
+		t.Errorf("Predict(lexicon fallback) = %v, want Positive", got)
+	}
+	if got := m.Predict(""); got != Neutral {
+		t.Errorf("Predict(\"\") = %v, want Neutral", got)
+	}
+}
+
+func BenchmarkTrain(b *testing.B) {
+	for b.Loop() {
+		if _, err := Train(trainSamples); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func ExampleTrain() {
+	m, err := Train([]Labeled{
+		{Text: "Xidmət əla idi, çox razı qaldım.", Sentiment: Positive},
+		{Text: "Personal mehriban idi.", Sentiment: Positive},
+		{Text: "Xidmət pis idi, heç razı qalmadım.", Sentiment: Negative},
+		{Text: "Personal kobud davrandı.", Sentiment: Negative},
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(m.Predict("Personal əla idi"))
+	fmt.Println(m.Predict("Kobud və pis xidmət"))
+	// Output:
+	// Positive
+	// Negative
+}
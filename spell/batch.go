@@ -0,0 +1,94 @@
+package spell
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// CorrectBatch returns the corrections of words: the result at index i is
+// CorrectWord(words[i]). Identical words are corrected once, and words
+// differing only in case ("Kitbda", "kitbda", "KITBDA") share one lookup,
+// with the case pattern of each input reapplied. The distinct words are
+// corrected concurrently on runtime.GOMAXPROCS(0) goroutines.
+//
+// The lookups are cached for the duration of the call only; for repeated
+// batches over the same vocabulary, morph.EnableCache also memoizes the
+// analyses behind them. Returns nil for an empty slice.
+func CorrectBatch(words []string) []string {
+	return defaultChecker.CorrectBatch(words)
+}
+
+// CorrectBatch is like the package-level CorrectBatch but uses the
+// checker's custom words.
+func (c *Checker) CorrectBatch(words []string) []string {
+	if len(words) == 0 {
+		return nil
+	}
+
+	// Index the distinct lowercase forms. Words whose case mapping changes
+	// their length past maxWordBytes ("I" lowercases to two-byte "ı") are
+	// corrected directly, so the size limits see the same input as
+	// CorrectWord.
+	slots := make([]int, len(words)) // index into unique, or -1
+	seen := make(map[string]int)
+	var unique []string
+	for i, w := range words {
+		slots[i] = -1
+		if w == "" || len(w) > maxWordBytes {
+			continue
+		}
+		lower := azcase.ToLower(w)
+		if len(lower) > maxWordBytes {
+			continue
+		}
+		j, ok := seen[lower]
+		if !ok {
+			j = len(unique)
+			seen[lower] = j
+			unique = append(unique, lower)
+		}
+		slots[i] = j
+	}
+
+	terms := make([]string, len(unique)) // "" when the word is kept
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(unique)) {
+		wg.Go(func() {
+			for {
+				j := int(next.Add(1) - 1)
+				if j >= len(unique) {
+					return
+				}
+				terms[j] = c.correctLower(unique[j])
+			}
+		})
+	}
+	wg.Wait()
+
+	out := make([]string, len(words))
+	for i, w := range words {
+		switch j := slots[i]; {
+		case j < 0:
+			out[i] = c.CorrectWord(w)
+		case terms[j] == "":
+			out[i] = w
+		default:
+			out[i] = azcase.ApplyCase(w, terms[j])
+		}
+	}
+	return out
+}
+
+// correctLower returns the top correction of the lowercase word, or ""
+// when CorrectWord would keep it.
+func (c *Checker) correctLower(lower string) string {
+	suggestions := c.Suggest(lower, c.maxDistance())
+	if len(suggestions) == 0 {
+		return ""
+	}
+	return suggestions[0].Term
+}
@@ -0,0 +1,125 @@
+package spell
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+func TestCorrectBatch(t *testing.T) {
+	t.Parallel()
+
+	if got := CorrectBatch(nil); got != nil {
+		t.Errorf("CorrectBatch(nil) = %v, want nil", got)
+	}
+
+	words := []string{
+		"ketab", "Ketab", "KETAB", "kitab", "ketab", // duplicates and case variants
+		"kitbda", "gozel", "Bakı", "", "İstanbul", "I",
+		"2026", "x", "salam-dünya", "Bakı'nın", "qwrtyp",
+		strings.Repeat("a", maxWordBytes+1),
+		strings.Repeat("I", maxWordBytes), // lowercases past maxWordBytes
+	}
+	got := CorrectBatch(words)
+	if len(got) != len(words) {
+		t.Fatalf("got %d results, want %d", len(got), len(words))
+	}
+	for i, w := range words {
+		if want := CorrectWord(w); got[i] != want {
+			t.Errorf("CorrectBatch[%d] = %q, CorrectWord(%q) = %q", i, got[i], w, want)
+		}
+	}
+	if got[0] != "kitab" || got[1] != "Kitab" || got[2] != "KİTAB" {
+		t.Errorf("case variants = %q, want kitab Kitab KİTAB", got[:3])
+	}
+}
+
+func TestCorrectBatchMatchesCorrectWord(t *testing.T) {
+	t.Parallel()
+
+	words := batchCorpus(5000)
+	got := CorrectBatch(words)
+	for i, w := range words {
+		if want := CorrectWord(w); got[i] != want {
+			t.Fatalf("CorrectBatch[%d] = %q, CorrectWord(%q) = %q", i, got[i], w, want)
+		}
+	}
+}
+
+func TestCheckerCorrectBatch(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewChecker(WithWords("Zentrix"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := []string{"Zentrix", "zentrixdə", "Zentrx", "ketab"}
+	got := c.CorrectBatch(words)
+	want := []string{"Zentrix", "zentrixdə", "Zentrix", "kitab"}
+	if !slices.Equal(got, want) {
+		t.Errorf("CorrectBatch = %q, want %q", got, want)
+	}
+	if !slices.Equal(got[:2], words[:2]) {
+		t.Errorf("custom words corrected away: %q", got[:2])
+	}
+}
+
+// batchWords is the vocabulary of batchCorpus: common words, inflected
+// forms, and typical misspellings, most frequent first.
+var batchWords = strings.Fields(`
+	və bu bir da ki ilə üçün olan çox daha Azərbaycan Respublikası
+	kitab kitabda kitablar ketab kitbda gozel gözəl şəhər şəhərdə seher
+	Bakı Bakının paytaxt paytaxtı hökumət hokumet iqtisadiyyat iqtisadiyat
+	məktəb mekteb müəllim muellim tələbə telebe universitet univeristet
+	dəyişmədi deyismedi gəldi geldi gedirəm gedirem oxuyuruq oxuyuruk
+	ölkə olke xalq xalqın tarix tarixi mədəniyyət medeniyyet dil dili
+	neft qaz enerji layihə layihe inkişaf inkisaf sabah dünən bu gün
+`)
+
+// batchCorpus returns n tokens drawn from batchWords with a Zipf
+// distribution, with sentence-initial capitals, like running text.
+func batchCorpus(n int) []string {
+	r := rand.New(rand.NewPCG(1, 2))
+	zipf := rand.NewZipf(r, 1.1, 1, uint64(len(batchWords)-1))
+	words := make([]string, n)
+	for i := range words {
+		w := batchWords[zipf.Uint64()]
+		if r.IntN(10) == 0 {
+			w = azcase.UpperFirst(w)
+		}
+		words[i] = w
+	}
+	return words
+}
+
+const benchCorpusTokens = 1_000_000
+
+func BenchmarkCorrectWordLoop(b *testing.B) {
+	words := batchCorpus(benchCorpusTokens)
+	b.ResetTimer()
+	for b.Loop() {
+		for _, w := range words {
+			CorrectWord(w)
+		}
+	}
+	b.ReportMetric(float64(len(words))*float64(b.N)/b.Elapsed().Seconds(), "tokens/s")
+}
+
+func BenchmarkCorrectBatch(b *testing.B) {
+	words := batchCorpus(benchCorpusTokens)
+	b.ResetTimer()
+	for b.Loop() {
+		CorrectBatch(words)
+	}
+	b.ReportMetric(float64(len(words))*float64(b.N)/b.Elapsed().Seconds(), "tokens/s")
+}
+
+func ExampleCorrectBatch() {
+	fmt.Println(CorrectBatch([]string{"Ketab", "şəhərdə", "ketab", "KETAB"}))
+	// Output:
+	// [Kitab şəhərdə kitab KİTAB]
+}
@@ -0,0 +1,256 @@
+package spell
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+)
+
+// customWordFreq is the frequency given to custom words listed without
+// one. It ranks them below any embedded word at the same edit distance.
+const customWordFreq = 1
+
+// defaultChecker backs the package-level functions: the embedded
+// dictionary with no custom words and the default index parameters.
+var defaultChecker = &Checker{}
+
+// Checker is a spell checker over the embedded dictionary extended with
+// custom words such as brand names, technical jargon, and neologisms.
+// Custom words are accepted by IsCorrect (including their inflected
+// forms), proposed by Suggest, and never corrected away.
+//
+// The package-level functions behave like a Checker with no custom words.
+// A Checker is safe for concurrent use; AddWords may be called while
+// other goroutines check text.
+type Checker struct {
+	mu        sync.RWMutex
+	base      *index           // embedded dictionary; nil for the shared default index
+	custom    *index           // nil until the first custom word is added
+	bigrams   map[string]int64 // custom bigrams; nil unless WithBigrams is used
+	maxDist   int              // index parameters requested by options; 0 selects the default
+	prefixLen int
+}
+
+// Option configures a Checker created by NewChecker.
+type Option func(*Checker) error
+
+// WithCustomDictionary adds the words listed in r. Each non-empty line is
+// either a word or "word frequency", the format of the embedded frequency
+// dictionary; lines starting with '#' are comments. Words are matched
+// case-insensitively.
+func WithCustomDictionary(r io.Reader) Option {
+	return func(c *Checker) error {
+		sc := bufio.NewScanner(r)
+		for n := 1; sc.Scan(); n++ {
+			line := bytes.TrimSpace(sc.Bytes())
+			if len(line) == 0 || line[0] == '#' {
+				continue
+			}
+			word, freq, ok := parseFreqLine(line)
+			if !ok {
+				word, freq = string(line), customWordFreq
+			}
+			if err := c.addWord(word, freq); err != nil {
+				return fmt.Errorf("spell: custom dictionary line %d: %w", n, err)
+			}
+		}
+		if err := sc.Err(); err != nil {
+			return fmt.Errorf("spell: reading custom dictionary: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithWords adds words to the Checker, as AddWords does.
+func WithWords(words ...string) Option {
+	return func(c *Checker) error {
+		c.AddWords(words...)
+		return nil
+	}
+}
+
+// WithMaxDistance sets the maximum edit distance of the delete index,
+// from 1 to 3 (default 2). Suggest clamps its maxDist to this value.
+// Distance 1 roughly halves the memory of the index at the cost of
+// recall; distance 3 finds corrections for noisy input such as OCR text
+// at the cost of a larger index, a slower build, and slower lookups.
+// Returns an error from NewChecker for a distance outside 1-3.
+func WithMaxDistance(n int) Option {
+	return func(c *Checker) error {
+		if n < 1 || n > maxDistLimit {
+			return fmt.Errorf("spell: max distance %d out of range 1-%d", n, maxDistLimit)
+		}
+		c.maxDist = n
+		return nil
+	}
+}
+
+// WithPrefixLength sets how many leading runes of each word the delete
+// index covers, from 2 to 10 (default 7). A shorter prefix saves memory;
+// words whose errors lie beyond the prefix are still found, but candidate
+// lists grow and lookups slow down. Returns an error from NewChecker for
+// a length outside 2-10 or not greater than the maximum distance.
+func WithPrefixLength(k int) Option {
+	return func(c *Checker) error {
+		if k < 2 || k > maxPrefixLength {
+			return fmt.Errorf("spell: prefix length %d out of range 2-%d", k, maxPrefixLength)
+		}
+		c.prefixLen = k
+		return nil
+	}
+}
+
+// NewChecker returns a Checker over the embedded dictionary, configured
+// by opts. Returns an error if an option fails (e.g. a malformed custom
+// dictionary).
+//
+// With WithMaxDistance or WithPrefixLength, NewChecker builds the
+// Checker's own index of the embedded dictionary, which takes about a
+// second (several at distance 3); the shared default index is then never
+// built unless the package-level functions or a default Checker are used.
+func NewChecker(opts ...Option) (*Checker, error) {
+	c := &Checker{}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	maxDist, prefixLen := cmp.Or(c.maxDist, maxEditDistance), cmp.Or(c.prefixLen, prefixLength)
+	if prefixLen <= maxDist {
+		return nil, fmt.Errorf("spell: prefix length %d must exceed max distance %d", prefixLen, maxDist)
+	}
+	if maxDist != maxEditDistance || prefixLen != prefixLength {
+		c.base = loadEmbedded(maxDist, prefixLen)
+		if c.custom != nil {
+			c.custom = c.custom.reindex(maxDist, prefixLen)
+		}
+	}
+	return c, nil
+}
+
+// dict returns the index of the embedded dictionary used by c.
+func (c *Checker) dict() *index {
+	if c.base != nil {
+		return c.base
+	}
+	return embeddedIndex()
+}
+
+// maxDistance returns the highest edit distance c can look up.
+func (c *Checker) maxDistance() int {
+	return c.dict().maxDist
+}
+
+// AddWords registers words as correctly spelled. Words are matched
+// case-insensitively and also serve as stems for inflected forms
+// (adding "Zentrix" accepts "Zentrixdə"). Empty words, words containing
+// whitespace, and words exceeding maxWordBytes are ignored.
+func (c *Checker) AddWords(words ...string) {
+	for _, w := range words {
+		_ = c.addWord(w, customWordFreq) // invalid words are skipped
+	}
+}
+
+// addWord validates and inserts a custom word.
+func (c *Checker) addWord(word string, freq int64) error {
+	switch {
+	case word == "":
+		return errors.New("empty word")
+	case len(word) > maxWordBytes:
+		return fmt.Errorf("word exceeds %d bytes", maxWordBytes)
+	case strings.ContainsFunc(word, isSpace):
+		return fmt.Errorf("word %q contains whitespace", word)
+	}
+	lower := azcase.ToLower(azcase.ComposeNFC(word))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.custom == nil {
+		maxDist, prefixLen := maxEditDistance, prefixLength
+		if c.base != nil {
+			maxDist, prefixLen = c.base.maxDist, c.base.prefixLen
+		}
+		c.custom = newIndex(0, maxDist, prefixLen)
+	}
+	c.custom.add(lower, freq)
+	return nil
+}
+
+// isSpace reports whether r separates words in a custom dictionary line.
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
+
+// isKnownWord reports whether the lowercase word is in the embedded or
+// custom dictionary.
+func (c *Checker) isKnownWord(lower string) bool {
+	if _, ok := c.dict().words[lower]; ok {
+		return true
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.custom == nil {
+		return false
+	}
+	_, ok := c.custom.words[lower]
+	return ok
+}
+
+// isKnownStem reports whether the lowercase stem is a morph dictionary
+// stem or a custom word.
+func (c *Checker) isKnownStem(lower string) bool {
+	if morph.IsKnownStem(lower) {
+		return true
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.custom == nil {
+		return false
+	}
+	_, ok := c.custom.words[lower]
+	return ok
+}
+
+// lookup merges candidates from the embedded and custom dictionaries,
+// found by edit distance or by phonetic key (see addPhonetic), sorted by
+// distance ascending, phonetic matches first, then frequency descending.
+// An exact match is returned alone.
+func (c *Checker) lookup(input string, maxDist int) []Suggestion {
+	c.mu.RLock()
+	var custom []Suggestion
+	if c.custom != nil {
+		custom = c.custom.lookup(input, maxDist)
+		if len(custom) == 0 || custom[0].Distance != 0 {
+			custom = addPhonetic(custom, c.custom, input, maxDist)
+		}
+	}
+	c.mu.RUnlock()
+
+	if len(custom) > 0 && custom[0].Distance == 0 {
+		return custom[:1]
+	}
+	dict := c.dict()
+	results := dict.lookup(input, maxDist)
+	if len(results) > 0 && results[0].Distance == 0 {
+		return results
+	}
+	results = addPhonetic(results, dict, input, maxDist)
+	for _, s := range custom {
+		if _, dup := dict.words[s.Term]; !dup {
+			results = append(results, s)
+		}
+	}
+	for i := range results {
+		results[i].Known = c.isKnownStem(results[i].Term) || c.isInflected(results[i].Term)
+	}
+	sortSuggestions(results)
+	return results
+}
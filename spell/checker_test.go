@@ -0,0 +1,219 @@
+package spell
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const testCustomDict = `# product names
+Zentrix
+kvantlaş 50
+
+`
+
+func TestCheckerCustomDictionary(t *testing.T) {
+	c, err := NewChecker(WithCustomDictionary(strings.NewReader(testCustomDict)))
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"zentrix", true},
+		{"Zentrix", true},
+		{"ZENTRİX", true},    // Azerbaijani casing: İ lowers to i
+		{"Zentrixdə", true},  // inflected custom stem
+		{"kvantlaşdı", true}, // inflected custom stem
+		{"kitab", true},      // embedded dictionary still applies
+		{"ketab", false},
+		{"zentrx", false},
+	}
+	for _, tt := range tests {
+		if got := c.IsCorrect(tt.word); got != tt.want {
+			t.Errorf("IsCorrect(%q) = %v, want %v", tt.word, got, tt.want)
+		}
+	}
+	if IsCorrect("zentrix") {
+		t.Error("custom word leaked into the package-level checker")
+	}
+}
+
+func TestCheckerSuggestsCustomWords(t *testing.T) {
+	c, err := NewChecker(WithWords("Zentrix"))
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	if got := c.CorrectWord("Zentrx"); got != "Zentrix" {
+		t.Errorf("CorrectWord(Zentrx) = %q, want Zentrix", got)
+	}
+	if got := c.Correct("zentrix şirkəti"); got != "zentrix şirkəti" {
+		t.Errorf("Correct corrected a custom word: %q", got)
+	}
+	if got := c.Correct("Bu ketab"); got != "Bu kitab" {
+		t.Errorf("Correct(Bu ketab) = %q, want Bu kitab", got)
+	}
+	if ops := c.CorrectRanges("Zentrix"); ops != nil {
+		t.Errorf("CorrectRanges(Zentrix) = %v, want nil", ops)
+	}
+}
+
+func TestCheckerAddWords(t *testing.T) {
+	c, err := NewChecker()
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	if c.IsCorrect("zentrix") {
+		t.Fatal("zentrix correct before AddWords")
+	}
+	c.AddWords("Zentrix", "", "two words", strings.Repeat("a", maxWordBytes+1))
+	if !c.IsCorrect("zentrix") {
+		t.Error("zentrix not correct after AddWords")
+	}
+	if c.IsCorrect("two words") || c.isKnownWord("two words") {
+		t.Error("word with whitespace was added")
+	}
+}
+
+func TestWithCustomDictionaryErrors(t *testing.T) {
+	long := strings.Repeat("a", maxWordBytes+1)
+	for _, data := range []string{"two words here\n", long + "\n"} {
+		if _, err := NewChecker(WithCustomDictionary(strings.NewReader(data))); err == nil {
+			t.Errorf("NewChecker(%.20q) = nil error, want error", data)
+		}
+	}
+}
+
+func TestCheckerConcurrentAddWords(t *testing.T) {
+	c, err := NewChecker()
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			c.AddWords(fmt.Sprintf("zentrix%c", 'a'+i))
+			c.Correct("Bu ketab zentrixa")
+		})
+	}
+	wg.Wait()
+	if !c.IsCorrect("zentrixh") {
+		t.Error("concurrently added word missing")
+	}
+}
+
+func TestCheckerIndexOptions(t *testing.T) {
+	t.Run("distance 3", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewChecker(WithMaxDistance(3), WithPrefixLength(4))
+		if err != nil {
+			t.Fatalf("NewChecker: %v", err)
+		}
+		got := c.Suggest("məktxbxx", 3)
+		if len(got) == 0 || got[0] != (Suggestion{Term: "məktəb", Distance: 3, Frequency: got[0].Frequency, Known: true}) {
+			t.Errorf("Suggest(məktxbxx, 3) = %v, want məktəb at distance 3 first", got)
+		}
+		if got := Suggest("məktxbxx", 3); got != nil {
+			t.Errorf("package-level Suggest(məktxbxx, 3) = %v, want nil", got)
+		}
+	})
+	t.Run("distance 1", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewChecker(WithMaxDistance(1))
+		if err != nil {
+			t.Fatalf("NewChecker: %v", err)
+		}
+		for _, word := range []string{"ketab", "dövlxtx", "parlamnetx"} {
+			for _, s := range c.Suggest(word, 2) {
+				if s.Distance > 1 {
+					t.Errorf("Suggest(%q, 2) returned %v beyond distance 1", word, s)
+				}
+			}
+		}
+		if got := c.CorrectWord("ketab"); got != "kitab" {
+			t.Errorf("CorrectWord(ketab) = %q, want kitab", got)
+		}
+	})
+	t.Run("short prefix", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewChecker(WithPrefixLength(4))
+		if err != nil {
+			t.Fatalf("NewChecker: %v", err)
+		}
+		for _, tt := range [][2]string{{"ketab", "kitab"}, {"kitabxanx", "kitabxana"}} {
+			if got := c.CorrectWord(tt[0]); got != tt[1] {
+				t.Errorf("CorrectWord(%q) = %q, want %q", tt[0], got, tt[1])
+			}
+		}
+	})
+	t.Run("custom words reindexed", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewChecker(WithWords("Zentrix"), WithMaxDistance(1), WithPrefixLength(5))
+		if err != nil {
+			t.Fatalf("NewChecker: %v", err)
+		}
+		if c.custom.maxDist != 1 || c.custom.prefixLen != 5 {
+			t.Errorf("custom index parameters = %d, %d, want 1, 5", c.custom.maxDist, c.custom.prefixLen)
+		}
+		c.AddWords("Zylqor")
+		for _, tt := range [][2]string{{"Zentrx", "Zentrix"}, {"Zylqr", "Zylqor"}} {
+			if got := c.CorrectWord(tt[0]); got != tt[1] {
+				t.Errorf("CorrectWord(%q) = %q, want %q", tt[0], got, tt[1])
+			}
+		}
+	})
+}
+
+func TestCheckerIndexOptionErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{"distance 0", []Option{WithMaxDistance(0)}},
+		{"distance 4", []Option{WithMaxDistance(4)}},
+		{"prefix 1", []Option{WithPrefixLength(1)}},
+		{"prefix 11", []Option{WithPrefixLength(11)}},
+		{"prefix not above distance", []Option{WithMaxDistance(3), WithPrefixLength(3)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if c, err := NewChecker(tt.opts...); err == nil || c != nil {
+				t.Errorf("NewChecker = %v, %v, want error", c, err)
+			}
+		})
+	}
+}
+
+func BenchmarkCheckerCorrect(b *testing.B) {
+	c, _ := NewChecker(WithWords("Zentrix"))
+	for b.Loop() {
+		c.Correct("Bu ketab Zentrx şirkətinindir")
+	}
+}
+
+func ExampleNewChecker() {
+	c, err := NewChecker(WithCustomDictionary(strings.NewReader("Zentrix\n")))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(IsCorrect("Zentrixdə"), c.IsCorrect("Zentrixdə"))
+	fmt.Println(c.CorrectWord("Zentrx"))
+	// Output:
+	// false true
+	// Zentrix
+}
+
+func ExampleWithMaxDistance() {
+	c, err := NewChecker(WithMaxDistance(3), WithPrefixLength(5))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(CorrectWord("Azrbaycnx"), c.CorrectWord("Azrbaycnx"))
+	// Output:
+	// Azrbaycnx Azərbaycan
+}
@@ -0,0 +1,145 @@
+package spell
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// bigrams is the embedded bigram model: "word1 word2" -> frequency
+// (populated in init, read-only after).
+var bigrams map[string]int64
+
+func init() {
+	lines := bytes.Split(data.SpellBigrams, []byte("\n"))
+	bigrams = make(map[string]int64, len(lines))
+	for _, line := range lines {
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		if pair, freq, ok := parseBigramLine(line); ok {
+			bigrams[pair] = freq
+		}
+	}
+}
+
+// parseBigramLine parses a "word1 word2 frequency" line into a lowercase
+// "word1 word2" key and its frequency.
+func parseBigramLine(line []byte) (string, int64, bool) {
+	pair, freq, ok := parseFreqLine(line)
+	if !ok || len(strings.Fields(pair)) != 2 || strings.Count(pair, " ") != 1 {
+		return "", 0, false
+	}
+	return azcase.ToLower(azcase.ComposeNFC(pair)), freq, true
+}
+
+// WithBigrams adds the word bigram frequencies listed in r to the model
+// used by CorrectContext. Each non-empty line is "word1 word2 frequency",
+// the format of the embedded bigram model; lines starting with '#' are
+// comments. Frequencies add to the embedded ones.
+func WithBigrams(r io.Reader) Option {
+	return func(c *Checker) error {
+		sc := bufio.NewScanner(r)
+		for n := 1; sc.Scan(); n++ {
+			line := bytes.TrimSpace(sc.Bytes())
+			if len(line) == 0 || line[0] == '#' {
+				continue
+			}
+			pair, freq, ok := parseBigramLine(line)
+			if !ok {
+				return fmt.Errorf("spell: bigram line %d: want \"word1 word2 frequency\", got %q", n, line)
+			}
+			c.mu.Lock()
+			if c.bigrams == nil {
+				c.bigrams = make(map[string]int64)
+			}
+			c.bigrams[pair] += freq
+			c.mu.Unlock()
+		}
+		if err := sc.Err(); err != nil {
+			return fmt.Errorf("spell: reading bigrams: %w", err)
+		}
+		return nil
+	}
+}
+
+// bigramFreq returns the frequency of the lowercase pair w1 w2 in the
+// embedded and custom models. Returns 0 when either word is empty.
+func (c *Checker) bigramFreq(w1, w2 string) int64 {
+	if w1 == "" || w2 == "" {
+		return 0
+	}
+	key := w1 + " " + w2
+	n := bigrams[key]
+	c.mu.RLock()
+	n += c.bigrams[key]
+	c.mu.RUnlock()
+	return n
+}
+
+// CorrectContext is like Correct but chooses between equally close
+// candidates by how often each occurs next to the neighboring words, using
+// an embedded bigram model. The embedded model is a small seed list of
+// about 150 common collocations with hand-assigned relative weights, not
+// corpus counts, so most word pairs are not covered; add corpus
+// frequencies with WithBigrams for wider coverage. Neighbors are the
+// adjacent words separated only by whitespace; punctuation breaks the
+// context. Corrected words serve as the left context of the words after
+// them.
+// When no candidate occurs with its neighbors, the choice is the same as
+// Correct's.
+// Returns the input unchanged for empty or oversized (>1 MiB) input.
+func CorrectContext(text string) string {
+	return defaultChecker.CorrectContext(text)
+}
+
+// CorrectContext is like the package-level CorrectContext but uses the
+// checker's custom words and bigrams.
+func (c *Checker) CorrectContext(text string) string {
+	return applyOps(text, c.correctRanges(text, true))
+}
+
+// correctInContext returns the correction of word given the lowercase
+// neighboring words prev and next, either of which may be empty.
+func (c *Checker) correctInContext(word, prev, next string) string {
+	if word == "" || len(word) > maxWordBytes || c.IsCorrect(word) {
+		return word
+	}
+	suggestions := c.Suggest(word, c.maxDistance())
+	if len(suggestions) == 0 {
+		return word
+	}
+
+	best, bestScore := suggestions[0].Term, int64(0)
+	for _, s := range suggestions {
+		if s.Distance != suggestions[0].Distance {
+			break // only candidates tied on distance compete on context
+		}
+		lower := azcase.ToLower(s.Term)
+		if score := c.bigramFreq(prev, lower) + c.bigramFreq(lower, next); score > bestScore {
+			best, bestScore = s.Term, score
+		}
+	}
+	return best
+}
+
+// nextWord returns the lowercase word following tokens[i] when only
+// whitespace separates them, or "".
+func nextWord(tokens []tokenizer.Token, i int) string {
+	for _, tok := range tokens[i+1:] {
+		switch tok.Type {
+		case tokenizer.Space:
+			continue
+		case tokenizer.Word:
+			return azcase.ToLower(tok.Text)
+		}
+		return ""
+	}
+	return ""
+}
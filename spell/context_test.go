@@ -0,0 +1,100 @@
+package spell
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCorrectContext(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"right context", "Ona həyat baxş etdi.", "Ona həyat bəxş etdi."},
+		{"question", "O kimu idi?", "O kim idi?"},
+		{"left context is corrected word", "qeyd etdı", "qeyd etdi"},
+		{"no context falls back to Correct", "Bu ketab", "Bu kitab"},
+		{"punctuation breaks context", "Ona baxş. etdi", "Ona baş. etdi"},
+		{"correct text unchanged", "Bu kitab çox yaxşıdır.", "Bu kitab çox yaxşıdır."},
+		{"title-case unknown left alone", "Zentrx etdi", "Zentrx etdi"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := CorrectContext(tt.input); got != tt.want {
+				t.Errorf("CorrectContext(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCorrectContextDiffersFromCorrect(t *testing.T) {
+	const input = "Ona həyat baxş etdi."
+	if got := Correct(input); got != "Ona həyat baş etdi." {
+		t.Errorf("Correct(%q) = %q, want the most frequent candidate", input, got)
+	}
+	if got := CorrectContext(input); got != "Ona həyat bəxş etdi." {
+		t.Errorf("CorrectContext(%q) = %q", input, got)
+	}
+}
+
+func TestCorrectContextOversized(t *testing.T) {
+	input := strings.Repeat("ketab ", maxInputBytes/6+1)
+	if got := CorrectContext(input); got != input {
+		t.Error("CorrectContext modified oversized input")
+	}
+}
+
+func TestWithBigrams(t *testing.T) {
+	c, err := NewChecker(WithBigrams(strings.NewReader("# poetry\ngözəl beyt 50\n\n")))
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	const input = "Bu gözəl qeyt idi."
+	if got := CorrectContext(input); got != "Bu gözəl qeyd idi." {
+		t.Errorf("CorrectContext(%q) = %q", input, got)
+	}
+	if got := c.CorrectContext(input); got != "Bu gözəl beyt idi." {
+		t.Errorf("Checker.CorrectContext(%q) = %q, want custom bigram to win", input, got)
+	}
+}
+
+func TestWithBigramsErrors(t *testing.T) {
+	for _, data := range []string{"gözəl beyt\n", "tək 5\n", "bir iki üç 5\n", "gözəl beyt -1\n"} {
+		if _, err := NewChecker(WithBigrams(strings.NewReader(data))); err == nil {
+			t.Errorf("NewChecker(WithBigrams(%q)) = nil error, want error", data)
+		}
+	}
+}
+
+func TestEmbeddedBigrams(t *testing.T) {
+	if len(bigrams) == 0 {
+		t.Fatal("embedded bigram model is empty")
+	}
+	if got := defaultChecker.bigramFreq("qeyd", "etdi"); got == 0 {
+		t.Error(`bigramFreq("qeyd", "etdi") = 0`)
+	}
+	if got := defaultChecker.bigramFreq("azərbaycan", "respublikası"); got == 0 {
+		t.Error("embedded bigrams are not lowercased")
+	}
+	if got := defaultChecker.bigramFreq("", "etdi"); got != 0 {
+		t.Errorf(`bigramFreq("", "etdi") = %d, want 0`, got)
+	}
+}
+
+func BenchmarkCorrectContext(b *testing.B) {
+	for b.Loop() {
+		CorrectContext("Ona həyat baxş etdi. O kimu idi? Bu ketab gozeldir.")
+	}
+}
+
+func ExampleCorrectContext() {
+	fmt.Println(Correct("Ona həyat baxş etdi."))
+	fmt.Println(CorrectContext("Ona həyat baxş etdi."))
+	// Output:
+	// Ona həyat baş etdi.
+	// Ona həyat bəxş etdi.
+}
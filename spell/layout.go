@@ -0,0 +1,165 @@
+// Detection of words typed with the wrong keyboard layout active.
+package spell
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/normalize"
+	"github.com/az-ai-labs/az-lang-nlp/translit"
+)
+
+const (
+	// minLayoutRunes is the minimum length of a word FixLayout converts to.
+	// Shorter conversions match dictionary words by chance.
+	minLayoutRunes = 3
+
+	// minLayoutLetterRunes is the minimum conversion length for Latin input
+	// of letters only, which always has a Russian-layout reading ("evv" →
+	// "умм").
+	minLayoutLetterRunes = 5
+)
+
+// Keyboard layouts are described by the character each key produces,
+// keyed by the character the same key produces on the US English layout.
+
+// qwertyToAz maps US English keys to the Azerbaijani Latin layout. Letter
+// keys not listed produce the same letter on both layouts.
+var qwertyToAz = map[rune]rune{
+	'w': 'ü', '[': 'ö', ']': 'ğ', ';': 'ı', '\'': 'ə', ',': 'ç', '.': 'ş', '/': '.',
+	'W': 'Ü', '{': 'Ö', '}': 'Ğ', ':': 'I', '"': 'Ə', '<': 'Ç', '>': 'Ş', '?': ',',
+	'I': 'İ',
+}
+
+// qwertyToRu maps US English keys to the Russian (ЙЦУКЕН) layout.
+var qwertyToRu = map[rune]rune{
+	'q': 'й', 'w': 'ц', 'e': 'у', 'r': 'к', 't': 'е', 'y': 'н', 'u': 'г',
+	'i': 'ш', 'o': 'щ', 'p': 'з', '[': 'х', ']': 'ъ', 'a': 'ф', 's': 'ы',
+	'd': 'в', 'f': 'а', 'g': 'п', 'h': 'р', 'j': 'о', 'k': 'л', 'l': 'д',
+	';': 'ж', '\'': 'э', 'z': 'я', 'x': 'ч', 'c': 'с', 'v': 'м', 'b': 'и',
+	'n': 'т', 'm': 'ь', ',': 'б', '.': 'ю', '`': 'ё', '/': '.',
+	'Q': 'Й', 'W': 'Ц', 'E': 'У', 'R': 'К', 'T': 'Е', 'Y': 'Н', 'U': 'Г',
+	'I': 'Ш', 'O': 'Щ', 'P': 'З', '{': 'Х', '}': 'Ъ', 'A': 'Ф', 'S': 'Ы',
+	'D': 'В', 'F': 'А', 'G': 'П', 'H': 'Р', 'J': 'О', 'K': 'Л', 'L': 'Д',
+	':': 'Ж', '"': 'Э', 'Z': 'Я', 'X': 'Ч', 'C': 'С', 'V': 'М', 'B': 'И',
+	'N': 'Т', 'M': 'Ь', '<': 'Б', '>': 'Ю', '~': 'Ё', '?': ',',
+}
+
+// ruToQwerty maps the Cyrillic letters of the Russian layout back to
+// their US English keys (populated in init).
+var ruToQwerty = make(map[rune]rune, len(qwertyToRu))
+
+func init() {
+	for k, v := range qwertyToRu {
+		if unicode.IsLetter(v) {
+			ruToQwerty[v] = k
+		}
+	}
+}
+
+// FixLayout reports whether word was typed with the wrong keyboard layout
+// active and returns the intended Azerbaijani Latin word. Three mistakes
+// are recognized:
+//
+//   - Azerbaijani typed on the English layout: "g'l" → "gəl", "wzv" →
+//     "üzm" (the keys for ə, ı, ç, ş, ö, ğ, ü produce punctuation or w);
+//   - Azerbaijani typed on the Russian layout: "лшефи" → "kitab";
+//   - Azerbaijani Cyrillic typed on the English layout: "rbnf," ("китаб")
+//     → "kitab".
+//
+// A conversion is accepted only when word is not a correct word (with
+// any trailing punctuation removed) and the result is a correct word of
+// letters only, at least three letters long, or five when word has
+// letters only. Diacritics the Russian layout cannot type are
+// restored ("vtrnt," → "məktəb"), and the case of each letter follows the
+// Shift state of its key. Returns word and false otherwise.
+func FixLayout(word string) (string, bool) {
+	return defaultChecker.FixLayout(word)
+}
+
+// FixLayout is like the package-level FixLayout but also accepts the
+// checker's custom words as conversions.
+func (c *Checker) FixLayout(word string) (string, bool) {
+	if word == "" || len(word) > maxWordBytes {
+		return word, false
+	}
+	// A correct word followed by punctuation ("idi.") is left alone.
+	core := strings.TrimRight(word, ".,!?;:")
+	if isLetters(core) && c.IsCorrect(core) {
+		return word, false
+	}
+
+	minRunes := minLayoutRunes
+	var candidates []string
+	switch layoutScript(word) {
+	case unicode.Latin:
+		if isLetters(word) {
+			minRunes = minLayoutLetterRunes
+		}
+		candidates = []string{
+			mapRunes(word, qwertyToAz),
+			translit.CyrillicToLatin(mapRunes(word, qwertyToRu)),
+		}
+	case unicode.Cyrillic:
+		candidates = []string{mapRunes(mapRunes(word, ruToQwerty), qwertyToAz)}
+	}
+	for _, cand := range candidates {
+		if cand != word && isLetters(cand) &&
+			utf8.RuneCountInString(cand) >= minRunes && c.IsCorrect(cand) {
+			// The Russian layout has no ə, ö, ü, ğ, ı: "мектеб" → "məktəb".
+			return normalize.NormalizeWord(cand), true
+		}
+	}
+	return word, false
+}
+
+// layoutScript returns the script of the keyboard word was typed on:
+// unicode.Latin for ASCII-only text, unicode.Cyrillic for text whose
+// letters are all Cyrillic, or nil. Letters outside ASCII in Latin text
+// (ə, ş) mean the Azerbaijani layout was active.
+func layoutScript(word string) *unicode.RangeTable {
+	ascii, cyrillic, letters := true, true, false
+	for _, r := range word {
+		if r >= utf8.RuneSelf {
+			ascii = false
+		}
+		if unicode.IsLetter(r) {
+			letters = true
+			if !unicode.Is(unicode.Cyrillic, r) {
+				cyrillic = false
+			}
+		}
+	}
+	switch {
+	case !letters:
+		return nil
+	case ascii:
+		return unicode.Latin
+	case cyrillic:
+		return unicode.Cyrillic
+	}
+	return nil
+}
+
+// mapRunes replaces each rune of s found in m.
+func mapRunes(s string, m map[rune]rune) string {
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		if mr, ok := m[r]; ok {
+			r = mr
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// isLetters reports whether s is non-empty and consists of letters only.
+func isLetters(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return s != ""
+}
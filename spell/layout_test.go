@@ -0,0 +1,89 @@
+package spell
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFixLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   string
+		wantOK bool
+	}{
+		// -- Azerbaijani typed on the English layout --
+		{"schwa key", "g'l", "gəl", true},
+		{"schwa key inflected", "g'lir", "gəlir", true},
+		{"title case", "D'n", "Dən", true},
+
+		// -- Azerbaijani typed on the Russian layout --
+		{"russian layout", "лшефи", "kitab", true},
+		{"russian layout title case", "Лшефи", "Kitab", true},
+
+		// -- Azerbaijani Cyrillic typed on the English layout --
+		{"cyrillic via english", "rbnf,", "kitab", true},
+		{"cyrillic via english title case", "Rbnf,", "Kitab", true},
+		{"letters only", "cfkfv", "salam", true},
+		{"diacritics restored", "vtrnt,", "məktəb", true},
+
+		// -- Left alone --
+		{"correct word", "kitab", "kitab", false},
+		{"correct word with period", "idi.", "idi.", false},
+		{"english word", "hello", "hello", false},
+		{"short letters-only conversion", "evv", "evv", false},
+		{"azerbaijani letters", "gəll", "gəll", false},
+		{"russian word", "привет", "привет", false},
+		{"punctuation", ",.;", ",.;", false},
+		{"empty", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := FixLayout(tt.input)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("FixLayout(%q) = %q, %v, want %q, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCheckerFixLayout(t *testing.T) {
+	c, err := NewChecker(WithWords("Zentrix"))
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	// "Zentrix" typed on the Russian layout.
+	const input = "Яутекшч"
+	if got, ok := FixLayout(input); ok {
+		t.Errorf("FixLayout(%q) = %q, want no conversion", input, got)
+	}
+	if got, ok := c.FixLayout(input); !ok || got != "Zentrix" {
+		t.Errorf("Checker.FixLayout(%q) = %q, %v, want %q", input, got, ok, "Zentrix")
+	}
+}
+
+func TestFixLayoutOversized(t *testing.T) {
+	input := strings.Repeat("r", maxWordBytes+1)
+	if got, ok := FixLayout(input); ok || got != input {
+		t.Error("FixLayout converted an oversized word")
+	}
+}
+
+func BenchmarkFixLayout(b *testing.B) {
+	for b.Loop() {
+		FixLayout("rbnf,")
+	}
+}
+
+func ExampleFixLayout() {
+	for _, w := range []string{"rbnf,", "лшефи", "g'l", "kitab"} {
+		fmt.Println(FixLayout(w))
+	}
+	// Output:
+	// kitab true
+	// kitab true
+	// gəl true
+	// kitab false
+}
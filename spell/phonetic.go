@@ -0,0 +1,87 @@
+package spell
+
+import (
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// phoneticSlack is how many edits further than maxDist a phonetic match
+// may be and still be suggested.
+const phoneticSlack = 1
+
+// phoneticClasses maps each Azerbaijani letter to its sound class. Letters
+// in one class are confused in dialectal and careless spelling: the velars
+// k/g/q/ğ, the fricatives x/h, voiced and voiceless pairs (b/p, c/ç, d/t,
+// v/f, s/ş), and vowels differing only in frontness (a/ə is kept apart,
+// as it distinguishes many words).
+var phoneticClasses = map[rune]byte{
+	'a': 'A', 'e': 'E', 'ə': 'E', 'ı': 'I', 'i': 'I', 'o': 'O', 'ö': 'O', 'u': 'U', 'ü': 'U',
+	'b': 'P', 'p': 'P', 'c': 'C', 'ç': 'C', 'j': 'C', 'd': 'T', 't': 'T', 'f': 'F', 'v': 'F', 'w': 'F',
+	'g': 'K', 'ğ': 'K', 'k': 'K', 'q': 'K', 'h': 'H', 'x': 'H',
+	's': 'S', 'ş': 'S', 'z': 'Z', 'l': 'L', 'm': 'M', 'n': 'N', 'r': 'R', 'y': 'Y',
+}
+
+// PhoneticKey returns a key shared by Azerbaijani words that sound alike:
+// each letter is replaced by its sound class and repeated classes are
+// collapsed, so "kitab", "gitab", and "kitap" share the key "KITAP", and
+// "xəbər" and "həbər" share "HEPER". Characters other than Azerbaijani
+// letters are dropped. Matching is case-insensitive.
+func PhoneticKey(word string) string {
+	var b strings.Builder
+	b.Grow(len(word))
+	var last byte
+	for _, r := range azcase.ToLower(word) {
+		c, ok := phoneticClasses[r]
+		if !ok || c == last {
+			continue
+		}
+		b.WriteByte(c)
+		last = c
+	}
+	return b.String()
+}
+
+// phoneticMatches returns the indices in ix.wordList of the words with
+// the given phonetic key. The phonetic index is built on first use.
+func (ix *index) phoneticMatches(key string) []uint32 {
+	ix.phoneticOnce.Do(func() {
+		ix.phonetic = make(map[string][]uint32, len(ix.wordList))
+		for i, w := range ix.wordList {
+			k := PhoneticKey(w)
+			ix.phonetic[k] = append(ix.phonetic[k], uint32(i)) //nolint:gosec // dictionary size is bounded well below uint32 max
+		}
+	})
+	return ix.phonetic[key]
+}
+
+// addPhonetic merges into results the words of ix that share input's
+// phonetic key and lie within maxDist+phoneticSlack edits, and marks
+// results that share the key as phonetic matches.
+func addPhonetic(results []Suggestion, ix *index, input string, maxDist int) []Suggestion {
+	key := PhoneticKey(input)
+	if key == "" {
+		return results
+	}
+	for _, idx := range ix.phoneticMatches(key) {
+		term := ix.wordList[idx]
+		if i := suggestionIndex(results, term); i >= 0 {
+			results[i].Phonetic = true
+			continue
+		}
+		if dist := damerauLevenshtein(input, term); dist <= maxDist+phoneticSlack {
+			results = append(results, Suggestion{Term: term, Distance: dist, Frequency: ix.words[term], Phonetic: true})
+		}
+	}
+	return results
+}
+
+// suggestionIndex returns the index of the suggestion for term, or -1.
+func suggestionIndex(results []Suggestion, term string) int {
+	for i, s := range results {
+		if s.Term == term {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,89 @@
+package spell
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPhoneticKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"kitab", "KITAP"},
+		{"gitab", "KITAP"},
+		{"kitap", "KITAP"},
+		{"qitab", "KITAP"},
+		{"xəbər", "HEPER"},
+		{"həbər", "HEPER"},
+		{"dəyişmədi", "TEYISMETI"},
+		{"deyismedi", "TEYISMETI"},
+		{"KİTAB", "KITAP"},
+		{"yaxşı", "YAHSI"},
+		{"saat", "SAT"},       // repeated classes collapse
+		{"kitab-a", "KITAPA"}, // non-letters dropped
+		{"", ""},
+		{"123", ""},
+	}
+	for _, tt := range tests {
+		if got := PhoneticKey(tt.input); got != tt.want {
+			t.Errorf("PhoneticKey(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestPhonetic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		want     string
+		wantDist int
+	}{
+		{"gitab", "kitab", 1}, // k/g: ranked above the more distant gitar
+		{"qitab", "kitab", 1}, // k/q
+		{"kiçiq", "kiçik", 1}, // q/k
+		{"yahşı", "yaxşı", 1}, // x/h
+		{"dostluk", "dostluq", 1},
+		{"deyismedi", "dəyişmədi", 3}, // one edit beyond maxDist
+	}
+	for _, tt := range tests {
+		got := Suggest(tt.input, 2)
+		if len(got) == 0 {
+			t.Errorf("Suggest(%q) = nil, want %q first", tt.input, tt.want)
+			continue
+		}
+		if got[0].Term != tt.want || got[0].Distance != tt.wantDist || !got[0].Phonetic {
+			t.Errorf("Suggest(%q)[0] = %+v, want phonetic %q at distance %d", tt.input, got[0], tt.want, tt.wantDist)
+		}
+	}
+}
+
+func TestSuggestPhoneticCustomWords(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewChecker(WithWords("qoqaqız"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := c.Suggest("kokaqız", 1)
+	if len(got) == 0 || got[0].Term != "qoqaqız" || !got[0].Phonetic {
+		t.Errorf("Suggest(kokaqız) = %+v, want phonetic qoqaqız first", got)
+	}
+}
+
+func BenchmarkPhoneticKey(b *testing.B) {
+	for b.Loop() {
+		PhoneticKey("Azərbaycan")
+	}
+}
+
+func ExamplePhoneticKey() {
+	fmt.Println(PhoneticKey("kitab"), PhoneticKey("gitab"), PhoneticKey("kitap"))
+	fmt.Println(Suggest("gitab", 2)[0].Term)
+	// Output:
+	// KITAP KITAP KITAP
+	// kitab
+}
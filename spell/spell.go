@@ -1,12 +1,24 @@
 // Package spell provides spell checking for Azerbaijani text using the
 // SymSpell (Symmetric Delete) algorithm with morphology-aware validation.
 //
-// The package provides four functions:
+// The package provides ten functions:
 //
 //   - IsCorrect reports whether a word is correctly spelled.
 //   - Suggest returns ranked correction candidates for a misspelled word.
 //   - CorrectWord corrects a single word, preserving its case pattern.
 //   - Correct corrects all misspelled words in a text.
+//   - CorrectRanges returns the corrections as a byte-offset edit script.
+//   - CorrectWithEdits returns the corrected text together with the
+//     original and replacement of every corrected word, for highlighting.
+//   - CorrectContext corrects a text like Correct, choosing between equally
+//     close candidates by the neighboring words (an embedded seed list of
+//     common bigrams).
+//   - CorrectBatch corrects a slice of words like CorrectWord, looking up
+//     each distinct word once and spreading the work over all CPUs.
+//   - FixLayout recognizes a word typed with the English or Russian keyboard
+//     layout active and converts it to the intended word ("rbnf," → "kitab").
+//   - PhoneticKey returns a key shared by words that sound alike
+//     ("kitab", "gitab", "kitap").
 //
 // Words are validated through a layered approach:
 //
@@ -21,8 +33,28 @@
 // via [morph.Analyze], the stem is corrected, and the word is reconstructed
 // with the original suffixes.
 //
-// The frequency dictionary is embedded via //go:embed and parsed in init(),
-// making the API stateless and safe for concurrent use by multiple goroutines.
+// Suggest also proposes dictionary words with the same PhoneticKey as the
+// input, so dialectal and careless spellings that confuse k/g/q/ğ, x/h,
+// voiced and voiceless consonants, or e/ə are corrected even one edit
+// beyond maxDist ("deyismedi" → "dəyişmədi"). At equal distance, phonetic
+// matches rank first and are marked by Suggestion.Phonetic.
+//
+// Candidates are also checked with [morph.Analyze]: a dictionary stem or
+// an inflection of one (Suggestion.Known) outranks a more frequent
+// neighbor at the same distance that is neither, such as a corpus typo
+// or a truncated form ("kitbda" → "kitabda").
+//
+// The frequency dictionary is embedded via //go:embed and indexed on first
+// use, making the API stateless and safe for concurrent use by multiple
+// goroutines.
+//
+// A Checker created by NewChecker extends the embedded dictionary with
+// custom words (WithCustomDictionary, WithWords, AddWords) and bigrams
+// (WithBigrams) and offers the first nine as methods; the package-level
+// functions never see custom words. WithMaxDistance and WithPrefixLength
+// tune the delete index of a Checker: a lower distance or shorter prefix
+// saves memory for embedded use, and distance 3 raises recall for noisy
+// OCR text.
 //
 // Known limitations:
 //
@@ -47,9 +79,11 @@ import (
 
 // Suggestion represents a spelling correction candidate.
 type Suggestion struct {
-	Term      string `json:"term"`      // corrected word
-	Distance  int    `json:"distance"`  // edit distance from input
-	Frequency int64  `json:"frequency"` // corpus frequency (higher = more common)
+	Term      string `json:"term"`               // corrected word
+	Distance  int    `json:"distance"`           // edit distance from input
+	Frequency int64  `json:"frequency"`          // corpus frequency (higher = more common)
+	Phonetic  bool   `json:"phonetic,omitempty"` // shares the input's PhoneticKey
+	Known     bool   `json:"known,omitempty"`    // a dictionary stem or an inflected form of one
 }
 
 // IsCorrect reports whether word is correctly spelled.
@@ -60,6 +94,12 @@ type Suggestion struct {
 // maxWordBytes (not a spelling issue). Returns true for words shorter
 // than minWordRunes (too short to meaningfully spell-check).
 func IsCorrect(word string) bool {
+	return defaultChecker.IsCorrect(word)
+}
+
+// IsCorrect is like the package-level IsCorrect but also accepts the
+// checker's custom words and their inflected forms.
+func (c *Checker) IsCorrect(word string) bool {
 	if word == "" {
 		return true
 	}
@@ -81,7 +121,7 @@ func IsCorrect(word string) bool {
 			return true
 		}
 		for _, part := range parts {
-			if part != "" && !IsCorrect(part) {
+			if part != "" && !c.IsCorrect(part) {
 				return false
 			}
 		}
@@ -91,7 +131,7 @@ func IsCorrect(word string) bool {
 	// Apostrophe handling: validate only the pre-apostrophe stem.
 	for i, r := range lower {
 		if i > 0 && azcase.IsApostrophe(r) && i < len(lower)-1 {
-			return IsCorrect(lower[:i])
+			return c.IsCorrect(lower[:i])
 		}
 	}
 
@@ -104,41 +144,53 @@ func IsCorrect(word string) bool {
 	}
 
 	// Direct frequency dictionary hit.
-	if _, ok := words[lower]; ok {
+	if c.isKnownWord(lower) {
 		return true
 	}
 
 	// Morphological analysis: any decomposition with a known stem validates the word.
-	analyses := morph.Analyze(lower)
-	for _, a := range analyses {
-		if len(a.Morphemes) > 0 && morph.IsKnownStem(azcase.ToLower(a.Stem)) {
-			return true
-		}
+	if c.isInflected(lower) {
+		return true
 	}
 
 	// Diacritic normalization: if the normalized form differs and is valid, accept.
 	normalized := normalize.NormalizeWord(lower)
 	if normalized != lower {
-		if _, ok := words[normalized]; ok {
+		if c.isKnownWord(normalized) || c.isInflected(normalized) {
 			return true
 		}
-		for _, a := range morph.Analyze(normalized) {
-			if len(a.Morphemes) > 0 && morph.IsKnownStem(azcase.ToLower(a.Stem)) {
-				return true
-			}
-		}
 	}
 
 	return false
 }
 
+// isInflected reports whether some analysis of the lowercase word strips
+// at least one suffix and leaves a known stem.
+func (c *Checker) isInflected(lower string) bool {
+	for _, a := range morph.Analyze(lower) {
+		if len(a.Morphemes) > 0 && c.isKnownStem(azcase.ToLower(a.Stem)) {
+			return true
+		}
+	}
+	return false
+}
+
 // Suggest returns spelling correction candidates for word, sorted by
-// edit distance ascending then frequency descending.
+// edit distance ascending, phonetic matches first, morphologically valid
+// words (Suggestion.Known) next, then frequency descending. Words sharing the input's PhoneticKey are suggested up to
+// one edit beyond maxDist.
 // Returns nil if the word is correct or empty.
-// maxDist caps the maximum edit distance (clamped to maxEditDistance).
+// maxDist caps the maximum edit distance (clamped to 2, the default index
+// distance; see WithMaxDistance).
 // Callers who want only the best match can take the first element.
 func Suggest(word string, maxDist int) []Suggestion {
-	if word == "" || IsCorrect(word) {
+	return defaultChecker.Suggest(word, maxDist)
+}
+
+// Suggest is like the package-level Suggest but also proposes the
+// checker's custom words.
+func (c *Checker) Suggest(word string, maxDist int) []Suggestion {
+	if word == "" || c.IsCorrect(word) {
 		return nil
 	}
 	if len(word) > maxWordBytes {
@@ -147,12 +199,12 @@ func Suggest(word string, maxDist int) []Suggestion {
 
 	lower := azcase.ToLower(word)
 
-	if maxDist > maxEditDistance {
-		maxDist = maxEditDistance
+	if maxDist > c.maxDistance() {
+		maxDist = c.maxDistance()
 	}
 
 	// Try whole-word lookup first.
-	if results := lookup(lower, maxDist); len(results) > 0 {
+	if results := c.lookup(lower, maxDist); len(results) > 0 {
 		for i := range results {
 			results[i].Term = azcase.ApplyCase(word, results[i].Term)
 		}
@@ -169,11 +221,11 @@ func Suggest(word string, maxDist int) []Suggestion {
 			continue
 		}
 		stem := azcase.ToLower(a.Stem)
-		if morph.IsKnownStem(stem) {
+		if c.isKnownStem(stem) {
 			continue // stem already correct, nothing to fix
 		}
 
-		stemSuggestions := lookup(stem, maxDist)
+		stemSuggestions := c.lookup(stem, maxDist)
 		suffix := suffixSurface(a)
 
 		for _, ss := range stemSuggestions {
@@ -185,15 +237,7 @@ func Suggest(word string, maxDist int) []Suggestion {
 			seen[reconstructed] = struct{}{}
 
 			// Validate the reconstruction produces a valid morphological form.
-			reanalyses := morph.Analyze(reconstructed)
-			valid := false
-			for _, ra := range reanalyses {
-				if len(ra.Morphemes) > 0 && morph.IsKnownStem(azcase.ToLower(ra.Stem)) {
-					valid = true
-					break
-				}
-			}
-			if !valid {
+			if !c.isInflected(reconstructed) {
 				continue
 			}
 
@@ -201,6 +245,7 @@ func Suggest(word string, maxDist int) []Suggestion {
 				Term:      reconstructed,
 				Distance:  ss.Distance,
 				Frequency: ss.Frequency,
+				Known:     true,
 			})
 		}
 	}
@@ -223,14 +268,20 @@ func Suggest(word string, maxDist int) []Suggestion {
 // Returns the original word if it is correct or has no suggestions.
 // Preserves the case pattern of the input (title-case, all-upper, lowercase).
 func CorrectWord(word string) string {
+	return defaultChecker.CorrectWord(word)
+}
+
+// CorrectWord is like the package-level CorrectWord but uses the checker's
+// custom words.
+func (c *Checker) CorrectWord(word string) string {
 	if word == "" || len(word) > maxWordBytes {
 		return word
 	}
-	if IsCorrect(word) {
+	if c.IsCorrect(word) {
 		return word
 	}
 
-	suggestions := Suggest(word, maxEditDistance)
+	suggestions := c.Suggest(word, c.maxDistance())
 	if len(suggestions) == 0 {
 		return word
 	}
@@ -238,40 +289,141 @@ func CorrectWord(word string) string {
 	return suggestions[0].Term
 }
 
+// ReplaceOp is a single edit in a correction script: replace
+// text[Start:End] with Replacement.
+type ReplaceOp struct {
+	Start       int    `json:"start"`       // byte offset, inclusive
+	End         int    `json:"end"`         // byte offset, exclusive
+	Replacement string `json:"replacement"` // corrected word
+}
+
 // Correct returns text with misspelled words replaced by their
 // top correction candidate. Words with no suggestions are left unchanged.
-// Non-word tokens (spaces, punctuation, numbers) are preserved.
+// Bytes outside the corrected words (spaces, punctuation, numbers, and
+// any malformed UTF-8) are copied from the input unchanged.
 // Returns the input unchanged for empty or oversized (>1 MiB) input.
 func Correct(text string) string {
-	if text == "" || len(text) > maxInputBytes {
-		return text
-	}
+	return defaultChecker.Correct(text)
+}
 
-	tokens := tokenizer.WordTokens(text)
-	if len(tokens) == 0 {
+// Correct is like the package-level Correct but uses the checker's custom
+// words.
+func (c *Checker) Correct(text string) string {
+	return applyOps(text, c.CorrectRanges(text))
+}
+
+// applyOps returns text with ops applied. ops must be sorted by Start and
+// non-overlapping.
+func applyOps(text string, ops []ReplaceOp) string {
+	if len(ops) == 0 {
 		return text
 	}
 
 	var sb strings.Builder
 	sb.Grow(len(text))
 
-	for _, tok := range tokens {
+	prev := 0
+	for _, op := range ops {
+		sb.WriteString(text[prev:op.Start])
+		sb.WriteString(op.Replacement)
+		prev = op.End
+	}
+	sb.WriteString(text[prev:])
+
+	return sb.String()
+}
+
+// CorrectRanges returns the minimal edit script that Correct would apply
+// to text: one ReplaceOp per corrected word, sorted by Start and
+// non-overlapping. Editors can apply the ops as patches (in reverse order,
+// or with a running offset) instead of replacing the whole buffer.
+// Returns nil when nothing needs correcting, or for empty or oversized
+// (>1 MiB) input.
+func CorrectRanges(text string) []ReplaceOp {
+	return defaultChecker.CorrectRanges(text)
+}
+
+// CorrectRanges is like the package-level CorrectRanges but uses the
+// checker's custom words.
+func (c *Checker) CorrectRanges(text string) []ReplaceOp {
+	return c.correctRanges(text, false)
+}
+
+// Edit records one correction made by CorrectWithEdits. Start and End are
+// byte offsets into the original text, so text[Start:End] == Original.
+type Edit struct {
+	Start       int    `json:"start"`       // byte offset in the original text, inclusive
+	End         int    `json:"end"`         // byte offset in the original text, exclusive
+	Original    string `json:"original"`    // misspelled word as written
+	Replacement string `json:"replacement"` // corrected word
+}
+
+// CorrectWithEdits returns the same corrected text as Correct together
+// with one Edit per corrected word, sorted by Start, so that editors can
+// highlight the changes. Offsets refer to text, not to the corrected
+// output. Returns text and nil edits when nothing needs correcting, or for
+// empty or oversized (>1 MiB) input.
+func CorrectWithEdits(text string) (string, []Edit) {
+	return defaultChecker.CorrectWithEdits(text)
+}
+
+// CorrectWithEdits is like the package-level CorrectWithEdits but uses
+// the checker's custom words.
+func (c *Checker) CorrectWithEdits(text string) (string, []Edit) {
+	ops := c.CorrectRanges(text)
+	if len(ops) == 0 {
+		return text, nil
+	}
+	edits := make([]Edit, len(ops))
+	for i, op := range ops {
+		edits[i] = Edit{
+			Start:       op.Start,
+			End:         op.End,
+			Original:    text[op.Start:op.End],
+			Replacement: op.Replacement,
+		}
+	}
+	return applyOps(text, ops), edits
+}
+
+// correctRanges implements CorrectRanges, and CorrectContext when
+// context is true.
+func (c *Checker) correctRanges(text string, context bool) []ReplaceOp {
+	if text == "" || len(text) > maxInputBytes {
+		return nil
+	}
+
+	var ops []ReplaceOp
+	tokens := tokenizer.WordTokens(text)
+	prev := "" // lowercase previous word (after correction) in context mode
+	for i, tok := range tokens {
 		if tok.Type != tokenizer.Word {
-			sb.WriteString(tok.Text)
+			if tok.Type != tokenizer.Space {
+				prev = ""
+			}
 			continue
 		}
 
-		// Leave title-case unknown words unchanged to avoid over-correcting
-		// proper nouns (names, places, organizations).
-		if azcase.IsTitleCase(tok.Text) && !IsCorrect(tok.Text) {
-			sb.WriteString(tok.Text)
-			continue
+		fixed := tok.Text
+		switch {
+		case azcase.IsTitleCase(tok.Text) && !c.IsCorrect(tok.Text):
+			// Leave title-case unknown words unchanged to avoid
+			// over-correcting proper nouns (names, places, organizations).
+		case context:
+			fixed = c.correctInContext(tok.Text, prev, nextWord(tokens, i))
+		default:
+			fixed = c.CorrectWord(tok.Text)
 		}
 
-		sb.WriteString(CorrectWord(tok.Text))
+		if fixed != tok.Text {
+			ops = append(ops, ReplaceOp{Start: tok.Start, End: tok.End, Replacement: fixed})
+		}
+		if context {
+			prev = azcase.ToLower(fixed)
+		}
 	}
 
-	return sb.String()
+	return ops
 }
 
 // suffixSurface concatenates the surface forms of all morphemes in an analysis,
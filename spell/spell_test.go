@@ -206,7 +206,8 @@ func TestSuggestFirstSuggestion(t *testing.T) {
 }
 
 // TestSuggestSortOrder verifies suggestions are sorted by distance ascending,
-// then frequency descending.
+// phonetic matches first, morphologically valid words next, then frequency
+// descending.
 func TestSuggestSortOrder(t *testing.T) {
 	t.Parallel()
 	got := Suggest("ketab", 2)
@@ -219,13 +220,46 @@ func TestSuggestSortOrder(t *testing.T) {
 			t.Errorf("suggestions not sorted by distance: got[%d].Distance=%d > got[%d].Distance=%d",
 				i-1, a.Distance, i, b.Distance)
 		}
-		if a.Distance == b.Distance && a.Frequency < b.Frequency {
+		if a.Distance != b.Distance || a.Phonetic != b.Phonetic {
+			continue
+		}
+		if !a.Known && b.Known {
+			t.Errorf("invalid word before valid one: got[%d]=%v, got[%d]=%v", i-1, a, i, b)
+		}
+		if a.Known == b.Known && a.Frequency < b.Frequency {
 			t.Errorf("same-distance suggestions not sorted by frequency desc: got[%d].Frequency=%d < got[%d].Frequency=%d",
 				i-1, a.Frequency, i, b.Frequency)
 		}
 	}
 }
 
+// TestSuggestKnownRanking verifies that a morphologically valid candidate
+// outranks a more frequent one at the same distance that is not.
+func TestSuggestKnownRanking(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input   string
+		valid   string // a valid form, Known
+		invalid string // a more frequent form without a valid analysis
+	}{
+		{"kitbda", "kitabla", "kitabdak"},
+		{"məktəbdn", "məktəblə", "məktəbdək"},
+	}
+	for _, tt := range tests {
+		got := Suggest(tt.input, 2)
+		vi, ii := suggestionIndex(got, tt.valid), suggestionIndex(got, tt.invalid)
+		if vi < 0 || ii < 0 {
+			t.Fatalf("Suggest(%q, 2) = %v, want %q and %q", tt.input, got, tt.valid, tt.invalid)
+		}
+		if !got[vi].Known || got[ii].Known || vi > ii {
+			t.Errorf("Suggest(%q, 2): %v at %d, %v at %d; want the valid form first", tt.input, got[vi], vi, got[ii], ii)
+		}
+	}
+	if got := Suggest("kitbda", 2); len(got) == 0 || got[0].Term != "kitabda" || !got[0].Known {
+		t.Errorf("Suggest(kitbda, 2) = %v, want kitabda first", got)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // TestCorrectWord
 // ---------------------------------------------------------------------------
@@ -364,6 +398,142 @@ func TestCorrectPreservesSpacesAndPunctuation(t *testing.T) {
 	}
 }
 
+// TestCorrectRanges verifies the edit script offsets and replacements.
+func TestCorrectRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []ReplaceOp
+	}{
+		{name: "empty string", input: "", want: nil},
+		{name: "already correct text", input: "bu kitab", want: nil},
+		{name: "oversized input", input: strings.Repeat("a", maxInputBytes+1), want: nil},
+		{
+			name:  "two corrections",
+			input: "Bu ketab gozeldir",
+			want: []ReplaceOp{
+				{Start: 3, End: 8, Replacement: "kitab"},
+				{Start: 9, End: 17, Replacement: "gözəldir"},
+			},
+		},
+		{
+			name:  "offsets after multibyte prefix",
+			input: "Gözəl ketab",
+			want:  []ReplaceOp{{Start: 8, End: 13, Replacement: "kitab"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := CorrectRanges(tt.input)
+			if len(got) != len(tt.want) {
+				t.Fatalf("CorrectRanges(%.40q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("op[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestCorrectWithEdits verifies that edits carry offsets into the original
+// text and that the returned text matches Correct.
+func TestCorrectWithEdits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []Edit
+	}{
+		{name: "empty string", input: "", want: nil},
+		{name: "already correct text", input: "bu kitab", want: nil},
+		{name: "oversized input", input: strings.Repeat("a", maxInputBytes+1), want: nil},
+		{
+			name:  "two corrections",
+			input: "Bu ketab gozeldir",
+			want: []Edit{
+				{Start: 3, End: 8, Original: "ketab", Replacement: "kitab"},
+				{Start: 9, End: 17, Original: "gozeldir", Replacement: "gözəldir"},
+			},
+		},
+		{
+			name:  "offsets after multibyte prefix and earlier growth",
+			input: "Gözəl gozeldir ketab.",
+			want: []Edit{
+				{Start: 8, End: 16, Original: "gozeldir", Replacement: "gözəldir"},
+				{Start: 17, End: 22, Original: "ketab", Replacement: "kitab"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, edits := CorrectWithEdits(tt.input)
+			if want := Correct(tt.input); got != want {
+				t.Errorf("CorrectWithEdits(%.40q) text = %q, want %q", tt.input, got, want)
+			}
+			if len(edits) != len(tt.want) {
+				t.Fatalf("CorrectWithEdits(%.40q) edits = %+v, want %+v", tt.input, edits, tt.want)
+			}
+			for i, e := range edits {
+				if e != tt.want[i] {
+					t.Errorf("edit[%d] = %+v, want %+v", i, e, tt.want[i])
+				}
+				if tt.input[e.Start:e.End] != e.Original {
+					t.Errorf("edit[%d]: input[%d:%d] = %q, want %q", i, e.Start, e.End, tt.input[e.Start:e.End], e.Original)
+				}
+			}
+		})
+	}
+}
+
+// TestCorrectPreservesUnchangedBytes verifies that every byte outside a
+// ReplaceOp range is copied verbatim, including irregular whitespace,
+// control characters, and malformed UTF-8.
+func TestCorrectPreservesUnchangedBytes(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		"Bu  ketab,\t\tgozeldir!\r\n",
+		"ketab\x00ketab",
+		"\xff\xfeketab \u00a0 gozel\u200b.",
+		"  ketab  ",
+		"123 ketab — «gozel»",
+	}
+
+	for _, in := range inputs {
+		ops := CorrectRanges(in)
+		got := Correct(in)
+
+		// Rebuild the expected output from the ops and the original bytes.
+		var want strings.Builder
+		prev := 0
+		for _, op := range ops {
+			if op.Start < prev || op.End < op.Start || op.End > len(in) {
+				t.Fatalf("CorrectRanges(%q): invalid or overlapping op %+v", in, op)
+			}
+			want.WriteString(in[prev:op.Start])
+			want.WriteString(op.Replacement)
+			prev = op.End
+		}
+		want.WriteString(in[prev:])
+
+		if got != want.String() {
+			t.Errorf("Correct(%q) = %q, want %q", in, got, want.String())
+		}
+		if len(ops) == 0 && got != in {
+			t.Errorf("Correct(%q) = %q, want unchanged", in, got)
+		}
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Benchmarks
 // ---------------------------------------------------------------------------
@@ -427,6 +597,27 @@ func ExampleCorrect() {
 	// Bu kitab gözəldir
 }
 
+func ExampleCorrectRanges() {
+	for _, op := range CorrectRanges("Bu ketab gozeldir") {
+		fmt.Printf("[%d:%d] %s\n", op.Start, op.End, op.Replacement)
+	}
+	// Output:
+	// [3:8] kitab
+	// [9:17] gözəldir
+}
+
+func ExampleCorrectWithEdits() {
+	text, edits := CorrectWithEdits("Bu ketab gozeldir")
+	fmt.Println(text)
+	for _, e := range edits {
+		fmt.Printf("[%d:%d] %s → %s\n", e.Start, e.End, e.Original, e.Replacement)
+	}
+	// Output:
+	// Bu kitab gözəldir
+	// [3:8] ketab → kitab
+	// [9:17] gozeldir → gözəldir
+}
+
 // ---------------------------------------------------------------------------
 // Security Tests
 // ---------------------------------------------------------------------------
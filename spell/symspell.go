@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"hash/fnv"
 	"strconv"
+	"sync"
 	"unicode/utf8"
 
 	"github.com/az-ai-labs/az-lang-nlp/azcase"
@@ -11,8 +12,10 @@ import (
 )
 
 const (
-	maxEditDistance = 2       // maximum pre-computed edit distance
-	prefixLength    = 7       // prefix length for delete generation (memory optimization)
+	maxEditDistance = 2       // default maximum pre-computed edit distance
+	prefixLength    = 7       // default prefix length for delete generation (memory optimization)
+	maxDistLimit    = 3       // highest maximum edit distance accepted by WithMaxDistance
+	maxPrefixLength = 10      // longest prefix length accepted by WithPrefixLength
 	maxWordBytes    = 256     // maximum word length in bytes
 	maxInputBytes   = 1 << 20 // 1 MiB limit for Correct
 	minWordRunes    = 2       // minimum runes for a word to be spell-checked
@@ -20,55 +23,102 @@ const (
 	maxHyphenParts  = 8       // maximum hyphen-separated parts to check independently
 )
 
-// Core SymSpell index (populated in init, read-only after).
-var (
+// index is a SymSpell dictionary: word frequencies plus the delete-variant
+// index used for candidate lookup.
+type index struct {
 	words      map[string]int64    // word -> frequency
 	deletes    map[uint32][]uint32 // hash(delete) -> []index into wordList
 	wordList   []string            // indexed word list (saves memory vs storing strings in deletes)
 	maxWordLen int                 // longest word in dictionary (in runes)
-)
+	maxDist    int                 // maximum pre-computed edit distance
+	prefixLen  int                 // prefix length for delete generation
 
-func init() {
-	lines := bytes.Split(data.SpellFreq, []byte("\n"))
-	words = make(map[string]int64, len(lines))
-	wordList = make([]string, 0, len(lines))
-	deletes = make(map[uint32][]uint32, len(lines)*deletesPerWord)
+	phoneticOnce sync.Once
+	phonetic     map[string][]uint32 // PhoneticKey -> []index into wordList; built on first use
+}
 
+// embeddedIndex returns the embedded frequency dictionary indexed with the
+// default parameters, built on first use and read-only after.
+var embeddedIndex = sync.OnceValue(func() *index {
+	return loadEmbedded(maxEditDistance, prefixLength)
+})
+
+func newIndex(capacity, maxDist, prefixLen int) *index {
+	return &index{
+		words:     make(map[string]int64, capacity),
+		wordList:  make([]string, 0, capacity),
+		deletes:   make(map[uint32][]uint32, capacity*deletesPerWord),
+		maxDist:   maxDist,
+		prefixLen: prefixLen,
+	}
+}
+
+// loadEmbedded indexes the embedded frequency dictionary with the given
+// parameters.
+func loadEmbedded(maxDist, prefixLen int) *index {
+	lines := bytes.Split(data.SpellFreq, []byte("\n"))
+	ix := newIndex(len(lines), maxDist, prefixLen)
 	for _, line := range lines {
-		if len(line) == 0 {
-			continue
+		word, freq, ok := parseFreqLine(line)
+		if ok {
+			ix.add(word, freq)
 		}
+	}
+	return ix
+}
 
-		sp := bytes.LastIndexByte(line, ' ')
-		if sp <= 0 {
-			continue
-		}
+// parseFreqLine parses a "word frequency" line. Lines without a valid
+// non-negative frequency are rejected.
+func parseFreqLine(line []byte) (string, int64, bool) {
+	sp := bytes.LastIndexByte(line, ' ')
+	if sp <= 0 {
+		return "", 0, false
+	}
+	freq, err := strconv.ParseInt(string(line[sp+1:]), 10, 64)
+	if err != nil || freq < 0 {
+		return "", 0, false
+	}
+	return string(line[:sp]), freq, true
+}
 
-		word := string(line[:sp])
-		freq, err := strconv.ParseInt(string(line[sp+1:]), 10, 64)
-		if err != nil || freq < 0 {
-			continue
-		}
+// add inserts word with frequency freq. Re-adding a word updates its
+// frequency without re-indexing it.
+func (ix *index) add(word string, freq int64) {
+	if _, ok := ix.words[word]; ok {
+		ix.words[word] = freq
+		return
+	}
+	ix.words[word] = freq
+	idx := uint32(len(ix.wordList)) //nolint:gosec // dictionary size is bounded well below uint32 max
+	ix.wordList = append(ix.wordList, word)
 
-		words[word] = freq
-		idx := uint32(len(wordList)) //nolint:gosec // dictionary size is bounded well below uint32 max
-		wordList = append(wordList, word)
+	if ix.phonetic != nil {
+		k := PhoneticKey(word)
+		ix.phonetic[k] = append(ix.phonetic[k], idx)
+	}
 
-		n := utf8.RuneCountInString(word)
-		if n > maxWordLen {
-			maxWordLen = n
-		}
+	n := utf8.RuneCountInString(word)
+	if n > ix.maxWordLen {
+		ix.maxWordLen = n
+	}
 
-		// Generate delete variants for the prefix and add to the index.
-		prefix := truncateToRunes(word, prefixLength)
-		edits := generateDeletes(prefix, maxEditDistance)
-		for _, del := range edits {
-			h := fnvHash(del)
-			deletes[h] = append(deletes[h], idx)
-		}
+	// Generate delete variants for the prefix and add to the index.
+	prefix := truncateToRunes(word, ix.prefixLen)
+	for _, del := range generateDeletes(prefix, ix.maxDist) {
+		h := fnvHash(del)
+		ix.deletes[h] = append(ix.deletes[h], idx)
 	}
 }
 
+// reindex returns a copy of ix indexed with the given parameters.
+func (ix *index) reindex(maxDist, prefixLen int) *index {
+	out := newIndex(len(ix.wordList), maxDist, prefixLen)
+	for _, word := range ix.wordList {
+		out.add(word, ix.words[word])
+	}
+	return out
+}
+
 // truncateToRunes returns s truncated to at most n runes.
 func truncateToRunes(s string, n int) string {
 	count := 0
@@ -137,24 +187,24 @@ func generateDeletes(s string, dist int) []string {
 // lookup finds spelling correction candidates for the input word within maxDist
 // edit distance. Returns candidates sorted by distance ascending, then frequency
 // descending. Returns nil if input is empty or exceeds maxWordLen + maxDist.
-func lookup(input string, maxDist int) []Suggestion {
+func (ix *index) lookup(input string, maxDist int) []Suggestion {
 	if input == "" {
 		return nil
 	}
-	if maxDist > maxEditDistance {
-		maxDist = maxEditDistance
+	if maxDist > ix.maxDist {
+		maxDist = ix.maxDist
 	}
 
 	inputLower := azcase.ToLower(input)
 	inputLen := utf8.RuneCountInString(inputLower)
 
 	// Exact match: distance 0.
-	if freq, ok := words[inputLower]; ok {
+	if freq, ok := ix.words[inputLower]; ok {
 		return []Suggestion{{Term: inputLower, Distance: 0, Frequency: freq}}
 	}
 
 	// No possible match if every dictionary word is too short.
-	if inputLen-maxDist > maxWordLen {
+	if inputLen-maxDist > ix.maxWordLen {
 		return nil
 	}
 
@@ -163,19 +213,19 @@ func lookup(input string, maxDist int) []Suggestion {
 
 	// Generate delete variants of the input prefix, and include the prefix
 	// itself so that distance-0 prefix collisions are detected.
-	inputPrefix := truncateToRunes(inputLower, prefixLength)
+	inputPrefix := truncateToRunes(inputLower, ix.prefixLen)
 	inputDeletes := generateDeletes(inputPrefix, maxDist)
 	inputDeletes = append(inputDeletes, inputPrefix)
 
 	for _, del := range inputDeletes {
 		h := fnvHash(del)
-		candidates, ok := deletes[h]
+		candidates, ok := ix.deletes[h]
 		if !ok {
 			continue
 		}
 
 		for _, idx := range candidates {
-			candidate := wordList[idx]
+			candidate := ix.wordList[idx]
 			if _, already := seen[candidate]; already {
 				continue
 			}
@@ -194,7 +244,7 @@ func lookup(input string, maxDist int) []Suggestion {
 
 			dist := damerauLevenshtein(inputLower, candidate)
 			if dist <= maxDist {
-				freq := words[candidate]
+				freq := ix.words[candidate]
 				results = append(results, Suggestion{Term: candidate, Distance: dist, Frequency: freq})
 			}
 		}
@@ -204,8 +254,8 @@ func lookup(input string, maxDist int) []Suggestion {
 	return results
 }
 
-// sortSuggestions sorts candidates by distance ascending, then frequency
-// descending. Uses insertion sort because result sets are small (typically < 20).
+// sortSuggestions sorts candidates by distance ascending, phonetic
+// matches first, then frequency descending. Uses insertion sort because result sets are small (typically < 20).
 func sortSuggestions(s []Suggestion) {
 	for i := 1; i < len(s); i++ {
 		key := s[i]
@@ -222,6 +272,12 @@ func suggestionLess(a, b Suggestion) bool {
 	if a.Distance != b.Distance {
 		return a.Distance < b.Distance
 	}
+	if a.Phonetic != b.Phonetic {
+		return a.Phonetic
+	}
+	if a.Known != b.Known {
+		return a.Known
+	}
 	return a.Frequency > b.Frequency
 }
 
@@ -245,7 +301,7 @@ func damerauLevenshtein(a, b string) int {
 	if diff < 0 {
 		diff = -diff
 	}
-	if diff > maxEditDistance {
+	if diff > maxDistLimit {
 		return diff
 	}
 
@@ -0,0 +1,29 @@
+package summarize
+
+import (
+	"slices"
+	"testing"
+)
+
+func FuzzExtract(f *testing.F) {
+	f.Add("Neft hasilatı artıb. Hava günəşlidir.", 1)
+	f.Add("", 3)
+	f.Add("a", 0)
+	f.Add("Birinci. İkinci! Üçüncü?", 2)
+	f.Add("\xff\xfe. \x00", 1)
+
+	f.Fuzz(func(t *testing.T, text string, k int) {
+		got := Extract(text, k)
+		for i, s := range got {
+			if text[s.Start:s.End] != s.Text {
+				t.Fatalf("text[%d:%d] = %q, want %q", s.Start, s.End, text[s.Start:s.End], s.Text)
+			}
+			if i > 0 && s.Start < got[i-1].End {
+				t.Fatalf("sentences overlap or out of order: %v", got)
+			}
+		}
+		if again := Extract(text, k); !slices.Equal(got, again) {
+			t.Errorf("non-deterministic:\n  a = %v\n  b = %v", got, again)
+		}
+	})
+}
@@ -0,0 +1,150 @@
+// Package summarize produces extractive summaries of Azerbaijani text by
+// selecting its most central sentences.
+//
+// Sentences come from tokenizer.SentenceTokens. Each stem of the text is
+// weighted by keywords.ExtractTextRank over the whole document, and a
+// sentence scores the sum of the weights of its distinct stems, divided by
+// the square root of their number so that long sentences are not favored
+// merely for their length. The top-k sentences are returned in their
+// original order, so the summary reads like the source.
+//
+// Two API layers:
+//
+//   - Structured: Extract returns []Sentence with byte offsets, position,
+//     and score. The invariant text[s.Start:s.End] == s.Text holds for
+//     every sentence.
+//   - Convenience: Summary returns the selected sentences joined by spaces.
+//
+// All functions are safe for concurrent use by multiple goroutines.
+//
+// Known limitations:
+//
+//   - Sentence boundaries inherit the tokenizer's limitations (no quote or
+//     parenthesis nesting).
+//   - Sentences are scored independently; near-identical sentences may
+//     both be selected.
+//   - Input must be Azerbaijani Latin in NFC form, as for keywords.
+package summarize
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/keywords"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const (
+	maxInputBytes    = 1 << 20 // 1 MiB input guard
+	defaultSentences = 3       // sentences returned when k <= 0
+	maxStems         = 10000   // stems ranked by TextRank per document
+)
+
+// Sentence is a sentence selected for the summary.
+//
+// Byte-offset invariant: text[s.Start:s.End] == s.Text, with surrounding
+// whitespace excluded.
+type Sentence struct {
+	Text  string  `json:"text"`  // sentence text, trimmed
+	Start int     `json:"start"` // byte offset in the original text (inclusive)
+	End   int     `json:"end"`   // byte offset in the original text (exclusive)
+	Index int     `json:"index"` // zero-based position among the text's sentences
+	Score float64 `json:"score"` // centrality score; higher is more central
+}
+
+// String returns a debug representation, e.g. Sentence(2)[40:95](0.41).
+func (s Sentence) String() string {
+	return fmt.Sprintf("Sentence(%d)[%d:%d](%.2f)", s.Index, s.Start, s.End, s.Score)
+}
+
+// Extract returns the k highest-scoring sentences of text in their
+// original order. When k <= 0, 3 sentences are returned; when the text has
+// k sentences or fewer, all are returned. Ties in score go to the earlier
+// sentence. Returns nil for empty, whitespace-only, or oversized (>1 MiB)
+// input.
+func Extract(text string, k int) []Sentence {
+	if text == "" || len(text) > maxInputBytes {
+		return nil
+	}
+	if k <= 0 {
+		k = defaultSentences
+	}
+
+	sentences := split(text)
+	if len(sentences) == 0 {
+		return nil
+	}
+
+	rank := make(map[string]float64)
+	for _, kw := range keywords.ExtractTextRank(text, maxStems) {
+		rank[kw.Stem] = kw.Score
+	}
+	for i := range sentences {
+		sentences[i].Score = score(sentences[i].Text, rank)
+	}
+	if len(sentences) <= k {
+		return sentences
+	}
+
+	top := slices.Clone(sentences)
+	slices.SortStableFunc(top, func(a, b Sentence) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	top = top[:k]
+	slices.SortFunc(top, func(a, b Sentence) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return top
+}
+
+// Summary returns the text of Extract(text, k) joined by single spaces.
+// Returns "" when Extract returns nil.
+func Summary(text string, k int) string {
+	sentences := Extract(text, k)
+	parts := make([]string, len(sentences))
+	for i, s := range sentences {
+		parts[i] = s.Text
+	}
+	return strings.Join(parts, " ")
+}
+
+// split returns the non-blank sentences of text with surrounding
+// whitespace trimmed from their offsets.
+func split(text string) []Sentence {
+	var out []Sentence
+	for _, tok := range tokenizer.SentenceTokens(text) {
+		trimmed := strings.TrimLeftFunc(tok.Text, unicode.IsSpace)
+		start := tok.Start + len(tok.Text) - len(trimmed)
+		trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+		if trimmed == "" {
+			continue
+		}
+		out = append(out, Sentence{
+			Text:  trimmed,
+			Start: start,
+			End:   start + len(trimmed),
+			Index: len(out),
+		})
+	}
+	return out
+}
+
+// score sums the TextRank weights of the distinct stems of sentence and
+// divides by the square root of their number.
+func score(sentence string, rank map[string]float64) float64 {
+	stems := keywords.Vector(sentence)
+	if len(stems) == 0 {
+		return 0
+	}
+	// Sum in stem order so that ties do not depend on map order.
+	var sum float64
+	for _, stem := range slices.Sorted(maps.Keys(stems)) {
+		sum += rank[stem]
+	}
+	return sum / math.Sqrt(float64(len(stems)))
+}
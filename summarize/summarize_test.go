@@ -0,0 +1,106 @@
+package summarize
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const news = "Azərbaycanda neft hasilatı artıb. " +
+	"Hava bu gün günəşli olacaq. " +
+	"Neft hasilatının artması neft ixracını da artırıb. " +
+	"Nazirlik neft ixracı üzrə yeni hesabat açıqlayıb. " +
+	"Axşam konsert keçiriləcək."
+
+func TestExtract(t *testing.T) {
+	got := Extract(news, 2)
+	if len(got) != 2 {
+		t.Fatalf("got %d sentences, want 2: %v", len(got), got)
+	}
+	for _, s := range got {
+		if !strings.Contains(strings.ToLower(s.Text), "neft") {
+			t.Errorf("off-topic sentence selected: %q", s.Text)
+		}
+		if news[s.Start:s.End] != s.Text {
+			t.Errorf("news[%d:%d] = %q, want %q", s.Start, s.End, news[s.Start:s.End], s.Text)
+		}
+		if strings.TrimSpace(s.Text) != s.Text {
+			t.Errorf("sentence not trimmed: %q", s.Text)
+		}
+	}
+	if got[0].Index >= got[1].Index || got[0].Start >= got[1].Start {
+		t.Errorf("sentences not in original order: %v", got)
+	}
+}
+
+func TestExtractCounts(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		k    int
+		want int
+	}{
+		{"default k", news, 0, defaultSentences},
+		{"negative k", news, -1, defaultSentences},
+		{"k above sentence count", news, 10, 5},
+		{"single sentence", "Neft hasilatı artıb.", 3, 1},
+		{"empty", "", 3, 0},
+		{"whitespace only", " \n\t ", 3, 0},
+		{"oversized", strings.Repeat("a", maxInputBytes+1), 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Extract(tt.text, tt.k)
+			if len(got) != tt.want {
+				t.Errorf("Extract(k=%d) returned %d sentences, want %d", tt.k, len(got), tt.want)
+			}
+			if tt.want == 0 && got != nil {
+				t.Errorf("Extract = %v, want nil", got)
+			}
+		})
+	}
+}
+
+func TestExtractAllSentencesIndexed(t *testing.T) {
+	got := Extract(news, 10)
+	for i, s := range got {
+		if s.Index != i {
+			t.Errorf("sentence %d has Index %d", i, s.Index)
+		}
+	}
+}
+
+func TestSummary(t *testing.T) {
+	got := Summary(news, 2)
+	want := Extract(news, 2)[0].Text + " " + Extract(news, 2)[1].Text
+	if got != want {
+		t.Errorf("Summary = %q, want %q", got, want)
+	}
+	if got := Summary("", 2); got != "" {
+		t.Errorf("Summary(\"\") = %q, want empty", got)
+	}
+}
+
+func TestSentenceString(t *testing.T) {
+	s := Sentence{Text: "Salam.", Start: 4, End: 10, Index: 1, Score: 0.5}
+	if got, want := s.String(), "Sentence(1)[4:10](0.50)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func BenchmarkExtract(b *testing.B) {
+	text := strings.Repeat(news+" ", 20)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		Extract(text, 3)
+	}
+}
+
+func ExampleExtract() {
+	for _, s := range Extract(news, 2) {
+		fmt.Printf("%d: %s\n", s.Index, s.Text)
+	}
+	// Output:
+	// 2: Neft hasilatının artması neft ixracını da artırıb.
+	// 3: Nazirlik neft ixracı üzrə yeni hesabat açıqlayıb.
+}
@@ -0,0 +1,92 @@
+package tokenizer
+
+import (
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Profile selects a built-in set of domain abbreviations for sentence
+// splitting.
+type Profile int
+
+const (
+	ProfileLegal    Profile = iota // maddə, hissə, bənd references ("mad.", "hiss.", "məc.")
+	ProfileMedical                 // doses and units ("mq.", "ml.", "həb.", "sut.")
+	ProfileMilitary                // ranks and units ("gen.", "pol.", "leyt.", "div.")
+)
+
+// profileAbbreviations lists the abbreviations of each profile, in the
+// same form as the built-in list (lowercase, with trailing dot).
+var profileAbbreviations = [...][]string{
+	ProfileLegal: {
+		"mad.", "hiss.", "yarımb.", "fəs.", "məc.", "müq.", "red.", "nöm.", "səh.", "bax.",
+	},
+	ProfileMedical: {
+		"mq.", "mkq.", "ml.", "mmol.", "amp.", "həb.", "dam.", "sut.", "diaq.", "müal.",
+	},
+	ProfileMilitary: {
+		"gen.", "pol.", "ppol.", "may.", "kap.", "leyt.", "serj.", "bat.", "div.", "briq.", "korp.",
+	},
+}
+
+// SentenceOptions extends the built-in abbreviation list used by
+// SentenceTokensWithOptions.
+//
+// Abbreviations are matched case-insensitively on the letters before a
+// dot; a trailing dot is added when missing ("Mq" and "mq." are the same).
+type SentenceOptions struct {
+	Profiles      []Profile // built-in domain lists to enable
+	Abbreviations []string  // additional abbreviations, e.g. "tab." or "Mr"
+}
+
+// abbreviationSet returns the abbreviations enabled by opts in the form of
+// the built-in list, or nil when opts adds none.
+func (opts SentenceOptions) abbreviationSet() map[string]bool {
+	if len(opts.Profiles) == 0 && len(opts.Abbreviations) == 0 {
+		return nil
+	}
+	set := make(map[string]bool)
+	for _, p := range opts.Profiles {
+		if int(p) >= 0 && int(p) < len(profileAbbreviations) {
+			for _, a := range profileAbbreviations[p] {
+				set[a] = true
+			}
+		}
+	}
+	for _, a := range opts.Abbreviations {
+		a = azcase.ToLower(strings.TrimSpace(a))
+		if a == "" || a == "." {
+			continue
+		}
+		if !strings.HasSuffix(a, ".") {
+			a += "."
+		}
+		set[a] = true
+	}
+	return set
+}
+
+// SentenceTokensWithOptions is like SentenceTokens but also suppresses
+// sentence breaks after the abbreviations enabled by opts, so domain texts
+// are not split mid-sentence ("Xəstəyə 500 mq. Parasetamol verildi").
+func SentenceTokensWithOptions(s string, opts SentenceOptions) []Token {
+	if s == "" {
+		return nil
+	}
+	return sentenceTokens(s, opts.abbreviationSet())
+}
+
+// SentencesWithOptions is like Sentences but applies opts as
+// SentenceTokensWithOptions does.
+func SentencesWithOptions(s string, opts SentenceOptions) []string {
+	tokens := SentenceTokensWithOptions(s, opts)
+	if tokens == nil {
+		return nil
+	}
+	sentences := make([]string, len(tokens))
+	for i, t := range tokens {
+		sentences[i] = t.Text
+	}
+	return sentences
+}
@@ -0,0 +1,107 @@
+package tokenizer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSentenceTokensWithOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  SentenceOptions
+		want  []string
+	}{
+		{
+			"no options matches SentenceTokens",
+			"Xəstəyə 500 mq. Parasetamol verildi.",
+			SentenceOptions{},
+			[]string{"Xəstəyə 500 mq.", " Parasetamol verildi."},
+		},
+		{
+			"medical profile",
+			"Xəstəyə 500 mq. Parasetamol verildi. Sonra 10 ml. Su içdi.",
+			SentenceOptions{Profiles: []Profile{ProfileMedical}},
+			[]string{"Xəstəyə 500 mq. Parasetamol verildi.", " Sonra 10 ml. Su içdi."},
+		},
+		{
+			"legal profile",
+			"Məcəllənin 12-ci mad. Birinci hissəsi tətbiq edilir.",
+			SentenceOptions{Profiles: []Profile{ProfileLegal}},
+			[]string{"Məcəllənin 12-ci mad. Birinci hissəsi tətbiq edilir."},
+		},
+		{
+			"military profile",
+			"Tədbirdə gen. Məmmədov çıxış etdi.",
+			SentenceOptions{Profiles: []Profile{ProfileMilitary}},
+			[]string{"Tədbirdə gen. Məmmədov çıxış etdi."},
+		},
+		{
+			"custom abbreviation without dot, any case",
+			"Hər gün 2 Tab. Aspirin qəbul edin.",
+			SentenceOptions{Abbreviations: []string{"TAB"}},
+			[]string{"Hər gün 2 Tab. Aspirin qəbul edin."},
+		},
+		{
+			"profile combined with custom abbreviation",
+			"Gündə 3 dəfə 5 ml. Sirop və 1 kaps. Vitamin.",
+			SentenceOptions{Profiles: []Profile{ProfileMedical}, Abbreviations: []string{"kaps."}},
+			[]string{"Gündə 3 dəfə 5 ml. Sirop və 1 kaps. Vitamin."},
+		},
+		{
+			"built-in abbreviations still apply",
+			"Prof. Əliyev gəldi.",
+			SentenceOptions{Profiles: []Profile{ProfileMedical}},
+			[]string{"Prof. Əliyev gəldi."},
+		},
+		{
+			"unknown profile ignored",
+			"Bir. İki.",
+			SentenceOptions{Profiles: []Profile{Profile(99)}},
+			[]string{"Bir.", " İki."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := SentenceTokensWithOptions(tt.input, tt.opts)
+			var got []string
+			var b strings.Builder
+			for _, tok := range tokens {
+				got = append(got, tok.Text)
+				b.WriteString(tok.Text)
+				if tt.input[tok.Start:tok.End] != tok.Text || tok.Type != Sentence {
+					t.Errorf("bad token %+v", tok)
+				}
+			}
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if b.String() != tt.input {
+				t.Errorf("tokens do not reconstruct input: %q", b.String())
+			}
+		})
+	}
+}
+
+func TestSentencesWithOptions(t *testing.T) {
+	if got := SentencesWithOptions("", SentenceOptions{}); got != nil {
+		t.Errorf("empty: got %q, want nil", got)
+	}
+	opts := SentenceOptions{Profiles: []Profile{ProfileMedical}}
+	got := SentencesWithOptions("5 mq. Dozada. İkinci.", opts)
+	want := []string{"5 mq. Dozada.", " İkinci."}
+	if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func ExampleSentencesWithOptions() {
+	text := "Xəstəyə 500 mq. Parasetamol verildi. Vəziyyəti yaxşıdır."
+	for _, s := range SentencesWithOptions(text, SentenceOptions{Profiles: []Profile{ProfileMedical}}) {
+		fmt.Printf("%q\n", s)
+	}
+	// Output:
+	// "Xəstəyə 500 mq. Parasetamol verildi."
+	// " Vəziyyəti yaxşıdır."
+}
@@ -0,0 +1,94 @@
+package tokenizer
+
+import (
+	"slices"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// TokenFilter drops or rewrites tokens. It receives a slice that Filter
+// owns and may modify it in place; it returns the filtered tokens.
+type TokenFilter func(tokens []Token) []Token
+
+// Filter applies filters to tokens in order and returns the result. The
+// tokens slice is not modified.
+//
+// Filters that drop tokens keep the remaining tokens unchanged, so the
+// invariant s[t.Start:t.End] == t.Text still holds. Filters that rewrite
+// Text (LowercaseAz, MapText, MapStems) keep Start and End pointing at the
+// original span, so a rewritten token can still be located in s.
+func Filter(tokens []Token, filters ...TokenFilter) []Token {
+	if len(tokens) == 0 {
+		return nil
+	}
+	out := slices.Clone(tokens)
+	for _, f := range filters {
+		out = f(out)
+	}
+	return out
+}
+
+// DropSpaces removes Space tokens.
+func DropSpaces(tokens []Token) []Token {
+	return dropTypes(tokens, Space)
+}
+
+// DropPunctuation removes Punctuation tokens.
+func DropPunctuation(tokens []Token) []Token {
+	return dropTypes(tokens, Punctuation)
+}
+
+// LowercaseAz lowercases the text of every token with Azerbaijani rules
+// (I → ı, İ → i).
+func LowercaseAz(tokens []Token) []Token {
+	for i := range tokens {
+		tokens[i].Text = azcase.ToLower(tokens[i].Text)
+	}
+	return tokens
+}
+
+// KeepTypes returns a filter that removes every token whose type is not
+// listed, e.g. KeepTypes(Word, Number).
+func KeepTypes(types ...TokenType) TokenFilter {
+	return func(tokens []Token) []Token {
+		return slices.DeleteFunc(tokens, func(t Token) bool {
+			return !slices.Contains(types, t.Type)
+		})
+	}
+}
+
+// MapText returns a filter that replaces the text of every token of the
+// given types with f(text). With no types listed, it applies to Word
+// tokens.
+func MapText(f func(string) string, types ...TokenType) TokenFilter {
+	if len(types) == 0 {
+		types = []TokenType{Word}
+	}
+	return func(tokens []Token) []Token {
+		for i := range tokens {
+			if slices.Contains(types, tokens[i].Type) {
+				tokens[i].Text = f(tokens[i].Text)
+			}
+		}
+		return tokens
+	}
+}
+
+// MapStems returns a filter that replaces the text of Word tokens with
+// stem(text), e.g. MapStems(morph.Stem). A word whose stem is empty keeps
+// its text.
+func MapStems(stem func(string) string) TokenFilter {
+	return MapText(func(w string) string {
+		if s := stem(w); s != "" {
+			return s
+		}
+		return w
+	})
+}
+
+// dropTypes removes the tokens of type typ.
+func dropTypes(tokens []Token, typ TokenType) []Token {
+	return slices.DeleteFunc(tokens, func(t Token) bool {
+		return t.Type == typ
+	})
+}
@@ -0,0 +1,120 @@
+package tokenizer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+)
+
+// tokenTexts returns the texts of tokens.
+func tokenTexts(tokens []Token) []string {
+	out := make([]string, len(tokens))
+	for i, t := range tokens {
+		out[i] = t.Text
+	}
+	return out
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	const s = "İlham Bakıya gəldi, 5 kitab aldı."
+	tests := []struct {
+		name    string
+		filters []TokenFilter
+		want    []string
+	}{
+		{"no filters", nil,
+			[]string{"İlham", " ", "Bakıya", " ", "gəldi", ",", " ", "5", " ", "kitab", " ", "aldı", "."}},
+		{"drop spaces", []TokenFilter{DropSpaces},
+			[]string{"İlham", "Bakıya", "gəldi", ",", "5", "kitab", "aldı", "."}},
+		{"drop spaces and punctuation", []TokenFilter{DropSpaces, DropPunctuation},
+			[]string{"İlham", "Bakıya", "gəldi", "5", "kitab", "aldı"}},
+		{"keep words", []TokenFilter{KeepTypes(Word)},
+			[]string{"İlham", "Bakıya", "gəldi", "kitab", "aldı"}},
+		{"lowercase", []TokenFilter{KeepTypes(Word), LowercaseAz},
+			[]string{"ilham", "bakıya", "gəldi", "kitab", "aldı"}},
+		{"stems", []TokenFilter{KeepTypes(Word, Number), LowercaseAz, MapStems(morph.Stem)},
+			[]string{"ilham", "bakı", "gəl", "5", "kitab", "al"}},
+		{"map numbers", []TokenFilter{KeepTypes(Number), MapText(func(string) string { return "<num>" }, Number)},
+			[]string{"<num>"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tokenTexts(Filter(WordTokens(s), tt.filters...))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterOffsets(t *testing.T) {
+	t.Parallel()
+
+	const s = "BAKI Şəhəri, İyun"
+	for _, tok := range Filter(WordTokens(s), DropSpaces, DropPunctuation) {
+		if s[tok.Start:tok.End] != tok.Text {
+			t.Errorf("dropped filter broke offsets: %v", tok)
+		}
+	}
+	for _, tok := range Filter(WordTokens(s), DropSpaces, LowercaseAz) {
+		if azcase.ToLower(s[tok.Start:tok.End]) != tok.Text {
+			t.Errorf("rewritten token %v does not point at %q", tok, s[tok.Start:tok.End])
+		}
+	}
+}
+
+func TestFilterDoesNotModifyInput(t *testing.T) {
+	t.Parallel()
+
+	tokens := WordTokens("Salam, Dünya!")
+	before := slices.Clone(tokens)
+	Filter(tokens, DropSpaces, LowercaseAz)
+	if !slices.Equal(tokens, before) {
+		t.Errorf("input modified: %v, want %v", tokens, before)
+	}
+}
+
+func TestFilterEmpty(t *testing.T) {
+	t.Parallel()
+
+	if got := Filter(nil, DropSpaces); got != nil {
+		t.Errorf("Filter(nil) = %v, want nil", got)
+	}
+	if got := Filter(WordTokens("   "), DropSpaces); len(got) != 0 {
+		t.Errorf("Filter(spaces) = %v, want empty", got)
+	}
+}
+
+func TestMapStemsEmptyStem(t *testing.T) {
+	t.Parallel()
+
+	got := Filter(WordTokens("söz"), MapStems(func(string) string { return "" }))
+	if len(got) != 1 || got[0].Text != "söz" {
+		t.Errorf("got %v, want the original word", got)
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	tokens := WordTokens(strings.Repeat("Bakı Azərbaycanın paytaxtıdır, 2 milyon insan yaşayır. ", 100))
+	for b.Loop() {
+		Filter(tokens, DropSpaces, DropPunctuation, LowercaseAz)
+	}
+}
+
+func ExampleFilter() {
+	tokens := WordTokens("İLHAM kitabları oxudu.")
+	for _, t := range Filter(tokens, DropSpaces, DropPunctuation, LowercaseAz, MapStems(morph.Stem)) {
+		fmt.Println(t)
+	}
+	// Output:
+	// Word("ilham")[0:6]
+	// Word("kitab")[7:17]
+	// Word("oxu")[18:23]
+}
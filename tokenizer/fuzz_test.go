@@ -17,6 +17,29 @@ func FuzzWordTokens(f *testing.F) {
 	})
 }
 
+func FuzzWordTokensWithOptions(f *testing.F) {
+	f.Add("mia.gov.az-da", "ftp")
+	f.Add("tel:+994501234567", "tel:")
+	f.Add("e-gov.az:8080/x?y=1.", "")
+	f.Add("info.az@mail.az", "mailto://")
+	f.Add("\xffa.az", "\xff")
+	f.Fuzz(func(t *testing.T, s, scheme string) {
+		tokens := WordTokensWithOptions(s, WordOptions{Schemes: []string{scheme}, BareDomains: true})
+		verifyInvariants(t, s, tokens)
+	})
+}
+
+func FuzzSocialTokens(f *testing.F) {
+	f.Add("@user #tag 😀")
+	f.Add("👨\u200d👩\u200d👧 🇦🇿")
+	f.Add("user@mail.az #")
+	f.Add("\xff#\xfe@")
+	f.Fuzz(func(t *testing.T, s string) {
+		tokens := SocialTokens(s)
+		verifyInvariants(t, s, tokens)
+	})
+}
+
 func FuzzSentenceTokens(f *testing.F) {
 	f.Add("Birinci. \u0130kinci.")
 	f.Add("Prof. \u018eliyev g\u0259ldi.")
@@ -28,3 +51,25 @@ func FuzzSentenceTokens(f *testing.F) {
 		verifyInvariants(t, s, tokens)
 	})
 }
+
+func FuzzSpans(f *testing.F) {
+	f.Add("«Biz (hamımız) „razıyıq“»")
+	f.Add(`"a" 'b' (c [d])`)
+	f.Add("(«)»")
+	f.Add("Bakı'nın '")
+	f.Add("\xff(\xfe)")
+	f.Fuzz(func(t *testing.T, s string) {
+		spans := Spans(s)
+		for i, sp := range spans {
+			if sp.Start < 0 || sp.End > len(s) || s[sp.Start:sp.End] != sp.Text {
+				t.Fatalf("span %v: offsets do not match text", sp)
+			}
+			if len(sp.Open)+len(sp.Close) > len(sp.Text) {
+				t.Fatalf("span %v: delimiters %q %q overlap", sp, sp.Open, sp.Close)
+			}
+			if i > 0 && spans[i-1].Start > sp.Start {
+				t.Fatalf("spans out of order: %v before %v", spans[i-1], sp)
+			}
+		}
+	})
+}
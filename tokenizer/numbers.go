@@ -0,0 +1,295 @@
+package tokenizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// NumberKind classifies a number expression.
+type NumberKind int
+
+const (
+	Cardinal  NumberKind = iota // Plain number: "42", "1.500", "3,14"
+	Ordinal                     // Number with an ordinal suffix: "3-cü", "5ci", "2026-cı"
+	Percent                     // Percentage: "25%", "%25", "1,5 faiz"
+	Currency                    // Amount of money: "1.500 AZN", "$300", "10 ₼", "100 manat"
+	PhoneLike                   // Digit groups shaped like a phone number: "+994 50 123 45 67", "050-123-45-67"
+)
+
+// numberKindNames maps NumberKind values to their string names.
+var numberKindNames = [...]string{
+	Cardinal:  "Cardinal",
+	Ordinal:   "Ordinal",
+	Percent:   "Percent",
+	Currency:  "Currency",
+	PhoneLike: "PhoneLike",
+}
+
+// numberKindFromName maps string names back to NumberKind values.
+var numberKindFromName = map[string]NumberKind{
+	"Cardinal":  Cardinal,
+	"Ordinal":   Ordinal,
+	"Percent":   Percent,
+	"Currency":  Currency,
+	"PhoneLike": PhoneLike,
+}
+
+// String returns the name of the number kind.
+func (k NumberKind) String() string {
+	if int(k) >= 0 && int(k) < len(numberKindNames) {
+		return numberKindNames[k]
+	}
+	return fmt.Sprintf("NumberKind(%d)", int(k))
+}
+
+// MarshalJSON encodes the number kind as a JSON string (e.g. "Percent").
+func (k NumberKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Percent") into a NumberKind.
+func (k *NumberKind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := numberKindFromName[s]
+	if !ok {
+		return fmt.Errorf("unknown number kind: %q", s)
+	}
+	*k = v
+	return nil
+}
+
+// NumberToken is a number expression: a Number token together with the
+// sign, unit, or suffix tokens that classify it.
+type NumberToken struct {
+	Token            // The whole expression ("1.500 AZN"); Type is Number
+	Kind  NumberKind `json:"kind"`           // Cardinal, Ordinal, Percent, Currency, or PhoneLike
+	Value string     `json:"value"`          // The digits as written ("1.500", "3"); PhoneLike: digits only, with a leading "+" if present
+	Unit  string     `json:"unit,omitempty"` // Percent and Currency: the sign, code, or word ("%", "faiz", "AZN", "₼", "manata")
+}
+
+// String returns a debug representation, e.g. Currency("1.500 AZN")[0:9].
+func (n NumberToken) String() string {
+	return fmt.Sprintf("%s(%q)[%d:%d]", n.Kind, n.Text, n.Start, n.End)
+}
+
+// ordinalSuffixes lists the ordinal suffix forms written after digits,
+// with or without a hyphen: 3-cü, 5ci, 1-inci, 2-nci.
+var ordinalSuffixes = map[string]bool{
+	"cı": true, "ci": true, "cu": true, "cü": true,
+	"ncı": true, "nci": true, "ncu": true, "ncü": true,
+	"ıncı": true, "inci": true, "uncu": true, "üncü": true,
+}
+
+// currencySigns are the currency symbols written before or after an amount.
+var currencySigns = map[string]bool{
+	"₼": true, "$": true, "€": true, "₽": true, "₺": true, "£": true,
+}
+
+// currencyCodes are the ISO 4217 codes recognized before or after an
+// amount. They match only in uppercase.
+var currencyCodes = map[string]bool{
+	"AZN": true, "USD": true, "EUR": true, "RUB": true, "TRY": true, "GBP": true,
+}
+
+// currencyWords are the stems of currency names written after an amount,
+// in any case form ("manat", "manata", "dollarlıq").
+var currencyWords = []string{"manat", "dollar", "avro", "euro", "rubl", "lirə", "funt", "qəpik"}
+
+// percentWord is the stem of the word for percent ("faiz", "faizə").
+const percentWord = "faiz"
+
+// Bounds on the digit groups of a PhoneLike expression.
+const (
+	minPhoneGroups = 3  // "050 123 4567"
+	maxPhoneGroup  = 4  // digits per group after the first
+	minPhoneDigits = 9  // "012 345 67 89" without the trunk prefix
+	maxPhoneDigits = 13 // "+994 50 123 45 67" has 12
+)
+
+// NumberTokens returns the number expressions of text, classified by
+// kind, in text order. See ClassifyNumbers.
+func NumberTokens(s string) []NumberToken {
+	if s == "" {
+		return nil
+	}
+	return ClassifyNumbers(wordTokens(s, wordConfig{}))
+}
+
+// ClassifyNumbers groups every Number token in tokens with its
+// neighbours into a NumberToken and classifies it:
+//
+//   - PhoneLike: three or more digit groups joined by single spaces or
+//     hyphens, 9 to 13 digits in all, starting with "+" or "0".
+//   - Percent: "%" directly before or after the number, or "%" or a form
+//     of "faiz" after a single space.
+//   - Currency: a currency sign directly before the number, an ISO code
+//     and a space before it, or a sign, code, or currency word (in any case
+//     form) after it, directly or after a single space.
+//   - Ordinal: an ordinal suffix after the digits, directly or after a
+//     hyphen ("5ci", "3-cü").
+//   - Cardinal: any other number.
+//
+// Tokens are expected in the order WordTokens returns them, with Space
+// tokens kept; only tokens whose offsets touch are grouped, so the
+// invariant s[n.Start:n.End] == n.Text holds for every NumberToken.
+// Dates ("12.03.2024") and times come out as separate Cardinals.
+func ClassifyNumbers(tokens []Token) []NumberToken {
+	var out []NumberToken
+	free := 0 // first token not yet part of an expression
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].Type != Number {
+			continue
+		}
+		n, first, last := classifyNumber(tokens, i, free)
+		var b strings.Builder
+		for _, t := range tokens[first : last+1] {
+			b.WriteString(t.Text)
+		}
+		n.Token = Token{Text: b.String(), Start: tokens[first].Start, End: tokens[last].End, Type: Number}
+		out = append(out, n)
+		i = last
+		free = last + 1
+	}
+	return out
+}
+
+// classifyNumber classifies the Number token at i, using no token before
+// free. It returns the NumberToken without its Token, and the indices of
+// the first and last tokens of the expression.
+func classifyNumber(tokens []Token, i, free int) (NumberToken, int, int) {
+	num := tokens[i]
+	if first, last, value, ok := phoneLike(tokens, i, free); ok {
+		return NumberToken{Kind: PhoneLike, Value: value}, first, last
+	}
+
+	// Unit before the number: "%25", "$300", "AZN 25".
+	if i-1 >= free && touches(tokens, i) {
+		t := tokens[i-1]
+		switch {
+		case t.Type == Punctuation && t.Text == "%":
+			return NumberToken{Kind: Percent, Value: num.Text, Unit: t.Text}, i - 1, i
+		case t.Type == Symbol && currencySigns[t.Text]:
+			return NumberToken{Kind: Currency, Value: num.Text, Unit: t.Text}, i - 1, i
+		}
+	}
+	if i-2 >= free && isSingleSpace(tokens, i-1) && tokens[i-2].Type == Word && currencyCodes[tokens[i-2].Text] {
+		return NumberToken{Kind: Currency, Value: num.Text, Unit: tokens[i-2].Text}, i - 2, i
+	}
+
+	// Ordinal suffix: "5ci", "3-cü".
+	if isDigits(num.Text) {
+		j := i + 1
+		if j < len(tokens) && touches(tokens, j) && tokens[j].Type == Punctuation && tokens[j].Text == "-" {
+			j++
+		}
+		if j < len(tokens) && touches(tokens, j) && tokens[j].Type == Word && ordinalSuffixes[azcase.ToLower(tokens[j].Text)] {
+			return NumberToken{Kind: Ordinal, Value: num.Text}, i, j
+		}
+	}
+
+	// Unit after the number, directly or after a single space.
+	j := i + 1
+	if isSingleSpace(tokens, j) {
+		j++
+	}
+	if j < len(tokens) && touches(tokens, j) {
+		t := tokens[j]
+		switch {
+		case t.Type == Punctuation && t.Text == "%":
+			return NumberToken{Kind: Percent, Value: num.Text, Unit: t.Text}, i, j
+		case t.Type == Symbol && currencySigns[t.Text]:
+			return NumberToken{Kind: Currency, Value: num.Text, Unit: t.Text}, i, j
+		case t.Type == Word && currencyCodes[t.Text]:
+			return NumberToken{Kind: Currency, Value: num.Text, Unit: t.Text}, i, j
+		case t.Type == Word && strings.HasPrefix(azcase.ToLower(t.Text), percentWord):
+			return NumberToken{Kind: Percent, Value: num.Text, Unit: t.Text}, i, j
+		case t.Type == Word && isCurrencyWord(t.Text):
+			return NumberToken{Kind: Currency, Value: num.Text, Unit: t.Text}, i, j
+		}
+	}
+
+	return NumberToken{Kind: Cardinal, Value: num.Text}, i, i
+}
+
+// phoneLike reports whether the Number token at i starts a phone-like run
+// of digit groups, optionally after a "+" at or after free. It returns the
+// indices of the first and last tokens and the digits of the run.
+func phoneLike(tokens []Token, i, free int) (first, last int, value string, ok bool) {
+	if !isDigits(tokens[i].Text) {
+		return 0, 0, "", false
+	}
+	first = i
+	plus := i-1 >= free && touches(tokens, i) && tokens[i-1].Type == Symbol && tokens[i-1].Text == "+"
+	if plus {
+		first = i - 1
+	}
+	if !plus && tokens[i].Text[0] != '0' {
+		return 0, 0, "", false
+	}
+
+	digits := tokens[i].Text
+	groups := 1
+	last = i
+	for last+2 < len(tokens) && touches(tokens, last+1) && touches(tokens, last+2) {
+		sep, next := tokens[last+1], tokens[last+2]
+		if !(sep.Type == Space && sep.Text == " " || sep.Type == Punctuation && sep.Text == "-") {
+			break
+		}
+		if next.Type != Number || !isDigits(next.Text) || len(next.Text) > maxPhoneGroup {
+			break
+		}
+		digits += next.Text
+		groups++
+		last += 2
+	}
+	if groups < minPhoneGroups || len(digits) < minPhoneDigits || len(digits) > maxPhoneDigits {
+		return 0, 0, "", false
+	}
+	if plus {
+		digits = "+" + digits
+	}
+	return first, last, digits, true
+}
+
+// touches reports whether tokens[j] starts where tokens[j-1] ends, so that
+// the two can be grouped into one expression.
+func touches(tokens []Token, j int) bool {
+	return j > 0 && j < len(tokens) && tokens[j-1].End == tokens[j].Start
+}
+
+// isSingleSpace reports whether tokens[j] is a single ASCII space that
+// touches its neighbours.
+func isSingleSpace(tokens []Token, j int) bool {
+	return j < len(tokens) && tokens[j].Type == Space && tokens[j].Text == " " &&
+		touches(tokens, j) && touches(tokens, j+1)
+}
+
+// isCurrencyWord reports whether w is a form of a currency name.
+func isCurrencyWord(w string) bool {
+	w = azcase.ToLower(w)
+	for _, c := range currencyWords {
+		if strings.HasPrefix(w, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := range len(s) {
+		if !isDigitByte(s[i]) {
+			return false
+		}
+	}
+	return true
+}
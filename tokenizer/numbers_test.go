@@ -0,0 +1,163 @@
+package tokenizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNumberTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		kind  NumberKind
+		text  string
+		value string
+		unit  string
+	}{
+		{"cardinal", "42 kitab", Cardinal, "42", "42", ""},
+		{"grouped cardinal", "1.500 nəfər", Cardinal, "1.500", "1.500", ""},
+		{"decimal cardinal", "3,14", Cardinal, "3,14", "3,14", ""},
+
+		{"percent after", "25% artdı", Percent, "25%", "25", "%"},
+		{"percent after space", "1,5 % artım", Percent, "1,5 %", "1,5", "%"},
+		{"percent before", "%30 endirim", Percent, "%30", "30", "%"},
+		{"percent word", "15 faiz", Percent, "15 faiz", "15", "faiz"},
+		{"percent word inflected", "20 faizə qədər", Percent, "20 faizə", "20", "faizə"},
+		{"percent with suffix", "3,5%-dək", Percent, "3,5%", "3,5", "%"},
+
+		{"currency code after", "1.500 AZN", Currency, "1.500 AZN", "1.500", "AZN"},
+		{"currency code before", "AZN 25", Currency, "AZN 25", "25", "AZN"},
+		{"currency sign before", "$300", Currency, "$300", "300", "$"},
+		{"currency sign after", "10 ₼", Currency, "10 ₼", "10", "₼"},
+		{"currency word", "100 manat", Currency, "100 manat", "100", "manat"},
+		{"currency word inflected", "500 dollara", Currency, "500 dollara", "500", "dollara"},
+		{"lowercase code is not a currency", "25 azn", Cardinal, "25", "25", ""},
+
+		{"ordinal hyphen", "3-cü yer", Ordinal, "3-cü", "3", ""},
+		{"ordinal no hyphen", "5ci sinif", Ordinal, "5ci", "5", ""},
+		{"ordinal long suffix", "3-üncü", Ordinal, "3-üncü", "3", ""},
+		{"ordinal year", "2026-cı il", Ordinal, "2026-cı", "2026", ""},
+		{"decimal is not ordinal", "1,5-ci", Cardinal, "1,5", "1,5", ""},
+
+		{"phone international", "+994 50 123 45 67", PhoneLike, "+994 50 123 45 67", "+994501234567", ""},
+		{"phone hyphens", "050-123-45-67", PhoneLike, "050-123-45-67", "0501234567", ""},
+		{"phone too short", "050 12 34", Cardinal, "050", "050", ""},
+		{"digit groups without prefix", "123 456 789 012", Cardinal, "123", "123", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NumberTokens(tt.input)
+			if len(got) == 0 {
+				t.Fatalf("NumberTokens(%q) = nil", tt.input)
+			}
+			n := got[0]
+			if n.Kind != tt.kind || n.Text != tt.text || n.Value != tt.value || n.Unit != tt.unit {
+				t.Errorf("NumberTokens(%q)[0] = %v value=%q unit=%q, want %s(%q) value=%q unit=%q",
+					tt.input, n, n.Value, n.Unit, tt.kind, tt.text, tt.value, tt.unit)
+			}
+			if n.Type != Number {
+				t.Errorf("Type = %v, want Number", n.Type)
+			}
+			for _, n := range got {
+				if tt.input[n.Start:n.End] != n.Text {
+					t.Errorf("input[%d:%d] = %q, Text = %q", n.Start, n.End, tt.input[n.Start:n.End], n.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestNumberTokensSequence(t *testing.T) {
+	input := "Qiymət 12.03.2024 tarixindən 5% artaraq 1.575 AZN oldu, 2-ci dəfə."
+	var got []string
+	for _, n := range NumberTokens(input) {
+		got = append(got, n.String())
+	}
+	want := []string{
+		`Cardinal("12")[8:10]`,
+		`Cardinal("03")[11:13]`,
+		`Cardinal("2024")[14:18]`,
+		`Percent("5%")[31:33]`,
+		`Currency("1.575 AZN")[42:51]`,
+		`Ordinal("2-ci")[58:62]`,
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("NumberTokens(%q)\n got %v\nwant %v", input, got, want)
+	}
+}
+
+func TestNumberTokensUnitNotShared(t *testing.T) {
+	// The "%" after 25 belongs to 25, not to 30 after it.
+	got := NumberTokens("25%30")
+	if len(got) != 2 || got[0].Kind != Percent || got[1].Kind != Cardinal || got[1].Text != "30" {
+		t.Errorf("NumberTokens(25%%30) = %v", got)
+	}
+}
+
+func TestClassifyNumbersFilteredTokens(t *testing.T) {
+	// Without Space tokens the words after a space no longer touch the
+	// number, so only directly attached units are grouped.
+	tokens := Filter(WordTokens("25% və 100 manat"), DropSpaces)
+	got := ClassifyNumbers(tokens)
+	if len(got) != 2 || got[0].Kind != Percent || got[1].Kind != Cardinal {
+		t.Errorf("ClassifyNumbers(no spaces) = %v", got)
+	}
+}
+
+func TestNumberTokensEmpty(t *testing.T) {
+	if got := NumberTokens(""); got != nil {
+		t.Errorf("NumberTokens(\"\") = %v, want nil", got)
+	}
+	if got := NumberTokens("rəqəm yoxdur"); got != nil {
+		t.Errorf("NumberTokens(no digits) = %v, want nil", got)
+	}
+}
+
+func TestNumberKindJSON(t *testing.T) {
+	for _, k := range []NumberKind{Cardinal, Ordinal, Percent, Currency, PhoneLike} {
+		data, err := json.Marshal(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got NumberKind
+		if err := json.Unmarshal(data, &got); err != nil || got != k {
+			t.Errorf("round trip of %v = %v, %v", k, got, err)
+		}
+	}
+	var k NumberKind
+	if err := json.Unmarshal([]byte(`"Fraction"`), &k); err == nil {
+		t.Error("Unmarshal(Fraction) succeeded")
+	}
+	if got := NumberKind(9).String(); got != "NumberKind(9)" {
+		t.Errorf("NumberKind(9).String() = %q", got)
+	}
+
+	data, err := json.Marshal(NumberTokens("1.500 AZN"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"text":"1.500 AZN","start":0,"end":9,"type":"Number","kind":"Currency","value":"1.500","unit":"AZN"}]`
+	if string(data) != want {
+		t.Errorf("JSON = %s\nwant %s", data, want)
+	}
+}
+
+func BenchmarkNumberTokens(b *testing.B) {
+	text := strings.Repeat("Qiymət 5% artaraq 1.575 AZN oldu, 2-ci dəfə +994 50 123 45 67 nömrəsinə zəng edin. ", 100)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		NumberTokens(text)
+	}
+}
+
+func ExampleNumberTokens() {
+	for _, n := range NumberTokens("Qiymət 5% artaraq 1.575 AZN oldu, 3-cü dəfə.") {
+		fmt.Println(n.Kind, n.Text, n.Value, n.Unit)
+	}
+	// Output:
+	// Percent 5% 5 %
+	// Currency 1.575 AZN 1.575 AZN
+	// Ordinal 3-cü 3
+}
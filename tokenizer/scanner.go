@@ -6,26 +6,37 @@ import (
 	"unicode/utf8"
 )
 
+// wordConfig selects the optional rules of wordTokens. The zero value is
+// the rule set of WordTokens.
+type wordConfig struct {
+	social      bool     // hashtag, mention, and emoji rules of SocialTokens
+	noURLs      bool     // disable URL detection
+	noEmails    bool     // disable Email detection
+	bareDomains bool     // detect URLs without a scheme
+	schemes     []string // extra URL schemes, lowercase without ':'
+}
+
 // wordTokens splits s into tokens using a rune-by-rune state machine.
 // The caller guarantees s is non-empty.
 //
 // Rule priority (highest first):
-//   - URL detection (http:// or https://)
+//   - URL detection (http://, https://, extra schemes, bare domains)
 //   - Email detection (backtrack from @)
+//   - Hashtag, mention, and emoji detection (social only)
 //   - Number grouping (dot as thousand separator, comma as decimal)
 //   - Hyphen joining (single U+002D between letter/digit)
 //   - Apostrophe joining (U+0027, U+2019, U+02BC between letters)
 //   - Default unicode classification
-func wordTokens(s string) []Token {
+func wordTokens(s string, cfg wordConfig) []Token {
 	tokens := make([]Token, 0, len(s)/4+1)
 
 	i := 0
 	for i < len(s) {
 		r, size := utf8.DecodeRuneInString(s[i:])
 
-		// Rule 1: URL detection — check for http:// or https://
-		if (r == 'h' || r == 'H') && i+7 <= len(s) {
-			if end, ok := scanURL(s, i); ok {
+		// Rule 1: URL detection — http://, https://, extra schemes, bare domains
+		if !cfg.noURLs && r < utf8.RuneSelf {
+			if end, ok := cfg.scanURL(s, i); ok {
 				tokens = append(tokens, Token{Text: s[i:end], Start: i, End: end, Type: URL})
 				i = end
 				continue
@@ -33,7 +44,7 @@ func wordTokens(s string) []Token {
 		}
 
 		// Rule 2: Email detection — when we see @, backtrack for local part
-		if r == '@' {
+		if r == '@' && !cfg.noEmails {
 			if start, end, ok := scanEmail(s, i); ok {
 				// If we already emitted tokens that overlap the local part, replace them.
 				tokens = trimTokensForEmail(tokens, start)
@@ -43,6 +54,15 @@ func wordTokens(s string) []Token {
 			}
 		}
 
+		// Rule 3: social media tokens
+		if cfg.social {
+			if tok, ok := scanSocial(s, i, r); ok {
+				tokens = append(tokens, tok)
+				i = tok.End
+				continue
+			}
+		}
+
 		// Whitespace: merge contiguous into one Space token
 		if unicode.IsSpace(r) {
 			start := i
@@ -103,34 +123,34 @@ func wordTokens(s string) []Token {
 	return tokens
 }
 
-// scanURL checks if s[pos:] starts with http:// or https:// and consumes
-// until whitespace or end of string. Strips a single trailing punctuation
-// mark (. , ! ?) from the URL text.
-func scanURL(s string, pos int) (end int, ok bool) {
-	rest := s[pos:]
-	prefix := ""
+// httpPrefixLen returns the length of the http:// or https:// prefix of
+// rest, or 0 if rest has neither.
+func httpPrefixLen(rest string) int {
 	if len(rest) >= 8 && (rest[0] == 'h' || rest[0] == 'H') &&
 		(rest[1] == 't' || rest[1] == 'T') &&
 		(rest[2] == 't' || rest[2] == 'T') &&
 		(rest[3] == 'p' || rest[3] == 'P') {
 		if (rest[4] == 's' || rest[4] == 'S') && rest[5] == ':' && rest[6] == '/' && rest[7] == '/' {
-			prefix = "https://"
+			return len("https://")
 		} else if rest[4] == ':' && rest[5] == '/' && rest[6] == '/' {
-			prefix = "http://"
+			return len("http://")
 		}
 	}
-	if prefix == "" {
-		return 0, false
-	}
+	return 0
+}
 
+// scanURLBody consumes a URL whose body starts at bodyStart until
+// whitespace or end of string. Strips a single trailing punctuation mark
+// (. , ! ?) from the URL text. The URL must have content after bodyStart.
+func scanURLBody(s string, bodyStart int) (end int, ok bool) {
 	// Must have at least one character after the protocol
-	if len(rest) <= len(prefix) {
+	if bodyStart >= len(s) {
 		return 0, false
 	}
 
 	// Consume until whitespace or end
-	end = pos + len(rest)
-	for j := pos + len(prefix); j < len(s); {
+	end = len(s)
+	for j := bodyStart; j < len(s); {
 		r, size := utf8.DecodeRuneInString(s[j:])
 		if unicode.IsSpace(r) {
 			end = j
@@ -140,15 +160,15 @@ func scanURL(s string, pos int) (end int, ok bool) {
 	}
 
 	// Strip a single trailing punctuation: . , ! ?
-	if end > pos+len(prefix) {
-		last, lastSize := utf8.DecodeLastRuneInString(s[pos:end])
+	if end > bodyStart {
+		last, lastSize := utf8.DecodeLastRuneInString(s[bodyStart:end])
 		if last == '.' || last == ',' || last == '!' || last == '?' {
 			end -= lastSize
 		}
 	}
 
 	// Validate: URL must have content after protocol
-	if end <= pos+len(prefix) {
+	if end <= bodyStart {
 		return 0, false
 	}
 
@@ -19,10 +19,11 @@ var abbreviations = map[string]bool{
 	"km.": true, "kq.": true, "sm.": true, "min.": true,
 }
 
-// sentenceTokens splits s into sentence-level tokens.
+// sentenceTokens splits s into sentence-level tokens, treating the
+// built-in abbreviations and those in extra (same form) as non-terminal.
 // Adjacent tokens cover the entire input without gaps or overlaps:
 // concatenating all Token.Text values reconstructs s exactly.
-func sentenceTokens(s string) []Token {
+func sentenceTokens(s string, extra map[string]bool) []Token {
 	tokens := make([]Token, 0, len(s)/40+1)
 	sentStart := 0 // byte offset where the current sentence begins
 
@@ -73,7 +74,7 @@ func sentenceTokens(s string) []Token {
 
 			// Single dot: check for abbreviation.
 			if r == '.' {
-				if isAbbreviation(s, i) {
+				if isAbbreviation(s, i, extra) {
 					i += size
 					continue
 				}
@@ -155,7 +156,8 @@ func followedByWhitespaceUppercase(s string, pos int) bool {
 // isAbbreviation checks whether the dot at byte position dotPos is part of
 // a known abbreviation rather than a sentence-ending period.
 // It also handles the special multi-word abbreviation "və s." pattern.
-func isAbbreviation(s string, dotPos int) bool {
+// extra holds abbreviations registered in addition to the built-in list.
+func isAbbreviation(s string, dotPos int, extra map[string]bool) bool {
 	// Extract the word immediately before the dot.
 	word, wordStart := wordBefore(s, dotPos)
 	if word == "" {
@@ -174,21 +176,21 @@ func isAbbreviation(s string, dotPos int) bool {
 		}
 	}
 
-	if !abbreviations[candidate] {
+	if !abbreviations[candidate] && !extra[candidate] {
 		return false
 	}
 
 	// Greedy forward matching: check if the abbreviation extends further.
 	// For example, after matching "az.", check if what follows forms "az.r.".
 	afterDot := dotPos + 1
-	return greedyAbbreviation(s, candidate, afterDot)
+	return greedyAbbreviation(s, candidate, afterDot, extra)
 }
 
 // greedyAbbreviation tries to extend a matched abbreviation prefix forward.
 // It returns true once no further extension is possible, confirming the abbreviation.
 // For example: prefix="az.", pos points to text after the dot.
 // If next chars are "r.", it checks "az.r." — if that is also an abbreviation, recurse.
-func greedyAbbreviation(s, prefix string, pos int) bool {
+func greedyAbbreviation(s, prefix string, pos int, extra map[string]bool) bool {
 	// Try to read the next word and dot to extend the abbreviation.
 	// The next segment must be: word + "." immediately adjacent (no whitespace).
 	if pos >= len(s) {
@@ -214,9 +216,9 @@ func greedyAbbreviation(s, prefix string, pos int) bool {
 	nextWord := azcase.ToLower(s[pos:j])
 	extended := prefix + nextWord + "."
 
-	if abbreviations[extended] {
+	if abbreviations[extended] || extra[extended] {
 		// The extended form is also an abbreviation; recurse past its dot.
-		return greedyAbbreviation(s, extended, j+1)
+		return greedyAbbreviation(s, extended, j+1, extra)
 	}
 
 	return true // extension not recognized, current match stands
@@ -0,0 +1,145 @@
+package tokenizer
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Emoji sequence components.
+const (
+	zeroWidthJoiner = '\u200D'
+	keycapMark      = '\u20E3'
+)
+
+// scanSocial tries the hashtag, mention, and emoji rules at pos, where r
+// is the rune at pos.
+func scanSocial(s string, pos int, r rune) (Token, bool) {
+	var (
+		end int
+		typ TokenType
+	)
+	switch {
+	case r == '#' || r == '@':
+		if pos > 0 {
+			pr, _ := utf8.DecodeLastRuneInString(s[:pos])
+			if unicode.IsLetter(pr) || unicode.IsDigit(pr) || pr == '_' {
+				return Token{}, false
+			}
+		}
+		typ = Hashtag
+		if r == '@' {
+			typ = Mention
+		}
+		end = scanHandle(s, pos+1, r == '@')
+		if end == pos+1 || (typ == Hashtag && !containsLetter(s[pos+1:end])) {
+			return Token{}, false
+		}
+	case isEmoji(r):
+		typ = Emoji
+		end = scanEmoji(s, pos)
+	default:
+		return Token{}, false
+	}
+	return Token{Text: s[pos:end], Start: pos, End: end, Type: typ}, true
+}
+
+// scanHandle consumes the body of a hashtag or mention starting at pos:
+// letters, digits, and underscores, plus single internal dots for
+// mentions (@az.ai). Returns the end offset.
+func scanHandle(s string, pos int, dots bool) int {
+	i := pos
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || unicode.Is(unicode.Mn, r):
+			i += size
+		case r == '.' && dots && i > pos && i+1 < len(s):
+			nr, _ := utf8.DecodeRuneInString(s[i+1:])
+			if !unicode.IsLetter(nr) && !unicode.IsDigit(nr) && nr != '_' {
+				return i
+			}
+			i += size
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// containsLetter reports whether s contains a letter.
+func containsLetter(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// scanEmoji consumes an emoji sequence starting at pos: the base emoji,
+// then any modifiers, variation selectors, keycap marks, and tag
+// characters, ZWJ-joined emoji, or a second regional indicator (flags).
+func scanEmoji(s string, pos int) int {
+	r, size := utf8.DecodeRuneInString(s[pos:])
+	i := pos + size
+	if isRegionalIndicator(r) {
+		if nr, ns := utf8.DecodeRuneInString(s[i:]); isRegionalIndicator(nr) {
+			return i + ns
+		}
+		return i
+	}
+	for i < len(s) {
+		nr, ns := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case isEmojiModifier(nr):
+			i += ns
+		case nr == zeroWidthJoiner:
+			jr, js := utf8.DecodeRuneInString(s[i+ns:])
+			if !isEmoji(jr) {
+				return i
+			}
+			i += ns + js
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// isEmoji reports whether r starts an emoji: pictographs, dingbats,
+// miscellaneous symbols, and regional indicators.
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // pictographs, emoticons, transport, flags
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // miscellaneous symbols, dingbats
+		return true
+	case r >= 0x2300 && r <= 0x23FF: // ⌚ ⌛ ⏰ and other technical symbols
+		return true
+	case r == 0x2B50 || r == 0x2B55 || r == 0x2B1B || r == 0x2B1C: // ⭐ ⭕ ⬛ ⬜
+		return true
+	}
+	return false
+}
+
+// isRegionalIndicator reports whether r is a regional indicator symbol;
+// pairs of them form country flags.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isEmojiModifier reports whether r extends the preceding emoji: skin-tone
+// modifiers, variation selectors, the keycap mark, and tag characters.
+func isEmojiModifier(r rune) bool {
+	switch {
+	case r >= 0x1F3FB && r <= 0x1F3FF: // skin tones
+		return true
+	case r == 0xFE0E || r == 0xFE0F: // variation selectors
+		return true
+	case r == keycapMark:
+		return true
+	case r >= 0xE0020 && r <= 0xE007F: // tags (subdivision flags)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package tokenizer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSocialTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Token
+	}{
+		{"hashtag", "#Bakı2026", []Token{
+			{Text: "#Bakı2026", Start: 0, End: 10, Type: Hashtag},
+		}},
+		{"hashtag with underscore", "#az_dili!", []Token{
+			{Text: "#az_dili", Start: 0, End: 8, Type: Hashtag},
+			{Text: "!", Start: 8, End: 9, Type: Punctuation},
+		}},
+		{"digit-only hashtag", "#1", []Token{
+			{Text: "#", Start: 0, End: 1, Type: Punctuation},
+			{Text: "1", Start: 1, End: 2, Type: Number},
+		}},
+		{"hash after letter", "C#", []Token{
+			{Text: "C", Start: 0, End: 1, Type: Word},
+			{Text: "#", Start: 1, End: 2, Type: Punctuation},
+		}},
+		{"mention", "@user salam", []Token{
+			{Text: "@user", Start: 0, End: 5, Type: Mention},
+			{Text: " ", Start: 5, End: 6, Type: Space},
+			{Text: "salam", Start: 6, End: 11, Type: Word},
+		}},
+		{"mention with dot", "@az.ai.", []Token{
+			{Text: "@az.ai", Start: 0, End: 6, Type: Mention},
+			{Text: ".", Start: 6, End: 7, Type: Punctuation},
+		}},
+		{"email stays email", "user@mail.az", []Token{
+			{Text: "user@mail.az", Start: 0, End: 12, Type: Email},
+		}},
+		{"bare at sign", "@ ", []Token{
+			{Text: "@", Start: 0, End: 1, Type: Punctuation},
+			{Text: " ", Start: 1, End: 2, Type: Space},
+		}},
+		{"URL fragment stays URL", "https://gov.az/#top", []Token{
+			{Text: "https://gov.az/#top", Start: 0, End: 19, Type: URL},
+		}},
+		{"single emoji", "əla😀", []Token{
+			{Text: "əla", Start: 0, End: 4, Type: Word},
+			{Text: "😀", Start: 4, End: 8, Type: Emoji},
+		}},
+		{"adjacent emoji", "😀😀", []Token{
+			{Text: "😀", Start: 0, End: 4, Type: Emoji},
+			{Text: "😀", Start: 4, End: 8, Type: Emoji},
+		}},
+		{"skin tone", "👍🏽", []Token{
+			{Text: "👍🏽", Start: 0, End: 8, Type: Emoji},
+		}},
+		{"variation selector", "❤️", []Token{
+			{Text: "❤️", Start: 0, End: 6, Type: Emoji},
+		}},
+		{"ZWJ family", "👨‍👩‍👧", []Token{
+			{Text: "👨‍👩‍👧", Start: 0, End: 18, Type: Emoji},
+		}},
+		{"flag", "🇦🇿🇹🇷", []Token{
+			{Text: "🇦🇿", Start: 0, End: 8, Type: Emoji},
+			{Text: "🇹🇷", Start: 8, End: 16, Type: Emoji},
+		}},
+		{"trailing ZWJ", "😀‍", []Token{
+			{Text: "😀", Start: 0, End: 4, Type: Emoji},
+			{Text: "‍", Start: 4, End: 7, Type: Symbol},
+		}},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := SocialTokens(tt.input)
+			verifyInvariants(t, tt.input, got)
+			if len(got) != len(tt.want) {
+				t.Fatalf("SocialTokens(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("token %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSocialTokensMatchWordTokens(t *testing.T) {
+	// Without social markup, SocialTokens and WordTokens agree.
+	input := "Prof. Əliyev 1.000 manat ödədi, user@mail.az yazdı: https://gov.az"
+	got, want := SocialTokens(input), WordTokens(input)
+	if len(got) != len(want) {
+		t.Fatalf("SocialTokens = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("token %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSocialTokenTypeJSON(t *testing.T) {
+	for _, tt := range []TokenType{Hashtag, Mention, Emoji} {
+		data, err := tt.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%v.MarshalJSON: %v", tt, err)
+		}
+		var got TokenType
+		if err := got.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+		}
+		if got != tt {
+			t.Errorf("round trip %v = %v", tt, got)
+		}
+	}
+}
+
+func BenchmarkSocialTokens(b *testing.B) {
+	input := strings.Repeat("@az_nlp Bakı çox gözəldir 😍🇦🇿 #Bakı #səyahət ", 1000)
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		SocialTokens(input)
+	}
+}
+
+func ExampleSocialTokens() {
+	for _, t := range SocialTokens("@aysel Bakı 😍 #səyahət") {
+		if t.Type != Space {
+			fmt.Printf("%s: %q\n", t.Type, t.Text)
+		}
+	}
+	// Output:
+	// Mention: "@aysel"
+	// Word: "Bakı"
+	// Emoji: "😍"
+	// Hashtag: "#səyahət"
+}
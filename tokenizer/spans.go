@@ -0,0 +1,225 @@
+package tokenizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SpanKind classifies a structural span.
+type SpanKind int
+
+const (
+	Quote         SpanKind = iota // Text between quotation marks: « », “ ”, „ “, " ", ' '
+	Parenthetical                 // Text between ( ) or [ ]
+)
+
+// spanKindNames maps SpanKind values to their string names.
+var spanKindNames = [...]string{
+	Quote:         "Quote",
+	Parenthetical: "Parenthetical",
+}
+
+// spanKindFromName maps string names back to SpanKind values.
+var spanKindFromName = map[string]SpanKind{
+	"Quote":         Quote,
+	"Parenthetical": Parenthetical,
+}
+
+// String returns the name of the span kind.
+func (k SpanKind) String() string {
+	if int(k) >= 0 && int(k) < len(spanKindNames) {
+		return spanKindNames[k]
+	}
+	return fmt.Sprintf("SpanKind(%d)", int(k))
+}
+
+// MarshalJSON encodes the span kind as a JSON string (e.g. "Quote").
+func (k SpanKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Quote") into a SpanKind.
+func (k *SpanKind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	sk, ok := spanKindFromName[s]
+	if !ok {
+		return fmt.Errorf("unknown span kind: %q", s)
+	}
+	*k = sk
+	return nil
+}
+
+// Span is a quoted span or parenthetical with the tokens it encloses.
+// The invariant s[sp.Start:sp.End] == sp.Text holds, and Text begins with
+// Open and ends with Close.
+type Span struct {
+	Kind   SpanKind `json:"kind"`   // Quote or Parenthetical
+	Text   string   `json:"text"`   // The span text, delimiters included
+	Start  int      `json:"start"`  // Byte offset of the opening delimiter (inclusive)
+	End    int      `json:"end"`    // Byte offset after the closing delimiter (exclusive)
+	Open   string   `json:"open"`   // The opening delimiter, e.g. "«"
+	Close  string   `json:"close"`  // The closing delimiter, e.g. "»"
+	Depth  int      `json:"depth"`  // Number of spans enclosing this one; 0 at the top level
+	Tokens []Token  `json:"tokens"` // WordTokens between the delimiters
+}
+
+// Content returns the span text without its delimiters.
+func (sp Span) Content() string {
+	return sp.Text[len(sp.Open) : len(sp.Text)-len(sp.Close)]
+}
+
+// String returns a debug representation, e.g. Quote("«salam»")[2:11].
+func (sp Span) String() string {
+	return fmt.Sprintf("%s(%q)[%d:%d]", sp.Kind, sp.Text, sp.Start, sp.End)
+}
+
+// spanClosers maps each opening delimiter to the delimiters that close it.
+// "“" both closes „ (Azerbaijani and Russian low-high quotes) and opens
+// English-style “ ” quotes; closing is tried first.
+var spanClosers = map[string][]string{
+	"«":  {"»"},
+	"“":  {"”"},
+	"„":  {"“", "”"},
+	"‘":  {"’"},
+	"\"": {"\""},
+	"'":  {"'"},
+	"(":  {")"},
+	"[":  {"]"},
+}
+
+// openSpan is a delimiter waiting for its closer.
+type openSpan struct {
+	delim string
+	start int // byte offset of the delimiter
+	tok   int // index of the delimiter token
+}
+
+// Spans returns the quoted spans and parentheticals of s, ordered by Start
+// with enclosing spans before the spans they contain. Delimiters are
+// matched on WordTokens output, so apostrophes inside words ("Bakı'nın")
+// never open a quote. Straight quotes " and ' open only before a non-space
+// token and close only after one, and ' does not open right after a word or
+// number ("5'li"). A closer without a matching opener is ignored, an
+// opener without a closer is dropped, and a blank line ("\n\n") drops all
+// openers, so an unbalanced quote does not swallow the rest of the text.
+func Spans(s string) []Span {
+	tokens := WordTokens(s)
+	var stack []openSpan
+	var spans []Span
+
+	for i, t := range tokens {
+		if t.Type == Space {
+			if strings.Count(t.Text, "\n") >= 2 {
+				stack = stack[:0]
+			}
+			continue
+		}
+		if t.Type != Punctuation && t.Type != Symbol {
+			continue
+		}
+		if j := matchingOpener(stack, t.Text, tokens, i); j >= 0 {
+			o := stack[j]
+			stack = stack[:j]
+			spans = append(spans, Span{
+				Kind:   spanKind(o.delim),
+				Text:   s[o.start:t.End],
+				Start:  o.start,
+				End:    t.End,
+				Open:   o.delim,
+				Close:  t.Text,
+				Tokens: tokens[o.tok+1 : i : i],
+			})
+			continue
+		}
+		if opensSpan(t.Text, tokens, i) {
+			stack = append(stack, openSpan{delim: t.Text, start: t.Start, tok: i})
+		}
+	}
+
+	sort.Slice(spans, func(a, b int) bool {
+		if spans[a].Start != spans[b].Start {
+			return spans[a].Start < spans[b].Start
+		}
+		return spans[a].End > spans[b].End
+	})
+	setDepths(spans)
+	return spans
+}
+
+// EnclosingSpan returns the innermost span whose content contains the byte
+// range [start, end), e.g. the offsets of a token or an entity. Delimiters
+// are not part of the content, so the closing » of a quote is not inside it.
+func EnclosingSpan(spans []Span, start, end int) (Span, bool) {
+	var best Span
+	found := false
+	for _, sp := range spans {
+		if sp.Start >= end {
+			break
+		}
+		if start >= sp.Start+len(sp.Open) && end <= sp.End-len(sp.Close) {
+			if !found || sp.Depth > best.Depth {
+				best, found = sp, true
+			}
+		}
+	}
+	return best, found
+}
+
+// matchingOpener returns the stack index of the opener that delim at
+// tokens[i] closes, or -1. Openers above the match are left unclosed.
+func matchingOpener(stack []openSpan, delim string, tokens []Token, i int) int {
+	if (delim == "\"" || delim == "'") && (i == 0 || tokens[i-1].Type == Space) {
+		return -1
+	}
+	for j := len(stack) - 1; j >= 0; j-- {
+		for _, c := range spanClosers[stack[j].delim] {
+			if c == delim {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+// opensSpan reports whether delim at tokens[i] opens a span.
+func opensSpan(delim string, tokens []Token, i int) bool {
+	if _, ok := spanClosers[delim]; !ok {
+		return false
+	}
+	if delim != "\"" && delim != "'" {
+		return true
+	}
+	if i+1 >= len(tokens) || tokens[i+1].Type == Space {
+		return false
+	}
+	if delim == "'" && i > 0 && (tokens[i-1].Type == Word || tokens[i-1].Type == Number) {
+		return false
+	}
+	return true
+}
+
+// spanKind returns the kind of span opened by delim.
+func spanKind(delim string) SpanKind {
+	if delim == "(" || delim == "[" {
+		return Parenthetical
+	}
+	return Quote
+}
+
+// setDepths sets Depth on spans sorted by Start with enclosing spans first.
+// Matched spans never partially overlap, so a stack of open ends suffices.
+func setDepths(spans []Span) {
+	var ends []int
+	for i := range spans {
+		for len(ends) > 0 && ends[len(ends)-1] <= spans[i].Start {
+			ends = ends[:len(ends)-1]
+		}
+		spans[i].Depth = len(ends)
+		ends = append(ends, spans[i].End)
+	}
+}
@@ -0,0 +1,150 @@
+package tokenizer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// spanStrings renders spans as "Kind(text)/depth" for compact comparison.
+func spanStrings(spans []Span) []string {
+	out := make([]string, len(spans))
+	for i, sp := range spans {
+		out[i] = fmt.Sprintf("%s(%s)/%d", sp.Kind, sp.Text, sp.Depth)
+	}
+	return out
+}
+
+func TestSpans(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"empty", "", nil},
+		{"no delimiters", "Bakı gözəl şəhərdir.", nil},
+		{"guillemets", "O dedi: «Sabah gələcəyəm.»", []string{"Quote(«Sabah gələcəyəm.»)/0"}},
+		{"straight double", `Kitabın adı "Əli və Nino" idi.`, []string{`Quote("Əli və Nino")/0`}},
+		{"curly double", "“Salam” dedi.", []string{"Quote(“Salam”)/0"}},
+		{"low-high quotes", "„Bəli“ cavabı", []string{"Quote(„Bəli“)/0"}},
+		{"single quotes", "Sözü 'bəli' idi.", []string{"Quote('bəli')/0"}},
+		{"parenthetical", "Nazirlik (MİA) bildirdi.", []string{"Parenthetical((MİA))/0"}},
+		{"brackets", "Mətn [redaktə] edildi.", []string{"Parenthetical([redaktə])/0"}},
+		{"nested", "O dedi: «Biz (hamımız) „razıyıq“».", []string{
+			"Quote(«Biz (hamımız) „razıyıq“»)/0",
+			"Parenthetical((hamımız))/1",
+			"Quote(„razıyıq“)/1",
+		}},
+		{"quote in parentheses", `(bax: "Qanun")`, []string{
+			`Parenthetical((bax: "Qanun"))/0`,
+			`Quote("Qanun")/1`,
+		}},
+		{"sequential", `"bir" və "iki"`, []string{`Quote("bir")/0`, `Quote("iki")/0`}},
+		{"apostrophe suffix", "Bakı'nın küçələri", nil},
+		{"apostrophe after number", "5'li sistem", nil},
+		{"apostrophe suffixes around quote", "Bakı'nın 'Qız qalası' Şuşa'dan", []string{"Quote('Qız qalası')/0"}},
+		{"unmatched closer", "1) birinci, 2) ikinci", nil},
+		{"unclosed opener", "O dedi: «gəlirəm", nil},
+		{"unclosed inner dropped", "(bir «iki)", []string{"Parenthetical((bir «iki))/0"}},
+		{"spaced straight quote", `a " b`, nil},
+		{"blank line resets", "«bir\n\niki»", nil},
+		{"single newline keeps", "«bir\niki»", []string{"Quote(«bir\niki»)/0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := spanStrings(Spans(tt.input))
+			if len(got) != len(tt.want) {
+				t.Fatalf("Spans(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("span %d = %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSpansOffsets(t *testing.T) {
+	input := "Müəllif yazır ki, «Şəhər (paytaxt) böyüyür» və s."
+	for _, sp := range Spans(input) {
+		if input[sp.Start:sp.End] != sp.Text {
+			t.Errorf("%v: input[%d:%d] = %q", sp, sp.Start, sp.End, input[sp.Start:sp.End])
+		}
+		if !strings.HasPrefix(sp.Text, sp.Open) || !strings.HasSuffix(sp.Text, sp.Close) {
+			t.Errorf("%v: delimiters %q %q", sp, sp.Open, sp.Close)
+		}
+		var b strings.Builder
+		for _, tok := range sp.Tokens {
+			b.WriteString(tok.Text)
+		}
+		if b.String() != sp.Content() {
+			t.Errorf("%v: tokens join to %q, want %q", sp, b.String(), sp.Content())
+		}
+	}
+}
+
+func TestEnclosingSpan(t *testing.T) {
+	input := "Nazir «Biz (hamımız) razıyıq» dedi."
+	spans := Spans(input)
+	tests := []struct {
+		word string
+		want string
+		ok   bool
+	}{
+		{"Nazir", "", false},
+		{"Biz", "«Biz (hamımız) razıyıq»", true},
+		{"hamımız", "(hamımız)", true},
+		{"razıyıq", "«Biz (hamımız) razıyıq»", true},
+		{"»", "", false},
+		{"dedi", "", false},
+	}
+	for _, tt := range tests {
+		start := strings.Index(input, tt.word)
+		got, ok := EnclosingSpan(spans, start, start+len(tt.word))
+		if ok != tt.ok || got.Text != tt.want {
+			t.Errorf("EnclosingSpan(%q) = %q, %v, want %q, %v", tt.word, got.Text, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestSpanKindJSON(t *testing.T) {
+	for _, k := range []SpanKind{Quote, Parenthetical} {
+		data, err := k.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%v.MarshalJSON: %v", k, err)
+		}
+		var got SpanKind
+		if err := got.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+		}
+		if got != k {
+			t.Errorf("round trip %v = %v", k, got)
+		}
+	}
+	var k SpanKind
+	if err := k.UnmarshalJSON([]byte(`"Aside"`)); err == nil {
+		t.Error("UnmarshalJSON(\"Aside\") = nil, want error")
+	}
+	if got := SpanKind(9).String(); got != "SpanKind(9)" {
+		t.Errorf("SpanKind(9).String() = %q", got)
+	}
+}
+
+func BenchmarkSpans(b *testing.B) {
+	input := strings.Repeat("Nazir «Biz (hamımız) razıyıq» dedi və \"Əli və Nino\" kitabını oxudu. ", 1000)
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		Spans(input)
+	}
+}
+
+func ExampleSpans() {
+	for _, sp := range Spans("Nazir bildirdi: «Layihə (2026) vaxtında bitəcək».") {
+		fmt.Printf("%s %d: %s\n", sp.Kind, sp.Depth, sp.Content())
+	}
+	// Output:
+	// Quote 0: Layihə (2026) vaxtında bitəcək
+	// Parenthetical 1: 2026
+}
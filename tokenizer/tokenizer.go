@@ -11,16 +11,47 @@
 //   - Convenience: Words and Sentences return []string for common use cases
 //     where offsets and types are not needed.
 //
+// SocialTokens extends WordTokens for social media text with Hashtag,
+// Mention, and Emoji tokens.
+//
+// WordTokensWithOptions and WordsWithOptions configure URL and Email
+// detection: extra URL schemes (ftp, tel:, mailto:), bare domains
+// ("mia.gov.az"), or no URL or Email tokens at all for pipelines that treat
+// them as plain words.
+//
+// SentenceTokensWithOptions and SentencesWithOptions extend the built-in
+// abbreviation list with domain profiles (ProfileLegal, ProfileMedical,
+// ProfileMilitary) and caller-supplied abbreviations, so that "500 mq." or
+// "gen." does not end a sentence.
+//
+// Filter runs tokens through composable TokenFilters: DropSpaces,
+// DropPunctuation, and KeepTypes remove tokens; LowercaseAz, MapText, and
+// MapStems (e.g. MapStems(morph.Stem)) rewrite token text while keeping
+// the offsets of the original span.
+//
+// NumberTokens and ClassifyNumbers group each Number token with the signs,
+// units, and suffixes around it and classify the expression as Cardinal,
+// Ordinal ("3-cü"), Percent ("25%"), Currency ("1.500 AZN"), or PhoneLike
+// ("+994 50 123 45 67"), so that callers need not re-parse number formats.
+//
+// Spans adds a structural layer over WordTokens: quoted spans (« », “ ”,
+// „ “, " ", ' ') and parentheticals (( ), [ ]) with byte offsets, nesting
+// depth, and the tokens they enclose, so that reported speech can be told
+// apart from the author's own text. EnclosingSpan finds the innermost span
+// around a token or entity.
+//
 // All functions are safe for concurrent use by multiple goroutines.
 //
 // Known limitations (v1.0):
 //
 //   - Sentence splitting does not track quote or parenthesis nesting.
 //     Terminal punctuation inside quotes may cause false sentence breaks.
-//   - Bare URLs without a protocol prefix (www.example.com) are not detected.
-//     Only http:// and https:// prefixed URLs are recognized.
+//   - Bare URLs without a protocol prefix (www.example.com) are not detected
+//     by default. Only http:// and https:// prefixed URLs are recognized
+//     unless WordOptions enables bare domains or extra schemes.
 //   - Single-letter abbreviations (m., s., d.) are not in the built-in list
-//     due to ambiguity with sentence-ending periods.
+//     due to ambiguity with sentence-ending periods. Callers who know their
+//     domain can add them through SentenceOptions.
 //   - Az.R. and similar multi-part abbreviations followed by an uppercase letter
 //     may cause a false sentence break, since the splitter sees period + uppercase.
 package tokenizer
@@ -46,6 +77,9 @@ const (
 	URL                          // http:// or https:// prefixed sequences
 	Email                        // user@domain.tld sequences
 	Sentence                     // Used only by SentenceTokens — a full sentence
+	Hashtag                      // Used only by SocialTokens — #tag
+	Mention                      // Used only by SocialTokens — @user
+	Emoji                        // Used only by SocialTokens — emoji, including ZWJ and flag sequences
 )
 
 // tokenTypeNames maps TokenType values to their string names.
@@ -58,6 +92,9 @@ var tokenTypeNames = [...]string{
 	URL:         "URL",
 	Email:       "Email",
 	Sentence:    "Sentence",
+	Hashtag:     "Hashtag",
+	Mention:     "Mention",
+	Emoji:       "Emoji",
 }
 
 // tokenTypeFromName maps string names back to TokenType values.
@@ -70,6 +107,9 @@ var tokenTypeFromName = map[string]TokenType{
 	"URL":         URL,
 	"Email":       Email,
 	"Sentence":    Sentence,
+	"Hashtag":     Hashtag,
+	"Mention":     Mention,
+	"Emoji":       Emoji,
 }
 
 // String returns the name of the token type.
@@ -120,7 +160,23 @@ func WordTokens(s string) []Token {
 	if s == "" {
 		return nil
 	}
-	return wordTokens(s)
+	return wordTokens(s, wordConfig{})
+}
+
+// SocialTokens splits social media text into tokens with metadata.
+// It behaves like WordTokens and additionally returns Hashtag tokens
+// (#Bakı2026, #az_dili), Mention tokens (@user, @az.ai), and Emoji tokens.
+// An emoji token holds a full emoji sequence: skin-tone modifiers,
+// variation selectors, ZWJ sequences (👨‍👩‍👧), and flags (🇦🇿) stay intact.
+//
+// A hashtag needs at least one letter and a mention at least one letter
+// or digit; neither may directly follow a letter or digit (C#, user@host).
+// The byte offset invariant and reconstruction property of WordTokens hold.
+func SocialTokens(s string) []Token {
+	if s == "" {
+		return nil
+	}
+	return wordTokens(s, wordConfig{social: true})
 }
 
 // Words returns only Word-type token texts from the text.
@@ -130,7 +186,11 @@ func Words(s string) []string {
 	if s == "" {
 		return nil
 	}
-	tokens := wordTokens(s)
+	return wordTexts(wordTokens(s, wordConfig{}))
+}
+
+// wordTexts returns the texts of the Word tokens in tokens.
+func wordTexts(tokens []Token) []string {
 	words := make([]string, 0, len(tokens)/wordsPerTokenEstimate)
 	for _, t := range tokens {
 		if t.Type == Word {
@@ -149,7 +209,7 @@ func SentenceTokens(s string) []Token {
 	if s == "" {
 		return nil
 	}
-	return sentenceTokens(s)
+	return sentenceTokens(s, nil)
 }
 
 // Sentences returns sentence strings from the text.
@@ -157,7 +217,7 @@ func Sentences(s string) []string {
 	if s == "" {
 		return nil
 	}
-	tokens := sentenceTokens(s)
+	tokens := sentenceTokens(s, nil)
 	sentences := make([]string, len(tokens))
 	for i, t := range tokens {
 		sentences[i] = t.Text
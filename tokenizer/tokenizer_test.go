@@ -6,8 +6,6 @@ import (
 	"strings"
 	"sync"
 	"testing"
-
-	"github.com/az-ai-labs/az-lang-nlp/translit"
 )
 
 // verifyInvariants checks two invariants that must hold for every tokenization:
@@ -513,6 +511,9 @@ func TestTokenTypeString(t *testing.T) {
 		{URL, "URL"},
 		{Email, "Email"},
 		{Sentence, "Sentence"},
+		{Hashtag, "Hashtag"},
+		{Mention, "Mention"},
+		{Emoji, "Emoji"},
 		{TokenType(99), "TokenType(99)"},
 	}
 	for _, tt := range tests {
@@ -536,20 +537,6 @@ func TestTokenString(t *testing.T) {
 	}
 }
 
-// ---------------------------------------------------------------------------
-// Integration with translit
-// ---------------------------------------------------------------------------
-
-func TestTranslitIntegration(t *testing.T) {
-	input := translit.CyrillicToLatin("\u0411\u0430\u043a\u044b \u0448\u04d9\u04bb\u04d9\u0440\u0438 \u0433\u04e9\u0437\u04d9\u043b\u0434\u0438\u0440.")
-	tokens := WordTokens(input)
-	verifyInvariants(t, input, tokens)
-	words := Words(input)
-	if len(words) == 0 {
-		t.Error("expected words from transliterated text")
-	}
-}
-
 // ---------------------------------------------------------------------------
 // Benchmarks
 // ---------------------------------------------------------------------------
@@ -0,0 +1,29 @@
+// Integration with translit lives in an external test package: translit
+// imports tokenizer for CyrillicToLatinMixed.
+package tokenizer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+	"github.com/az-ai-labs/az-lang-nlp/translit"
+)
+
+func TestTranslitIntegration(t *testing.T) {
+	input := translit.CyrillicToLatin("Бакы шәһәри гөзәлдир.")
+	tokens := tokenizer.WordTokens(input)
+	var b strings.Builder
+	for i, tok := range tokens {
+		if got := input[tok.Start:tok.End]; got != tok.Text {
+			t.Errorf("token %d offset invariant broken: input[%d:%d]=%q, Text=%q", i, tok.Start, tok.End, got, tok.Text)
+		}
+		b.WriteString(tok.Text)
+	}
+	if b.String() != input {
+		t.Errorf("reconstruction invariant broken: got %q, want %q", b.String(), input)
+	}
+	if words := tokenizer.Words(input); len(words) == 0 {
+		t.Error("expected words from transliterated text")
+	}
+}
@@ -0,0 +1,169 @@
+package tokenizer
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// commonTLDs lists the top-level domains accepted in bare domains. A fixed
+// list keeps a missing space after a period ("gəldi.sonra", "getdi.bu") from
+// being read as a domain.
+var commonTLDs = map[string]bool{
+	"az": true, "com": true, "net": true, "org": true, "edu": true, "gov": true,
+	"mil": true, "int": true, "info": true, "biz": true, "io": true, "ai": true,
+	"co": true, "me": true, "tv": true, "app": true, "dev": true, "news": true,
+	"ru": true, "tr": true, "ge": true, "ir": true, "ua": true, "kz": true,
+	"uz": true, "by": true, "uk": true, "de": true, "fr": true, "us": true,
+	"eu": true,
+}
+
+// WordOptions configures URL and Email detection for
+// WordTokensWithOptions. The zero value detects http:// and https:// URLs
+// and emails, like WordTokens.
+//
+// Schemes are matched case-insensitively and may be given with or without
+// the trailing ":" or "://" ("ftp", "tel:", "mailto:" are all accepted).
+// A URL with a scheme needs at least one character after the colon, so
+// "tel: 012" is not a URL. Entries that are not valid scheme names are
+// ignored.
+type WordOptions struct {
+	Schemes     []string // additional URL schemes, e.g. "ftp", "tel", "mailto"
+	BareDomains bool     // detect URLs without a scheme ("mia.gov.az", "www.example.com/path")
+	NoURLs      bool     // disable URL detection; URLs split into words and punctuation
+	NoEmails    bool     // disable Email detection; emails split into words and punctuation
+}
+
+// config returns the wordTokens rules enabled by opts.
+func (opts WordOptions) config() wordConfig {
+	cfg := wordConfig{noURLs: opts.NoURLs, noEmails: opts.NoEmails, bareDomains: opts.BareDomains}
+	for _, scheme := range opts.Schemes {
+		scheme = strings.ToLower(strings.TrimSpace(scheme))
+		scheme = strings.TrimSuffix(strings.TrimSuffix(scheme, "//"), ":")
+		if isSchemeName(scheme) && !slices.Contains(cfg.schemes, scheme) {
+			cfg.schemes = append(cfg.schemes, scheme)
+		}
+	}
+	return cfg
+}
+
+// WordTokensWithOptions is like WordTokens but detects URLs and emails as
+// configured by opts. With BareDomains, a domain without a scheme is a URL
+// when its top-level domain is a common one written in lowercase (.az,
+// .com, .org, .gov, .ru, ...); a path or port may follow, and a case suffix
+// after a hyphen stays outside ("mia.gov.az-da" is URL "mia.gov.az").
+// The byte offset invariant and reconstruction property of WordTokens hold.
+func WordTokensWithOptions(s string, opts WordOptions) []Token {
+	if s == "" {
+		return nil
+	}
+	return wordTokens(s, opts.config())
+}
+
+// WordsWithOptions is like Words but applies opts as WordTokensWithOptions
+// does. With NoURLs or NoEmails, the words inside URLs or emails are
+// returned.
+func WordsWithOptions(s string, opts WordOptions) []string {
+	if s == "" {
+		return nil
+	}
+	return wordTexts(wordTokens(s, opts.config()))
+}
+
+// scanURL detects a URL starting at pos: an http:// or https:// prefix,
+// one of the extra schemes, or a bare domain if enabled.
+func (cfg wordConfig) scanURL(s string, pos int) (end int, ok bool) {
+	rest := s[pos:]
+	if n := httpPrefixLen(rest); n > 0 {
+		return scanURLBody(s, pos+n)
+	}
+	if n := schemePrefixLen(rest, cfg.schemes); n > 0 {
+		return scanURLBody(s, pos+n)
+	}
+	if cfg.bareDomains {
+		return scanBareDomain(s, pos)
+	}
+	return 0, false
+}
+
+// schemePrefixLen returns the length of the "scheme:" or "scheme://"
+// prefix of rest for one of schemes, or 0 if there is none.
+func schemePrefixLen(rest string, schemes []string) int {
+	for _, scheme := range schemes {
+		n := len(scheme)
+		if len(rest) > n && rest[n] == ':' && strings.EqualFold(rest[:n], scheme) {
+			if strings.HasPrefix(rest[n+1:], "//") {
+				return n + len("://")
+			}
+			return n + len(":")
+		}
+	}
+	return 0
+}
+
+// scanBareDomain detects a domain without a scheme starting at pos, such
+// as "mia.gov.az", followed by an optional port and path.
+func scanBareDomain(s string, pos int) (end int, ok bool) {
+	// A domain cannot continue a word, number, or other domain.
+	if pos > 0 {
+		pr, _ := utf8.DecodeLastRuneInString(s[:pos])
+		if unicode.IsLetter(pr) || unicode.IsDigit(pr) || strings.ContainsRune(".-_@/", pr) {
+			return 0, false
+		}
+	}
+
+	end = pos
+	for end < len(s) && isEmailDomainChar(rune(s[end])) {
+		end++
+	}
+	domain := strings.TrimRight(s[pos:end], ".")
+	lastDot := strings.LastIndexByte(domain, '.')
+	if lastDot < 0 {
+		return 0, false
+	}
+
+	// The top-level domain ends at a hyphen, leaving a case suffix outside.
+	tld := domain[lastDot+1:]
+	if h := strings.IndexByte(tld, '-'); h >= 0 {
+		tld = tld[:h]
+		domain = domain[:lastDot+1+h]
+	}
+	if !commonTLDs[tld] {
+		return 0, false
+	}
+	for label := range strings.SplitSeq(domain, ".") {
+		if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+			return 0, false
+		}
+	}
+
+	end = pos + len(domain)
+	if end == len(s) {
+		return end, true
+	}
+	r, _ := utf8.DecodeRuneInString(s[end:])
+	switch {
+	case r == '/' || r == ':' && end+1 < len(s) && isDigitByte(s[end+1]):
+		return scanURLBody(s, end)
+	case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '@':
+		// Part of a longer word or an email local part ("info.az@mail.az").
+		return 0, false
+	}
+	return end, true
+}
+
+// isSchemeName reports whether s is a valid lowercase URL scheme name: an
+// ASCII letter followed by ASCII letters, digits, "+", "-", or ".".
+func isSchemeName(s string) bool {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if (c < 'a' || c > 'z') && !isDigitByte(c) && c != '+' && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package tokenizer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// linkTokens returns the URL and Email tokens of tokens as "Type:text".
+func linkTokens(tokens []Token) []string {
+	var links []string
+	for _, t := range tokens {
+		if t.Type == URL || t.Type == Email {
+			links = append(links, t.Type.String()+":"+t.Text)
+		}
+	}
+	return links
+}
+
+func TestWordTokensWithOptions(t *testing.T) {
+	schemes := WordOptions{Schemes: []string{"ftp", "tel:", "MAILTO://"}}
+	bare := WordOptions{BareDomains: true}
+	tests := []struct {
+		name  string
+		input string
+		opts  WordOptions
+		want  []string
+	}{
+		{"zero options match WordTokens", "https://gov.az və ftp://files.az, user@mail.az", WordOptions{},
+			[]string{"URL:https://gov.az", "Email:user@mail.az"}},
+
+		// -- Extra schemes --
+
+		{"ftp", "Fayl: ftp://files.gov.az/doc.pdf.", schemes,
+			[]string{"URL:ftp://files.gov.az/doc.pdf"}},
+		{"tel", "Zəng edin: tel:+994501234567", schemes,
+			[]string{"URL:tel:+994501234567"}},
+		{"mailto", "mailto:info@mia.gov.az yazın", schemes,
+			[]string{"URL:mailto:info@mia.gov.az"}},
+		{"scheme case-insensitive", "FTP://files.az", schemes,
+			[]string{"URL:FTP://files.az"}},
+		{"scheme without content", "tel: 012 444 44 44", schemes, nil},
+		{"scheme inside a word", "hotel:yes", schemes, nil},
+		{"invalid scheme ignored", "1x:abc", WordOptions{Schemes: []string{"1x", ""}}, nil},
+		{"http still detected", "http://a.az ftp://b.az", schemes,
+			[]string{"URL:http://a.az", "URL:ftp://b.az"}},
+
+		// -- Bare domains --
+
+		{"bare domain", "Ətraflı: mia.gov.az.", bare,
+			[]string{"URL:mia.gov.az"}},
+		{"www", "www.example.com saytı", bare,
+			[]string{"URL:www.example.com"}},
+		{"path and port", "Bax: e-gov.az:8080/services/list?id=5, sonra", bare,
+			[]string{"URL:e-gov.az:8080/services/list?id=5"}},
+		{"digit label", "1news.az xəbər verir", bare,
+			[]string{"URL:1news.az"}},
+		{"case suffix after hyphen", "mia.gov.az-da yazılıb", bare,
+			[]string{"URL:mia.gov.az"}},
+		{"apostrophe suffix", "mia.gov.az'da", bare,
+			[]string{"URL:mia.gov.az"}},
+		{"email still detected", "info.az@mail.az", bare,
+			[]string{"Email:info.az@mail.az"}},
+		{"scheme URL unchanged", "https://mia.gov.az/x", bare,
+			[]string{"URL:https://mia.gov.az/x"}},
+		{"missing space after period", "Gəldi.Sonra getdi.bu", bare, nil},
+		{"uncommon TLD", "file.txt və main.go", bare, nil},
+		{"uppercase TLD", "Bu.Az", bare, nil},
+		{"number", "1.000.000,50 və 3.14", bare, nil},
+		{"continues a word", "Bakımia.gov.az", bare, nil},
+		{"empty label", "mia..az", bare, nil},
+
+		// -- Disabled detection --
+
+		{"no URLs", "https://gov.az user@mail.az", WordOptions{NoURLs: true},
+			[]string{"Email:user@mail.az"}},
+		{"no emails", "https://gov.az user@mail.az", WordOptions{NoEmails: true},
+			[]string{"URL:https://gov.az"}},
+		{"no URLs overrides schemes", "ftp://files.az mia.gov.az",
+			WordOptions{NoURLs: true, Schemes: []string{"ftp"}, BareDomains: true}, nil},
+		{"empty", "", bare, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tokens := WordTokensWithOptions(tt.input, tt.opts)
+			verifyInvariants(t, tt.input, tokens)
+			if got := linkTokens(tokens); !slices.Equal(got, tt.want) {
+				t.Errorf("WordTokensWithOptions(%q) links = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordTokensWithOptionsDisabled(t *testing.T) {
+	input := "https://gov.az user@mail.az"
+	got := WordTokensWithOptions(input, WordOptions{NoURLs: true, NoEmails: true})
+	want := []Token{
+		{Text: "https", Start: 0, End: 5, Type: Word},
+		{Text: ":", Start: 5, End: 6, Type: Punctuation},
+		{Text: "/", Start: 6, End: 7, Type: Punctuation},
+		{Text: "/", Start: 7, End: 8, Type: Punctuation},
+		{Text: "gov", Start: 8, End: 11, Type: Word},
+		{Text: ".", Start: 11, End: 12, Type: Punctuation},
+		{Text: "az", Start: 12, End: 14, Type: Word},
+		{Text: " ", Start: 14, End: 15, Type: Space},
+		{Text: "user", Start: 15, End: 19, Type: Word},
+		{Text: "@", Start: 19, End: 20, Type: Punctuation},
+		{Text: "mail", Start: 20, End: 24, Type: Word},
+		{Text: ".", Start: 24, End: 25, Type: Punctuation},
+		{Text: "az", Start: 25, End: 27, Type: Word},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("WordTokensWithOptions(%q) =\n%v\nwant\n%v", input, got, want)
+	}
+}
+
+func TestWordsWithOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  WordOptions
+		want  []string
+	}{
+		{"URLs excluded", "mia.gov.az saytı", WordOptions{BareDomains: true}, []string{"saytı"}},
+		{"URL words included", "https://gov.az saytı", WordOptions{NoURLs: true},
+			[]string{"https", "gov", "az", "saytı"}},
+		{"email words included", "user@mail.az", WordOptions{NoEmails: true},
+			[]string{"user", "mail", "az"}},
+		{"empty", "", WordOptions{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := WordsWithOptions(tt.input, tt.opts); !slices.Equal(got, tt.want) {
+				t.Errorf("WordsWithOptions(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordTokensWithOptionsMatchWordTokens(t *testing.T) {
+	input := "Prof. Əliyev 1.000 manat ödədi, user@mail.az yazdı: https://gov.az"
+	if got, want := WordTokensWithOptions(input, WordOptions{}), WordTokens(input); !slices.Equal(got, want) {
+		t.Errorf("WordTokensWithOptions = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkWordTokensWithOptions(b *testing.B) {
+	input := strings.Repeat("Ətraflı məlumat mia.gov.az və ftp://files.az saytındadır, 1.000 manat. ", 1000)
+	opts := WordOptions{Schemes: []string{"ftp", "tel", "mailto"}, BareDomains: true}
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		WordTokensWithOptions(input, opts)
+	}
+}
+
+func ExampleWordTokensWithOptions() {
+	opts := WordOptions{Schemes: []string{"tel"}, BareDomains: true}
+	for _, t := range WordTokensWithOptions("Müraciət: mia.gov.az, tel:+994124926859", opts) {
+		if t.Type == URL {
+			fmt.Println(t.Text)
+		}
+	}
+	// Output:
+	// mia.gov.az
+	// tel:+994124926859
+}
+
+func ExampleWordsWithOptions() {
+	fmt.Println(WordsWithOptions("https://gov.az saytı", WordOptions{NoURLs: true}))
+	// Output:
+	// [https gov az saytı]
+}
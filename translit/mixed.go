@@ -0,0 +1,277 @@
+package translit
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/detect"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// Conversion records one word converted by CyrillicToLatinMixed. Start and
+// End are byte offsets into the input, so s[Start:End] == Original.
+type Conversion struct {
+	Start     int    `json:"start"`     // byte offset in the input, inclusive
+	End       int    `json:"end"`       // byte offset in the input, exclusive
+	Original  string `json:"original"`  // the Cyrillic word
+	Converted string `json:"converted"` // its Latin form
+}
+
+// Letters that tell the two Cyrillic orthographies apart: the first set
+// exists only in Azerbaijani, the second only in Russian.
+const (
+	azerbaijaniCyrillic = "ӘәҒғҸҹҜҝӨөҮүҺһЈј"
+	russianCyrillic     = "ЦцЩщЭэЮюЯяЁёЪъЬь"
+)
+
+// CyrillicToLatinMixed converts the Azerbaijani Cyrillic words of s to
+// Latin and leaves everything else as written, for text that embeds
+// Russian quotations, code, or links in Azerbaijani. It returns the
+// converted text and one Conversion per converted word, sorted by Start.
+//
+// Each Cyrillic word is judged on its own letters first: a word with
+// letters only Russian has (я, ю, щ, ц, э, ё, ъ, ь) stays Cyrillic, and a
+// word with letters only Azerbaijani has (ә, ғ, ҹ, ҝ, ө, ү, һ, ј) is
+// converted. Other words follow the language of the innermost quotation
+// or parenthetical around them (tokenizer.Spans), or else of their
+// sentence (detect.Segments): they stay Cyrillic in Russian context and
+// are converted otherwise. The language of a quotation, or of a sentence
+// outside its quotations and code, is told by the same letters when only
+// one set occurs in it, and by detect otherwise. URLs, email addresses,
+// domain names, and code between backticks (`inline` or ```fenced```)
+// are never changed.
+// Converted words are transliterated as by CyrillicToLatin.
+//
+// Returns s and nil conversions when no word is converted.
+func CyrillicToLatinMixed(s string) (string, []Conversion) {
+	if s == "" {
+		return "", nil
+	}
+
+	hasGje := containsGje(s)
+	code := codeRanges(s)
+	spans := detectedSpans(s)
+	segs := segmentLangs(s, spans, code)
+
+	var convs []Conversion
+	var b strings.Builder
+	var stack []detectedSpan // spans enclosing the current word, outermost first
+	seg, sp, prev := 0, 0, 0
+	for _, tok := range tokenizer.WordTokens(s) {
+		if tok.Type != tokenizer.Word || !hasCyrillic(tok.Text) {
+			continue
+		}
+		for len(code) > 0 && code[0][1] <= tok.Start {
+			code = code[1:]
+		}
+		if len(code) > 0 && code[0][0] < tok.End {
+			continue
+		}
+		if addressPart(s, tok.Start, tok.End) {
+			continue
+		}
+
+		convert := false
+		switch {
+		case strings.ContainsAny(tok.Text, russianCyrillic):
+		case strings.ContainsAny(tok.Text, azerbaijaniCyrillic):
+			convert = true
+		default:
+			for len(stack) > 0 && stack[len(stack)-1].end <= tok.Start {
+				stack = stack[:len(stack)-1]
+			}
+			for sp < len(spans) && spans[sp].start <= tok.Start {
+				if spans[sp].end > tok.Start {
+					stack = append(stack, spans[sp])
+				}
+				sp++
+			}
+			for seg < len(segs) && segs[seg].end <= tok.Start {
+				seg++
+			}
+			lang := detect.Unknown
+			if len(stack) > 0 {
+				lang = stack[len(stack)-1].lang
+			} else if seg < len(segs) {
+				lang = segs[seg].lang
+			}
+			convert = lang != detect.Russian
+		}
+		if !convert {
+			continue
+		}
+
+		if b.Len() == 0 {
+			b.Grow(len(s))
+		}
+		b.WriteString(s[prev:tok.Start])
+		n := b.Len()
+		writeLatin(&b, s, tok.Start, tok.End, hasGje)
+		convs = append(convs, Conversion{
+			Start:     tok.Start,
+			End:       tok.End,
+			Original:  tok.Text,
+			Converted: b.String()[n:],
+		})
+		prev = tok.End
+	}
+
+	if len(convs) == 0 {
+		return s, nil
+	}
+	b.WriteString(s[prev:])
+	return b.String(), convs
+}
+
+// letterLang returns the language of a piece of Cyrillic text told by
+// its letters: Azerbaijani when it has letters only Azerbaijani has and
+// none only Russian has, Russian in the opposite case, and detected
+// otherwise. The letters are decisive where detect, built for longer
+// text, often mistakes a short Azerbaijani sentence for Russian.
+func letterLang(text string, detected detect.Language) detect.Language {
+	az := strings.ContainsAny(text, azerbaijaniCyrillic)
+	ru := strings.ContainsAny(text, russianCyrillic)
+	switch {
+	case az && !ru:
+		return detect.Azerbaijani
+	case ru && !az:
+		return detect.Russian
+	default:
+		return detected
+	}
+}
+
+// detectedSpan is a quotation or parenthetical, or a sentence segment,
+// whose content has a detectable language.
+type detectedSpan struct {
+	start, end int
+	lang       detect.Language
+}
+
+// detectedSpans returns the spans of s whose language letterLang or
+// detect can tell, ordered like tokenizer.Spans: by start, enclosing
+// spans first.
+func detectedSpans(s string) []detectedSpan {
+	var out []detectedSpan
+	for _, sp := range tokenizer.Spans(s) {
+		content := sp.Content()
+		if lang := letterLang(content, detect.Unknown); lang != detect.Unknown {
+			out = append(out, detectedSpan{start: sp.Start, end: sp.End, lang: lang})
+		} else if res := detect.Detect(content); res.Lang != detect.Unknown {
+			out = append(out, detectedSpan{start: sp.Start, end: sp.End, lang: res.Lang})
+		}
+	}
+	return out
+}
+
+// segmentLangs returns the segments of s from detect.Segments with the
+// language of their text outside the top-level spans and code, so a
+// Russian sentence quoting Azerbaijani is still Russian. When that text
+// is too short to tell ("Он сказал:" before a quotation), the segment
+// without its code is detected instead.
+func segmentLangs(s string, spans []detectedSpan, code [][2]int) []detectedSpan {
+	var skip [][2]int // spans and code, ordered by start
+	for _, sp := range spans {
+		skip = append(skip, [2]int{sp.start, sp.end})
+	}
+	skip = append(skip, code...)
+	slices.SortFunc(skip, func(a, b [2]int) int { return cmp.Compare(a[0], b[0]) })
+
+	segs := detect.Segments(s)
+	out := make([]detectedSpan, len(segs))
+	for i, seg := range segs {
+		outside := cutRanges(s, seg.Start, seg.End, skip)
+		lang := detect.Detect(outside).Lang
+		if lang == detect.Unknown {
+			lang = detect.Detect(cutRanges(s, seg.Start, seg.End, code)).Lang
+		}
+		out[i] = detectedSpan{start: seg.Start, end: seg.End, lang: letterLang(outside, lang)}
+	}
+	return out
+}
+
+// cutRanges returns s[start:end] without the parts covered by ranges,
+// which are ordered by start and may nest or overlap.
+func cutRanges(s string, start, end int, ranges [][2]int) string {
+	var b strings.Builder
+	pos := start
+	for _, r := range ranges {
+		if r[0] >= end {
+			break
+		}
+		if r[1] <= pos {
+			continue
+		}
+		b.WriteString(s[pos:max(r[0], pos)])
+		pos = min(r[1], end)
+	}
+	b.WriteString(s[pos:end])
+	return b.String()
+}
+
+// addressPart reports whether s[start:end] is part of an email address or
+// a domain name that the tokenizer did not recognize, as with Cyrillic
+// addresses ("китаб@пример.рф"): it touches an '@', or a '.' joining it
+// to another letter.
+func addressPart(s string, start, end int) bool {
+	if start > 0 && s[start-1] == '@' || end < len(s) && s[end] == '@' {
+		return true
+	}
+	if end+1 < len(s) && s[end] == '.' {
+		if r, _ := utf8.DecodeRuneInString(s[end+1:]); unicode.IsLetter(r) {
+			return true
+		}
+	}
+	if start > 1 && s[start-1] == '.' {
+		if r, _ := utf8.DecodeLastRuneInString(s[:start-1]); unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// codeRanges returns the byte ranges of code in s, in order: fenced
+// blocks between ``` lines, then inline code between single backticks on
+// one line. An unclosed fence runs to the end of s; an unclosed inline
+// backtick is ignored.
+func codeRanges(s string) [][2]int {
+	var out [][2]int
+	for i := 0; i < len(s); {
+		j := strings.IndexByte(s[i:], '`')
+		if j < 0 {
+			break
+		}
+		start := i + j
+		if strings.HasPrefix(s[start:], "```") {
+			end := len(s)
+			if k := strings.Index(s[start+3:], "```"); k >= 0 {
+				end = start + 3 + k + 3
+			}
+			out = append(out, [2]int{start, end})
+			i = end
+			continue
+		}
+		k := strings.IndexAny(s[start+1:], "`\n")
+		if k < 0 || s[start+1+k] == '\n' {
+			i = start + 1
+			continue
+		}
+		end := start + 1 + k + 1
+		out = append(out, [2]int{start, end})
+		i = end
+	}
+	return out
+}
+
+// hasCyrillic reports whether s contains a Cyrillic letter.
+func hasCyrillic(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Cyrillic, r) {
+			return true
+		}
+	}
+	return false
+}
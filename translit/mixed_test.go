@@ -0,0 +1,147 @@
+package translit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCyrillicToLatinMixed(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"latin only", "Bakı gözəl şəhərdir.", "Bakı gözəl şəhərdir."},
+		{"azerbaijani cyrillic", "Азәрбајҹан дили чох ҝөзәлдир.", "Azərbaycan dili çox gözəldir."},
+		{"short azerbaijani sentence", "Бу китаб чох јахшыдыр.", "Bu kitab çox yaxşıdır."},
+		{
+			"russian quote",
+			"Мән Бакыда јашајырам. Он деди: «Я живу в Москве и работаю программистом».",
+			"Mən Bakıda yaşayıram. Он деди: «Я живу в Москве и работаю программистом».",
+		},
+		{
+			"russian sentence",
+			"Командир сказал, что завтра будет холодно.\nАзәрбајҹан дили зәнҝиндир.",
+			"Командир сказал, что завтра будет холодно.\nAzərbaycan dili zəngindir.",
+		},
+		{
+			"azerbaijani quote in russian",
+			"Он сказал: «Бакы бөјүк шәһәрдир», и ушёл домой сегодня вечером.",
+			"Он сказал: «Bakı böyük şəhərdir», и ушёл домой сегодня вечером.",
+		},
+		{
+			"russian parenthetical",
+			"Бакы (рус. Баку, главный город) бөјүк шәһәрдир.",
+			"Bakı (рус. Баку, главный город) böyük şəhərdir.",
+		},
+		{
+			"url and email",
+			"Сајт: https://пример.рф/китаб, мəктуб: китаб@пример.рф",
+			"Sayt: https://пример.рф/китаб, məktub: китаб@пример.рф",
+		},
+		{
+			"inline code",
+			"Дәјишән `китаб := 5` јазылыб.",
+			"Dəyişən `китаб := 5` yazılıb.",
+		},
+		{
+			"fenced code",
+			"Кодда:\n```\nпрограм китаб\n```\nСонра китаб ҝәлди.",
+			"Kodda:\n```\nпрограм китаб\n```\nSonra kitab gəldi.",
+		},
+		{"unclosed fence", "Китаб ```програм", "Kitab ```програм"},
+		{"unclosed backtick", "Китаб `ҝәлди\nвә ҝетди", "Kitab `gəldi\nvə getdi"},
+		{"russian word by letters", "Мән бу съезддә идим.", "Mən bu съезддә idim."},
+		{"cyrillic email and domain", "Мәктуб: китаб@пример.рф, сајт пример.рф", "Məktub: китаб@пример.рф, sayt пример.рф"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, convs := CyrillicToLatinMixed(tt.in)
+			if got != tt.want {
+				t.Fatalf("CyrillicToLatinMixed(%q)\n got %q\nwant %q", tt.in, got, tt.want)
+			}
+			if got == tt.in && convs != nil {
+				t.Errorf("unchanged text with conversions %v", convs)
+			}
+			verifyConversions(t, tt.in, got, convs)
+		})
+	}
+}
+
+// verifyConversions checks that convs are ordered offsets into in and
+// that applying them to in gives out.
+func verifyConversions(t *testing.T, in, out string, convs []Conversion) {
+	t.Helper()
+	var b strings.Builder
+	prev := 0
+	for _, c := range convs {
+		if c.Start < prev || c.End <= c.Start || c.End > len(in) {
+			t.Fatalf("conversion %+v out of order or range", c)
+		}
+		if in[c.Start:c.End] != c.Original {
+			t.Errorf("in[%d:%d] = %q, want %q", c.Start, c.End, in[c.Start:c.End], c.Original)
+		}
+		if c.Converted != CyrillicToLatin(c.Original) && !strings.ContainsAny(c.Original, "Гг") {
+			t.Errorf("%q converted to %q, CyrillicToLatin gives %q", c.Original, c.Converted, CyrillicToLatin(c.Original))
+		}
+		b.WriteString(in[prev:c.Start])
+		b.WriteString(c.Converted)
+		prev = c.End
+	}
+	b.WriteString(in[prev:])
+	if b.String() != out {
+		t.Errorf("conversions rebuild %q, want %q", b.String(), out)
+	}
+}
+
+func TestCyrillicToLatinMixedMatchesCyrillicToLatin(t *testing.T) {
+	// Text without Russian, URLs, or code converts as a whole would.
+	in := "Азәрбајҹан Республикасы. Гәләҹәк гызлар гуш, ағ гаја!"
+	got, _ := CyrillicToLatinMixed(in)
+	if want := CyrillicToLatin(in); got != want {
+		t.Errorf("CyrillicToLatinMixed = %q, CyrillicToLatin = %q", got, want)
+	}
+}
+
+func TestCodeRanges(t *testing.T) {
+	tests := []struct {
+		in   string
+		want [][2]int
+	}{
+		{"", nil},
+		{"a `b` c", [][2]int{{2, 5}}},
+		{"a `b\nc` d", nil},
+		{"```x``` `y`", [][2]int{{0, 7}, {8, 11}}},
+		{"a ```x", [][2]int{{2, 6}}},
+	}
+	for _, tt := range tests {
+		got := codeRanges(tt.in)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("codeRanges(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkCyrillicToLatinMixed(b *testing.B) {
+	s := strings.Repeat("Мән Бакыда јашајырам. Он деди: «Я живу в Москве». Сајт: https://пример.рф. ", 200)
+	b.SetBytes(int64(len(s)))
+	for b.Loop() {
+		CyrillicToLatinMixed(s)
+	}
+}
+
+func ExampleCyrillicToLatinMixed() {
+	out, convs := CyrillicToLatinMixed("Мән деди: «Я живу в Москве и работаю там» вә ҝетди.")
+	fmt.Println(out)
+	for _, c := range convs {
+		fmt.Printf("%d-%d %s → %s\n", c.Start, c.End, c.Original, c.Converted)
+	}
+	// Output:
+	// Mən dedi: «Я живу в Москве и работаю там» və getdi.
+	// 0-6 Мән → Mən
+	// 7-15 деди → dedi
+	// 74-78 вә → və
+	// 79-89 ҝетди → getdi
+}
@@ -0,0 +1,428 @@
+package translit
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+)
+
+const (
+	maxRepairBytes  = 1 << 20 // 1 MiB input guard
+	maxRepairPasses = 2       // UTF-8 encoded twice over is repaired in two passes
+	maxLostLetters  = 5       // words with more lost letters are left alone (5^5 candidates)
+	minPrefixRunes  = 3       // shortest corpus prefix accepted as evidence for a lost letter
+)
+
+// codePage maps the runes of a single-byte code page to their bytes
+// 0x80-0xFF.
+type codePage map[rune]byte
+
+// newCodePage builds a codePage from the runes of bytes 0x80-0xFF, 0 for
+// undefined bytes. Undefined bytes also map from the C1 control of the
+// same value, which is how Latin-1 decoders render them.
+func newCodePage(high [128]rune) codePage {
+	cp := make(codePage, len(high))
+	for i, r := range high {
+		b := byte(0x80 + i)
+		if r == 0 {
+			r = rune(b)
+		}
+		cp[r] = b
+	}
+	return cp
+}
+
+// windows1252 is Windows-1252, a superset of the printable Latin-1 range.
+var windows1252 = newCodePage(func() (high [128]rune) {
+	copy(high[:0x20], []rune{
+		'€', 0, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0, 'Ž', 0,
+		0, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0, 'ž', 'Ÿ',
+	})
+	for i := 0x20; i < 0x80; i++ {
+		high[i] = rune(0x80 + i)
+	}
+	return high
+}())
+
+// windows1251 is Windows-1251, the Cyrillic code page of Soviet-era and
+// Russian-localized systems.
+var windows1251 = newCodePage(func() (high [128]rune) {
+	copy(high[:0x40], []rune{
+		'Ђ', 'Ѓ', '‚', 'ѓ', '„', '…', '†', '‡', '€', '‰', 'Љ', '‹', 'Њ', 'Ќ', 'Ћ', 'Џ',
+		'ђ', '‘', '’', '“', '”', '•', '–', '—', 0, '™', 'љ', '›', 'њ', 'ќ', 'ћ', 'џ',
+		' ', 'Ў', 'ў', 'Ј', '¤', 'Ґ', '¦', '§', 'Ё', '©', 'Є', '«', '¬', '­', '®', 'Ї',
+		'°', '±', 'І', 'і', 'ґ', 'µ', '¶', '·', 'ё', '№', 'є', '»', 'ј', 'Ѕ', 'ѕ', 'ї',
+	})
+	for i := 0x40; i < 0x80; i++ {
+		high[i] = 'А' + rune(i-0x40)
+	}
+	return high
+}())
+
+// azLetters are the Azerbaijani Latin letters outside ASCII.
+const azLetters = "əƏçÇğĞıİöÖşŞüÜ"
+
+// latin5AsLatin1 maps the Latin-5 letters that differ from Latin-1, as a
+// Latin-1 decoder renders them, back to the Azerbaijani letters.
+var latin5AsLatin1 = map[rune]rune{
+	'ð': 'ğ', 'Ð': 'Ğ', 'ý': 'ı', 'Ý': 'İ', 'þ': 'ş', 'Þ': 'Ş',
+}
+
+// latin5AsCyrillic maps Latin-5 letters, as a Windows-1251 decoder renders
+// them, back to the letters. ä (д) is a legacy stand-in for ə.
+var latin5AsCyrillic = map[rune]rune{
+	'з': 'ç', 'З': 'Ç', 'р': 'ğ', 'Р': 'Ğ', 'э': 'ı', 'Э': 'İ',
+	'ц': 'ö', 'Ц': 'Ö', 'ю': 'ş', 'Ю': 'Ş', 'ь': 'ü', 'Ь': 'Ü',
+	'д': 'ä', 'Д': 'Ä',
+}
+
+// lostLetters are the letters that a legacy code page could not store and
+// that were replaced by '?': ə everywhere, ı ş ğ outside Latin-5.
+// lostCapitals adds the i of İ, lost where a capital stands.
+var (
+	lostLetters  = []rune{'ə', 'ı', 'ş', 'ğ'}
+	lostCapitals = []rune{'ə', 'ı', 'ş', 'ğ', 'i'}
+)
+
+// RepairEncoding restores Azerbaijani text garbled by legacy encoding
+// mix-ups:
+//
+//   - UTF-8 read as Windows-1252 or Latin-1: "AzÉ™rbaycan BakÄ±" →
+//     "Azərbaycan Bakı", also for UTF-8 encoded twice over.
+//   - UTF-8 read as Windows-1251: "AzЙ™rbaycan BakД±" → "Azərbaycan Bakı".
+//   - Latin-5 read as Latin-1 ("Bakýda") or Windows-1251 ("Bakэda"),
+//     inside words that also contain ASCII letters.
+//   - ə written as ä by legacy keyboards ("Azärbaycan"), or letters the
+//     code page lacked replaced by '?' ("M?kt?bd? d?rs"): each reading
+//     with ə, ı, ş, or ğ is looked up in the embedded corpus frequency
+//     list and the most frequent word, or failing that the longest known
+//     prefix, is kept. This applies only to text containing no ə.
+//
+// Text without these patterns is returned unchanged. Only sequences that
+// decode to Azerbaijani letters or typographic punctuation are repaired,
+// so genuine Cyrillic text is left alone; Icelandic ð, þ, ý in a word with
+// ASCII letters are read as Latin-5. A '?' at the end of a word is kept
+// as a question mark unless the word with a letter there is in
+// the corpus ("Niy?" → "Niyə").
+//
+// Returns the input unchanged for empty input, invalid UTF-8, or input
+// exceeding 1 MiB.
+func RepairEncoding(text string) string {
+	if text == "" || len(text) > maxRepairBytes || !utf8.ValidString(text) {
+		return text
+	}
+	for range maxRepairPasses {
+		out := repairUTF8(repairUTF8(text, windows1252, false), windows1251, true)
+		if out == text {
+			break
+		}
+		text = out
+	}
+	return repairWords(text)
+}
+
+// repairUTF8 decodes runs of runes that encode, in code page cp, to a
+// UTF-8 sequence. When strict is set, two-byte sequences must decode to an
+// Azerbaijani letter, since a Cyrillic capital followed by punctuation is
+// common in genuine text.
+func repairUTF8(s string, cp codePage, strict bool) string {
+	rs := []rune(s)
+	var (
+		b       strings.Builder
+		changed bool
+		seq     [utf8.UTFMax]byte
+	)
+	b.Grow(len(s))
+	for i := 0; i < len(rs); i++ {
+		lead, ok := cp[rs[i]]
+		n := utf8SeqLen(lead)
+		if !ok || n == 0 || i+n > len(rs) {
+			b.WriteRune(rs[i])
+			continue
+		}
+		// Ə is C6 8F; 8F is undefined in Windows-1252 and often lost.
+		if lead == 0xC6 && !strict && (rs[i+1] == '?' || rs[i+1] == utf8.RuneError) {
+			b.WriteRune('Ə')
+			i++
+			changed = true
+			continue
+		}
+		seq[0] = lead
+		valid := true
+		for j := 1; j < n; j++ {
+			c, ok := cp[rs[i+j]]
+			if !ok || c < 0x80 || c > 0xBF {
+				valid = false
+				break
+			}
+			seq[j] = c
+		}
+		r, size := utf8.DecodeRune(seq[:n])
+		if !valid || r == utf8.RuneError || size != n || !plausibleRepair(r, n, strict) {
+			b.WriteRune(rs[i])
+			continue
+		}
+		b.WriteRune(r)
+		i += n - 1
+		changed = true
+	}
+	if !changed {
+		return s
+	}
+	return b.String()
+}
+
+// utf8SeqLen returns the length of the UTF-8 sequence starting with lead,
+// or 0 if lead cannot start a multi-byte sequence.
+func utf8SeqLen(lead byte) int {
+	switch {
+	case lead >= 0xC2 && lead <= 0xDF:
+		return 2
+	case lead >= 0xE0 && lead <= 0xEF:
+		return 3
+	case lead >= 0xF0 && lead <= 0xF4:
+		return 4
+	}
+	return 0
+}
+
+// plausibleRepair reports whether r, decoded from an n-byte sequence, is
+// something Azerbaijani text would contain.
+func plausibleRepair(r rune, n int, strict bool) bool {
+	if strings.ContainsRune(azLetters, r) {
+		return true
+	}
+	if strict && n < 3 {
+		return false
+	}
+	switch {
+	case r >= 0x2010 && r <= 0x203A, r == '€', r == '№', r == '™': // dashes, quotes, ellipsis
+		return true
+	case n == 2:
+		// Other Latin letters (é, ñ) and Latin-1 symbols (« » °), or the
+		// C1 controls and symbols that UTF-8 encoded twice over decodes to
+		// on the first pass.
+		return r >= 0x80 && r <= 0x24F
+	}
+	return false
+}
+
+// repairWords repairs single-byte mis-decodings and lost letters word by
+// word. Lost letters are restored only in text without ə, since text
+// that kept ə did not pass through a code page lacking it.
+func repairWords(s string) string {
+	restore := !strings.ContainsAny(s, "əƏ")
+	var (
+		b       strings.Builder
+		changed bool
+	)
+	start := -1
+	flush := func(end int) {
+		word := s[start:end]
+		fixed := repairWord(word, restore)
+		if fixed != word {
+			changed = true
+		}
+		b.WriteString(fixed)
+		start = -1
+	}
+	for i, r := range s {
+		if unicode.IsLetter(r) || r == '?' {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			flush(i)
+		}
+		b.WriteRune(r)
+	}
+	if start >= 0 {
+		flush(len(s))
+	}
+	if !changed {
+		return s
+	}
+	return b.String()
+}
+
+// repairWord repairs one run of letters and '?'.
+func repairWord(word string, restore bool) string {
+	if strings.IndexFunc(word, isASCIILetter) >= 0 {
+		word = mapLegacyLetters(word)
+	}
+	if restore && strings.ContainsAny(word, "?äÄ") {
+		word = restoreLostLetters(word)
+	}
+	return word
+}
+
+// isASCIILetter reports whether r is an ASCII letter.
+func isASCIILetter(r rune) bool {
+	return r < utf8.RuneSelf && unicode.IsLetter(r)
+}
+
+// mapLegacyLetters maps Latin-5 letters rendered by a Latin-1 or Windows-1251
+// decoder back to the Azerbaijani letters. Cyrillic letters are mapped only
+// when all of them have a Latin-5 reading.
+func mapLegacyLetters(word string) string {
+	cyrillic := false
+	for _, r := range word {
+		if unicode.Is(unicode.Cyrillic, r) {
+			if _, ok := latin5AsCyrillic[r]; !ok {
+				return word
+			}
+			cyrillic = true
+		}
+	}
+	return strings.Map(func(r rune) rune {
+		if l, ok := latin5AsLatin1[r]; ok {
+			return l
+		}
+		if l, ok := latin5AsCyrillic[r]; ok && cyrillic {
+			return l
+		}
+		return r
+	}, word)
+}
+
+// reading is a candidate restoration of a word with lost letters.
+type reading struct {
+	text  string
+	known bool  // the whole word is in the corpus
+	runes int   // length of the longest prefix in the corpus, if not known
+	freq  int64 // corpus frequency of the word or of the prefix
+}
+
+// better reports whether r is a more likely restoration than o: a known
+// word beats an unknown one, then the longer known prefix, then the higher
+// frequency.
+func (r reading) better(o reading) bool {
+	if r.known != o.known {
+		return r.known
+	}
+	if r.runes != o.runes {
+		return r.runes > o.runes
+	}
+	return r.freq > o.freq
+}
+
+// restoreLostLetters replaces ä with ə and each '?' with ə, ı, ş, or ğ,
+// keeping the most likely reading. A '?' at the end of the word may also
+// be a question mark, unless the reading with a letter there is a
+// known word. Words with no reading in the corpus, even as a prefix
+// reaching a lost letter, are returned with only ä replaced.
+func restoreLostLetters(word string) string {
+	word = strings.NewReplacer("ä", "ə", "Ä", "Ə").Replace(word)
+	core := strings.TrimRight(word, "?")
+	if core == "" {
+		return word
+	}
+	upper := azcase.ToUpper(word) == word
+	best, ok := bestReading(core, upper)
+	if core != word {
+		best.text += word[len(core):]
+		// Letters lost at the end of the word need a known word.
+		if r, found := bestReading(word, upper); found && r.known && (!ok || r.better(best)) {
+			best, ok = r, true
+		}
+	}
+	if !ok {
+		return word
+	}
+	if upper {
+		return azcase.ToUpper(best.text)
+	}
+	return best.text
+}
+
+// bestReading returns the most likely reading of word with each '?'
+// replaced by a lost letter, or by a lost capital at the start of the word
+// or anywhere if upper is set. Reports
+// false if no reading is in the corpus
+// as a word or as a prefix reaching a lost letter.
+func bestReading(word string, upper bool) (reading, bool) {
+	rs := []rune(word)
+	var holes []int
+	for i, r := range rs {
+		if r == '?' {
+			holes = append(holes, i)
+		}
+	}
+	if len(holes) > maxLostLetters {
+		return reading{}, false
+	}
+	if len(holes) == 0 {
+		f, ok := repairFreq()[azcase.ToLower(word)]
+		return reading{text: word, known: ok, freq: f}, ok
+	}
+
+	// İ can be lost only where a capital stands.
+	letters := make([][]rune, len(holes))
+	n := 1
+	for i, h := range holes {
+		letters[i] = lostLetters
+		if upper || h == 0 {
+			letters[i] = lostCapitals
+		}
+		n *= len(letters[i])
+	}
+	freq := repairFreq()
+	var best reading
+	found := false
+	for c := range n {
+		for i, h := range holes {
+			rs[h] = letters[i][c%len(letters[i])]
+			c /= len(letters[i])
+		}
+		if rs[0] == 'i' && holes[0] == 0 {
+			rs[0] = 'İ'
+		}
+		lower := []rune(azcase.ToLower(string(rs)))
+		cand := reading{text: string(rs)}
+		if f, ok := freq[string(lower)]; ok {
+			cand.known, cand.freq = true, f
+		} else {
+			// The longest prefix in the corpus covering a lost letter.
+			for l := len(lower) - 1; l > holes[0] && l >= minPrefixRunes; l-- {
+				if f, ok := freq[string(lower[:l])]; ok {
+					cand.runes, cand.freq = l, f
+					break
+				}
+			}
+			if cand.runes == 0 {
+				continue
+			}
+		}
+		if !found || cand.better(best) {
+			best, found = cand, true
+		}
+	}
+	return best, found
+}
+
+// repairFreq returns the corpus word frequencies from the embedded spell
+// frequency list, loaded on first use.
+var repairFreq = sync.OnceValue(func() map[string]int64 {
+	lines := bytes.Split(data.SpellFreq, []byte("\n"))
+	m := make(map[string]int64, len(lines))
+	for _, line := range lines {
+		sp := bytes.LastIndexByte(line, ' ')
+		if sp <= 0 {
+			continue
+		}
+		freq, err := strconv.ParseInt(string(line[sp+1:]), 10, 64)
+		if err != nil || freq < 0 {
+			continue
+		}
+		m[string(line[:sp])] = freq
+	}
+	return m
+})
@@ -0,0 +1,134 @@
+package translit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// mangle returns s encoded as UTF-8 and decoded byte by byte with cp, the
+// way a legacy decoder garbles it.
+func mangle(s string, cp codePage) string {
+	runes := make(map[byte]rune, len(cp))
+	for r, b := range cp {
+		if prev, ok := runes[b]; !ok || prev < 0x80 || prev > 0x9F {
+			runes[b] = r
+		}
+	}
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if c < 0x80 {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteRune(runes[c])
+	}
+	return b.String()
+}
+
+func TestRepairEncodingDoubleEncoded(t *testing.T) {
+	sentences := []string{
+		"Azərbaycan Respublikasının paytaxtı Bakı şəhəridir.",
+		"ÇOX GÖZƏL İŞ GÖRÜLDÜ, Əli Şəkiyə ğ-dən çıxdı.",
+		"Ölkə üzrə “ən yaxşı” məktəb — 2024-cü il…",
+	}
+	for _, want := range sentences {
+		tests := []struct {
+			name  string
+			input string
+		}{
+			{"windows-1252", mangle(want, windows1252)},
+			{"windows-1251", mangle(want, windows1251)},
+			{"windows-1252 twice", mangle(mangle(want, windows1252), windows1252)},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				if got := RepairEncoding(tt.input); got != want {
+					t.Errorf("RepairEncoding(%q) = %q, want %q", tt.input, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestRepairEncoding(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"utf-8 as latin-1", "AzÉ™rbaycan BakÄ±", "Azərbaycan Bakı"},
+		{"utf-8 as windows-1251", "AzЙ™rbaycan BakД±", "Azərbaycan Bakı"},
+		{"Ə with lost byte", "Æ?li gəldi", "Əli gəldi"},
+		{"typographic quotes", "â€œSalamâ€\u009d", "“Salam”"},
+		{"latin-5 as latin-1", "Bakýda yaþayýr, Aðdam", "Bakıda yaşayır, Ağdam"},
+		{"latin-5 capitals as latin-1", "Ýsmayýllý, Þamaxý", "İsmayıllı, Şamaxı"},
+		{"latin-5 as windows-1251", "Bakэda yaюayэr, Aрdam", "Bakıda yaşayır, Ağdam"},
+		{"ä for ə", "Azärbaycan Respublikasы", "Azərbaycan Respublikasы"},
+		{"lost letters", "M?kt?bd? d?rs ba?lad?.", "Məktəbdə dərs başladı."},
+		{"lost letter at end", "Niy? g?lm?din?", "Niyə gəlmədin?"},
+		{"lost İ at start", "Ey ?ki", "Ey İki"},
+		{"lost capitals", "??H?R", "ŞƏHƏR"},
+
+		// Left alone.
+		{"clean text", "Azərbaycan Respublikası", "Azərbaycan Respublikası"},
+		{"question mark with ə", "Bu nədir? H?ç nə.", "Bu nədir? H?ç nə."},
+		{"question marks", "Nə? ??", "Nə? ??"},
+		{"unknown word", "a?b x?y", "a?b x?y"},
+		{"russian", "Привет, как дела? Это Москва.", "Привет, как дела? Это Москва."},
+		{"azerbaijani cyrillic", "Азәрбајҹан Республикасы", "Азәрбајҹан Республикасы"},
+		{"cyrillic word with latin-5 letters", "дом", "дом"},
+		{"french", "Café résumé naïve à Paris", "Café résumé naïve à Paris"},
+		{"empty", "", ""},
+		{"invalid utf-8", "AzÉ™r\xff", "AzÉ™r\xff"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := RepairEncoding(tt.input); got != tt.want {
+				t.Errorf("RepairEncoding(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepairEncodingIdempotent(t *testing.T) {
+	for _, s := range []string{
+		"AzÉ™rbaycan BakÄ± ÅŸÉ™hÉ™ri",
+		"Bakýda yaþayýr",
+		"M?kt?bd? d?rs ba?lad?.",
+		"Azərbaycan Respublikası",
+	} {
+		once := RepairEncoding(s)
+		if twice := RepairEncoding(once); twice != once {
+			t.Errorf("RepairEncoding not idempotent on %q: %q then %q", s, once, twice)
+		}
+	}
+}
+
+func TestRepairEncodingLargeInput(t *testing.T) {
+	input := strings.Repeat("AzÉ™rbaycan ", maxRepairBytes/len("AzÉ™rbaycan ")+1)
+	if got := RepairEncoding(input); got != input {
+		t.Error("expected input over 1 MiB to be returned unchanged")
+	}
+}
+
+func BenchmarkRepairEncoding(b *testing.B) {
+	input := strings.Repeat("AzÉ™rbaycan RespublikasÄ±nÄ±n paytaxtÄ± BakÄ± ÅŸÉ™hÉ™ridir. ", 200)
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		RepairEncoding(input)
+	}
+}
+
+func ExampleRepairEncoding() {
+	fmt.Println(RepairEncoding("AzÉ™rbaycan RespublikasÄ±nÄ±n paytaxtÄ± BakÄ± ÅŸÉ™hÉ™ridir."))
+	fmt.Println(RepairEncoding("Bakýda yaþayýr"))
+	fmt.Println(RepairEncoding("M?kt?bd? d?rs ba?lad?."))
+	// Output:
+	// Azərbaycan Respublikasının paytaxtı Bakı şəhəridir.
+	// Bakıda yaşayır
+	// Məktəbdə dərs başladı.
+}
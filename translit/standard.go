@@ -0,0 +1,255 @@
+// Romanization standards for Azerbaijani Cyrillic.
+package translit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxErrLen caps how much of an invalid name is echoed in error messages.
+const maxErrLen = 50
+
+// Standard is a romanization standard for Azerbaijani Cyrillic.
+type Standard int
+
+const (
+	// Official is the Azerbaijani Latin alphabet of 1992, as written by
+	// CyrillicToLatin: Ә → Ə, Ҹ → C, Ч → Ç, Г → Q or G by context.
+	Official Standard = iota
+	// ISO9 is ISO 9:1995, one Latin letter with diacritics per Cyrillic
+	// letter: Ә → A̋, Ж → Ž, Ҹ → C̦, Һ → Ḥ.
+	ISO9
+	// BGNPCGN is the BGN/PCGN romanization used for place names, with
+	// digraphs: Ғ → Gh, Ж → Zh, Х → Kh, Ч → Ch, Ш → Sh.
+	BGNPCGN
+	// ALALC is the ALA-LC romanization used in library catalogs:
+	// Ә → Ă, Ө → Ȯ, Ү → U̇, Х → Kh.
+	ALALC
+)
+
+// standardNames maps Standard values to their string representations.
+var standardNames = [...]string{
+	Official: "Official",
+	ISO9:     "ISO9",
+	BGNPCGN:  "BGN/PCGN",
+	ALALC:    "ALA-LC",
+}
+
+// standardFromName maps string representations back to Standard values.
+var standardFromName = map[string]Standard{
+	"Official": Official,
+	"ISO9":     ISO9,
+	"BGN/PCGN": BGNPCGN,
+	"ALA-LC":   ALALC,
+}
+
+// String returns the name of the standard (e.g. "ISO9").
+func (s Standard) String() string {
+	if int(s) >= 0 && int(s) < len(standardNames) {
+		return standardNames[s]
+	}
+	return fmt.Sprintf("Standard(%d)", int(s))
+}
+
+// MarshalJSON encodes the standard as a JSON string (e.g. "ISO9").
+func (s Standard) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "ISO9") into a Standard.
+func (s *Standard) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	v, ok := standardFromName[name]
+	if !ok {
+		if len(name) > maxErrLen {
+			name = name[:maxErrLen] + "..."
+		}
+		return fmt.Errorf("translit: unknown standard: %q", name)
+	}
+	*s = v
+	return nil
+}
+
+// standardTables maps the lowercase Cyrillic letters to their lowercase
+// romanization under each standard other than Official, which uses
+// cyrToLat. Uppercase letters are romanized in title case ("Ж" → "Zh"),
+// or in upper case next to another uppercase letter ("ЖУРНАЛ" →
+// "ZHURNAL"). The Russian letters of loanwords (ц, щ, э, ю, я, ё) are
+// included.
+var standardTables = [...]map[rune]string{
+	ISO9: {
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'ғ': "ġ", 'д': "d", 'е': "e",
+		'ә': "a̋", 'ж': "ž", 'з': "z", 'и': "i", 'ы': "y", 'ј': "ǰ", 'й': "j",
+		'к': "k", 'ҝ': "k̂", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'ө': "ô",
+		'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ү': "ù", 'ф': "f",
+		'х': "h", 'һ': "ḥ", 'ч': "č", 'ҹ': "c̦", 'ш': "š",
+		'ц': "c", 'щ': "ŝ", 'э': "è", 'ю': "û", 'я': "â", 'ё': "ë",
+		'ь': "ʹ", 'ъ': "ʺ",
+	},
+	BGNPCGN: {
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'ғ': "gh", 'д': "d", 'е': "e",
+		'ә': "ä", 'ж': "zh", 'з': "z", 'и': "i", 'ы': "y", 'ј': "y", 'й': "y",
+		'к': "k", 'ҝ': "g", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'ө': "ö",
+		'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ү': "ü", 'ф': "f",
+		'х': "kh", 'һ': "h", 'ч': "ch", 'ҹ': "j", 'ш': "sh",
+		'ц': "ts", 'щ': "shch", 'э': "e", 'ю': "yu", 'я': "ya", 'ё': "yë",
+		'ь': "’", 'ъ': "”",
+	},
+	ALALC: {
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'ғ': "ġ", 'д': "d", 'е': "e",
+		'ә': "ă", 'ж': "zh", 'з': "z", 'и': "i", 'ы': "y", 'ј': "ĭ", 'й': "ĭ",
+		'к': "k", 'ҝ': "g̀", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'ө': "ȯ",
+		'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ү': "u̇", 'ф': "f",
+		'х': "kh", 'һ': "h", 'ч': "ch", 'ҹ': "j", 'ш': "sh",
+		'ц': "t͡s", 'щ': "shch", 'э': "ė", 'ю': "i͡u", 'я': "i͡a", 'ё': "ë",
+		'ь': "ʹ", 'ъ': "ʺ",
+	},
+}
+
+// Romanizer converts Azerbaijani Cyrillic to Latin script under a chosen
+// Standard, optionally with some letters mapped differently.
+//
+// A Romanizer is immutable once created and safe for concurrent use by
+// multiple goroutines.
+type Romanizer struct {
+	standard Standard
+	table    map[rune]string // Cyrillic letter of either case -> romanization
+}
+
+// Option configures a Romanizer created by NewRomanizer.
+type Option func(*Romanizer) error
+
+// WithStandard selects the romanization standard; the default is
+// Official. Options apply in order, so WithStandard must precede
+// WithMapping.
+func WithStandard(s Standard) Option {
+	return func(ro *Romanizer) error {
+		if int(s) < 0 || int(s) >= len(standardNames) {
+			return fmt.Errorf("translit: unknown standard: %v", s)
+		}
+		ro.standard = s
+		ro.table = standardTable(s)
+		return nil
+	}
+}
+
+// WithMapping overrides the romanization of the given Cyrillic letters,
+// for catalogs that follow a local variant of a standard. An empty string
+// drops the letter. A lowercase letter given without its uppercase form
+// also sets the uppercase form, in title case. Overriding Г/г replaces
+// the contextual Q/G rule of Official.
+func WithMapping(m map[rune]string) Option {
+	return func(ro *Romanizer) error {
+		for r, s := range m {
+			if !unicode.Is(unicode.Cyrillic, r) || !unicode.IsLetter(r) {
+				return fmt.Errorf("translit: mapping key %q is not a Cyrillic letter", r)
+			}
+			if !utf8.ValidString(s) {
+				return fmt.Errorf("translit: mapping for %q is not valid UTF-8", r)
+			}
+		}
+		for r, s := range m {
+			ro.table[r] = s
+			if up := unicode.ToUpper(r); up != r {
+				if _, ok := m[up]; !ok {
+					ro.table[up] = titleCase(s)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// NewRomanizer returns a Romanizer configured by opts, or an error if an
+// option is invalid.
+func NewRomanizer(opts ...Option) (*Romanizer, error) {
+	ro := &Romanizer{table: standardTable(Official)}
+	for _, opt := range opts {
+		if err := opt(ro); err != nil {
+			return nil, err
+		}
+	}
+	return ro, nil
+}
+
+// Standard returns the romanization standard of ro.
+func (ro *Romanizer) Standard() Standard {
+	return ro.standard
+}
+
+// standardTable returns a fresh table of both letter cases for s.
+func standardTable(s Standard) map[rune]string {
+	if s == Official {
+		t := make(map[rune]string, len(cyrToLat)+4)
+		for cyr, lat := range cyrToLat {
+			t[cyr] = string(lat)
+		}
+		for _, r := range "ЬьЪъ" {
+			t[r] = ""
+		}
+		return t
+	}
+	t := make(map[rune]string, 2*len(standardTables[s]))
+	for cyr, lat := range standardTables[s] {
+		t[cyr] = lat
+		t[unicode.ToUpper(cyr)] = titleCase(lat)
+	}
+	return t
+}
+
+// titleCase upper-cases the first rune of s.
+func titleCase(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// CyrillicToLatin is like the package-level CyrillicToLatin but romanizes
+// under ro's standard and mappings. Characters outside the table pass
+// through unchanged.
+func (ro *Romanizer) CyrillicToLatin(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	hasGje := containsGje(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i, r := range s {
+		lat, ok := ro.table[r]
+		switch {
+		case !ok && (r == 'Г' || r == 'г'):
+			rest := s[i+utf8.RuneLen(r):]
+			b.WriteRune(resolveG(r == 'Г', rest, hasGje))
+		case !ok:
+			b.WriteRune(r)
+		case unicode.IsUpper(r) && utf8.RuneCountInString(lat) > 1 && upperNeighbor(s, i, utf8.RuneLen(r)):
+			b.WriteString(strings.ToUpper(lat))
+		default:
+			b.WriteString(lat)
+		}
+	}
+
+	return b.String()
+}
+
+// upperNeighbor reports whether the letter before s[i] or after the
+// size-byte rune at s[i] is uppercase, so that a digraph is written in
+// upper case inside an all-caps word.
+func upperNeighbor(s string, i, size int) bool {
+	if r, _ := utf8.DecodeRuneInString(s[i+size:]); unicode.IsLetter(r) {
+		return unicode.IsUpper(r)
+	}
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return unicode.IsUpper(r)
+}
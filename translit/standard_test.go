@@ -0,0 +1,193 @@
+package translit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func newRomanizer(t *testing.T, opts ...Option) *Romanizer {
+	t.Helper()
+	ro, err := NewRomanizer(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ro
+}
+
+func TestRomanizerOfficialMatchesCyrillicToLatin(t *testing.T) {
+	ro := newRomanizer(t)
+	for _, s := range []string{
+		"Азәрбајҹан Республикасы",
+		"Һәјат ҝөзәлдир",
+		"Гарабағ гәлди",  // contextual Г
+		"Гарабаг, Ҝәнҹә", // Ҝ present: Г → Q
+		"КИТАБ, ЧАЈ, ШӘҺӘР",
+		"объект, альбом",
+		"Hello, 123 😀",
+		"",
+	} {
+		if got, want := ro.CyrillicToLatin(s), CyrillicToLatin(s); got != want {
+			t.Errorf("CyrillicToLatin(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
+
+func TestRomanizerStandards(t *testing.T) {
+	tests := []struct {
+		standard Standard
+		input    string
+		want     string
+	}{
+		{ISO9, "Азәрбајҹан", "Aza̋rbaǰc̦an"},
+		{ISO9, "Һәјат ҝөзәлдир", "Ḥa̋ǰat k̂ôza̋ldir"},
+		{ISO9, "Шуша, Ҝәнҹә", "Šuša, K̂a̋nc̦a̋"},
+		{ISO9, "Гарабағ", "Garabaġ"},
+		{ISO9, "объект", "obʺekt"},
+		{BGNPCGN, "Азәрбајҹан", "Azärbayjan"},
+		{BGNPCGN, "Шуша", "Shusha"},
+		{BGNPCGN, "ШУША", "SHUSHA"},
+		{BGNPCGN, "Хачмаз", "Khachmaz"},
+		{BGNPCGN, "Ҝәнҹә", "Gänjä"},
+		{BGNPCGN, "Ғ", "Gh"},
+		{BGNPCGN, "ҒАЗАХ", "GHAZAKH"},
+		{ALALC, "Азәрбајҹан", "Azărbaĭjan"},
+		{ALALC, "Өзбәк", "Ȯzbăk"},
+		{ALALC, "Хәзәр", "Khăzăr"},
+		{ALALC, "Ж", "Zh"},
+		{ALALC, "Ж.", "Zh."},
+		{ALALC, "Ж. ӘЛИЈЕВ", "Zh. ĂLIĬEV"},
+	}
+	for _, tt := range tests {
+		ro := newRomanizer(t, WithStandard(tt.standard))
+		if got := ro.CyrillicToLatin(tt.input); got != tt.want {
+			t.Errorf("%v: CyrillicToLatin(%q) = %q, want %q", tt.standard, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRomanizerLatinPassThrough(t *testing.T) {
+	for s := range len(standardNames) {
+		ro := newRomanizer(t, WithStandard(Standard(s)))
+		const input = "Bakı 2026, şəhər!"
+		if got := ro.CyrillicToLatin(input); got != input {
+			t.Errorf("%v: CyrillicToLatin(%q) = %q, want unchanged", Standard(s), input, got)
+		}
+	}
+}
+
+func TestWithMapping(t *testing.T) {
+	ro := newRomanizer(t, WithStandard(BGNPCGN), WithMapping(map[rune]string{'ә': "ə", 'ҹ': "dzh"}))
+	if got, want := ro.CyrillicToLatin("Ҹәлилабад ҸӘЛИЛАБАД"), "Dzhəlilabad DZHƏLILABAD"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	ro = newRomanizer(t, WithMapping(map[rune]string{'г': "g", 'Г': "G", 'ь': "'"}))
+	if got, want := ro.CyrillicToLatin("Гарабаг альбом"), "Garabag al'bom"; got != want {
+		t.Errorf("overridden Г: got %q, want %q", got, want)
+	}
+
+	ro = newRomanizer(t, WithStandard(ISO9), WithMapping(map[rune]string{'ъ': ""}))
+	if got, want := ro.CyrillicToLatin("объект"), "obekt"; got != want {
+		t.Errorf("dropped letter: got %q, want %q", got, want)
+	}
+}
+
+func TestNewRomanizerErrors(t *testing.T) {
+	for name, opt := range map[string]Option{
+		"unknown standard":    WithStandard(Standard(99)),
+		"negative standard":   WithStandard(Standard(-1)),
+		"latin key":           WithMapping(map[rune]string{'a': "b"}),
+		"cyrillic non-letter": WithMapping(map[rune]string{'҃': "x"}),
+		"invalid UTF-8":       WithMapping(map[rune]string{'а': "\xff"}),
+	} {
+		if _, err := NewRomanizer(opt); err == nil {
+			t.Errorf("%s: NewRomanizer succeeded, want error", name)
+		}
+	}
+}
+
+func TestRomanizerDoesNotShareTables(t *testing.T) {
+	newRomanizer(t, WithStandard(ISO9), WithMapping(map[rune]string{'ш': "sh"}))
+	ro := newRomanizer(t, WithStandard(ISO9))
+	if got := ro.CyrillicToLatin("ш"); got != "š" {
+		t.Errorf("mapping leaked into another Romanizer: got %q", got)
+	}
+	if got := ro.Standard(); got != ISO9 {
+		t.Errorf("Standard() = %v, want ISO9", got)
+	}
+}
+
+func TestStandardString(t *testing.T) {
+	tests := []struct {
+		s    Standard
+		want string
+	}{
+		{Official, "Official"},
+		{ISO9, "ISO9"},
+		{BGNPCGN, "BGN/PCGN"},
+		{ALALC, "ALA-LC"},
+		{Standard(99), "Standard(99)"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("Standard(%d).String() = %q, want %q", int(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestStandardJSON(t *testing.T) {
+	for s := range len(standardNames) {
+		data, err := json.Marshal(Standard(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Standard
+		if err := json.Unmarshal(data, &got); err != nil || got != Standard(s) {
+			t.Errorf("round trip of %v via %s = %v, %v", Standard(s), data, got, err)
+		}
+	}
+	var s Standard
+	if err := json.Unmarshal([]byte(`"GOST"`), &s); err == nil {
+		t.Error("Unmarshal unknown standard: want error")
+	}
+	long := `"` + strings.Repeat("x", 100) + `"`
+	if err := json.Unmarshal([]byte(long), &s); err == nil || len(err.Error()) > 100 {
+		t.Errorf("Unmarshal long name: error %v, want truncated", err)
+	}
+}
+
+func TestTableCoverage(t *testing.T) {
+	for s := ISO9; int(s) < len(standardNames); s++ {
+		for cyr := range cyrToLat {
+			if _, ok := standardTables[s][cyr]; !ok && cyr == []rune(strings.ToLower(string(cyr)))[0] {
+				t.Errorf("%v: no romanization for %q", s, cyr)
+			}
+		}
+	}
+}
+
+func BenchmarkRomanizer(b *testing.B) {
+	ro, _ := NewRomanizer(WithStandard(BGNPCGN))
+	input := strings.Repeat("Азәрбајҹан Республикасынын пајтахты Бакы шәһәридир. ", 100)
+	b.SetBytes(int64(len(input)))
+	for b.Loop() {
+		ro.CyrillicToLatin(input)
+	}
+}
+
+func ExampleNewRomanizer() {
+	for _, s := range []Standard{Official, ISO9, BGNPCGN, ALALC} {
+		ro, err := NewRomanizer(WithStandard(s))
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%-8v %s\n", s, ro.CyrillicToLatin("Ҹәлил Хәзәр"))
+	}
+	// Output:
+	// Official Cəlil Xəzər
+	// ISO9     C̦a̋lil Ha̋za̋r
+	// BGN/PCGN Jälil Khäzär
+	// ALA-LC   Jălil Khăzăr
+}
@@ -0,0 +1,171 @@
+package translit
+
+import (
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Direction selects the target script of a streaming conversion.
+type Direction int
+
+const (
+	// ToLatin converts Cyrillic to Latin, like CyrillicToLatin.
+	ToLatin Direction = iota
+	// ToCyrillic converts Latin to Cyrillic, like LatinToCyrillic.
+	ToCyrillic
+)
+
+const (
+	streamBufSize = 32 << 10 // read size of a Reader
+	maxLookahead  = 4 << 10  // bytes scanned past Г/г for the next letter
+)
+
+// converter transliterates a byte stream, holding back input that cannot
+// be converted yet: an incomplete UTF-8 sequence, or a Г/г whose next
+// letter has not arrived.
+type converter struct {
+	dir    Direction
+	hasGje bool // a Ҝ/ҝ has been converted
+}
+
+// convert appends the conversion of the longest convertible prefix of src
+// to dst and returns the extended dst and the prefix length. At EOF all of
+// src is converted.
+func (c *converter) convert(dst, src []byte, atEOF bool) ([]byte, int) {
+	i := 0
+	for i < len(src) {
+		if !atEOF && !utf8.FullRune(src[i:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(src[i:])
+		if c.dir == ToCyrillic {
+			if cyr, ok := latToCyr[r]; ok {
+				r = cyr
+			}
+			dst = utf8.AppendRune(dst, r)
+			i += size
+			continue
+		}
+
+		switch r {
+		case 'Г', 'г':
+			rest := src[i+size:]
+			if !atEOF && !c.hasGje && !hasNextLetter(rest) && len(rest) < maxLookahead {
+				return dst, i
+			}
+			dst = utf8.AppendRune(dst, resolveG(r == 'Г', string(rest[:min(len(rest), maxLookahead)]), c.hasGje))
+		case 'Ь', 'ь', 'Ъ', 'ъ':
+			// Silently removed.
+		case 'Ҝ', 'ҝ':
+			c.hasGje = true
+			dst = utf8.AppendRune(dst, cyrToLat[r])
+		default:
+			if lat, ok := cyrToLat[r]; ok {
+				r = lat
+			}
+			dst = utf8.AppendRune(dst, r)
+		}
+		i += size
+	}
+	return dst, i
+}
+
+// hasNextLetter reports whether a letter occurs in the complete runes of
+// b, so that Г/г can be resolved without more input.
+func hasNextLetter(b []byte) bool {
+	for len(b) > 0 && utf8.FullRune(b) {
+		r, size := utf8.DecodeRune(b)
+		if unicode.IsLetter(r) {
+			return true
+		}
+		b = b[size:]
+	}
+	return false
+}
+
+// Reader transliterates the text read from an underlying reader.
+type Reader struct {
+	r    io.Reader
+	conv converter
+	buf  []byte // read buffer
+	src  []byte // input not yet converted
+	out  []byte // converted output not yet returned
+	err  error  // sticky error from r
+}
+
+// NewReader returns a Reader that transliterates the text read from r in
+// direction dir, so whole files can be converted without loading them
+// into memory.
+//
+// The output equals CyrillicToLatin or LatinToCyrillic of the whole input,
+// with one exception for ToLatin: CyrillicToLatin maps every Г/г to Q once
+// Ҝ/ҝ appears anywhere in the text, while a stream can only apply that
+// rule to Г/г after the first Ҝ/ҝ. Г/г is resolved from at most 4 KiB of
+// following text.
+func NewReader(r io.Reader, dir Direction) *Reader {
+	return &Reader{r: r, conv: converter{dir: dir}, buf: make([]byte, streamBufSize)}
+}
+
+// Read implements io.Reader.
+func (t *Reader) Read(p []byte) (int, error) {
+	for len(t.out) == 0 {
+		if t.err != nil {
+			if len(t.src) > 0 {
+				t.out, _ = t.conv.convert(t.out[:0], t.src, true)
+				t.src = t.src[:0]
+				continue
+			}
+			return 0, t.err
+		}
+		n, err := t.r.Read(t.buf)
+		t.src = append(t.src, t.buf[:n]...)
+		t.err = err
+		var used int
+		t.out, used = t.conv.convert(t.out[:0], t.src, false)
+		t.src = t.src[:copy(t.src, t.src[used:])]
+	}
+	n := copy(p, t.out)
+	t.out = t.out[n:]
+	return n, nil
+}
+
+// Writer transliterates text written to it and writes the result to an
+// underlying writer. Close must be called to flush the held-back input.
+type Writer struct {
+	w    io.Writer
+	conv converter
+	src  []byte // input not yet converted
+	out  []byte // scratch buffer for converted output
+}
+
+// NewWriter returns a Writer that transliterates text in direction dir
+// before writing it to w. The output matches NewReader.
+func NewWriter(w io.Writer, dir Direction) *Writer {
+	return &Writer{w: w, conv: converter{dir: dir}}
+}
+
+// Write implements io.Writer. It reports len(p) on success; input that
+// cannot be converted yet is held until the next Write or Close.
+func (t *Writer) Write(p []byte) (int, error) {
+	t.src = append(t.src, p...)
+	var used int
+	t.out, used = t.conv.convert(t.out[:0], t.src, false)
+	t.src = t.src[:copy(t.src, t.src[used:])]
+	if _, err := t.w.Write(t.out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close converts and writes the held-back input. It does not close the
+// underlying writer.
+func (t *Writer) Close() error {
+	if len(t.src) == 0 {
+		return nil
+	}
+	t.out, _ = t.conv.convert(t.out[:0], t.src, true)
+	t.src = t.src[:0]
+	_, err := t.w.Write(t.out)
+	return err
+}
@@ -0,0 +1,136 @@
+package translit
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var streamInputs = []string{
+	"",
+	"Азәрбајҹан Республикасы",
+	"Гәләҹәк гызлар гуш, ағ гаја",
+	"Г. Бакы", // Г followed by punctuation, then a letter
+	"сон г",   // Г at end of input
+	"Гь!",     // Г before a removed sign
+	"дил 123 ъ ь",
+	"\xd0Азәр\xff",         // malformed UTF-8
+	"тест\xd0",             // truncated rune at EOF
+	"Salam, dünya! 🇦🇿 Əli", // Latin and emoji pass through
+}
+
+func readAll(t *testing.T, r io.Reader) string {
+	t.Helper()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	return string(out)
+}
+
+func TestNewReaderMatchesStringFunctions(t *testing.T) {
+	for _, in := range streamInputs {
+		wantLat, wantCyr := CyrillicToLatin(in), LatinToCyrillic(in)
+		for name, wrap := range map[string]func(io.Reader) io.Reader{
+			"whole":    func(r io.Reader) io.Reader { return r },
+			"one byte": iotest.OneByteReader,
+			"half":     iotest.HalfReader,
+		} {
+			if got := readAll(t, NewReader(wrap(strings.NewReader(in)), ToLatin)); got != wantLat {
+				t.Errorf("%s ToLatin(%q) = %q, want %q", name, in, got, wantLat)
+			}
+			if got := readAll(t, NewReader(wrap(strings.NewReader(in)), ToCyrillic)); got != wantCyr {
+				t.Errorf("%s ToCyrillic(%q) = %q, want %q", name, in, got, wantCyr)
+			}
+		}
+	}
+}
+
+func TestNewWriterMatchesStringFunctions(t *testing.T) {
+	for _, in := range streamInputs {
+		var whole, bytewise bytes.Buffer
+		w := NewWriter(&whole, ToLatin)
+		if _, err := w.Write([]byte(in)); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		w = NewWriter(&bytewise, ToLatin)
+		for i := range len(in) {
+			if _, err := w.Write([]byte{in[i]}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		want := CyrillicToLatin(in)
+		if whole.String() != want || bytewise.String() != want {
+			t.Errorf("Writer(%q) = %q / %q (bytewise), want %q", in, whole.String(), bytewise.String(), want)
+		}
+	}
+}
+
+func TestStreamGjeRule(t *testing.T) {
+	// Г after Ҝ maps to Q; before it, the vowel rule applies.
+	in := "гәл ҝәл гәл"
+	got := readAll(t, iotest.OneByteReader(NewReader(strings.NewReader(in), ToLatin)))
+	if want := "gəl gəl qəl"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestStreamLookaheadLimit(t *testing.T) {
+	// Г followed by more than maxLookahead non-letters resolves as Q.
+	in := "г" + strings.Repeat(" ", maxLookahead+10) + "ә"
+	got := readAll(t, NewReader(iotest.OneByteReader(strings.NewReader(in)), ToLatin))
+	if !strings.HasPrefix(got, "q ") || !strings.HasSuffix(got, "ə") {
+		t.Errorf("got prefix %q suffix %q, want q ... ə", got[:2], got[len(got)-2:])
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func TestNewReaderError(t *testing.T) {
+	want := errors.New("boom")
+	r := NewReader(io.MultiReader(strings.NewReader("Бакы"), errReader{want}), ToLatin)
+	out, err := io.ReadAll(r)
+	if !errors.Is(err, want) {
+		t.Errorf("err = %v, want %v", err, want)
+	}
+	if string(out) != "Bakı" {
+		t.Errorf("out = %q, want Bakı", out)
+	}
+}
+
+func BenchmarkNewReader(b *testing.B) {
+	in := strings.Repeat("Азәрбајҹан Республикасы Гафгазда јерләшир. ", 1000)
+	b.SetBytes(int64(len(in)))
+	for b.Loop() {
+		_, _ = io.Copy(io.Discard, NewReader(strings.NewReader(in), ToLatin))
+	}
+}
+
+func ExampleNewReader() {
+	r := NewReader(strings.NewReader("Азәрбајҹан Республикасы\n"), ToLatin)
+	_, _ = io.Copy(os.Stdout, r)
+	// Output:
+	// Azərbaycan Respublikası
+}
+
+func ExampleNewWriter() {
+	w := NewWriter(os.Stdout, ToCyrillic)
+	_, _ = io.WriteString(w, "Bakı şəhəri\n")
+	_ = w.Close()
+	// Output:
+	// Бакы шәһәри
+}
@@ -4,7 +4,29 @@
 // Latin (1929-1939 and post-1991), and Cyrillic (1939-1991). This package handles
 // conversion between the modern Latin and Soviet-era Cyrillic scripts.
 //
-// All functions are safe for concurrent use by multiple goroutines.
+// NewRomanizer returns a Romanizer for the romanization standards required
+// by library and archive metadata: ISO 9, BGN/PCGN, and ALA-LC, besides
+// the official Azerbaijani Latin alphabet of CyrillicToLatin (see
+// WithStandard), with individual letters remapped by WithMapping.
+//
+// CyrillicToLatinMixed converts only the Azerbaijani Cyrillic words of a
+// text, leaving Russian quotations and sentences, URLs, email addresses,
+// and code as written, and reports the converted words with their
+// offsets.
+//
+// NewReader and NewWriter convert streams in either Direction without
+// loading the whole text, for converting large files.
+//
+// RepairEncoding restores text garbled by legacy encoding mix-ups
+// ("mojibake") common in older Azerbaijani web pages and databases: UTF-8
+// read as Windows-1252 or Windows-1251 ("AzÉ™rbaycan"), Latin-5 read as
+// Latin-1 ("Bakýda"), and letters a code page lacked written as ä or '?'.
+// Lost letters are restored with the embedded corpus frequency list; a
+// word that was never in the corpus may keep its '?' or get the wrong
+// letter.
+//
+// All functions are safe for concurrent use by multiple goroutines. A
+// Reader or Writer must not be used concurrently.
 //
 // Known lossy conversions (Cyrillic → Latin):
 //   - Soft sign (Ь/ь) and hard sign (Ъ/ъ) are silently removed (no Latin equivalent).
@@ -32,15 +54,20 @@ func CyrillicToLatin(s string) string {
 		return ""
 	}
 
-	hasGje := containsGje(s)
-
 	var b strings.Builder
 	b.Grow(len(s))
+	writeLatin(&b, s, 0, len(s), containsGje(s))
+	return b.String()
+}
 
-	for i, r := range s {
+// writeLatin writes the conversion of s[start:end] to b. Г/г looks ahead
+// past end into the rest of s, so converting a text piece by piece gives
+// the same result as converting it whole.
+func writeLatin(b *strings.Builder, s string, start, end int, hasGje bool) {
+	for i, r := range s[start:end] {
 		switch r {
 		case 'Г', 'г':
-			rest := s[i+utf8.RuneLen(r):]
+			rest := s[start+i+utf8.RuneLen(r):]
 			b.WriteRune(resolveG(r == 'Г', rest, hasGje))
 		case 'Ь', 'ь', 'Ъ', 'ъ':
 			// Silently removed.
@@ -52,8 +79,6 @@ func CyrillicToLatin(s string) string {
 			}
 		}
 	}
-
-	return b.String()
 }
 
 // LatinToCyrillic converts Azerbaijani Latin text to Cyrillic script.
@@ -35,8 +35,9 @@ var latToCyr = map[rune]rune{
 const maxEditDist = 2
 
 // appendSpellingIssues detects misspelled words via spell.IsCorrect.
-// Skips non-Word tokens, empty tokens, digit-containing tokens, and
-// title-case unknown words (proper noun heuristic).
+// Skips non-Word tokens, empty tokens, digit-containing tokens,
+// title-case unknown words (proper noun heuristic), and words inside
+// keyboard layout issues already in issues.
 // Spelling is skipped entirely when the dominant script is Cyrillic.
 func appendSpellingIssues(issues []Issue, tokens []tokenizer.Token, det detect.Result) []Issue {
 	// The spell module requires Latin-script input.
@@ -44,6 +45,7 @@ func appendSpellingIssues(issues []Issue, tokens []tokenizer.Token, det detect.R
 		return issues
 	}
 
+	layout := keyboardLayoutSpans(issues)
 	for i := range tokens {
 		if len(issues) >= maxIssues {
 			return issues
@@ -53,6 +55,9 @@ func appendSpellingIssues(issues []Issue, tokens []tokenizer.Token, det detect.R
 		if tok.Type != tokenizer.Word || tok.Text == "" {
 			continue
 		}
+		if inSpans(tok, &layout) {
+			continue
+		}
 		if azcase.ContainsDigit(tok.Text) {
 			continue
 		}
@@ -85,6 +90,14 @@ func appendSpellingIssues(issues []Issue, tokens []tokenizer.Token, det detect.R
 
 // ── Punctuation check ──────────────────────────────────────────────────
 
+// Punctuation issue messages. Fix recognizes fixable issues by message.
+const (
+	msgMultipleSpaces    = "multiple spaces"
+	msgSpaceBeforePunct  = "space before punctuation"
+	msgMissingSpaceAfter = "missing space after punctuation"
+	msgRepeatedPunct     = "repeated punctuation"
+)
+
 const (
 	minDoubleSpaces     = 2 // minimum spaces in a Space token to flag
 	minConsecutivePunct = 2 // minimum identical punctuation chars to flag
@@ -109,7 +122,7 @@ func appendPunctuationIssues(issues []Issue, tokens []tokenizer.Token) []Issue {
 				End:        tok.End,
 				Type:       Punctuation,
 				Severity:   Warning,
-				Message:    "multiple spaces",
+				Message:    msgMultipleSpaces,
 				Suggestion: " ",
 			})
 			continue
@@ -125,7 +138,7 @@ func appendPunctuationIssues(issues []Issue, tokens []tokenizer.Token) []Issue {
 					End:        tok.End,
 					Type:       Punctuation,
 					Severity:   Warning,
-					Message:    "space before punctuation",
+					Message:    msgSpaceBeforePunct,
 					Suggestion: "",
 				})
 				continue
@@ -142,7 +155,7 @@ func appendPunctuationIssues(issues []Issue, tokens []tokenizer.Token) []Issue {
 					End:        tok.End,
 					Type:       Punctuation,
 					Severity:   Warning,
-					Message:    "missing space after punctuation",
+					Message:    msgMissingSpaceAfter,
 					Suggestion: tok.Text + " ",
 				})
 				continue
@@ -172,7 +185,7 @@ func appendPunctuationIssues(issues []Issue, tokens []tokenizer.Token) []Issue {
 						End:        last.End,
 						Type:       Punctuation,
 						Severity:   Info,
-						Message:    "repeated punctuation",
+						Message:    msgRepeatedPunct,
 						Suggestion: tok.Text,
 					})
 				}
@@ -202,44 +215,143 @@ func isSentenceEnd(text string) bool {
 	return false
 }
 
-// ── Layout check (homoglyphs) ──────────────────────────────────────────
+// ── Layout check (keyboard layout, homoglyphs) ─────────────────────────
 
-// appendLayoutIssues detects homoglyph characters from the wrong script.
-func appendLayoutIssues(issues []Issue, tokens []tokenizer.Token, det detect.Result) []Issue {
-	if det.Confidence < minDetectConfidence {
-		return issues
-	}
-	if det.Script != detect.ScriptLatn && det.Script != detect.ScriptCyrl {
-		return issues
-	}
+// msgKeyboardLayout is the message of issues for text typed with the wrong
+// keyboard layout active. The spelling and mixed-script checks skip words
+// inside such issues.
+const msgKeyboardLayout = "typed with the wrong keyboard layout"
 
-	for i := range tokens {
-		if len(issues) >= maxIssues {
-			return issues
+// appendLayoutIssues detects text typed with the wrong keyboard layout
+// active ("rbnf," for "kitab") and homoglyph characters from the wrong
+// script. Keyboard layout errors span a whitespace-delimited chunk,
+// since the keys of ə, ç, ş and others produce punctuation on other
+// layouts; homoglyphs are reported per word.
+func appendLayoutIssues(issues []Issue, tokens []tokenizer.Token, det detect.Result) []Issue {
+	// Layout conversion targets Latin Azerbaijani, like the spell module.
+	keyboard := det.Script != detect.ScriptCyrl
+	homoglyphs := det.Confidence >= minDetectConfidence &&
+		(det.Script == detect.ScriptLatn || det.Script == detect.ScriptCyrl)
+
+	for i := 0; i < len(tokens); {
+		if tokens[i].Type == tokenizer.Space {
+			i++
+			continue
 		}
+		j := i + 1
+		for j < len(tokens) && tokens[j].Type != tokenizer.Space {
+			j++
+		}
+		chunk := tokens[i:j]
+		i = j
 
-		tok := &tokens[i]
-		if tok.Type != tokenizer.Word {
+		if keyboard {
+			if is, ok := keyboardLayoutIssue(chunk); ok {
+				if len(issues) >= maxIssues {
+					return issues
+				}
+				issues = append(issues, is)
+				continue
+			}
+		}
+		if !homoglyphs {
 			continue
 		}
+		for k := range chunk {
+			tok := &chunk[k]
+			if tok.Type != tokenizer.Word {
+				continue
+			}
+			replaced, found := replaceHomoglyphs(tok.Text, det.Script)
+			if !found {
+				continue
+			}
+			if len(issues) >= maxIssues {
+				return issues
+			}
+			issues = append(issues, Issue{
+				Text:       tok.Text,
+				Start:      tok.Start,
+				End:        tok.End,
+				Type:       Layout,
+				Severity:   Error,
+				Message:    "contains characters from wrong script (possible keyboard layout error)",
+				Suggestion: replaced,
+			})
+		}
+	}
 
-		replaced, found := replaceHomoglyphs(tok.Text, det.Script)
-		if !found {
-			continue
+	return issues
+}
+
+// keyboardLayoutIssue reports a chunk of word and punctuation tokens
+// typed with the wrong keyboard layout. The chunk is converted from its
+// first word through its last token ("rbnf," → "kitab"), or, failing
+// that, through its last word, leaving trailing punctuation ("g'l." →
+// "gəl.").
+func keyboardLayoutIssue(chunk []tokenizer.Token) (Issue, bool) {
+	first, last := -1, -1
+	for k := range chunk {
+		switch chunk[k].Type {
+		case tokenizer.Word:
+			if first < 0 {
+				first = k
+			}
+			last = k
+		case tokenizer.Punctuation:
+		default:
+			return Issue{}, false
 		}
+	}
+	if first < 0 {
+		return Issue{}, false
+	}
 
-		issues = append(issues, Issue{
-			Text:       tok.Text,
-			Start:      tok.Start,
-			End:        tok.End,
-			Type:       Layout,
-			Severity:   Error,
-			Message:    "contains characters from wrong script (possible keyboard layout error)",
-			Suggestion: replaced,
-		})
+	ends := []int{len(chunk) - 1}
+	if last != len(chunk)-1 {
+		ends = append(ends, last)
+	}
+	for _, end := range ends {
+		var sb strings.Builder
+		for k := first; k <= end; k++ {
+			sb.WriteString(chunk[k].Text)
+		}
+		text := sb.String()
+		if fixed, ok := spell.FixLayout(text); ok {
+			return Issue{
+				Text:       text,
+				Start:      chunk[first].Start,
+				End:        chunk[end].End,
+				Type:       Layout,
+				Severity:   Error,
+				Message:    msgKeyboardLayout,
+				Suggestion: fixed,
+			}, true
+		}
 	}
+	return Issue{}, false
+}
 
-	return issues
+// keyboardLayoutSpans returns the keyboard layout issues among issues, in
+// order.
+func keyboardLayoutSpans(issues []Issue) []Issue {
+	var out []Issue
+	for _, is := range issues {
+		if is.Type == Layout && is.Message == msgKeyboardLayout {
+			out = append(out, is)
+		}
+	}
+	return out
+}
+
+// inSpans reports whether tok lies inside one of spans, which must be
+// sorted by Start. Spans ending before tok are dropped from *spans, so
+// tokens must be visited in order.
+func inSpans(tok *tokenizer.Token, spans *[]Issue) bool {
+	for len(*spans) > 0 && (*spans)[0].End <= tok.Start {
+		*spans = (*spans)[1:]
+	}
+	return len(*spans) > 0 && (*spans)[0].Start <= tok.Start
 }
 
 // replaceHomoglyphs scans word for runes from the non-dominant script
@@ -299,9 +411,8 @@ func replaceHomoglyphs(word string, dominant detect.Script) (string, bool) {
 // ── Mixed script check ─────────────────────────────────────────────────
 
 // appendMixedScriptIssues detects tokens entirely in a non-dominant
-// script. Independent pass with no dependency on layout check results.
-// Tokens where ALL letter runes are homoglyphs are skipped (layout
-// check handles those).
+// script. Tokens where ALL letter runes are homoglyphs, and tokens inside
+// keyboard layout issues, are skipped (layout check handles those).
 func appendMixedScriptIssues(issues []Issue, tokens []tokenizer.Token, det detect.Result) []Issue {
 	if det.Confidence < minDetectConfidence {
 		return issues
@@ -310,13 +421,14 @@ func appendMixedScriptIssues(issues []Issue, tokens []tokenizer.Token, det detec
 		return issues
 	}
 
+	layout := keyboardLayoutSpans(issues)
 	for i := range tokens {
 		if len(issues) >= maxIssues {
 			return issues
 		}
 
 		tok := &tokens[i]
-		if tok.Type != tokenizer.Word {
+		if tok.Type != tokenizer.Word || inSpans(tok, &layout) {
 			continue
 		}
 
@@ -0,0 +1,209 @@
+package validate
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/spell"
+)
+
+// ── Auto-fix ───────────────────────────────────────────────────────────
+
+// maxFixPasses bounds the validate→apply iterations in Fix that follow a
+// complete report. A repair can expose a new issue (collapsing "a  ," to
+// "a ," reveals a space before punctuation); three passes cover every
+// chain the checks can produce. Passes on a report truncated at maxIssues
+// are not counted, since the issues past the cap are still pending.
+const maxFixPasses = 3
+
+const (
+	// minFixWordRunes is the minimum length of a misspelled word that Fix
+	// corrects. Short words have too many one-edit neighbors.
+	minFixWordRunes = 4
+
+	// minFixFreqRatio is how many times more frequent the top spelling
+	// candidate must be than the next one at the same edit distance.
+	minFixFreqRatio = 10
+)
+
+// Fix applies the mechanical repairs suggested by Validate and returns the
+// repaired text with the issues that were applied.
+//
+// Only issues whose suggestion is safe to apply without review are used:
+// punctuation spacing and repetition, keyboard layout conversion,
+// homoglyph replacement, trailing whitespace, mixed line endings, a
+// missing final newline, and high-confidence spelling corrections (see
+// isConfidentSpelling). Other spelling issues, mixed-script, grammar, and
+// indentation issues are left for the caller.
+//
+// Conflicting edits are resolved deterministically: issues are sorted by
+// start offset (longest span first on ties), and any edit that overlaps an
+// already accepted one is dropped, so contained edits never apply twice.
+// Validation is repeated on the repaired text until no fixable issue
+// remains, also when a report is cut at the issue cap (1000), which makes
+// Fix idempotent: Fix(Fix(s)) returns the same text.
+//
+// Applied issues are sorted by Start and their offsets refer to text.
+// Empty or oversized (>1 MiB) input is returned unchanged with nil issues.
+func Fix(text string) (string, []Issue) {
+	if text == "" || len(text) > maxInputBytes {
+		return text, nil
+	}
+
+	var applied []Issue
+	var passes [][]Issue // accepted edits per pass, for mapping offsets back
+	cur := text
+	for chained := 0; chained < maxFixPasses; {
+		issues := Validate(cur).Issues
+		edits := resolveConflicts(fixableIssues(issues))
+		if len(edits) == 0 {
+			break
+		}
+		next := applyEdits(cur, edits)
+		if len(next) > maxInputBytes || next == cur {
+			break
+		}
+		if len(issues) < maxIssues {
+			chained++
+		}
+		for _, e := range edits {
+			start, end := e.Start, e.End
+			for i := len(passes) - 1; i >= 0; i-- {
+				start = mapBack(start, passes[i])
+				end = mapBack(end, passes[i])
+			}
+			e.Start, e.End, e.Text = start, end, text[start:end]
+			applied = append(applied, e)
+		}
+		passes = append(passes, edits)
+		cur = next
+	}
+
+	slices.SortStableFunc(applied, func(a, b Issue) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	return cur, applied
+}
+
+// fixableIssues returns the issues Fix may apply automatically.
+func fixableIssues(issues []Issue) []Issue {
+	var out []Issue
+	for _, is := range issues {
+		if isFixable(is) {
+			out = append(out, is)
+		}
+	}
+	return out
+}
+
+// isFixable reports whether applying the issue's Suggestion is a safe,
+// meaning-preserving repair. An empty Suggestion on a fixable issue means
+// the span is deleted.
+func isFixable(is Issue) bool {
+	switch is.Type {
+	case Punctuation:
+		// A space run that spans a line break would be collapsed onto one
+		// line; trailing-whitespace repair handles the spaces instead.
+		return is.Message != msgMultipleSpaces || !strings.ContainsAny(is.Text, "\r\n")
+	case Layout:
+		return is.Suggestion != ""
+	case Spelling:
+		return is.Suggestion != "" && isConfidentSpelling(is.Text)
+	case Whitespace:
+		switch is.Message {
+		case msgTrailingWhitespace, msgMixedLineEndings, msgMissingFinalNewline:
+			return true
+		}
+	}
+	return false
+}
+
+// isConfidentSpelling reports whether the top spelling candidate for word
+// is safe to apply without review: the word has at least minFixWordRunes
+// letters, the candidate is one edit away, and every other one-edit
+// candidate is at least minFixFreqRatio times rarer ("azərbaycn" →
+// "azərbaycan", but not "kitabb", which may be "kitab" or "kitabı").
+func isConfidentSpelling(word string) bool {
+	if utf8.RuneCountInString(word) < minFixWordRunes {
+		return false
+	}
+	suggestions := spell.Suggest(word, 1)
+	if len(suggestions) == 0 || suggestions[0].Distance != 1 {
+		return false
+	}
+	// Suggest ranks by distance, then frequency, so the top candidate here
+	// is also the issue's Suggestion.
+	return len(suggestions) == 1 || suggestions[1].Distance > 1 ||
+		suggestions[0].Frequency >= minFixFreqRatio*suggestions[1].Frequency
+}
+
+// resolveConflicts orders edits by start offset — longest span first, then
+// higher severity, then message — and drops every edit that overlaps an
+// accepted one. Zero-width insertions at the boundary of an accepted edit
+// are kept; exact duplicates of an accepted span are dropped.
+func resolveConflicts(edits []Issue) []Issue {
+	slices.SortStableFunc(edits, func(a, b Issue) int {
+		if c := cmp.Compare(a.Start, b.Start); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.End-b.Start, a.End-a.Start); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Severity, a.Severity); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Message, b.Message)
+	})
+
+	out := make([]Issue, 0, len(edits))
+	lastEnd := 0
+	for _, e := range edits {
+		if len(out) > 0 {
+			prev := out[len(out)-1]
+			if e.Start < lastEnd || e.Start == prev.Start && e.End == prev.End {
+				continue
+			}
+		}
+		out = append(out, e)
+		lastEnd = e.End
+	}
+	return out
+}
+
+// applyEdits replaces each edit's span with its Suggestion. Edits must be
+// sorted and non-overlapping.
+func applyEdits(text string, edits []Issue) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+	prev := 0
+	for _, e := range edits {
+		sb.WriteString(text[prev:e.Start])
+		sb.WriteString(e.Suggestion)
+		prev = e.End
+	}
+	sb.WriteString(text[prev:])
+	return sb.String()
+}
+
+// mapBack maps offset off in the text produced by applyEdits(text, edits)
+// back to an offset in text. Offsets inside a replacement map to the start
+// of the replaced span, or to its end when off is at the replacement's end.
+func mapBack(off int, edits []Issue) int {
+	delta := 0
+	for _, e := range edits {
+		newStart := e.Start + delta
+		newEnd := newStart + len(e.Suggestion)
+		switch {
+		case off < newStart:
+			return off - delta
+		case off == newEnd:
+			return e.End
+		case off < newEnd:
+			return e.Start
+		}
+		delta += len(e.Suggestion) - (e.End - e.Start)
+	}
+	return off - delta
+}
@@ -0,0 +1,223 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFix(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"clean text unchanged", "Bu kitab gözəldir.", "Bu kitab gözəldir."},
+		{"space before comma", "Bu kitab , gözəldir.", "Bu kitab, gözəldir."},
+		{"double space", "Bu  kitab gözəldir.", "Bu kitab gözəldir."},
+		{"double space before punctuation", "Bu kitab  , gözəldir.", "Bu kitab, gözəldir."},
+		{"missing space after period", "Salam.Necəsən?", "Salam. Necəsən?"},
+		{"repeated punctuation then word", "Salam!!Necəsən?", "Salam! Necəsən?"},
+		{"ellipsis kept", "Bəli... gəlirəm.", "Bəli... gəlirəm."},
+		{"homoglyph", "Bu kitаb gözəldir.", "Bu kitab gözəldir."},
+		{"keyboard layout", "Bu rbnf, gözəldir.", "Bu kitab gözəldir."},
+		{"trailing whitespace and final newline", "Salam  \nNecəsən?", "Salam\nNecəsən?\n"},
+		{"mixed line endings", "a\nb\nc\r\n", "a\nb\nc\n"},
+		{"confident spelling", "Bu ketab gözəldir.", "Bu kitab gözəldir."},
+		{"ambiguous spelling left alone", "Bu kitabb gözəldir.", "Bu kitabb gözəldir."},
+		{"short word left alone", "Bizim evv.", "Bizim evv."},
+		{"spelling and spacing", "Bu azərbaycn  , dilidir.", "Bu azərbaycan, dilidir."},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := Fix(tt.in)
+			if got != tt.want {
+				t.Errorf("Fix(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixAppliedOffsets(t *testing.T) {
+	in := "Bu kitab  , gözəldir."
+	_, applied := Fix(in)
+	if len(applied) != 2 {
+		t.Fatalf("applied = %+v, want 2 issues", applied)
+	}
+	// Both the double-space collapse and the follow-up space removal refer
+	// to the original two-space span.
+	for _, is := range applied {
+		if is.Start != 8 || is.End != 10 || is.Text != "  " {
+			t.Errorf("applied %+v, want span [8:10] %q", is, "  ")
+		}
+	}
+	if applied[0].Message != msgMultipleSpaces || applied[1].Message != msgSpaceBeforePunct {
+		t.Errorf("applied messages = %q, %q", applied[0].Message, applied[1].Message)
+	}
+}
+
+func TestFixAppliedSpelling(t *testing.T) {
+	in := "Bu ketab gözəldir."
+	_, applied := Fix(in)
+	if len(applied) != 1 {
+		t.Fatalf("applied = %+v, want 1 issue", applied)
+	}
+	is := applied[0]
+	if is.Type != Spelling || is.Start != 3 || is.End != 8 || is.Text != "ketab" || is.Suggestion != "kitab" {
+		t.Errorf("applied %+v, want spelling [3:8] %q → %q", is, "ketab", "kitab")
+	}
+}
+
+func TestIsConfidentSpelling(t *testing.T) {
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"ketab", true},     // single one-edit candidate
+		{"azərbaycn", true}, // one-edit runner-up far rarer
+		{"kitabb", false},   // kitab vs kitabı
+		{"mektab", false},   // nearest candidate two edits away
+		{"evv", false},      // too short
+		{"qqqqqqqq", false}, // no candidate
+	}
+	for _, tt := range tests {
+		if got := isConfidentSpelling(tt.word); got != tt.want {
+			t.Errorf("isConfidentSpelling(%q) = %v, want %v", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestResolveConflicts(t *testing.T) {
+	edits := []Issue{
+		{Start: 6, End: 7, Suggestion: "! ", Message: msgMissingSpaceAfter},
+		{Start: 5, End: 7, Suggestion: "!", Message: msgRepeatedPunct},
+		{Start: 10, End: 12, Suggestion: " ", Message: msgMultipleSpaces},
+		{Start: 10, End: 12, Suggestion: "", Message: msgTrailingWhitespace, Type: Whitespace},
+		{Start: 11, End: 14, Suggestion: "x"},  // partial overlap
+		{Start: 12, End: 12, Suggestion: "\n"}, // insertion at boundary
+	}
+	got := resolveConflicts(edits)
+	want := []struct{ start, end int }{{5, 7}, {10, 12}, {12, 12}}
+	if len(got) != len(want) {
+		t.Fatalf("resolveConflicts = %+v, want %d edits", got, len(want))
+	}
+	for i, w := range want {
+		if got[i].Start != w.start || got[i].End != w.end {
+			t.Errorf("[%d] = [%d:%d], want [%d:%d]", i, got[i].Start, got[i].End, w.start, w.end)
+		}
+	}
+	// Same span and severity: tie broken by message, not input order.
+	if got[1].Message != msgMultipleSpaces {
+		t.Errorf("tie winner = %q, want %q", got[1].Message, msgMultipleSpaces)
+	}
+}
+
+func TestMapBack(t *testing.T) {
+	// "ab  ,c" with [2:4]→" " gives "ab ,c"; then [2:3]→"" gives "ab,c".
+	edits := []Issue{{Start: 2, End: 4, Suggestion: " "}}
+	for _, tt := range []struct{ in, want int }{{0, 0}, {2, 2}, {3, 4}, {4, 5}} {
+		if got := mapBack(tt.in, edits); got != tt.want {
+			t.Errorf("mapBack(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+// fixCorpus is a set of messy inputs exercising overlapping issues.
+var fixCorpus = []string{
+	"Bu kitab  , gözəldir.",
+	"Salam!!Necəsən??Yaxşıyam.",
+	"Bu  ,  kitab  .  Gözəl !",
+	"a ,b .c !d ?e",
+	"Salam.\r\nNecəsən ?  \nYaxşı\r\n",
+	"kitаb  ,kitаb",
+	"  Bu kitab ;  gözəl :  çox  \t\n\tbəli  ",
+	"!!!,,,...???",
+	"Ə . Ə , Ə ; Ə",
+	"line1   \r\nline2\nline3\rline4 ,",
+	"Bu ketab ,gözəldir.",
+	"  , ​ .",
+	"a\n\n\n , b",
+}
+
+// TestFixProperties asserts, for every corpus entry and pairwise
+// concatenations, that Fix output is valid UTF-8, idempotent, free of
+// fixable issues, and that applied offsets address the input.
+func TestFixProperties(t *testing.T) {
+	inputs := append([]string(nil), fixCorpus...)
+	for _, a := range fixCorpus {
+		for _, b := range fixCorpus {
+			inputs = append(inputs, a+b, a+" "+b, a+"\n"+b)
+		}
+	}
+
+	for _, in := range inputs {
+		out, applied := Fix(in)
+		if !utf8.ValidString(out) {
+			t.Errorf("Fix(%q) produced invalid UTF-8", in)
+		}
+		if again, more := Fix(out); again != out || len(more) != 0 {
+			t.Errorf("Fix not idempotent on %q: %q then %q (%d more)", in, out, again, len(more))
+		}
+		for _, is := range Validate(out).Issues {
+			if isFixable(is) {
+				t.Errorf("Fix(%q) = %q still has fixable issue %+v", in, out, is)
+			}
+		}
+		prev := 0
+		for _, is := range applied {
+			if is.Start < prev || is.End < is.Start || is.End > len(in) || in[is.Start:is.End] != is.Text {
+				t.Errorf("Fix(%q): bad applied offsets %+v", in, is)
+			}
+			prev = is.Start
+		}
+		if len(applied) == 0 && out != in {
+			t.Errorf("Fix(%q) changed text without applied issues", in)
+		}
+	}
+}
+
+// TestFixManyIssues checks idempotence on input with more fixable issues
+// than one report holds.
+func TestFixManyIssues(t *testing.T) {
+	in := strings.Repeat("Salam  dünya .\n", 2500)
+	out, applied := Fix(in)
+	if want := strings.Repeat("Salam dünya.\n", 2500); out != want {
+		t.Errorf("Fix left %d bytes, want %d", len(out), len(want))
+	}
+	if len(applied) != 5000 {
+		t.Errorf("applied %d issues, want 5000", len(applied))
+	}
+	if again, more := Fix(out); again != out || len(more) != 0 {
+		t.Errorf("Fix not idempotent: %d more issues, %d -> %d bytes", len(more), len(out), len(again))
+	}
+}
+
+func TestFixOversized(t *testing.T) {
+	in := strings.Repeat("a ", maxInputBytes)
+	if out, applied := Fix(in); out != in || applied != nil {
+		t.Error("oversized input should be returned unchanged")
+	}
+}
+
+func BenchmarkFix(b *testing.B) {
+	text := strings.Repeat("Bu kitab  , gözəldir.Salam!!Necəsən ? ", 20)
+	for b.Loop() {
+		Fix(text)
+	}
+}
+
+func ExampleFix() {
+	fixed, applied := Fix("Bu kitab  , gözəldir.Sağ ol!!")
+	fmt.Println(fixed)
+	for _, is := range applied {
+		fmt.Printf("%q -> %q (%s)\n", is.Text, is.Suggestion, is.Message)
+	}
+	// Output:
+	// Bu kitab, gözəldir. Sağ ol!
+	// "  " -> " " (multiple spaces)
+	// "  " -> "" (space before punctuation)
+	// "." -> ". " (missing space after punctuation)
+	// "!!" -> "!" (repeated punctuation)
+}
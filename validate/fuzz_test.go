@@ -59,3 +59,26 @@ func FuzzValidate(f *testing.F) {
 		}
 	})
 }
+
+func FuzzReadability(f *testing.F) {
+	f.Add("Bu kitab gözəldir.")
+	f.Add("Ana evə gəldi. Uşaq sevindi.")
+	f.Add("")
+	f.Add("123 456")
+	f.Add("BMT")
+	f.Add("\xff\xfe")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		r := Readability(text)
+
+		if r.Score < 0 || r.Score > maxScore {
+			t.Errorf("score %v out of [0, %d] range", r.Score, maxScore)
+		}
+		if (r.Words == 0) != (r.Level == Unrated) {
+			t.Errorf("words = %d but level = %v", r.Words, r.Level)
+		}
+		if r.Words > 0 && (r.Sentences == 0 || r.Syllables < r.Words || r.TypeTokenRatio > 1) {
+			t.Errorf("inconsistent report: %+v", r)
+		}
+	})
+}
@@ -0,0 +1,310 @@
+package validate
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/detect"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/numtext"
+	"github.com/az-ai-labs/az-lang-nlp/spell"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// ── Grammar check ──────────────────────────────────────────────────────
+
+// Grammar issue messages.
+const (
+	msgPluralAfterNumeral = "plural noun after a numeral"
+	msgQuestionSeparate   = "question particle written separately"
+	msgQuestionHarmony    = "question particle does not follow vowel harmony"
+	msgDuplicateCase      = "duplicated case suffix"
+)
+
+// idiomaticNumerals are round numerals that combine with plural nouns in
+// set phrases ("min illər", "yüz illərdir") and are not checked.
+var idiomaticNumerals = map[string]bool{
+	"yüz": true, "min": true, "milyon": true, "milyard": true,
+}
+
+// questionParticles is the set of question particle forms.
+var questionParticles = map[string]bool{
+	"mı": true, "mi": true, "mu": true, "mü": true,
+}
+
+// appendGrammarIssues detects agreement errors using morph analyses:
+// plural nouns directly after a cardinal numeral ("5 kitablar"), question
+// particles written apart from their word or with the wrong vowel
+// ("gəldin mi", "gəldinmı"), and a case suffix stacked on another case
+// suffix ("evdədə"). Grammar checks need Latin-script input.
+func appendGrammarIssues(issues []Issue, tokens []tokenizer.Token, det detect.Result) []Issue {
+	if det.Script == detect.ScriptCyrl {
+		return issues
+	}
+
+	for i := range tokens {
+		if len(issues) >= maxIssues {
+			return issues
+		}
+
+		tok := &tokens[i]
+		if tok.Type != tokenizer.Word || tok.Text == "" {
+			continue
+		}
+		lower := azcase.ToLower(tok.Text)
+
+		if i >= 2 && isWordGap(tokens[i-1]) {
+			prev := &tokens[i-2]
+			if is, ok := pluralAfterNumeral(prev, tok, lower); ok {
+				issues = append(issues, is)
+				continue
+			}
+			if questionParticles[lower] && prev.Type == tokenizer.Word {
+				issues = append(issues, Issue{
+					Text:       prev.Text + tokens[i-1].Text + tok.Text,
+					Start:      prev.Start,
+					End:        tok.End,
+					Type:       Grammar,
+					Severity:   Warning,
+					Message:    msgQuestionSeparate,
+					Suggestion: prev.Text + azcase.ApplyCase(tok.Text, questionParticle(prev.Text)),
+				})
+				continue
+			}
+		}
+
+		if azcase.ContainsDigit(tok.Text) || spell.IsCorrect(tok.Text) || hasKnownAnalysis(lower) {
+			continue
+		}
+		if suggestion, ok := fixQuestionHarmony(lower); ok {
+			issues = append(issues, Issue{
+				Text:       tok.Text,
+				Start:      tok.Start,
+				End:        tok.End,
+				Type:       Grammar,
+				Severity:   Warning,
+				Message:    msgQuestionHarmony,
+				Suggestion: azcase.ApplyCase(tok.Text, suggestion),
+			})
+			continue
+		}
+		if suggestion, ok := fixDuplicateCase(lower); ok {
+			if suggestion != "" {
+				suggestion = azcase.ApplyCase(tok.Text, suggestion)
+			}
+			issues = append(issues, Issue{
+				Text:       tok.Text,
+				Start:      tok.Start,
+				End:        tok.End,
+				Type:       Grammar,
+				Severity:   Warning,
+				Message:    msgDuplicateCase,
+				Suggestion: suggestion,
+			})
+		}
+	}
+
+	return issues
+}
+
+// isWordGap reports whether tok is whitespace within a line.
+func isWordGap(tok tokenizer.Token) bool {
+	return tok.Type == tokenizer.Space && !strings.ContainsAny(tok.Text, "\r\n")
+}
+
+// pluralAfterNumeral reports a plural noun tok that directly follows the
+// cardinal numeral prev, except an adverbial plural instrumental
+// (minlərlə, dəfələrlə). The suggestion drops the plural suffix when the
+// remaining suffix chain is still a valid word.
+func pluralAfterNumeral(prev, tok *tokenizer.Token, lower string) (Issue, bool) {
+	if !isCardinal(prev) || azcase.IsTitleCase(tok.Text) {
+		return Issue{}, false
+	}
+	a, ok := knownAnalysis(lower)
+	if !ok {
+		return Issue{}, false
+	}
+	idx := slices.IndexFunc(a.Morphemes, func(m morph.Morpheme) bool { return m.Tag == morph.Plural })
+	if idx < 0 {
+		return Issue{}, false
+	}
+	// -larla/-lərlə forms adverbs of quantity (minlərlə "by the thousands",
+	// dəfələrlə "many times"), not a plural noun.
+	if idx+1 < len(a.Morphemes) && a.Morphemes[idx+1].Tag == morph.CaseIns {
+		return Issue{}, false
+	}
+
+	rest := slices.Delete(slices.Clone(a.Morphemes), idx, idx+1)
+	var b strings.Builder
+	b.WriteString(softenStem(a.Stem, rest))
+	for _, m := range rest {
+		b.WriteString(m.Surface)
+	}
+	suggestion := ""
+	if singular := b.String(); hasAnalysis(singular, a.Stem, rest) {
+		suggestion = azcase.ApplyCase(tok.Text, singular)
+	}
+
+	return Issue{
+		Text:       tok.Text,
+		Start:      tok.Start,
+		End:        tok.End,
+		Type:       Grammar,
+		Severity:   Warning,
+		Message:    msgPluralAfterNumeral,
+		Suggestion: suggestion,
+	}, true
+}
+
+// softenStem applies final consonant softening to a polysyllabic stem
+// followed by a vowel-initial suffix (otaq + a → otağa, çiçək + i → çiçəyi).
+func softenStem(stem string, suffixes []morph.Morpheme) string {
+	if len(suffixes) == 0 || countVowels(stem) < 2 {
+		return stem
+	}
+	first := []rune(suffixes[0].Surface)
+	if len(first) == 0 || !isVowel(first[0]) {
+		return stem
+	}
+	if base, found := strings.CutSuffix(stem, "q"); found {
+		return base + "ğ"
+	}
+	if base, found := strings.CutSuffix(stem, "k"); found {
+		return base + "y"
+	}
+	return stem
+}
+
+// isVowel reports whether r is a lowercase Azerbaijani vowel.
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aıoueəiöü", r)
+}
+
+// countVowels returns the number of lowercase vowels in s.
+func countVowels(s string) int {
+	n := 0
+	for _, r := range s {
+		if isVowel(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// isCardinal reports whether tok is a cardinal number in digits or a
+// single numeral word, excluding idiomatic round numerals.
+func isCardinal(tok *tokenizer.Token) bool {
+	switch tok.Type {
+	case tokenizer.Number:
+		return strings.Trim(tok.Text, "0123456789") == ""
+	case tokenizer.Word:
+		lower := azcase.ToLower(tok.Text)
+		if idiomaticNumerals[lower] {
+			return false
+		}
+		_, err := numtext.Parse(lower)
+		return err == nil
+	}
+	return false
+}
+
+// knownAnalysis returns the first analysis of the lowercase word whose
+// stem is a dictionary stem.
+func knownAnalysis(word string) (morph.Analysis, bool) {
+	for _, a := range morph.Analyze(word) {
+		if morph.IsKnownStem(a.Stem) {
+			return a, true
+		}
+	}
+	return morph.Analysis{}, false
+}
+
+// hasKnownAnalysis reports whether the lowercase word analyzes to a
+// dictionary stem.
+func hasKnownAnalysis(word string) bool {
+	_, ok := knownAnalysis(word)
+	return ok
+}
+
+// hasAnalysis reports whether word analyzes to stem with exactly the tags
+// of morphemes.
+func hasAnalysis(word, stem string, morphemes []morph.Morpheme) bool {
+	for _, a := range morph.Analyze(word) {
+		if a.Stem != stem || len(a.Morphemes) != len(morphemes) {
+			continue
+		}
+		if slices.EqualFunc(a.Morphemes, morphemes, func(x, y morph.Morpheme) bool { return x.Tag == y.Tag }) {
+			return true
+		}
+	}
+	return false
+}
+
+// questionParticle returns the form of the question particle that
+// harmonizes with the last vowel of word.
+func questionParticle(word string) string {
+	runes := []rune(azcase.ToLower(word))
+	for i := len(runes) - 1; i >= 0; i-- {
+		switch runes[i] {
+		case 'a', 'ı':
+			return "mı"
+		case 'e', 'ə', 'i':
+			return "mi"
+		case 'o', 'u':
+			return "mu"
+		case 'ö', 'ü':
+			return "mü"
+		}
+	}
+	return "mi"
+}
+
+// fixQuestionHarmony returns the repair morph.SuggestHarmony proposes for
+// an unknown lowercase word when it changes only the vowel of a final
+// question particle ("gəldinmı" → "gəldinmi").
+func fixQuestionHarmony(word string) (string, bool) {
+	for _, s := range morph.SuggestHarmony(word) {
+		for p := range questionParticles {
+			base, found := strings.CutSuffix(s, p)
+			if found && base != "" && questionParticles[strings.TrimPrefix(word, base)] {
+				return s, true
+			}
+		}
+	}
+	return "", false
+}
+
+// fixDuplicateCase detects an unknown lowercase word whose last case
+// suffix attaches to a word already carrying a case suffix ("evdədə",
+// "kitabdadan"). When both suffixes mark the same case, the suggestion is
+// the word with one of them; otherwise the suggestion is empty.
+func fixDuplicateCase(word string) (string, bool) {
+	for _, a := range morph.Analyze(word) {
+		if len(a.Morphemes) != 1 || !isCase(a.Morphemes[0].Tag) {
+			continue
+		}
+		inner, ok := knownAnalysis(a.Stem)
+		if !ok || len(inner.Morphemes) == 0 {
+			continue
+		}
+		last := inner.Morphemes[len(inner.Morphemes)-1]
+		if !isCase(last.Tag) {
+			continue
+		}
+		if last.Tag == a.Morphemes[0].Tag {
+			return a.Stem, true
+		}
+		return "", true
+	}
+	return "", false
+}
+
+// isCase reports whether tag is a case suffix.
+func isCase(tag morph.MorphTag) bool {
+	switch tag {
+	case morph.CaseGen, morph.CaseDat, morph.CaseAcc, morph.CaseLoc, morph.CaseAbl, morph.CaseIns:
+		return true
+	}
+	return false
+}
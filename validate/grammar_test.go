@@ -0,0 +1,101 @@
+package validate
+
+import (
+	"testing"
+)
+
+func grammarIssues(r Report) []Issue {
+	var out []Issue
+	for _, is := range r.Issues {
+		if is.Type == Grammar {
+			out = append(out, is)
+		}
+	}
+	return out
+}
+
+func TestValidateGrammar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		text       string
+		message    string
+		suggestion string
+	}{
+		{"clean", "Mən 5 kitab aldım.", "", "", ""},
+		{"digit plural", "Mən 5 kitablar aldım.", "kitablar", msgPluralAfterNumeral, "kitab"},
+		{"word numeral plural", "Beş kitablarda yazılıb.", "kitablarda", msgPluralAfterNumeral, "kitabda"},
+		{"plural softened stem", "Üç otaqlara baxdıq.", "otaqlara", msgPluralAfterNumeral, "otağa"},
+		{"ordinal decade", "1990-cı illərdə yaşadı.", "", "", ""},
+		{"idiomatic round numeral", "Min illər keçdi.", "", "", ""},
+		{"numeral across line break", "Beş\nkitablar oxudum.", "", "", ""},
+		{"adverbial plural instrumental", "Orada 10 minlərlə adam var idi.", "", "", ""},
+		{"adverbial dəfələrlə", "Mən 2 dəfələrlə demişəm.", "", "", ""},
+		{"particle separate", "Sən gəldin mi?", "gəldin mi", msgQuestionSeparate, "gəldinmi"},
+		{"particle separate wrong vowel", "Bu kitab mi?", "kitab mi", msgQuestionSeparate, "kitabmı"},
+		{"particle attached", "Sən gəldinmi?", "", "", ""},
+		{"particle wrong harmony", "Sən gəldinmı?", "gəldinmı", msgQuestionHarmony, "gəldinmi"},
+		{"particle wrong harmony back", "Bu kitabmi?", "kitabmi", msgQuestionHarmony, "kitabmı"},
+		{"suffix harmony left to spelling", "Mən kitablər aldım.", "", "", ""},
+		{"duplicate case", "Biz evdədə qaldıq.", "evdədə", msgDuplicateCase, "evdə"},
+		{"stacked cases", "O kitabdadan oxudu.", "kitabdadan", msgDuplicateCase, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := grammarIssues(Validate(tt.input))
+			if tt.message == "" {
+				if len(got) != 0 {
+					t.Fatalf("Validate(%q): got grammar issues %v, want none", tt.input, got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("Validate(%q): got %d grammar issues %v, want 1", tt.input, len(got), got)
+			}
+			is := got[0]
+			if is.Text != tt.text || is.Message != tt.message || is.Suggestion != tt.suggestion {
+				t.Errorf("issue = {%q %q %q}, want {%q %q %q}",
+					is.Text, is.Message, is.Suggestion, tt.text, tt.message, tt.suggestion)
+			}
+			if is.Severity != Warning {
+				t.Errorf("Severity = %v, want warning", is.Severity)
+			}
+			if tt.input[is.Start:is.End] != is.Text {
+				t.Errorf("input[%d:%d] = %q, want %q", is.Start, is.End, tt.input[is.Start:is.End], is.Text)
+			}
+		})
+	}
+}
+
+func TestFixSkipsGrammar(t *testing.T) {
+	t.Parallel()
+
+	in := "Mən 5 kitablar aldım.\n"
+	if got, applied := Fix(in); got != in || len(applied) != 0 {
+		t.Errorf("Fix(%q) = %q, %v; want input unchanged", in, got, applied)
+	}
+}
+
+func TestQuestionParticle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"gəldin", "mi"},
+		{"kitab", "mı"},
+		{"oxudun", "mu"},
+		{"gördün", "mü"},
+		{"BAKI", "mı"},
+	}
+	for _, tt := range tests {
+		if got := questionParticle(tt.word); got != tt.want {
+			t.Errorf("questionParticle(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// ── Readability ────────────────────────────────────────────────────────
+
+// ReadingLevel grades how easy a text is to read, from its readability
+// score.
+type ReadingLevel int
+
+const (
+	Unrated       ReadingLevel = iota // no words to rate
+	VeryEasy                          // score 90-100
+	Easy                              // score 70-89
+	Medium                            // score 50-69
+	Difficult                         // score 30-49
+	VeryDifficult                     // score below 30
+)
+
+// readingLevelNames maps ReadingLevel values to their string names.
+var readingLevelNames = [...]string{
+	Unrated:       "unrated",
+	VeryEasy:      "very_easy",
+	Easy:          "easy",
+	Medium:        "medium",
+	Difficult:     "difficult",
+	VeryDifficult: "very_difficult",
+}
+
+// readingLevelFromName maps string names back to ReadingLevel values.
+var readingLevelFromName = map[string]ReadingLevel{
+	"unrated":        Unrated,
+	"very_easy":      VeryEasy,
+	"easy":           Easy,
+	"medium":         Medium,
+	"difficult":      Difficult,
+	"very_difficult": VeryDifficult,
+}
+
+// String returns the name of the reading level.
+func (l ReadingLevel) String() string {
+	if int(l) >= 0 && int(l) < len(readingLevelNames) {
+		return readingLevelNames[l]
+	}
+	return fmt.Sprintf("ReadingLevel(%d)", int(l))
+}
+
+// MarshalJSON encodes the reading level as a JSON string (e.g. "easy").
+func (l ReadingLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "easy") into a ReadingLevel.
+func (l *ReadingLevel) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := readingLevelFromName[s]
+	if !ok {
+		return fmt.Errorf("validate: unknown reading level: %q", s)
+	}
+	*l = v
+	return nil
+}
+
+// ReadabilityReport holds readability metrics of a text.
+type ReadabilityReport struct {
+	Sentences         int          `json:"sentences"`
+	Words             int          `json:"words"`
+	Syllables         int          `json:"syllables"`
+	AvgSentenceLength float64      `json:"avg_sentence_length"` // words per sentence
+	AvgSyllables      float64      `json:"avg_syllables"`       // syllables per word
+	TypeTokenRatio    float64      `json:"type_token_ratio"`    // distinct words / words, case-insensitive
+	Score             float64      `json:"score"`               // 0-100, higher is easier
+	Level             ReadingLevel `json:"level"`
+}
+
+// Ateşman's adaptation of the Flesch Reading Ease formula to Turkish,
+// whose syllable structure and word length Azerbaijani shares:
+// score = 198.825 - 40.175 × syllables/word - 2.610 × words/sentence.
+const (
+	readabilityBase         = 198.825
+	readabilitySyllableCoef = 40.175
+	readabilitySentenceCoef = 2.610
+)
+
+// Score thresholds of the reading levels.
+const (
+	scoreVeryEasy  = 90
+	scoreEasy      = 70
+	scoreMedium    = 50
+	scoreDifficult = 30
+)
+
+// azVowels lists the Azerbaijani vowels in Latin and Cyrillic script,
+// lowercase.
+const azVowels = "aıoueəiöüаыоуеәиөү"
+
+// Readability measures how easy text is to read: average sentence length,
+// average syllables per word, type-token ratio, and a Flesch-style score
+// with its reading level, for grading educational content.
+//
+// Words are the Word tokens of [tokenizer.WordTokens]; numbers and
+// punctuation are not counted. Syllables are counted by vowels, since
+// every Azerbaijani syllable has exactly one vowel ("mü-əl-lim" has three);
+// a word without vowels, such as the abbreviation "BMT", counts as one.
+// Sentences come from [tokenizer.Sentences].
+//
+// The score uses Ateşman's adaptation of the Flesch formula to Turkish,
+// clamped to 0-100: 90 and above is very easy (early primary school),
+// below 30 very difficult (academic or legal prose). The type-token ratio
+// falls as texts grow longer, so compare it only between texts of similar
+// length.
+//
+// Empty or oversized (>1 MiB) input, or text without words, returns a
+// zero report with Level Unrated.
+// Safe for concurrent use.
+func Readability(text string) ReadabilityReport {
+	if text == "" || len(text) > maxInputBytes {
+		return ReadabilityReport{}
+	}
+
+	var r ReadabilityReport
+	types := make(map[string]struct{})
+	for _, sentence := range tokenizer.Sentences(text) {
+		words := 0
+		for _, tok := range tokenizer.WordTokens(sentence) {
+			if tok.Type != tokenizer.Word {
+				continue
+			}
+			words++
+			r.Syllables += countSyllables(tok.Text)
+			types[azcase.ToLower(tok.Text)] = struct{}{}
+		}
+		if words > 0 {
+			r.Sentences++
+			r.Words += words
+		}
+	}
+	if r.Words == 0 {
+		return ReadabilityReport{}
+	}
+
+	r.AvgSentenceLength = float64(r.Words) / float64(r.Sentences)
+	r.AvgSyllables = float64(r.Syllables) / float64(r.Words)
+	r.TypeTokenRatio = float64(len(types)) / float64(r.Words)
+	r.Score = readabilityBase - readabilitySyllableCoef*r.AvgSyllables - readabilitySentenceCoef*r.AvgSentenceLength
+	r.Score = min(max(r.Score, 0), maxScore)
+	r.Level = levelForScore(r.Score)
+	return r
+}
+
+// countSyllables returns the number of syllables in word, at least 1.
+func countSyllables(word string) int {
+	n := 0
+	for _, c := range word {
+		if strings.ContainsRune(azVowels, unicode.ToLower(c)) {
+			n++
+		}
+	}
+	return max(n, 1)
+}
+
+// levelForScore returns the reading level of a readability score.
+func levelForScore(score float64) ReadingLevel {
+	switch {
+	case score >= scoreVeryEasy:
+		return VeryEasy
+	case score >= scoreEasy:
+		return Easy
+	case score >= scoreMedium:
+		return Medium
+	case score >= scoreDifficult:
+		return Difficult
+	}
+	return VeryDifficult
+}
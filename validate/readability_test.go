@@ -0,0 +1,131 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestReadability(t *testing.T) {
+	t.Parallel()
+
+	r := Readability("Ana evə gəldi. Uşaq sevindi. Uşaq yatdı.")
+	if r.Sentences != 3 || r.Words != 7 || r.Syllables != 15 {
+		t.Errorf("counts = %d sentences, %d words, %d syllables; want 3, 7, 15",
+			r.Sentences, r.Words, r.Syllables)
+	}
+	if math.Abs(r.AvgSentenceLength-7.0/3) > 1e-9 {
+		t.Errorf("AvgSentenceLength = %v, want %v", r.AvgSentenceLength, 7.0/3)
+	}
+	if math.Abs(r.AvgSyllables-15.0/7) > 1e-9 {
+		t.Errorf("AvgSyllables = %v, want %v", r.AvgSyllables, 15.0/7)
+	}
+	if math.Abs(r.TypeTokenRatio-6.0/7) > 1e-9 {
+		t.Errorf("TypeTokenRatio = %v, want %v (\"Uşaq\" repeats)", r.TypeTokenRatio, 6.0/7)
+	}
+	if r.Score != maxScore || r.Level != VeryEasy {
+		t.Errorf("Score, Level = %v, %v; want 100, very_easy", r.Score, r.Level)
+	}
+}
+
+func TestReadabilityLevels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want ReadingLevel
+	}{
+		{"children's text", "Bu gün hava yaxşıdır. Biz parka getdik və orada çox oynadıq.", VeryEasy},
+		{"weather report", "Sabah Bakıda hava yağışlı olacaq. Küləyin sürəti saniyədə on metrə çatacaq.", Easy},
+		{"news", "Hökumət yeni proqramı təsdiqləyib. Proqram kənd təsərrüfatının inkişafını nəzərdə tutur.", Medium},
+		{"official news", "Prezident beynəlxalq konfransda iştirak edib. Tədbirdə iqtisadi əməkdaşlıq məsələləri müzakirə olunub.", Difficult},
+		{"legal", "Azərbaycan Respublikasının Konstitusiyasına müvafiq olaraq, dövlət hakimiyyətinin qanunvericilik, " +
+			"icra və məhkəmə hakimiyyətlərinə bölünməsi prinsipi əsasında təşkil edilən idarəetmə sistemi müəyyənləşdirilmişdir.", VeryDifficult},
+		{"numbers only", "123 456", Unrated},
+		{"punctuation only", "... !!!", Unrated},
+		{"empty", "", Unrated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := Readability(tt.text)
+			if r.Level != tt.want {
+				t.Errorf("Readability(%q).Level = %v (score %.1f), want %v", tt.text, r.Level, r.Score, tt.want)
+			}
+			if r.Score < 0 || r.Score > maxScore {
+				t.Errorf("score %v out of [0, %d]", r.Score, maxScore)
+			}
+		})
+	}
+}
+
+func TestCountSyllables(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		word string
+		want int
+	}{
+		{"ev", 1},
+		{"kitab", 2},
+		{"müəllim", 3},
+		{"İstanbul", 3},
+		{"Azərbaycan", 4},
+		{"Азәрбајҹан", 4},
+		{"BMT", 1},
+	}
+	for _, tt := range tests {
+		if got := countSyllables(tt.word); got != tt.want {
+			t.Errorf("countSyllables(%q) = %d, want %d", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestReadabilityOversized(t *testing.T) {
+	t.Parallel()
+
+	if r := Readability(strings.Repeat("a ", maxInputBytes)); r.Level != Unrated || r.Words != 0 {
+		t.Errorf("oversized input: got %+v, want zero report", r)
+	}
+}
+
+func TestReadingLevelJSON(t *testing.T) {
+	t.Parallel()
+
+	for l := Unrated; l <= VeryDifficult; l++ {
+		data, err := json.Marshal(l)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", l, err)
+		}
+		var got ReadingLevel
+		if err := json.Unmarshal(data, &got); err != nil || got != l {
+			t.Errorf("round trip %v: got %v, err %v", l, got, err)
+		}
+	}
+	if s := ReadingLevel(99).String(); s != "ReadingLevel(99)" {
+		t.Errorf("String() = %q, want %q", s, "ReadingLevel(99)")
+	}
+	var l ReadingLevel
+	if err := json.Unmarshal([]byte(`"trivial"`), &l); err == nil {
+		t.Error("expected error for unknown reading level")
+	}
+}
+
+func BenchmarkReadability(b *testing.B) {
+	text := strings.Repeat("Prezident beynəlxalq konfransda iştirak edib. Tədbirdə əməkdaşlıq müzakirə olunub. ", 100)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		Readability(text)
+	}
+}
+
+func ExampleReadability() {
+	r := Readability("Bu gün hava yaxşıdır. Biz parka getdik və orada çox oynadıq.")
+	fmt.Printf("%d sentences, %.1f words/sentence, %.2f syllables/word, %s\n",
+		r.Sentences, r.AvgSentenceLength, r.AvgSyllables, r.Level)
+	// Output:
+	// 2 sentences, 5.5 words/sentence, 1.82 syllables/word, very_easy
+}
@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// SentenceReport is the validation result for one sentence of a text.
+type SentenceReport struct {
+	Text   string  `json:"text"`   // sentence without surrounding whitespace
+	Start  int     `json:"start"`  // byte offset of Text, inclusive
+	End    int     `json:"end"`    // byte offset of Text, exclusive
+	Index  int     `json:"index"`  // zero-based position among the text's sentences
+	Score  int     `json:"score"`  // 0-100, deducted like Report.Score
+	Issues []Issue `json:"issues"` // sorted like Report.Issues
+}
+
+// ValidateSentences validates text like [Validate] and scores each
+// sentence on its own issues, with the same deductions as the document
+// score. Sentences come from [tokenizer.SentenceTokens]; an issue belongs
+// to the sentence it starts in, and an issue in the whitespace before a
+// sentence or at the end of the text (a missing final newline) to the
+// nearest sentence before it. The checks see the whole document, so the
+// script detection and layout checks are the same as for Validate.
+//
+// Returns one report per sentence in text order, or nil for empty,
+// whitespace-only, or oversized (>1 MiB) input.
+// Safe for concurrent use.
+func ValidateSentences(text string) []SentenceReport {
+	if text == "" || len(text) > maxInputBytes {
+		return nil
+	}
+
+	var reports []SentenceReport
+	for _, tok := range tokenizer.SentenceTokens(text) {
+		trimmed := strings.TrimLeftFunc(tok.Text, unicode.IsSpace)
+		if trimmed == "" {
+			continue
+		}
+		start := tok.Start + len(tok.Text) - len(trimmed)
+		trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+		reports = append(reports, SentenceReport{
+			Text:  trimmed,
+			Start: start,
+			End:   start + len(trimmed),
+			Index: len(reports),
+		})
+	}
+	if len(reports) == 0 {
+		return nil
+	}
+
+	// Issues are sorted by offset, so they are assigned in a single pass.
+	k := 0
+	for _, issue := range Validate(text).Issues {
+		for k+1 < len(reports) && reports[k+1].Start <= issue.Start {
+			k++
+		}
+		reports[k].Issues = append(reports[k].Issues, issue)
+	}
+	for i := range reports {
+		reports[i].Score = calculateScore(reports[i].Issues)
+	}
+	return reports
+}
+
+// WorstSentences returns up to n sentences of text with the lowest
+// scores, lowest first and in text order among equal scores, so an editor
+// can go straight to the problem spots of a long article. Sentences
+// without issues are never returned.
+//
+// Returns nil when n <= 0, when no sentence has an issue, or for input
+// that ValidateSentences rejects.
+// Safe for concurrent use.
+func WorstSentences(text string, n int) []SentenceReport {
+	if n <= 0 {
+		return nil
+	}
+	var worst []SentenceReport
+	for _, r := range ValidateSentences(text) {
+		if len(r.Issues) > 0 {
+			worst = append(worst, r)
+		}
+	}
+	slices.SortStableFunc(worst, func(a, b SentenceReport) int {
+		return cmp.Compare(a.Score, b.Score)
+	})
+	if len(worst) > n {
+		worst = worst[:n]
+	}
+	return worst
+}
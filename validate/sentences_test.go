@@ -0,0 +1,129 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const sentencesText = "Bu gün hava yaxşıdır.  Biz 5 kitablar aldıq ,sonra getdik.\n\nMən kitabı oxudum.Yaxşı idi"
+
+func TestValidateSentences(t *testing.T) {
+	t.Parallel()
+
+	reports := ValidateSentences(sentencesText)
+	want := []struct {
+		text   string
+		score  int
+		issues []string // issue messages in order
+	}{
+		{"Bu gün hava yaxşıdır.", 97, []string{"multiple spaces"}},
+		{"Biz 5 kitablar aldıq ,sonra getdik.", 94, []string{"plural noun after a numeral", "space before punctuation"}},
+		{"Mən kitabı oxudum.Yaxşı idi", 96, []string{"missing space after punctuation", "missing final newline"}},
+	}
+	if len(reports) != len(want) {
+		t.Fatalf("got %d sentences, want %d: %+v", len(reports), len(want), reports)
+	}
+	var total []Issue
+	for i, r := range reports {
+		w := want[i]
+		if r.Index != i || r.Text != w.text || sentencesText[r.Start:r.End] != r.Text {
+			t.Errorf("sentence %d = %d %q [%d:%d], want %q", i, r.Index, r.Text, r.Start, r.End, w.text)
+		}
+		if r.Score != w.score || r.Score != calculateScore(r.Issues) {
+			t.Errorf("sentence %d score = %d, want %d", i, r.Score, w.score)
+		}
+		var got []string
+		for _, issue := range r.Issues {
+			got = append(got, issue.Message)
+		}
+		if strings.Join(got, "|") != strings.Join(w.issues, "|") {
+			t.Errorf("sentence %d issues = %q, want %q", i, got, w.issues)
+		}
+		total = append(total, r.Issues...)
+	}
+
+	// Every document issue lands in exactly one sentence.
+	doc := Validate(sentencesText)
+	if len(total) != len(doc.Issues) {
+		t.Errorf("sentences hold %d issues, document has %d", len(total), len(doc.Issues))
+	}
+}
+
+func TestValidateSentencesEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{"empty", "", 0},
+		{"whitespace only", " \n\n\t", 0},
+		{"oversized", strings.Repeat("a", maxInputBytes+1), 0},
+		{"single sentence", "Salam dünya.\n", 1},
+		{"leading blank lines", "\n\nSalam.\n", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ValidateSentences(tt.text); len(got) != tt.want {
+				t.Errorf("ValidateSentences(%q) = %d sentences, want %d", tt.name, len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestWorstSentences(t *testing.T) {
+	t.Parallel()
+
+	worst := WorstSentences(sentencesText, 2)
+	if len(worst) != 2 || worst[0].Index != 1 || worst[1].Index != 2 {
+		t.Fatalf("WorstSentences(2) = %+v, want sentences 1 and 2", worst)
+	}
+	if all := WorstSentences(sentencesText, 10); len(all) != 3 || all[2].Index != 0 {
+		t.Errorf("WorstSentences(10) = %+v, want 3 sentences ending with sentence 0", all)
+	}
+
+	// Equal scores keep text order.
+	tied := WorstSentences("Biz 5 kitablar aldıq. Onlar 3 evlər tikdi.\n", 5)
+	if len(tied) != 2 || tied[0].Index != 0 || tied[1].Index != 1 {
+		t.Errorf("tied WorstSentences = %+v, want sentences 0 and 1", tied)
+	}
+
+	for _, tt := range []struct {
+		text string
+		n    int
+	}{
+		{sentencesText, 0},
+		{sentencesText, -1},
+		{"Bu gün hava yaxşıdır.\n", 3},
+		{"", 3},
+	} {
+		if got := WorstSentences(tt.text, tt.n); got != nil {
+			t.Errorf("WorstSentences(%q, %d) = %+v, want nil", tt.text, tt.n, got)
+		}
+	}
+}
+
+func BenchmarkValidateSentences(b *testing.B) {
+	text := strings.Repeat("Biz 5 kitablar aldıq ,sonra getdik. Bu gün hava yaxşıdır. ", 100)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		ValidateSentences(text)
+	}
+}
+
+func ExampleWorstSentences() {
+	text := "Bu gün hava yaxşıdır. Biz 5 kitablar aldıq ,sonra getdik. Mən kitabı oxudum.\n"
+	for _, r := range WorstSentences(text, 1) {
+		fmt.Printf("%d %d %q\n", r.Index, r.Score, r.Text)
+		for _, issue := range r.Issues {
+			fmt.Printf("  %s: %s\n", issue.Type, issue.Message)
+		}
+	}
+	// Output:
+	// 1 94 "Biz 5 kitablar aldıq ,sonra getdik."
+	//   grammar: plural noun after a numeral
+	//   punctuation: space before punctuation
+}
@@ -1,16 +1,27 @@
 // Package validate provides text quality validation for Azerbaijani text.
 //
-// The validator checks four categories of issues:
+// The validator checks six categories of issues:
 //
 //   - Spelling: misspelled words detected via [spell.IsCorrect] with
 //     suggestions from [spell.Suggest]. Title-case unknown words are
 //     skipped as likely proper nouns.
 //   - Punctuation: spacing errors (space before comma, missing space
 //     after period, double spaces) and repeated punctuation.
-//   - Layout: visually confusable homoglyph characters from the wrong
-//     script (e.g. Cyrillic 'а' U+0430 in a Latin-dominant text).
+//   - Layout: text typed with the English or Russian keyboard layout
+//     active ("rbnf," or "лшефи" for "kitab"), detected with
+//     [spell.FixLayout], and visually confusable homoglyph characters
+//     from the wrong script (e.g. Cyrillic 'а' U+0430 in a Latin-dominant
+//     text).
 //   - Mixed script: tokens entirely in a different script from the
 //     document's dominant script.
+//   - Whitespace: trailing spaces at line ends, missing final newline,
+//     tab/space indentation mix, and mixed CRLF/LF line endings. These
+//     are Info issues; line-level counts are reported in [Report.Stats].
+//   - Grammar: agreement errors found with [morph.Analyze] — a plural
+//     noun after a numeral ("5 kitablar"), a question particle written
+//     apart or against vowel harmony ("gəldin mi", "gəldinmı"), and a
+//     case suffix stacked on another ("evdədə"). These are Warning
+//     issues and are never applied by [Fix].
 //
 // Two API layers are provided:
 //
@@ -19,6 +30,19 @@
 //   - Convenience: [IsValid] returns true when no error-severity issues
 //     exist.
 //
+// [ValidateSentences] scores every sentence on its own issues, and
+// [WorstSentences] returns the lowest-scoring sentences first, so editing
+// tools can take the author straight to the problem spots of a long text.
+//
+// [Fix] applies the mechanical repairs among the issues (spacing,
+// repeated punctuation, keyboard layout, homoglyphs, line endings) and
+// high-confidence spelling corrections, and returns the repaired text.
+// Overlapping edits are resolved deterministically and Fix is idempotent.
+//
+// [Readability] measures how easy a text is to read — average sentence
+// length, syllables per word, type-token ratio — and grades it with a
+// Flesch-style score adapted to Turkish, for educational content.
+//
 // The quality score starts at 100 and deducts points per issue:
 // error −10, warning −3, info −1, with a floor of 0. Score deductions
 // are absolute, not normalized by text length.
@@ -31,13 +55,18 @@
 //     Azerbaijani input skips the spelling check (layout and mixed-script
 //     checks still run).
 //   - Compound word splitting is not supported (inherited from spell).
-//   - Grammar checking (word order, agreement) is not performed.
+//   - Grammar checking covers only the agreement patterns above; word
+//     order and government are not checked. Only a numeral directly
+//     before the noun is considered ("5 böyük kitablar" is not flagged).
 //   - Arabic script is not supported.
 //   - Title-case heuristic may skip genuine misspellings that happen to
 //     be capitalized.
-//   - Only homoglyph detection is performed, not full keyboard layout
-//     mapping.
+//   - Keyboard layout errors are recognized only when the converted text
+//     is a known word of at least three letters, and only for the English
+//     and Russian layouts.
 //   - Bracket/quote matching is not supported (v2).
+//   - The readability score's coefficients were fitted on Turkish texts
+//     and have not been calibrated on Azerbaijani graded readers.
 package validate
 
 import (
@@ -55,8 +84,10 @@ type IssueType int
 const (
 	Spelling    IssueType = iota // misspelled word
 	Punctuation                  // punctuation error
-	Layout                       // wrong keyboard layout (homoglyph)
+	Layout                       // wrong keyboard layout or homoglyph
 	MixedScript                  // mixed script usage
+	Whitespace                   // whitespace hygiene (trailing spaces, line endings)
+	Grammar                      // agreement and suffix errors
 )
 
 // issueTypeNames maps IssueType values to their string names.
@@ -65,6 +96,8 @@ var issueTypeNames = [...]string{
 	Punctuation: "punctuation",
 	Layout:      "layout",
 	MixedScript: "mixed_script",
+	Whitespace:  "whitespace",
+	Grammar:     "grammar",
 }
 
 // issueTypeFromName maps string names back to IssueType values.
@@ -73,6 +106,8 @@ var issueTypeFromName = map[string]IssueType{
 	"punctuation":  Punctuation,
 	"layout":       Layout,
 	"mixed_script": MixedScript,
+	"whitespace":   Whitespace,
+	"grammar":      Grammar,
 }
 
 // String returns the name of the issue type.
@@ -168,6 +203,7 @@ type Issue struct {
 type Report struct {
 	Score  int     `json:"score"`  // 0-100, higher is better
 	Issues []Issue `json:"issues"` // sorted by byte offset, then severity desc
+	Stats  Stats   `json:"stats"`  // line-level layout counts
 }
 
 const (
@@ -182,7 +218,8 @@ const (
 
 // Validate checks text for quality issues.
 // Returns a Report with a quality score (0-100) and positioned issues.
-// All checks run: spelling, punctuation, layout (homoglyphs), mixed script.
+// All checks run: spelling, punctuation, layout (keyboard layout and
+// homoglyphs), mixed script, whitespace, grammar.
 // Empty or oversized (>1 MiB) input returns Report{Score: 100, Issues: nil}.
 // Safe for concurrent use.
 func Validate(text string) Report {
@@ -195,13 +232,19 @@ func Validate(text string) Report {
 		return Report{Score: maxScore}
 	}
 
-	detection := detect.Detect(text)
+	detection := detectText(text)
+	lines := splitLines(text)
+	stats := computeStats(text, lines)
 
 	var issues []Issue
+	// Layout runs first: spelling and mixed script skip the words it
+	// reports as typed with the wrong keyboard layout.
+	issues = appendLayoutIssues(issues, tokens, detection)
 	issues = appendSpellingIssues(issues, tokens, detection)
 	issues = appendPunctuationIssues(issues, tokens)
-	issues = appendLayoutIssues(issues, tokens, detection)
 	issues = appendMixedScriptIssues(issues, tokens, detection)
+	issues = appendWhitespaceIssues(issues, text, lines, stats)
+	issues = appendGrammarIssues(issues, tokens, detection)
 
 	// Cap total issues.
 	if len(issues) > maxIssues {
@@ -229,7 +272,19 @@ func Validate(text string) Report {
 	return Report{
 		Score:  calculateScore(issues),
 		Issues: issues,
+		Stats:  stats,
+	}
+}
+
+// detectText detects the language of text, reporting the script hint
+// detect.ScriptLatnTranslit as detect.ScriptLatn: the checks depend only
+// on the alphabet, not on the spelling habits.
+func detectText(text string) detect.Result {
+	r := detect.Detect(text)
+	if r.Script == detect.ScriptLatnTranslit {
+		r.Script = detect.ScriptLatn
 	}
+	return r
 }
 
 // IsValid reports whether text has no error-severity issues.
@@ -247,7 +302,7 @@ func IsValid(text string) bool {
 		return true
 	}
 
-	detection := detect.Detect(text)
+	detection := detectText(text)
 
 	// Run each check and return false as soon as any error is found.
 	for _, check := range []func([]Issue, []tokenizer.Token, detect.Result) []Issue{
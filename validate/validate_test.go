@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/detect"
 )
 
 // ---------------------------------------------------------------------------
@@ -238,6 +240,55 @@ func TestValidateLayout(t *testing.T) {
 	}
 }
 
+func TestValidateKeyboardLayout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		wantText string
+		wantSugg string
+	}{
+		{"cyrillic typed on english", "Bu rbnf, çox maraqlıdır.", "rbnf,", "kitab"},
+		{"latin typed on english", "Mən g'l. dedim", "g'l", "gəl"},
+		{"latin typed on russian", "Bu лшефи yaxşıdır.", "лшефи", "kitab"},
+		{"trailing comma kept", "Cfkfv, dostlar!", "Cfkfv", "Salam"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			report := Validate(tt.input)
+			var layout []Issue
+			for _, issue := range report.Issues {
+				switch issue.Type {
+				case Layout:
+					layout = append(layout, issue)
+				case Spelling, MixedScript:
+					t.Errorf("unexpected %v issue %q", issue.Type, issue.Text)
+				}
+			}
+			if len(layout) != 1 {
+				t.Fatalf("got %d layout issues, want 1: %v", len(layout), report.Issues)
+			}
+			is := layout[0]
+			if is.Text != tt.wantText || is.Suggestion != tt.wantSugg || is.Message != msgKeyboardLayout {
+				t.Errorf("layout issue = %+v, want %q → %q", is, tt.wantText, tt.wantSugg)
+			}
+			if tt.input[is.Start:is.End] != is.Text {
+				t.Errorf("input[%d:%d] = %q, want %q", is.Start, is.End, tt.input[is.Start:is.End], is.Text)
+			}
+		})
+	}
+
+	for _, input := range []string{"Bu kitab idi.", "Salam, dünya!", "The lazy dog."} {
+		for _, issue := range Validate(input).Issues {
+			if issue.Message == msgKeyboardLayout {
+				t.Errorf("Validate(%q): unexpected keyboard layout issue %q", input, issue.Text)
+			}
+		}
+	}
+}
+
 // ---------------------------------------------------------------------------
 // TestValidateMixedScript
 // ---------------------------------------------------------------------------
@@ -264,6 +315,23 @@ func TestValidateMixedScript(t *testing.T) {
 	}
 }
 
+// TestValidateMixedScriptTranslit verifies that Latin text detected as
+// transliterated is still checked as Latin.
+func TestValidateMixedScriptTranslit(t *testing.T) {
+	t.Parallel()
+
+	input := "Men seni chox sevirem, sen yakhshi \u041c\u043e\u0441\u043a\u0432\u0430 insansan."
+	if det := detect.Detect(input); det.Script != detect.ScriptLatnTranslit {
+		t.Fatalf("test setup: Detect(%q).Script = %v, want Latn-translit", input, det.Script)
+	}
+	for _, issue := range Validate(input).Issues {
+		if issue.Type == MixedScript {
+			return
+		}
+	}
+	t.Errorf("expected mixed-script issue in %q", input)
+}
+
 // TestNoDoubleReporting verifies all-homoglyph tokens are flagged as Layout
 // only, not also as MixedScript.
 func TestNoDoubleReporting(t *testing.T) {
@@ -411,6 +479,8 @@ func TestIssueTypeJSON(t *testing.T) {
 		{Punctuation, "punctuation"},
 		{Layout, "layout"},
 		{MixedScript, "mixed_script"},
+		{Whitespace, "whitespace"},
+		{Grammar, "grammar"},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,171 @@
+package validate
+
+import "strings"
+
+// ── Whitespace hygiene check ───────────────────────────────────────────
+
+// Whitespace issue messages. Fix recognizes fixable issues by message.
+const (
+	msgTrailingWhitespace  = "trailing whitespace"
+	msgMixedIndent         = "inconsistent indentation (tabs and spaces)"
+	msgMixedLineEndings    = "mixed line endings"
+	msgMissingFinalNewline = "missing final newline"
+)
+
+// Stats holds document-level layout counts gathered during validation.
+// Useful for corpus hygiene dashboards and CI gates.
+type Stats struct {
+	Lines          int  `json:"lines"`           // number of lines (a final unterminated line counts)
+	TrailingSpaces int  `json:"trailing_spaces"` // lines ending in spaces or tabs
+	TabIndented    int  `json:"tab_indented"`    // lines whose indentation starts with a tab
+	SpaceIndented  int  `json:"space_indented"`  // lines whose indentation starts with a space
+	LFEndings      int  `json:"lf_endings"`      // lines terminated by "\n"
+	CRLFEndings    int  `json:"crlf_endings"`    // lines terminated by "\r\n"
+	FinalNewline   bool `json:"final_newline"`   // text ends with a line terminator
+}
+
+// lineInfo describes one line of the input with byte offsets.
+type lineInfo struct {
+	start    int    // offset of the first byte of the line
+	end      int    // offset just past the last content byte (before terminator)
+	eol      string // "\n", "\r\n", or "" for the final unterminated line
+	trailing int    // offset where trailing spaces/tabs begin (== end if none)
+	indent   int    // offset where leading spaces/tabs end
+}
+
+// indented reports whether the line has content after leading spaces or
+// tabs. Whitespace-only lines count as trailing whitespace, not indentation.
+func (li lineInfo) indented() bool {
+	return li.indent > li.start && li.indent < li.trailing
+}
+
+// splitLines splits text into lines, recording terminators and
+// leading/trailing horizontal whitespace boundaries.
+func splitLines(text string) []lineInfo {
+	var lines []lineInfo
+	for start := 0; start < len(text); {
+		li := lineInfo{start: start}
+		nl := strings.IndexByte(text[start:], '\n')
+		switch {
+		case nl < 0:
+			li.end = len(text)
+		case nl > 0 && text[start+nl-1] == '\r':
+			li.end = start + nl - 1
+			li.eol = "\r\n"
+		default:
+			li.end = start + nl
+			li.eol = "\n"
+		}
+		content := text[li.start:li.end]
+		li.trailing = li.start + len(strings.TrimRight(content, " \t"))
+		li.indent = li.end - len(strings.TrimLeft(content, " \t"))
+		lines = append(lines, li)
+		start = li.end + len(li.eol)
+	}
+	return lines
+}
+
+// computeStats gathers line-level layout statistics for text.
+func computeStats(text string, lines []lineInfo) Stats {
+	st := Stats{
+		Lines:        len(lines),
+		FinalNewline: strings.HasSuffix(text, "\n"),
+	}
+	for _, li := range lines {
+		if li.trailing < li.end {
+			st.TrailingSpaces++
+		}
+		if li.indented() {
+			if text[li.start] == '\t' {
+				st.TabIndented++
+			} else {
+				st.SpaceIndented++
+			}
+		}
+		switch li.eol {
+		case "\n":
+			st.LFEndings++
+		case "\r\n":
+			st.CRLFEndings++
+		}
+	}
+	return st
+}
+
+// appendWhitespaceIssues reports trailing whitespace, missing final
+// newline, inconsistent indentation, and mixed line endings. All are
+// Info severity. Checks that compare lines (final newline, indentation,
+// line endings) only apply to multi-line text, so single-line strings are
+// never penalized for lacking a terminator.
+func appendWhitespaceIssues(issues []Issue, text string, lines []lineInfo, st Stats) []Issue {
+	multiline := st.LFEndings+st.CRLFEndings > 0
+
+	// Minority indentation style is flagged; ties flag the space-indented lines.
+	flagTabs := st.TabIndented > 0 && st.SpaceIndented > 0 && st.TabIndented < st.SpaceIndented
+	flagSpaces := st.TabIndented > 0 && st.SpaceIndented > 0 && !flagTabs
+
+	// Minority line ending is flagged; ties flag CRLF (LF is the default).
+	mixedEOL := st.LFEndings > 0 && st.CRLFEndings > 0
+	flagEOL, wantEOL := "\r\n", "\n"
+	if mixedEOL && st.CRLFEndings > st.LFEndings {
+		flagEOL, wantEOL = "\n", "\r\n"
+	}
+
+	for _, li := range lines {
+		if len(issues) >= maxIssues {
+			return issues
+		}
+		if li.trailing < li.end {
+			issues = append(issues, Issue{
+				Text:     text[li.trailing:li.end],
+				Start:    li.trailing,
+				End:      li.end,
+				Type:     Whitespace,
+				Severity: Info,
+				Message:  msgTrailingWhitespace,
+			})
+		}
+		if li.indented() {
+			isTab := text[li.start] == '\t'
+			if isTab && flagTabs || !isTab && flagSpaces {
+				issues = append(issues, Issue{
+					Text:     text[li.start:li.indent],
+					Start:    li.start,
+					End:      li.indent,
+					Type:     Whitespace,
+					Severity: Info,
+					Message:  msgMixedIndent,
+				})
+			}
+		}
+		if mixedEOL && li.eol == flagEOL {
+			issues = append(issues, Issue{
+				Text:       li.eol,
+				Start:      li.end,
+				End:        li.end + len(li.eol),
+				Type:       Whitespace,
+				Severity:   Info,
+				Message:    msgMixedLineEndings,
+				Suggestion: wantEOL,
+			})
+		}
+	}
+
+	if multiline && !st.FinalNewline && len(issues) < maxIssues {
+		eol := "\n"
+		if st.CRLFEndings > st.LFEndings {
+			eol = "\r\n"
+		}
+		issues = append(issues, Issue{
+			Text:       "",
+			Start:      len(text),
+			End:        len(text),
+			Type:       Whitespace,
+			Severity:   Info,
+			Message:    msgMissingFinalNewline,
+			Suggestion: eol,
+		})
+	}
+
+	return issues
+}
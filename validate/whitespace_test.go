@@ -0,0 +1,130 @@
+package validate
+
+import (
+	"testing"
+)
+
+func whitespaceIssues(r Report) []Issue {
+	var out []Issue
+	for _, is := range r.Issues {
+		if is.Type == Whitespace {
+			out = append(out, is)
+		}
+	}
+	return out
+}
+
+func TestValidateWhitespace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		messages []string
+	}{
+		{"single line no newline", "Bu kitab gözəldir.", nil},
+		{"clean multiline", "Bu kitab.\nO ev.\n", nil},
+		{"trailing space", "Bu kitab. \nO ev.\n", []string{"trailing whitespace"}},
+		{"trailing tab", "Bu kitab.\t\nO ev.\n", []string{"trailing whitespace"}},
+		{"missing final newline", "Bu kitab.\nO ev.", []string{"missing final newline"}},
+		{"mixed line endings", "Bir.\r\nİki.\r\nÜç.\n", []string{"mixed line endings"}},
+		{"mixed indentation", "Bir:\n\tiki\n\tüç\n dörd\n", []string{"inconsistent indentation (tabs and spaces)"}},
+		{
+			"mixed indentation with trailing whitespace",
+			"Bir:\n\tiki \n  üç\n  dörd\n",
+			[]string{"inconsistent indentation (tabs and spaces)", "trailing whitespace"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := whitespaceIssues(Validate(tt.input))
+			if len(got) != len(tt.messages) {
+				t.Fatalf("Validate(%q): got %d whitespace issues %v, want %v", tt.input, len(got), got, tt.messages)
+			}
+			for i, is := range got {
+				if is.Message != tt.messages[i] {
+					t.Errorf("issue[%d].Message = %q, want %q", i, is.Message, tt.messages[i])
+				}
+				if is.Severity != Info {
+					t.Errorf("issue[%d].Severity = %v, want info", i, is.Severity)
+				}
+				if tt.input[is.Start:is.End] != is.Text {
+					t.Errorf("issue[%d]: input[%d:%d] = %q, want %q", i, is.Start, is.End, tt.input[is.Start:is.End], is.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateWhitespaceOffsets(t *testing.T) {
+	t.Parallel()
+
+	in := "Bir.\r\nİki.  \nÜç.\n"
+	got := whitespaceIssues(Validate(in))
+	if len(got) != 2 {
+		t.Fatalf("got %v, want 2 whitespace issues", got)
+	}
+	// CRLF is the minority ending (1 vs 2 LF).
+	if got[0].Message != "mixed line endings" || got[0].Start != 4 || got[0].End != 6 || got[0].Suggestion != "\n" {
+		t.Errorf("got[0] = %+v, want mixed line endings at [4:6] suggesting LF", got[0])
+	}
+	if got[1].Message != "trailing whitespace" || got[1].Text != "  " {
+		t.Errorf("got[1] = %+v, want trailing whitespace \"  \"", got[1])
+	}
+}
+
+func TestValidateStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  Stats
+	}{
+		{"single line", "Salam dünya", Stats{Lines: 1}},
+		{"lf lines", "a b\nc d\n", Stats{Lines: 2, LFEndings: 2, FinalNewline: true}},
+		{"crlf lines", "a b\r\nc d\r\n", Stats{Lines: 2, CRLFEndings: 2, FinalNewline: true}},
+		{"unterminated last line", "a\nb", Stats{Lines: 2, LFEndings: 1}},
+		{
+			"indentation and trailing",
+			"x\n\ty\n  z \n",
+			Stats{Lines: 3, TrailingSpaces: 1, TabIndented: 1, SpaceIndented: 1, LFEndings: 3, FinalNewline: true},
+		},
+		{
+			"indented line with trailing whitespace",
+			"Salam.\n\tSalam dünya.  \n\tSalam.\n    Salam.\n",
+			Stats{Lines: 4, TrailingSpaces: 1, TabIndented: 2, SpaceIndented: 1, LFEndings: 4, FinalNewline: true},
+		},
+		{"blank whitespace line", "a\n   \nb\n", Stats{Lines: 3, TrailingSpaces: 1, LFEndings: 3, FinalNewline: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := Validate(tt.input).Stats; got != tt.want {
+				t.Errorf("Validate(%q).Stats = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateWhitespaceIsValid(t *testing.T) {
+	t.Parallel()
+	// Whitespace issues are Info only and never make text invalid.
+	if !IsValid("Bu kitab. \r\nO ev.\n\tSon") {
+		t.Error("IsValid = false, want true for whitespace-only issues")
+	}
+}
+
+func BenchmarkSplitLines(b *testing.B) {
+	text := ""
+	for range 200 {
+		text += "Bu kitab çox gözəldir. \r\n\tİkinci sətir.\n"
+	}
+	for b.Loop() {
+		lines := splitLines(text)
+		computeStats(text, lines)
+	}
+}